@@ -18,16 +18,19 @@ package immuadmin
 
 import (
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/codenotary/immudb/cmd/helper"
 	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/embedded/tbtree"
@@ -45,6 +48,8 @@ func addDbUpdateFlags(c *cobra.Command) {
 		"do not include server-side timestamps in commit checksums, useful when reproducibility is a desired feature")
 	c.Flags().Bool("embedded-values", false, "store values in the tx header")
 	c.Flags().Bool("prealloc-files", false, "enable file preallocation")
+	c.Flags().String("hash-algorithm", "sha256", "hash algorithm used by Merkle structures and proofs: sha256, sha512_256 or blake3 (only at database creation)")
+	c.Flags().Bool("authenticated-index", false, "enable proofs of membership and non-membership of keys (only at database creation)")
 	c.Flags().Bool("replication-enabled", false, "set database as a replica") // deprecated, use replication-is-replica instead
 	c.Flags().Bool("replication-is-replica", false, "set database as a replica")
 	c.Flags().Bool("replication-sync-enabled", false, "enable synchronous replication")
@@ -74,6 +79,15 @@ func addDbUpdateFlags(c *cobra.Command) {
 	c.Flags().Bool("autoload", true, "enable database autoloading")
 	c.Flags().Duration("retention-period", 0, "duration of time to retain data in storage")
 	c.Flags().Duration("truncation-frequency", database.DefaultTruncationFrequency, "set the truncation frequency for the database")
+	c.Flags().String("cold-storage-dir", "", "absolute path of the server directory where older values are moved (only at database creation)")
+	c.Flags().Duration("hot-retention-period", 0, "values of transactions older than this period are moved into the cold storage dir (0 disables migration)")
+	c.Flags().Duration("value-tiering-frequency", database.DefaultTieringFrequency, "set how often values are migrated into the cold storage dir")
+	c.Flags().Duration("sql-statement-timeout", 0, "maximum amount of time a SQL statement may run before being canceled (0 means no timeout)")
+	c.Flags().Uint64("sql-query-memory-limit", 0, "maximum number of bytes a SQL statement may use to sort, group or remove duplicated rows (0 means no limit)")
+	c.Flags().String("compression-format", "none", "compression format of newly created value files: none, flate, gzip, lzw, zlib, zstd or lz4")
+	c.Flags().Uint32("compression-level", 0, "compression level, from 1 (best speed) to 9 (best compression), 0 means the default level")
+	c.Flags().String("compression-dictionary", "", "file holding a raw dictionary used by zstd compression (an empty value removes the dictionary)")
+	c.Flags().String("compression-dictionary-samples", "", "directory holding sample records, one per file, used to train the dictionary used by zstd compression")
 
 	flagNameMapping := map[string]string{
 		"replication-enabled":           "replication-is-replica",
@@ -98,7 +112,7 @@ func (cl *commandline) database(cmd *cobra.Command) {
 		Short:             "Issue all database commands",
 		Aliases:           []string{"d"},
 		PersistentPostRun: cl.disconnect,
-		ValidArgs:         []string{"list", "create", "load", "unload", "delete", "update", "use", "flush", "compact", "truncate"},
+		ValidArgs:         []string{"list", "create", "load", "unload", "delete", "update", "use", "flush", "compact", "rebuild", "verify", "truncate"},
 	}
 
 	listCmd := &cobra.Command{
@@ -346,6 +360,89 @@ func (cl *commandline) database(cmd *cobra.Command) {
 		Args: cobra.ExactArgs(0),
 	}
 
+	rebuildCmd := &cobra.Command{
+		Use:               "rebuild",
+		Short:             "Rebuild database index from the transaction log while it keeps serving requests",
+		Example:           "rebuild",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.RebuildIndex(cl.context)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "database index successfully rebuilt\n")
+			return nil
+		},
+		Args: cobra.ExactArgs(0),
+	}
+
+	verifyCmd := &cobra.Command{
+		Use:               "verify",
+		Short:             "Verify database index against the transaction log",
+		Example:           "verify --max-discrepancies 10",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maxDiscrepancies, err := cmd.Flags().GetUint32("max-discrepancies")
+			if err != nil {
+				return err
+			}
+
+			res, err := cl.immuClient.VerifyIndex(cl.context, maxDiscrepancies)
+			if err != nil {
+				return err
+			}
+
+			var discrepancyCount uint64
+
+			for _, idx := range res.Indexes {
+				fmt.Fprintf(cmd.OutOrStdout(), "index (source prefix: %q, target prefix: %q) verified up to tx %d: %d expected entries, %d indexed entries, %d discrepancies\n",
+					idx.SourcePrefix, idx.TargetPrefix, idx.IndexedTxID, idx.ExpectedEntries, idx.IndexedEntries, idx.DiscrepancyCount)
+
+				for _, d := range idx.Discrepancies {
+					fmt.Fprintf(cmd.OutOrStdout(), "\t%s: key %q at tx %d\n", d.Reason, d.Key, d.TxID)
+				}
+
+				discrepancyCount += idx.DiscrepancyCount
+			}
+
+			if discrepancyCount > 0 {
+				return fmt.Errorf("database index is not consistent with the transaction log, %d discrepancies found", discrepancyCount)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "database index successfully verified\n")
+			return nil
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	verifyCmd.Flags().Uint32("max-discrepancies", 0, "max number of discrepancies reported per index (default limit if not specified)")
+
+	compactValuesCmd := &cobra.Command{
+		Use:               "compact-values",
+		Short:             "Physically remove expired values and values of deleted keys, transactions are kept intact",
+		Example:           "compact-values --up-to-tx 1000",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			upToTxID, err := cmd.Flags().GetUint64("up-to-tx")
+			if err != nil {
+				return err
+			}
+
+			n, err := cl.immuClient.CompactValues(cl.context, upToTxID)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "database values successfully compacted, %d values removed\n", n)
+			return nil
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	compactValuesCmd.Flags().Uint64("up-to-tx", 0, "compact values of entries committed up to this transaction (last committed transaction if not specified)")
+
 	truncateCmd := &cobra.Command{
 		Use:               "truncate",
 		Short:             "Truncate database (unrecoverable operation)",
@@ -391,6 +488,9 @@ func (cl *commandline) database(cmd *cobra.Command) {
 	dbCmd.AddCommand(updateCmd)
 	dbCmd.AddCommand(flushCmd)
 	dbCmd.AddCommand(compactCmd)
+	dbCmd.AddCommand(rebuildCmd)
+	dbCmd.AddCommand(verifyCmd)
+	dbCmd.AddCommand(compactValuesCmd)
 	dbCmd.AddCommand(truncateCmd)
 	dbCmd.AddCommand(cl.createExportCmd())
 	dbCmd.AddCommand(cl.createImportCmd())
@@ -626,6 +726,36 @@ func formatInsertValue(v string, colType string) string {
 	return v
 }
 
+// trainCompressionDictionary trains a zstd dictionary out of the files within samplesDir
+func trainCompressionDictionary(samplesDir string) ([]byte, error) {
+	entries, err := os.ReadDir(samplesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples [][]byte
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		sample, err := os.ReadFile(filepath.Join(samplesDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		samples = append(samples, sample)
+	}
+
+	dict := appendable.TrainCompressionDictionary(samples, appendable.DefaultCompressionDictionarySize)
+	if len(dict) == 0 {
+		return nil, fmt.Errorf("no content shared by the samples found in '%s'", samplesDir)
+	}
+
+	return dict, nil
+}
+
 func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNullableSettings, error) {
 	var err error
 
@@ -673,6 +803,17 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, nil
 	}
 
+	condUInt64 := func(name string) (*schema.NullableUint64, error) {
+		if flags.Changed(name) {
+			val, err := flags.GetUint64(name)
+			if err != nil {
+				return nil, err
+			}
+			return &schema.NullableUint64{Value: val}, nil
+		}
+		return nil, nil
+	}
+
 	condDuration := func(name string) (*schema.NullableMilliseconds, error) {
 		if flags.Changed(name) {
 			val, err := flags.GetDuration(name)
@@ -704,6 +845,16 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
+	ret.HashAlgorithm, err = condString("hash-algorithm")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.AuthenticatedIndex, err = condBool("authenticated-index")
+	if err != nil {
+		return nil, err
+	}
+
 	ret.ReplicationSettings.Replica, err = condBool("replication-is-replica")
 	if err != nil {
 		return nil, err
@@ -824,6 +975,62 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
+	ret.StatementTimeout, err = condDuration("sql-statement-timeout")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.QueryMemoryLimit, err = condUInt64("sql-query-memory-limit")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.CompressionFormat, err = condString("compression-format")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.CompressionLevel, err = condUInt32("compression-level")
+	if err != nil {
+		return nil, err
+	}
+
+	if flags.Changed("compression-dictionary") && flags.Changed("compression-dictionary-samples") {
+		return nil, errors.New("compression-dictionary and compression-dictionary-samples can not be both specified")
+	}
+
+	if flags.Changed("compression-dictionary-samples") {
+		samplesDir, err := flags.GetString("compression-dictionary-samples")
+		if err != nil {
+			return nil, err
+		}
+
+		dict, err := trainCompressionDictionary(samplesDir)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.CompressionDictionary = &schema.NullableBytes{Value: dict}
+	}
+
+	if flags.Changed("compression-dictionary") {
+		dictFile, err := flags.GetString("compression-dictionary")
+		if err != nil {
+			return nil, err
+		}
+
+		var dict []byte
+
+		if dictFile != "" {
+			dict, err = os.ReadFile(dictFile)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ret.CompressionDictionary = &schema.NullableBytes{Value: dict}
+	}
+
 	retentionPeriod, err := condDuration("retention-period")
 	if err != nil {
 		return nil, err
@@ -841,6 +1048,29 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		}
 	}
 
+	coldStorageDir, err := condString("cold-storage-dir")
+	if err != nil {
+		return nil, err
+	}
+
+	hotRetentionPeriod, err := condDuration("hot-retention-period")
+	if err != nil {
+		return nil, err
+	}
+
+	migrationFrequency, err := condDuration("value-tiering-frequency")
+	if err != nil {
+		return nil, err
+	}
+
+	if coldStorageDir != nil || hotRetentionPeriod != nil || migrationFrequency != nil {
+		ret.ValueTieringSettings = &schema.ValueTieringNullableSettings{
+			ColdStorageDir:     coldStorageDir,
+			HotRetentionPeriod: hotRetentionPeriod,
+			MigrationFrequency: migrationFrequency,
+		}
+	}
+
 	return ret, nil
 }
 
@@ -863,6 +1093,14 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 		propertiesStr = append(propertiesStr, fmt.Sprintf("prealloc-files: %v", settings.PreallocFiles.GetValue()))
 	}
 
+	if settings.HashAlgorithm != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("hash-algorithm: %s", settings.GetHashAlgorithm().GetValue()))
+	}
+
+	if settings.AuthenticatedIndex != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("authenticated-index: %v", settings.AuthenticatedIndex.GetValue()))
+	}
+
 	if settings.WriteTxHeaderVersion != nil {
 		propertiesStr = append(propertiesStr, fmt.Sprintf("write-tx-header-version: %d", settings.WriteTxHeaderVersion.GetValue()))
 	}
@@ -888,6 +1126,27 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 		propertiesStr = append(propertiesStr, fmt.Sprintf("autoload: %v", settings.Autoload.GetValue()))
 	}
 
+	if settings.StatementTimeout != nil {
+		timeout := time.Duration(settings.GetStatementTimeout().GetValue()) * time.Millisecond
+		propertiesStr = append(propertiesStr, fmt.Sprintf("sql-statement-timeout: %v", timeout))
+	}
+
+	if settings.QueryMemoryLimit != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("sql-query-memory-limit: %d", settings.GetQueryMemoryLimit().GetValue()))
+	}
+
+	if settings.CompressionFormat != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("compression-format: %s", settings.GetCompressionFormat().GetValue()))
+	}
+
+	if settings.CompressionLevel != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("compression-level: %d", settings.GetCompressionLevel().GetValue()))
+	}
+
+	if settings.CompressionDictionary != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("compression-dictionary: %d bytes", len(settings.GetCompressionDictionary().GetValue())))
+	}
+
 	if settings.TruncationSettings != nil {
 		if settings.TruncationSettings.RetentionPeriod != nil {
 			retDur := time.Duration(settings.TruncationSettings.GetRetentionPeriod().GetValue()) * time.Millisecond
@@ -900,6 +1159,22 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 		}
 	}
 
+	if settings.ValueTieringSettings != nil {
+		if settings.ValueTieringSettings.ColdStorageDir != nil {
+			propertiesStr = append(propertiesStr, fmt.Sprintf("cold-storage-dir: %s", settings.ValueTieringSettings.GetColdStorageDir().GetValue()))
+		}
+
+		if settings.ValueTieringSettings.HotRetentionPeriod != nil {
+			hotDur := time.Duration(settings.ValueTieringSettings.GetHotRetentionPeriod().GetValue()) * time.Millisecond
+			propertiesStr = append(propertiesStr, fmt.Sprintf("hot-retention-period: %v", hotDur))
+		}
+
+		if settings.ValueTieringSettings.MigrationFrequency != nil {
+			freq := time.Duration(settings.ValueTieringSettings.GetMigrationFrequency().GetValue()) * time.Millisecond
+			propertiesStr = append(propertiesStr, fmt.Sprintf("value-tiering-frequency: %v", freq))
+		}
+	}
+
 	return strings.Join(propertiesStr, ", ")
 }
 
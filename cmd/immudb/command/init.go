@@ -88,6 +88,11 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().String("s3-path-prefix", "", "s3 path prefix (multiple immudb instances can share the same bucket if they have different prefixes)")
 	cmd.Flags().Bool("s3-external-identifier", false, "use the remote identifier if there is no local identifier")
 	cmd.Flags().String("s3-instance-metadata-url", "http://169.254.169.254", "s3 instance metadata url")
+	cmd.Flags().String("encryption-keyfile", "", "keyfile used to encrypt data at rest of newly created databases, one '<key id> <hex encoded key>' per line (the last key is used for new files)")
+	cmd.Flags().String("encryption-key-plugin", "", "executable used to retrieve encryption keys from an external key management service (e.g. KMIP or Vault)")
+	cmd.Flags().String("value-tiering-cold-storage-dir", "", "absolute path of the directory where older values of defaultdb are moved (once set it must not be changed)")
+	cmd.Flags().Duration("value-tiering-hot-retention-period", 0, "values of defaultdb transactions older than this period are moved into the cold storage dir (0 disables migration)")
+	cmd.Flags().Duration("value-tiering-frequency", options.ValueTieringOptions.MigrationFrequency, "how often values of defaultdb are migrated into the cold storage dir")
 	cmd.Flags().Int("max-sessions", 100, "maximum number of simultaneously opened sessions")
 	cmd.Flags().Duration("max-session-inactivity-time", 3*time.Minute, "max session inactivity time is a duration after which an active session is declared inactive by the server. A session is kept active if server is still receiving requests from client (keep-alive or other methods)")
 	cmd.Flags().Duration("max-session-age-time", 0, "the current default value is infinity. max session age time is a duration after which session will be forcibly closed")
@@ -159,6 +164,11 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("s3-path-prefix", "")
 	viper.SetDefault("s3-external-identifier", false)
 	viper.SetDefault("s3-instance-metadata-url", "http://169.254.169.254")
+	viper.SetDefault("encryption-keyfile", "")
+	viper.SetDefault("encryption-key-plugin", "")
+	viper.SetDefault("value-tiering-cold-storage-dir", "")
+	viper.SetDefault("value-tiering-hot-retention-period", 0)
+	viper.SetDefault("value-tiering-frequency", options.ValueTieringOptions.MigrationFrequency)
 	viper.SetDefault("max-sessions", 100)
 	viper.SetDefault("max-session-inactivity-time", 3*time.Minute)
 	viper.SetDefault("max-session-age-time", 0)
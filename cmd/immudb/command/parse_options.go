@@ -119,6 +119,15 @@ func parseOptions() (options *server.Options, err error) {
 		WithS3ExternalIdentifier(s3ExternalIdentifier).
 		WithS3InstanceMetadataURL(s3MetadataURL)
 
+	encryptionOptions := server.DefaultEncryptionOptions().
+		WithKeyFile(viper.GetString("encryption-keyfile")).
+		WithKeyPlugin(viper.GetString("encryption-key-plugin"))
+
+	valueTieringOptions := server.DefaultValueTieringOptions().
+		WithColdStorageDir(viper.GetString("value-tiering-cold-storage-dir")).
+		WithHotRetentionPeriod(viper.GetDuration("value-tiering-hot-retention-period")).
+		WithMigrationFrequency(viper.GetDuration("value-tiering-frequency"))
+
 	sessionOptions := sessions.DefaultOptions().
 		WithMaxSessions(viper.GetInt("max-sessions")).
 		WithSessionGuardCheckInterval(viper.GetDuration("sessions-guard-check-interval")).
@@ -155,6 +164,8 @@ func parseOptions() (options *server.Options, err error) {
 		WithSigningKey(signingKey).
 		WithSynced(synced).
 		WithRemoteStorageOptions(remoteStorageOptions).
+		WithEncryptionOptions(encryptionOptions).
+		WithValueTieringOptions(valueTieringOptions).
 		WithTokenExpiryTime(tokenExpTime).
 		WithMetricsServer(metricsServer).
 		WithMetricsServerPort(metricsServerPort).
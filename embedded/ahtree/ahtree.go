@@ -29,6 +29,7 @@ import (
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/cache"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/multierr"
 )
 
@@ -42,6 +43,7 @@ var ErrEmptyTree = errors.New("ahtree: empty tree")
 var ErrReadOnly = errors.New("ahtree: read-only mode")
 var ErrUnexistentData = errors.New("ahtree: attempt to read unexistent data")
 var ErrCannotResetToLargerSize = errors.New("ahtree: can not reset the tree to a larger size")
+var ErrIncompatibleHashAlgorithm = errors.New("ahtree: tree was built with a different hash algorithm")
 
 const LeafPrefix = byte(0)
 const NodePrefix = byte(1)
@@ -49,7 +51,8 @@ const NodePrefix = byte(1)
 const Version = 1
 
 const (
-	MetaVersion = "VERSION"
+	MetaVersion       = "VERSION"
+	MetaHashAlgorithm = "HASH_ALGORITHM"
 )
 
 const cLogEntrySize = offsetSize + szSize
@@ -77,6 +80,8 @@ type AHtree struct {
 	syncThld int
 	readOnly bool
 
+	hashAlgorithm hashing.Algorithm
+
 	closed bool
 	mutex  sync.Mutex
 
@@ -105,6 +110,7 @@ func Open(path string, opts *Options) (*AHtree, error) {
 
 	metadata := appendable.NewMetadata(nil)
 	metadata.PutInt(MetaVersion, Version)
+	metadata.PutInt(MetaHashAlgorithm, int(opts.hashAlgorithm))
 
 	appendableOpts := multiapp.DefaultOptions().
 		WithReadOnly(opts.readOnly).
@@ -114,6 +120,7 @@ func Open(path string, opts *Options) (*AHtree, error) {
 		WithAutoSync(opts.autoSync).
 		WithFileSize(opts.fileSize).
 		WithFileMode(opts.fileMode).
+		WithKeyProvider(opts.keyProvider).
 		WithMetadata(metadata.Bytes())
 
 	appFactory := opts.appFactory
@@ -142,6 +149,23 @@ func Open(path string, opts *Options) (*AHtree, error) {
 		return nil, err
 	}
 
+	// trees created before the hash algorithm was recorded were built with sha256
+	hashAlgorithm := hashing.SHA256
+
+	alg, ok := appendable.NewMetadata(cLog.Metadata()).GetInt(MetaHashAlgorithm)
+	if ok {
+		hashAlgorithm = hashing.Algorithm(alg)
+	}
+
+	err = hashAlgorithm.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid hash algorithm in metadata", err)
+	}
+
+	if hashAlgorithm != opts.hashAlgorithm {
+		return nil, fmt.Errorf("%w: expected '%s' but '%s' was used", ErrIncompatibleHashAlgorithm, opts.hashAlgorithm, hashAlgorithm)
+	}
+
 	return OpenWith(pLog, dLog, cLog, opts)
 }
 
@@ -198,6 +222,7 @@ func OpenWith(pLog, dLog, cLog appendable.Appendable, opts *Options) (*AHtree, e
 		dCache:           dCache,
 		syncThld:         opts.syncThld,
 		readOnly:         opts.readOnly,
+		hashAlgorithm:    opts.hashAlgorithm,
 		cLogBuf:          cLogBuf,
 	}
 
@@ -289,7 +314,7 @@ func (t *AHtree) Append(d []byte) (n uint64, h [sha256.Size]byte, err error) {
 	b[0] = LeafPrefix
 	copy(b[1:], d) // payload
 
-	h = sha256.Sum256(b)
+	h = t.hashAlgorithm.Sum(b)
 	copy(t._digests[:], h[:])
 	dCount := 1
 
@@ -310,7 +335,7 @@ func (t *AHtree) Append(d []byte) (n uint64, h [sha256.Size]byte, err error) {
 			copy(b[1:], hkl[:])
 			copy(b[1+sha256.Size:], h[:])
 
-			h = sha256.Sum256(b[:])
+			h = t.hashAlgorithm.Sum(b[:])
 
 			copy(t._digests[dCount*sha256.Size:], h[:])
 			dCount++
@@ -28,6 +28,7 @@ import (
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/appendable/mocked"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/hashing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -714,6 +715,69 @@ func TestInclusionAndConsistencyProofs(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestProofsWithHashAlgorithm(t *testing.T) {
+	for _, alg := range []hashing.Algorithm{hashing.SHA512_256, hashing.BLAKE3} {
+		t.Run(alg.String(), func(t *testing.T) {
+			dir := t.TempDir()
+
+			tree, err := Open(dir, DefaultOptions().WithHashAlgorithm(alg))
+			require.NoError(t, err)
+
+			N := 64
+
+			for i := 1; i <= N; i++ {
+				_, _, err := tree.Append([]byte{byte(i)})
+				require.NoError(t, err)
+			}
+
+			for i := 1; i <= N; i++ {
+				h := alg.Sum([]byte{LeafPrefix, byte(i)})
+
+				for j := i; j <= N; j++ {
+					iproof, err := tree.InclusionProof(uint64(i), uint64(j))
+					require.NoError(t, err)
+
+					jroot, err := tree.RootAt(uint64(j))
+					require.NoError(t, err)
+
+					require.True(t, VerifyInclusionWith(alg, iproof, uint64(i), uint64(j), h, jroot))
+					require.False(t, VerifyInclusion(iproof, uint64(i), uint64(j), sha256.Sum256([]byte{LeafPrefix, byte(i)}), jroot))
+
+					cproof, err := tree.ConsistencyProof(uint64(i), uint64(j))
+					require.NoError(t, err)
+
+					iroot, err := tree.RootAt(uint64(i))
+					require.NoError(t, err)
+
+					require.True(t, VerifyConsistencyWith(alg, cproof, uint64(i), uint64(j), iroot, jroot))
+				}
+			}
+
+			iproof, err := tree.InclusionProof(uint64(N), uint64(N))
+			require.NoError(t, err)
+
+			root, err := tree.RootAt(uint64(N))
+			require.NoError(t, err)
+
+			require.True(t, VerifyLastInclusionWith(alg, iproof, uint64(N), alg.Sum([]byte{LeafPrefix, byte(N)}), root))
+			require.False(t, VerifyLastInclusionWith(hashing.Algorithm(99), iproof, uint64(N), alg.Sum([]byte{LeafPrefix, byte(N)}), root))
+
+			err = tree.Close()
+			require.NoError(t, err)
+
+			_, err = Open(dir, DefaultOptions())
+			require.ErrorIs(t, err, ErrIncompatibleHashAlgorithm)
+
+			tree, err = Open(dir, DefaultOptions().WithHashAlgorithm(alg))
+			require.NoError(t, err)
+			require.Equal(t, uint64(N), tree.Size())
+
+			err = tree.Close()
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestReOpenningImmudbStore(t *testing.T) {
 	dir := t.TempDir()
 
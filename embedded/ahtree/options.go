@@ -21,7 +21,9 @@ import (
 	"os"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/hashing"
 )
 
 const DefaultFileSize = multiapp.DefaultFileSize
@@ -32,6 +34,7 @@ const DefaultCompressionFormat = appendable.DefaultCompressionFormat
 const DefaultCompressionLevel = appendable.DefaultCompressionLevel
 const DefaultSyncThld = 100_000
 const DefaultWriteBufferSize = 1 << 24 //16Mb
+const DefaultHashAlgorithm = hashing.DefaultAlgorithm
 
 type AppFactoryFunc func(
 	rootPath string,
@@ -50,6 +53,8 @@ type Options struct {
 
 	fileMode os.FileMode
 
+	keyProvider encryptedapp.KeyProvider
+
 	appFactory AppFactoryFunc
 
 	dataCacheSlots    int
@@ -59,6 +64,7 @@ type Options struct {
 	fileSize          int
 	compressionFormat int
 	compressionLevel  int
+	hashAlgorithm     hashing.Algorithm
 }
 
 func DefaultOptions() *Options {
@@ -79,6 +85,7 @@ func DefaultOptions() *Options {
 		fileSize:          DefaultFileSize,
 		compressionFormat: DefaultCompressionFormat,
 		compressionLevel:  DefaultCompressionLevel,
+		hashAlgorithm:     DefaultHashAlgorithm,
 	}
 }
 
@@ -111,6 +118,10 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid syncThld", ErrInvalidOptions)
 	}
 
+	if !opts.hashAlgorithm.IsValid() {
+		return fmt.Errorf("%w: invalid hashAlgorithm", ErrInvalidOptions)
+	}
+
 	return nil
 }
 
@@ -174,6 +185,16 @@ func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opts
 }
 
+func (opts *Options) WithHashAlgorithm(hashAlgorithm hashing.Algorithm) *Options {
+	opts.hashAlgorithm = hashAlgorithm
+	return opts
+}
+
+func (opts *Options) WithKeyProvider(keyProvider encryptedapp.KeyProvider) *Options {
+	opts.keyProvider = keyProvider
+	return opts
+}
+
 func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	opts.appFactory = appFactory
 	return opts
@@ -16,19 +16,31 @@ limitations under the License.
 
 package ahtree
 
-import "crypto/sha256"
+import (
+	"crypto/sha256"
+
+	"github.com/codenotary/immudb/embedded/hashing"
+)
 
 func VerifyInclusion(iproof [][sha256.Size]byte, i, j uint64, iLeaf, jRoot [sha256.Size]byte) bool {
-	if i > j || i == 0 || (i < j && len(iproof) == 0) {
+	return VerifyInclusionWith(hashing.SHA256, iproof, i, j, iLeaf, jRoot)
+}
+
+func VerifyInclusionWith(hashAlgorithm hashing.Algorithm, iproof [][sha256.Size]byte, i, j uint64, iLeaf, jRoot [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || i > j || i == 0 || (i < j && len(iproof) == 0) {
 		return false
 	}
 
-	ciRoot := EvalInclusion(iproof, i, j, iLeaf)
+	ciRoot := EvalInclusionWith(hashAlgorithm, iproof, i, j, iLeaf)
 
 	return jRoot == ciRoot
 }
 
 func EvalInclusion(iproof [][sha256.Size]byte, i, j uint64, iLeaf [sha256.Size]byte) [sha256.Size]byte {
+	return EvalInclusionWith(hashing.SHA256, iproof, i, j, iLeaf)
+}
+
+func EvalInclusionWith(hashAlgorithm hashing.Algorithm, iproof [][sha256.Size]byte, i, j uint64, iLeaf [sha256.Size]byte) [sha256.Size]byte {
 	i1 := i - 1
 	j1 := j - 1
 
@@ -46,7 +58,7 @@ func EvalInclusion(iproof [][sha256.Size]byte, i, j uint64, iLeaf [sha256.Size]b
 			copy(b[sha256.Size+1:], ciRoot[:])
 		}
 
-		ciRoot = sha256.Sum256(b[:])
+		ciRoot = hashAlgorithm.Sum(b[:])
 
 		i1 >>= 1
 		j1 >>= 1
@@ -56,7 +68,11 @@ func EvalInclusion(iproof [][sha256.Size]byte, i, j uint64, iLeaf [sha256.Size]b
 }
 
 func VerifyConsistency(cproof [][sha256.Size]byte, i, j uint64, iRoot, jRoot [sha256.Size]byte) bool {
-	if i > j || i == 0 || (i < j && len(cproof) == 0) {
+	return VerifyConsistencyWith(hashing.SHA256, cproof, i, j, iRoot, jRoot)
+}
+
+func VerifyConsistencyWith(hashAlgorithm hashing.Algorithm, cproof [][sha256.Size]byte, i, j uint64, iRoot, jRoot [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || i > j || i == 0 || (i < j && len(cproof) == 0) {
 		return false
 	}
 
@@ -64,12 +80,16 @@ func VerifyConsistency(cproof [][sha256.Size]byte, i, j uint64, iRoot, jRoot [sh
 		return iRoot == jRoot
 	}
 
-	ciRoot, cjRoot := EvalConsistency(cproof, i, j)
+	ciRoot, cjRoot := EvalConsistencyWith(hashAlgorithm, cproof, i, j)
 
 	return iRoot == ciRoot && jRoot == cjRoot
 }
 
 func EvalConsistency(cproof [][sha256.Size]byte, i, j uint64) ([sha256.Size]byte, [sha256.Size]byte) {
+	return EvalConsistencyWith(hashing.SHA256, cproof, i, j)
+}
+
+func EvalConsistencyWith(hashAlgorithm hashing.Algorithm, cproof [][sha256.Size]byte, i, j uint64) ([sha256.Size]byte, [sha256.Size]byte) {
 	fn := i - 1
 	sn := j - 1
 
@@ -87,10 +107,10 @@ func EvalConsistency(cproof [][sha256.Size]byte, i, j uint64) ([sha256.Size]byte
 			copy(b[1:], h[:])
 
 			copy(b[1+sha256.Size:], ciRoot[:])
-			ciRoot = sha256.Sum256(b[:])
+			ciRoot = hashAlgorithm.Sum(b[:])
 
 			copy(b[1+sha256.Size:], cjRoot[:])
-			cjRoot = sha256.Sum256(b[:])
+			cjRoot = hashAlgorithm.Sum(b[:])
 
 			for fn%2 == 0 && fn != 0 {
 				fn >>= 1
@@ -99,7 +119,7 @@ func EvalConsistency(cproof [][sha256.Size]byte, i, j uint64) ([sha256.Size]byte
 		} else {
 			copy(b[1:], cjRoot[:])
 			copy(b[1+sha256.Size:], h[:])
-			cjRoot = sha256.Sum256(b[:])
+			cjRoot = hashAlgorithm.Sum(b[:])
 		}
 		fn >>= 1
 		sn >>= 1
@@ -109,14 +129,22 @@ func EvalConsistency(cproof [][sha256.Size]byte, i, j uint64) ([sha256.Size]byte
 }
 
 func VerifyLastInclusion(iproof [][sha256.Size]byte, i uint64, leaf, root [sha256.Size]byte) bool {
-	if i == 0 {
+	return VerifyLastInclusionWith(hashing.SHA256, iproof, i, leaf, root)
+}
+
+func VerifyLastInclusionWith(hashAlgorithm hashing.Algorithm, iproof [][sha256.Size]byte, i uint64, leaf, root [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || i == 0 {
 		return false
 	}
 
-	return root == EvalLastInclusion(iproof, i, leaf)
+	return root == EvalLastInclusionWith(hashAlgorithm, iproof, i, leaf)
 }
 
 func EvalLastInclusion(iproof [][sha256.Size]byte, i uint64, leaf [sha256.Size]byte) [sha256.Size]byte {
+	return EvalLastInclusionWith(hashing.SHA256, iproof, i, leaf)
+}
+
+func EvalLastInclusionWith(hashAlgorithm hashing.Algorithm, iproof [][sha256.Size]byte, i uint64, leaf [sha256.Size]byte) [sha256.Size]byte {
 	i1 := i - 1
 
 	root := leaf
@@ -128,7 +156,7 @@ func EvalLastInclusion(iproof [][sha256.Size]byte, i uint64, leaf [sha256.Size]b
 		copy(b[1:], h[:])
 		copy(b[sha256.Size+1:], root[:])
 
-		root = sha256.Sum256(b[:])
+		root = hashAlgorithm.Sum(b[:])
 
 		i1 >>= 1
 	}
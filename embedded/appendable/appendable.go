@@ -31,6 +31,8 @@ const (
 	GZipCompression
 	LZWCompression
 	ZLibCompression
+	ZStdCompression
+	LZ4Compression
 )
 
 const (
@@ -57,6 +59,13 @@ type Appendable interface {
 	CompressionLevel() int
 }
 
+// Range is a contiguous range of data, when data is compressed
+// it refers to the whole chunk of data appended at Off
+type Range struct {
+	Off int64
+	Len int64
+}
+
 func Checksum(rAt io.ReaderAt, off, n int64) (checksum [sha256.Size]byte, err error) {
 	h := sha256.New()
 	r := io.NewSectionReader(rAt, off, n)
@@ -0,0 +1,195 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appendable
+
+import "encoding/binary"
+
+const DefaultCompressionDictionarySize = 64 * 1024
+
+const (
+	// dictionaryDmerSize is the length of the substrings used to score segments,
+	// which are identified by their content read as an uint64
+	dictionaryDmerSize = 8
+
+	// dictionarySegmentSize is the length of the segments the dictionary is made of
+	dictionarySegmentSize = 256
+)
+
+// TrainCompressionDictionary trains a raw zstd dictionary out of sample records.
+//
+// Raw dictionaries are plain content zstd can reference when compressing small
+// records (e.g. JSON documents sharing the same structure). As the cover algorithm
+// used by the zstd trainer, samples are split into epochs and the segment holding
+// the substrings shared by most samples is selected from each epoch. Substrings
+// of a selected segment do not count for later ones, so the dictionary is not
+// filled with repeated content. Most valuable segments are placed at the end of
+// the dictionary, as zstd encodes nearer offsets with fewer bits.
+func TrainCompressionDictionary(samples [][]byte, maxSize int) []byte {
+	var data []byte
+
+	for _, sample := range samples {
+		data = append(data, sample...)
+	}
+
+	if maxSize <= 0 || len(data) < dictionaryDmerSize {
+		return nil
+	}
+
+	dmerAt := func(i int) uint64 {
+		return binary.BigEndian.Uint64(data[i:])
+	}
+
+	// the frequency of each substring is the number of samples it occurs in
+	freqs := make(map[uint64]int)
+
+	start := 0
+
+	for _, sample := range samples {
+		seen := make(map[uint64]struct{})
+
+		for i := start; i+dictionaryDmerSize <= start+len(sample); i++ {
+			dmer := dmerAt(i)
+
+			_, ok := seen[dmer]
+			if ok {
+				continue
+			}
+
+			seen[dmer] = struct{}{}
+			freqs[dmer]++
+		}
+
+		start += len(sample)
+	}
+
+	// substrings found in a single sample are of no use to compress other records
+	for dmer, freq := range freqs {
+		if freq < 2 {
+			delete(freqs, dmer)
+		}
+	}
+
+	segmentSize := dictionarySegmentSize
+	if segmentSize > maxSize {
+		segmentSize = maxSize
+	}
+	if segmentSize > len(data) {
+		segmentSize = len(data)
+	}
+
+	epochs := maxSize / segmentSize
+	if epochs < 1 {
+		epochs = 1
+	}
+
+	epochSize := len(data) / epochs
+	if epochSize < segmentSize {
+		epochSize = segmentSize
+	}
+
+	dict := make([]byte, maxSize)
+	tail := maxSize
+
+	for epochStart := 0; tail > 0 && epochStart+dictionaryDmerSize <= len(data); epochStart += epochSize {
+		epochEnd := epochStart + epochSize
+		if epochEnd > len(data) {
+			epochEnd = len(data)
+		}
+
+		begin, end := selectDictionarySegment(data[:epochEnd], epochStart, segmentSize, freqs, dmerAt)
+		if begin == end {
+			continue
+		}
+
+		// substrings of the selected segment are already referenced by the dictionary
+		for i := begin; i+dictionaryDmerSize <= end; i++ {
+			delete(freqs, dmerAt(i))
+		}
+
+		n := end - begin
+		if n > tail {
+			n = tail
+		}
+
+		tail -= n
+		copy(dict[tail:], data[end-n:end])
+	}
+
+	return dict[tail:]
+}
+
+// selectDictionarySegment returns the bounds of the segment within data[from:]
+// holding the most frequent substrings, trimmed to the substrings contributing to its score
+func selectDictionarySegment(data []byte, from, segmentSize int, freqs map[uint64]int, dmerAt func(int) uint64) (begin, end int) {
+	dmersPerSegment := segmentSize - dictionaryDmerSize + 1
+	if dmersPerSegment < 1 {
+		dmersPerSegment = 1
+	}
+
+	last := len(data) - dictionaryDmerSize
+
+	active := make(map[uint64]int)
+
+	var score, bestScore, bestStart int
+
+	for i := from; i <= last; i++ {
+		dmer := dmerAt(i)
+
+		active[dmer]++
+		if active[dmer] == 1 {
+			score += freqs[dmer]
+		}
+
+		// the window holds the substrings starting at [i-dmersPerSegment+1, i]
+		if i-from >= dmersPerSegment {
+			old := dmerAt(i - dmersPerSegment)
+
+			active[old]--
+			if active[old] == 0 {
+				delete(active, old)
+				score -= freqs[old]
+			}
+		}
+
+		if score > bestScore {
+			bestScore = score
+			bestStart = i - dmersPerSegment + 1
+			if bestStart < from {
+				bestStart = from
+			}
+		}
+	}
+
+	if bestScore == 0 {
+		return 0, 0
+	}
+
+	lastDmer := bestStart + dmersPerSegment - 1
+	if lastDmer > last {
+		lastDmer = last
+	}
+
+	for bestStart < lastDmer && freqs[dmerAt(bestStart)] == 0 {
+		bestStart++
+	}
+
+	for lastDmer > bestStart && freqs[dmerAt(lastDmer)] == 0 {
+		lastDmer--
+	}
+
+	return bestStart, lastDmer + dictionaryDmerSize
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appendable
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrainCompressionDictionary(t *testing.T) {
+	require.Empty(t, TrainCompressionDictionary(nil, DefaultCompressionDictionarySize))
+	require.Empty(t, TrainCompressionDictionary([][]byte{[]byte(`{"a":1}`)}, DefaultCompressionDictionarySize))
+
+	// content which is not shared by samples is not included into the dictionary
+	require.Empty(t, TrainCompressionDictionary([][]byte{[]byte(`{"name":"alice"}`), []byte(`[1,2,3,4,5,6,7,8,9]`)}, DefaultCompressionDictionarySize))
+
+	sample := func(i int) []byte {
+		return []byte(fmt.Sprintf(`{"id":%d,"customer":{"name":"customer-%d","country":"%s"},"status":"shipped","items":[{"sku":"sku-%d","quantity":%d}]}`,
+			i, i*7, []string{"IT", "DE", "US"}[i%3], i%17, i%5))
+	}
+
+	samples := make([][]byte, 500)
+	for i := range samples {
+		samples[i] = sample(i)
+	}
+
+	dict := TrainCompressionDictionary(samples, 1024)
+	require.NotEmpty(t, dict)
+	require.LessOrEqual(t, len(dict), 1024)
+	require.Contains(t, string(dict), `"status":"shipped","items":[{"sku":"sku-`)
+
+	compressedLen := func(dict []byte, b []byte) int {
+		opts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+		if len(dict) > 0 {
+			opts = append(opts, zstd.WithEncoderDictRaw(1<<31, dict))
+		}
+
+		enc, err := zstd.NewWriter(nil, opts...)
+		require.NoError(t, err)
+		defer enc.Close()
+
+		return len(enc.EncodeAll(b, nil))
+	}
+
+	record := sample(1000)
+	require.Less(t, 2*compressedLen(dict, record), compressedLen(nil, record))
+}
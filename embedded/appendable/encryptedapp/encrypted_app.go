@@ -0,0 +1,761 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptedapp
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"golang.org/x/crypto/hkdf"
+)
+
+var ErrIllegalArguments = errors.New("encryptedapp: illegal arguments")
+var ErrInvalidOptions = fmt.Errorf("%w: invalid options", ErrIllegalArguments)
+var ErrAlreadyClosed = errors.New("encryptedapp: already closed")
+var ErrReadOnly = errors.New("encryptedapp: read-only mode")
+var ErrCorruptedHeader = errors.New("encryptedapp: corrupted header")
+var ErrCorruptedData = errors.New("encryptedapp: corrupted data")
+var ErrInvalidKey = errors.New("encryptedapp: invalid key")
+var ErrKeyNotFound = errors.New("encryptedapp: key not found")
+var ErrKeyPluginFailure = errors.New("encryptedapp: key plugin failure")
+var ErrKeyProviderRequired = errors.New("encryptedapp: data is encrypted but no key provider was set")
+
+// files are prefixed by a header which occupies the first frame slot:
+// magic[8] | frameSize[4] | fileID[16] | keyIDLen[1] | keyID | nonce[12] | tag[16]
+// the tag authenticates the header and makes it possible to detect a wrong key
+var magic = []byte("immuenc\x01")
+
+// files are not encrypted with the key itself but with a subkey derived from it and the fileID,
+// so random nonces are only required to be unique among the frames written into the same file
+var subkeyInfo = []byte("immudb encryptedapp file key")
+
+const (
+	fileIDSize    = 16
+	lenSize       = 4
+	nonceSize     = 12
+	tagSize       = 16
+	frameOverhead = lenSize + nonceSize + tagSize
+
+	headerFixedSize = 8 + 4 + fileIDSize + 1
+	headerMaxSize   = headerFixedSize + MaxKeyIDLen + nonceSize + tagSize
+)
+
+var _ appendable.Appendable = (*AppendableFile)(nil)
+
+// AppendableFile encrypts the content of an underlying appendable using AES-GCM.
+//
+// Data is split into fixed-size blocks, each one stored as an independently
+// authenticated frame (len | nonce | ciphertext | tag) bound to the file and to
+// its position in it. Frames are encrypted with a key specific to the file. Only the trailing frame, which may hold a partial block, is
+// rewritten as data gets appended to it.
+type AppendableFile struct {
+	app appendable.Appendable
+
+	aead      cipher.AEAD
+	fileID    []byte
+	keyID     string
+	frameSize int
+	blockSize int // plain data held by a full frame
+
+	headerWritten bool
+
+	blocks      int64  // number of full frames
+	tail        []byte // plain data of the trailing partial block
+	tailWritten int    // amount of tail data already written into the underlying appendable
+
+	cachedBlock int64
+	cache       []byte
+
+	readOnly bool
+	closed   bool
+
+	mutex sync.Mutex
+}
+
+// Open wraps app with encryption.
+// Empty appendables are initialized using the current key of the key provider,
+// while existing ones are decrypted with the key they were created with.
+// Appendables holding data which was not encrypted are returned as they are.
+func Open(app appendable.Appendable, opts *Options) (appendable.Appendable, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if app == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	size, err := app.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	if size == 0 {
+		if opts.readOnly {
+			return &AppendableFile{
+				app:         app,
+				cachedBlock: -1,
+				readOnly:    true,
+			}, nil
+		}
+
+		return create(app, opts)
+	}
+
+	hdr := make([]byte, headerMaxSize)
+
+	n, err := app.ReadAt(hdr, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	hdr = hdr[:n]
+
+	if !bytes.HasPrefix(hdr, magic) {
+		// data was written without encryption
+		return app, nil
+	}
+
+	if len(hdr) < headerFixedSize {
+		return nil, ErrCorruptedHeader
+	}
+
+	frameSize := int(binary.BigEndian.Uint32(hdr[8:]))
+	fileID := hdr[12 : 12+fileIDSize]
+	keyIDLen := int(hdr[12+fileIDSize])
+
+	if frameSize < headerMaxSize || len(hdr) < headerFixedSize+keyIDLen+nonceSize+tagSize {
+		return nil, ErrCorruptedHeader
+	}
+
+	keyID := string(hdr[headerFixedSize : headerFixedSize+keyIDLen])
+
+	// the key id is read from the file, so it's checked before being handed to the key provider
+	err = validateKeyID(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := opts.keyProvider.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(keyID, key, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	hdrLen := headerFixedSize + keyIDLen
+	nonce := hdr[hdrLen : hdrLen+nonceSize]
+
+	_, err = aead.Open(nil, nonce, hdr[hdrLen+nonceSize:hdrLen+nonceSize+tagSize], hdr[:hdrLen])
+	if err != nil {
+		return nil, fmt.Errorf("%w: key '%s' does not match", ErrInvalidKey, keyID)
+	}
+
+	eapp := &AppendableFile{
+		app:           app,
+		aead:          aead,
+		fileID:        append([]byte{}, fileID...),
+		keyID:         keyID,
+		frameSize:     frameSize,
+		blockSize:     frameSize - frameOverhead,
+		headerWritten: true,
+		cachedBlock:   -1,
+		readOnly:      opts.readOnly,
+	}
+
+	if !opts.readOnly {
+		eapp.tail = make([]byte, 0, eapp.blockSize)
+	}
+
+	if size < int64(frameSize) {
+		if !opts.readOnly {
+			// header was not completely written, it will be rewritten with the first frame
+			eapp.headerWritten = false
+			err = app.SetOffset(0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return eapp, nil
+	}
+
+	// the trailing frame may not have been completely written, in such case
+	// its content is discarded and the frame will be overwritten
+	slots := (size - 1) / int64(frameSize)
+
+	for i := slots - 1; i >= 0 && i >= slots-2; i-- {
+		plain, err := eapp.readFrame(i)
+		if err != nil {
+			continue
+		}
+
+		if len(plain) == eapp.blockSize {
+			eapp.blocks = i + 1
+		} else {
+			eapp.blocks = i
+			eapp.tail = append(eapp.tail, plain...)
+			eapp.tailWritten = len(plain)
+		}
+
+		return eapp, nil
+	}
+
+	if slots > 2 {
+		eapp.blocks = slots - 2
+	}
+
+	return eapp, nil
+}
+
+// IsEncrypted returns true if the content of the appendable was written with encryption
+func IsEncrypted(app appendable.Appendable) (bool, error) {
+	size, err := app.Size()
+	if err != nil {
+		return false, err
+	}
+
+	if size < int64(len(magic)) {
+		return false, nil
+	}
+
+	bs := make([]byte, len(magic))
+
+	_, err = app.ReadAt(bs, 0)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(bs, magic), nil
+}
+
+func create(app appendable.Appendable, opts *Options) (*AppendableFile, error) {
+	keyID, key, err := opts.keyProvider.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateKey(keyID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	fileID := make([]byte, fileIDSize)
+
+	_, err = rand.Read(fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(keyID, key, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppendableFile{
+		app:         app,
+		aead:        aead,
+		fileID:      fileID,
+		keyID:       keyID,
+		frameSize:   opts.frameSize,
+		blockSize:   opts.frameSize - frameOverhead,
+		tail:        make([]byte, 0, opts.frameSize-frameOverhead),
+		cachedBlock: -1,
+	}, nil
+}
+
+func newAEAD(keyID string, key, fileID []byte) (cipher.AEAD, error) {
+	subkey := make([]byte, len(key))
+
+	_, err := io.ReadFull(hkdf.New(sha256.New, key, fileID, subkeyInfo), subkey)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(subkey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key '%s': %v", ErrInvalidKey, keyID, err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (a *AppendableFile) header() ([]byte, error) {
+	hdr := make([]byte, headerFixedSize+len(a.keyID)+nonceSize, a.frameSize)
+
+	copy(hdr, magic)
+	binary.BigEndian.PutUint32(hdr[8:], uint32(a.frameSize))
+	copy(hdr[12:], a.fileID)
+	hdr[12+fileIDSize] = byte(len(a.keyID))
+	copy(hdr[headerFixedSize:], a.keyID)
+
+	hdrLen := headerFixedSize + len(a.keyID)
+	nonce := hdr[hdrLen:]
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr = a.aead.Seal(hdr, nonce, nil, hdr[:hdrLen])
+
+	return hdr[:a.frameSize], nil
+}
+
+// KeyID returns the identifier of the key used to encrypt the appendable
+func (a *AppendableFile) KeyID() string {
+	return a.keyID
+}
+
+func (a *AppendableFile) frameOffset(block int64) int64 {
+	// the first frame slot is taken by the header
+	return (block + 1) * int64(a.frameSize)
+}
+
+func (a *AppendableFile) additionalData(block int64, plainLen int) []byte {
+	ad := make([]byte, fileIDSize+8+lenSize)
+	copy(ad, a.fileID)
+	binary.BigEndian.PutUint64(ad[fileIDSize:], uint64(block))
+	binary.BigEndian.PutUint32(ad[fileIDSize+8:], uint32(plainLen))
+	return ad
+}
+
+func (a *AppendableFile) readFrame(block int64) ([]byte, error) {
+	frame := make([]byte, a.frameSize)
+
+	n, err := a.app.ReadAt(frame, a.frameOffset(block))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if n < frameOverhead {
+		return nil, fmt.Errorf("%w: block %d is incomplete", ErrCorruptedData, block)
+	}
+
+	plainLen := int(binary.BigEndian.Uint32(frame))
+
+	if plainLen > a.blockSize || n < frameOverhead+plainLen {
+		return nil, fmt.Errorf("%w: block %d is incomplete", ErrCorruptedData, block)
+	}
+
+	nonce := frame[lenSize : lenSize+nonceSize]
+	ciphertext := frame[lenSize+nonceSize : frameOverhead+plainLen]
+
+	plain, err := a.aead.Open(ciphertext[:0], nonce, ciphertext, a.additionalData(block, plainLen))
+	if err != nil {
+		return nil, fmt.Errorf("%w: block %d could not be decrypted", ErrCorruptedData, block)
+	}
+
+	return plain, nil
+}
+
+func (a *AppendableFile) writeFrame(block int64, plain []byte) error {
+	if !a.headerWritten {
+		hdr, err := a.header()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = a.app.Append(hdr)
+		if err != nil {
+			return err
+		}
+
+		a.headerWritten = true
+	}
+
+	off := a.frameOffset(block)
+
+	// the trailing frame is overwritten when it grows or after moving the offset back
+	if a.app.Offset() != off {
+		err := a.app.SetOffset(off)
+		if err != nil {
+			return err
+		}
+	}
+
+	frame := make([]byte, lenSize+nonceSize, a.frameSize)
+	binary.BigEndian.PutUint32(frame, uint32(len(plain)))
+
+	nonce := frame[lenSize:]
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	frame = a.aead.Seal(frame, nonce, plain, a.additionalData(block, len(plain)))
+
+	_, _, err = a.app.Append(frame)
+	return err
+}
+
+func (a *AppendableFile) flushTail() error {
+	if len(a.tail) == a.tailWritten {
+		return nil
+	}
+
+	err := a.writeFrame(a.blocks, a.tail)
+	if err != nil {
+		return err
+	}
+
+	a.tailWritten = len(a.tail)
+
+	return nil
+}
+
+func (a *AppendableFile) size() int64 {
+	return a.blocks*int64(a.blockSize) + int64(len(a.tail))
+}
+
+func (a *AppendableFile) Copy(dstPath string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if !a.readOnly {
+		err := a.flushTail()
+		if err != nil {
+			return err
+		}
+	}
+
+	// encrypted content is copied as it is
+	return a.app.Copy(dstPath)
+}
+
+func (a *AppendableFile) CompressionFormat() int {
+	return a.app.CompressionFormat()
+}
+
+func (a *AppendableFile) CompressionLevel() int {
+	return a.app.CompressionLevel()
+}
+
+func (a *AppendableFile) Metadata() []byte {
+	return a.app.Metadata()
+}
+
+func (a *AppendableFile) Size() (int64, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	return a.size(), nil
+}
+
+func (a *AppendableFile) Offset() int64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.size()
+}
+
+func (a *AppendableFile) SetOffset(newOffset int64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if a.readOnly {
+		return ErrReadOnly
+	}
+
+	if newOffset < 0 {
+		return fmt.Errorf("%w: negative offset", ErrIllegalArguments)
+	}
+
+	currOffset := a.size()
+
+	if newOffset > currOffset {
+		return fmt.Errorf("%w: provided offset %d is bigger than current one %d", ErrIllegalArguments, newOffset, currOffset)
+	}
+
+	if newOffset == currOffset {
+		return nil
+	}
+
+	block := newOffset / int64(a.blockSize)
+	r := int(newOffset % int64(a.blockSize))
+
+	if block < a.blocks {
+		plain, err := a.readBlock(block)
+		if err != nil {
+			return err
+		}
+
+		a.tail = append(a.tail[:0], plain[:r]...)
+		a.tailWritten = 0
+		a.blocks = block
+		a.cachedBlock = -1
+	} else {
+		a.tail = a.tail[:r]
+
+		if a.tailWritten > r {
+			a.tailWritten = 0
+		}
+	}
+
+	if a.headerWritten && a.tailWritten == 0 && a.app.Offset() > a.frameOffset(a.blocks) {
+		return a.app.SetOffset(a.frameOffset(a.blocks))
+	}
+
+	return nil
+}
+
+func (a *AppendableFile) DiscardUpto(off int64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if a.size() < off {
+		return fmt.Errorf("%w: discard beyond existent data boundaries", ErrIllegalArguments)
+	}
+
+	if !a.headerWritten {
+		return a.app.DiscardUpto(0)
+	}
+
+	physicalOff := a.frameOffset(off / int64(a.blockSize))
+	if physicalOff > a.app.Offset() {
+		physicalOff = a.app.Offset()
+	}
+
+	return a.app.DiscardUpto(physicalOff)
+}
+
+func (a *AppendableFile) Append(bs []byte) (off int64, n int, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return 0, 0, ErrAlreadyClosed
+	}
+
+	if a.readOnly {
+		return 0, 0, ErrReadOnly
+	}
+
+	if len(bs) == 0 {
+		return 0, 0, ErrIllegalArguments
+	}
+
+	off = a.size()
+
+	for n < len(bs) {
+		chunkSize := minInt(a.blockSize-len(a.tail), len(bs)-n)
+
+		a.tail = append(a.tail, bs[n:n+chunkSize]...)
+
+		if len(a.tail) == a.blockSize {
+			err = a.writeFrame(a.blocks, a.tail)
+			if err != nil {
+				return off, n, err
+			}
+
+			a.blocks++
+			a.tail = a.tail[:0]
+			a.tailWritten = 0
+		}
+
+		n += chunkSize
+	}
+
+	return off, n, nil
+}
+
+func (a *AppendableFile) readBlock(block int64) ([]byte, error) {
+	if a.cachedBlock == block {
+		return a.cache, nil
+	}
+
+	plain, err := a.readFrame(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(plain) < a.blockSize {
+		return nil, fmt.Errorf("%w: block %d is incomplete", ErrCorruptedData, block)
+	}
+
+	a.cachedBlock = block
+	a.cache = plain
+
+	return plain, nil
+}
+
+func (a *AppendableFile) ReadAt(bs []byte, off int64) (n int, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if bs == nil {
+		return 0, ErrIllegalArguments
+	}
+
+	if off < 0 {
+		return 0, fmt.Errorf("%w: negative offset", ErrIllegalArguments)
+	}
+
+	size := a.size()
+
+	for n < len(bs) {
+		pos := off + int64(n)
+
+		if pos >= size {
+			return n, io.EOF
+		}
+
+		block := pos / int64(a.blockSize)
+
+		var plain []byte
+
+		if block == a.blocks {
+			plain = a.tail
+		} else {
+			plain, err = a.readBlock(block)
+			if err != nil {
+				return n, err
+			}
+		}
+
+		n += copy(bs[n:], plain[pos%int64(a.blockSize):])
+	}
+
+	return n, nil
+}
+
+func (a *AppendableFile) SwitchToReadOnlyMode() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if a.readOnly {
+		return ErrReadOnly
+	}
+
+	err := a.flushTail()
+	if err != nil {
+		return err
+	}
+
+	err = a.app.SwitchToReadOnlyMode()
+	if err != nil {
+		return err
+	}
+
+	a.readOnly = true
+
+	return nil
+}
+
+func (a *AppendableFile) Flush() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if a.readOnly {
+		return ErrReadOnly
+	}
+
+	err := a.flushTail()
+	if err != nil {
+		return err
+	}
+
+	return a.app.Flush()
+}
+
+func (a *AppendableFile) Sync() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if a.readOnly {
+		return ErrReadOnly
+	}
+
+	err := a.flushTail()
+	if err != nil {
+		return err
+	}
+
+	return a.app.Sync()
+}
+
+func (a *AppendableFile) Close() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.closed {
+		return ErrAlreadyClosed
+	}
+
+	if !a.readOnly {
+		err := a.flushTail()
+		if err != nil {
+			return err
+		}
+	}
+
+	a.closed = true
+
+	return a.app.Close()
+}
+
+func minInt(a, b int) int {
+	if a <= b {
+		return a
+	}
+	return b
+}
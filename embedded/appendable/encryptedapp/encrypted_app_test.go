@@ -0,0 +1,516 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptedapp
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testKeyProvider(t *testing.T) *StaticKeyProvider {
+	kp, err := NewStaticKeyProvider("k1", map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, 32),
+		"k2": bytes.Repeat([]byte{2}, 16),
+	})
+	require.NoError(t, err)
+
+	return kp
+}
+
+func openTestApp(t *testing.T, path string, opts *Options) appendable.Appendable {
+	sapp, err := singleapp.Open(path, singleapp.DefaultOptions().WithReadOnly(opts.readOnly))
+	require.NoError(t, err)
+
+	app, err := Open(sapp, opts)
+	require.NoError(t, err)
+
+	return app
+}
+
+func TestEncryptedApp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().
+		WithKeyProvider(testKeyProvider(t)).
+		WithFrameSize(512)
+
+	app := openTestApp(t, path, opts)
+	require.IsType(t, &AppendableFile{}, app)
+	require.Equal(t, "k1", app.(*AppendableFile).KeyID())
+
+	sz, err := app.Size()
+	require.NoError(t, err)
+	require.Zero(t, sz)
+
+	require.Equal(t, appendable.DefaultCompressionFormat, app.CompressionFormat())
+	require.Equal(t, appendable.DefaultCompressionLevel, app.CompressionLevel())
+	require.Nil(t, app.Metadata())
+
+	err = app.SetOffset(0)
+	require.NoError(t, err)
+
+	_, _, err = app.Append(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = app.ReadAt(nil, 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = app.ReadAt(make([]byte, 1), -1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = app.ReadAt(make([]byte, 1), 0)
+	require.ErrorIs(t, err, io.EOF)
+
+	data := make([]byte, 6000)
+	rand.Read(data)
+
+	var written int
+
+	for _, chunkSize := range []int{1, 10, 483, 484, 485, 1000, 2537} {
+		off, n, err := app.Append(data[written : written+chunkSize])
+		require.NoError(t, err)
+		require.Equal(t, int64(written), off)
+		require.Equal(t, chunkSize, n)
+
+		written += chunkSize
+	}
+
+	require.Equal(t, int64(written), app.Offset())
+
+	// data is readable before being flushed
+	bs := make([]byte, written)
+	n, err := app.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, written, n)
+	require.Equal(t, data[:written], bs)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	err = app.Sync()
+	require.NoError(t, err)
+
+	// reading beyond written data
+	n, err = app.ReadAt(make([]byte, 10), int64(written-5))
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, 5, n)
+
+	err = app.DiscardUpto(int64(written + 1))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = app.DiscardUpto(int64(written))
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(raw, data[:64]))
+
+	sapp, err := singleapp.Open(path, singleapp.DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	encrypted, err := IsEncrypted(sapp)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	err = sapp.Close()
+	require.NoError(t, err)
+
+	app = openTestApp(t, path, opts)
+
+	sz, err = app.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(written), sz)
+
+	for _, off := range []int{0, 1, 483, 484, 1000, written - 1} {
+		bs := make([]byte, written-off)
+		_, err = app.ReadAt(bs, int64(off))
+		require.NoError(t, err)
+		require.Equal(t, data[off:written], bs)
+	}
+
+	// move back into a full block and into the trailing one
+	for _, off := range []int{written - 10, 1000, 484, 100} {
+		err = app.SetOffset(int64(off))
+		require.NoError(t, err)
+		require.Equal(t, int64(off), app.Offset())
+
+		_, _, err = app.Append(data[off : off+200])
+		require.NoError(t, err)
+
+		err = app.Flush()
+		require.NoError(t, err)
+
+		err = app.SetOffset(int64(off + 200))
+		require.NoError(t, err)
+
+		bs := make([]byte, off+200)
+		_, err = app.ReadAt(bs, 0)
+		require.NoError(t, err)
+		require.Equal(t, data[:off+200], bs)
+	}
+
+	err = app.SetOffset(301)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = app.SetOffset(-1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = app.Append(data[300:written])
+	require.NoError(t, err)
+
+	copyPath := filepath.Join(t.TempDir(), "copy.aof")
+
+	err = app.Copy(copyPath)
+	require.NoError(t, err)
+
+	err = app.SwitchToReadOnlyMode()
+	require.NoError(t, err)
+
+	err = app.SwitchToReadOnlyMode()
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	_, _, err = app.Append(data[:1])
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.SetOffset(0)
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.Flush()
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.Sync()
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	_, err = app.Size()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	_, err = app.ReadAt(bs, 0)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	// copies are kept encrypted
+	raw, err = os.ReadFile(copyPath)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(raw, data[:64]))
+
+	app = openTestApp(t, copyPath, opts.WithReadOnly(true))
+
+	bs = make([]byte, written)
+	_, err = app.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, data[:written], bs)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestEncryptedAppEmptyReadOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	sapp, err := singleapp.Open(path, singleapp.DefaultOptions())
+	require.NoError(t, err)
+
+	err = sapp.Close()
+	require.NoError(t, err)
+
+	app := openTestApp(t, path, DefaultOptions().WithKeyProvider(testKeyProvider(t)).WithReadOnly(true))
+
+	sz, err := app.Size()
+	require.NoError(t, err)
+	require.Zero(t, sz)
+
+	_, err = app.ReadAt(make([]byte, 1), 0)
+	require.ErrorIs(t, err, io.EOF)
+
+	_, _, err = app.Append([]byte{1})
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestEncryptedAppKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+
+	kp := testKeyProvider(t)
+	opts := DefaultOptions().WithKeyProvider(kp)
+
+	app := openTestApp(t, filepath.Join(dir, "00000000.aof"), opts)
+
+	_, _, err := app.Append([]byte("encrypted with k1"))
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	kp.currentKeyID = "k2"
+
+	app = openTestApp(t, filepath.Join(dir, "00000000.aof"), opts)
+	require.Equal(t, "k1", app.(*AppendableFile).KeyID())
+
+	bs := make([]byte, 17)
+	_, err = app.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("encrypted with k1"), bs)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	app = openTestApp(t, filepath.Join(dir, "00000001.aof"), opts)
+	require.Equal(t, "k2", app.(*AppendableFile).KeyID())
+
+	_, _, err = app.Append([]byte("encrypted with k2"))
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	kp2, err := NewStaticKeyProvider("k2", map[string][]byte{"k2": kp.keys["k2"]})
+	require.NoError(t, err)
+
+	sapp, err := singleapp.Open(filepath.Join(dir, "00000000.aof"), singleapp.DefaultOptions())
+	require.NoError(t, err)
+
+	_, err = Open(sapp, DefaultOptions().WithKeyProvider(kp2))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	err = sapp.Close()
+	require.NoError(t, err)
+
+	// a different key with the same identifier can not be used to decrypt the data
+	kp3, err := NewStaticKeyProvider("k2", map[string][]byte{"k2": bytes.Repeat([]byte{3}, 16)})
+	require.NoError(t, err)
+
+	sapp, err = singleapp.Open(filepath.Join(dir, "00000001.aof"), singleapp.DefaultOptions())
+	require.NoError(t, err)
+
+	_, err = Open(sapp, DefaultOptions().WithKeyProvider(kp3))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	err = sapp.Close()
+	require.NoError(t, err)
+}
+
+func TestEncryptedAppTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().
+		WithKeyProvider(testKeyProvider(t)).
+		WithFrameSize(512)
+
+	app := openTestApp(t, path, opts)
+
+	data := make([]byte, 2000)
+	rand.Read(data)
+
+	_, _, err := app.Append(data)
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// flip a bit inside the encrypted frames
+	raw[len(raw)-2000] ^= 1
+
+	err = os.WriteFile(path, raw, 0644)
+	require.NoError(t, err)
+
+	app = openTestApp(t, path, opts.WithReadOnly(true))
+
+	bs := make([]byte, len(data))
+	n, err := app.ReadAt(bs, 0)
+	require.ErrorIs(t, err, ErrCorruptedData)
+	require.Less(t, n, len(data))
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestEncryptedAppFileKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithKeyProvider(testKeyProvider(t)).
+		WithFrameSize(512)
+
+	app1 := openTestApp(t, filepath.Join(dir, "testdata1.aof"), opts)
+	app2 := openTestApp(t, filepath.Join(dir, "testdata2.aof"), opts)
+
+	require.Equal(t, app1.(*AppendableFile).KeyID(), app2.(*AppendableFile).KeyID())
+
+	// files sharing the same key are encrypted with different subkeys
+	nonce := make([]byte, nonceSize)
+	plain := []byte("immudb")
+
+	sealed1 := app1.(*AppendableFile).aead.Seal(nil, nonce, plain, nil)
+	sealed2 := app2.(*AppendableFile).aead.Seal(nil, nonce, plain, nil)
+	require.NotEqual(t, sealed1, sealed2)
+
+	_, err := app2.(*AppendableFile).aead.Open(nil, nonce, sealed1, nil)
+	require.Error(t, err)
+
+	require.NoError(t, app1.Close())
+	require.NoError(t, app2.Close())
+}
+
+func TestEncryptedAppInvalidKeyID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().
+		WithKeyProvider(testKeyProvider(t)).
+		WithFrameSize(512)
+
+	app := openTestApp(t, path, opts)
+
+	_, _, err := app.Append([]byte("immudb"))
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// the key id 'k1' is replaced by one which would be taken as an option by a key plugin
+	keyIDOff := bytes.Index(raw, magic) + headerFixedSize
+	require.Equal(t, "k1", string(raw[keyIDOff:keyIDOff+2]))
+	copy(raw[keyIDOff:], "-h")
+
+	err = os.WriteFile(path, raw, 0644)
+	require.NoError(t, err)
+
+	sapp, err := singleapp.Open(path, singleapp.DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer sapp.Close()
+
+	_, err = Open(sapp, opts.WithReadOnly(true))
+	require.ErrorIs(t, err, ErrInvalidKey)
+	require.NotErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestEncryptedAppIncompleteFrame(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().
+		WithKeyProvider(testKeyProvider(t)).
+		WithFrameSize(512)
+
+	app := openTestApp(t, path, opts)
+
+	_, _, err := app.Append([]byte{1, 2, 3})
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	// stale data following the trailing frame is ignored
+	sapp, err := singleapp.Open(path, singleapp.DefaultOptions())
+	require.NoError(t, err)
+
+	_, _, err = sapp.Append(make([]byte, 40))
+	require.NoError(t, err)
+
+	err = sapp.Close()
+	require.NoError(t, err)
+
+	app = openTestApp(t, path, opts)
+
+	bs := make([]byte, 3)
+	_, err = app.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, bs)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	// simulate an incomplete write of the trailing frame
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+
+	err = os.Truncate(path, fi.Size()-45)
+	require.NoError(t, err)
+
+	app = openTestApp(t, path, opts)
+
+	sz, err := app.Size()
+	require.NoError(t, err)
+	require.Zero(t, sz)
+
+	_, _, err = app.Append([]byte{4, 5, 6})
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	app = openTestApp(t, path, opts)
+
+	_, err = app.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{4, 5, 6}, bs)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestEncryptedAppPlainData(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	sapp, err := singleapp.Open(path, singleapp.DefaultOptions())
+	require.NoError(t, err)
+
+	_, _, err = sapp.Append([]byte("plain data"))
+	require.NoError(t, err)
+
+	encrypted, err := IsEncrypted(sapp)
+	require.NoError(t, err)
+	require.False(t, encrypted)
+
+	app, err := Open(sapp, DefaultOptions().WithKeyProvider(testKeyProvider(t)))
+	require.NoError(t, err)
+	require.Same(t, sapp, app)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestEncryptedAppInvalidOpening(t *testing.T) {
+	_, err := Open(nil, DefaultOptions())
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, err = Open(nil, DefaultOptions().WithKeyProvider(testKeyProvider(t)))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptedapp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const MaxKeyIDLen = 255
+
+const DefaultPluginTimeout = 10 * time.Second
+
+const DefaultCurrentKeyTTL = time.Minute
+
+// besides letters and digits, key ids may only contain these symbols
+const keyIDSymbols = "-_.:/@+="
+
+// KeyProvider supplies the keys used to encrypt and decrypt appendables.
+//
+// Every encrypted file records the identifier of the key it was created with,
+// so rotating the current key only affects files created afterwards, while
+// existing files keep being decrypted with the key they reference.
+type KeyProvider interface {
+	// CurrentKey returns the key to be used for newly created files
+	CurrentKey() (keyID string, key []byte, err error)
+
+	// Key returns the key identified by keyID
+	Key(keyID string) ([]byte, error)
+}
+
+// validateKeyID makes sure key ids, which are also read from the header of encrypted files,
+// can be safely handed to a key plugin as a command argument
+func validateKeyID(keyID string) error {
+	if keyID == "" || len(keyID) > MaxKeyIDLen || keyID[0] == '-' {
+		return fmt.Errorf("%w: invalid key id %q", ErrInvalidKey, keyID)
+	}
+
+	for _, c := range keyID {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && !strings.ContainsRune(keyIDSymbols, c) {
+			return fmt.Errorf("%w: invalid key id %q", ErrInvalidKey, keyID)
+		}
+	}
+
+	return nil
+}
+
+func validateKey(keyID string, key []byte) error {
+	err := validateKeyID(keyID)
+	if err != nil {
+		return err
+	}
+
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	}
+
+	return fmt.Errorf("%w: key '%s' must be 16, 24 or 32 bytes long", ErrInvalidKey, keyID)
+}
+
+// StaticKeyProvider holds a fixed set of keys in memory
+type StaticKeyProvider struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+// NewStaticKeyProvider returns a provider which uses currentKeyID for new files.
+func NewStaticKeyProvider(currentKeyID string, keys map[string][]byte) (*StaticKeyProvider, error) {
+	for keyID, key := range keys {
+		err := validateKey(keyID, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, ok := keys[currentKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, currentKeyID)
+	}
+
+	return &StaticKeyProvider{
+		currentKeyID: currentKeyID,
+		keys:         keys,
+	}, nil
+}
+
+func (p *StaticKeyProvider) CurrentKey() (string, []byte, error) {
+	return p.currentKeyID, p.keys[p.currentKeyID], nil
+}
+
+func (p *StaticKeyProvider) Key(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, keyID)
+	}
+	return key, nil
+}
+
+// OpenKeyFile loads keys from a local keyfile.
+//
+// Each non-empty line not starting with '#' holds a key identifier followed by
+// the hex-encoded key. The last key in the file is used for new files, so keys
+// are rotated by appending a new line.
+func OpenKeyFile(path string) (*StaticKeyProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	keys := make(map[string][]byte)
+
+	var currentKeyID string
+
+	scanner := bufio.NewScanner(f)
+
+	for ln := 1; scanner.Scan(); ln++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%w: malformed line %d in keyfile '%s'", ErrInvalidKey, ln, path)
+		}
+
+		key, err := hex.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed key at line %d in keyfile '%s'", ErrInvalidKey, ln, path)
+		}
+
+		_, exists := keys[fields[0]]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated key id '%s' in keyfile '%s'", ErrInvalidKey, fields[0], path)
+		}
+
+		keys[fields[0]] = key
+		currentKeyID = fields[0]
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys found in keyfile '%s'", ErrKeyNotFound, path)
+	}
+
+	return NewStaticKeyProvider(currentKeyID, keys)
+}
+
+// PluginKeyProvider delegates key management to an external executable,
+// which makes it possible to integrate key management services such as
+// KMIP servers or Vault without linking their clients into immudb.
+//
+// The plugin is invoked as:
+//
+//	<plugin> current     prints "<keyID> <hex-encoded key>"
+//	<plugin> get <keyID> prints "<hex-encoded key>"
+//
+// Keys are cached in memory once retrieved. The identifier of the current key
+// is only cached for a limited amount of time, so keys rotated by the plugin are
+// used for the files created afterwards.
+type PluginKeyProvider struct {
+	path          string
+	args          []string
+	timeout       time.Duration
+	currentKeyTTL time.Duration
+
+	currentKeyID        string
+	currentKeyRetrieved time.Time
+	keys                map[string][]byte
+
+	mutex sync.Mutex
+}
+
+func NewPluginKeyProvider(path string, args ...string) *PluginKeyProvider {
+	return &PluginKeyProvider{
+		path:          path,
+		args:          args,
+		timeout:       DefaultPluginTimeout,
+		currentKeyTTL: DefaultCurrentKeyTTL,
+		keys:          make(map[string][]byte),
+	}
+}
+
+func (p *PluginKeyProvider) WithTimeout(timeout time.Duration) *PluginKeyProvider {
+	p.timeout = timeout
+	return p
+}
+
+// WithCurrentKeyTTL sets for how long the current key is used before querying the plugin again.
+// A zero TTL makes every call to CurrentKey query the plugin.
+func (p *PluginKeyProvider) WithCurrentKeyTTL(ttl time.Duration) *PluginKeyProvider {
+	p.currentKeyTTL = ttl
+	return p
+}
+
+func (p *PluginKeyProvider) CurrentKey() (string, []byte, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.currentKeyID != "" && time.Since(p.currentKeyRetrieved) < p.currentKeyTTL {
+		return p.currentKeyID, p.keys[p.currentKeyID], nil
+	}
+
+	out, err := p.run("current")
+	if err != nil {
+		return "", nil, err
+	}
+
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return "", nil, fmt.Errorf("%w: unexpected output from key plugin", ErrInvalidKey)
+	}
+
+	key, err := hex.DecodeString(fields[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: malformed key returned by key plugin", ErrInvalidKey)
+	}
+
+	err = validateKey(fields[0], key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cachedKey, cached := p.keys[fields[0]]
+	if cached && !bytes.Equal(cachedKey, key) {
+		return "", nil, fmt.Errorf("%w: key plugin returned a different key for key id '%s'", ErrInvalidKey, fields[0])
+	}
+
+	p.currentKeyID = fields[0]
+	p.currentKeyRetrieved = time.Now()
+	p.keys[fields[0]] = key
+
+	return p.currentKeyID, key, nil
+}
+
+func (p *PluginKeyProvider) Key(keyID string) ([]byte, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	key, ok := p.keys[keyID]
+	if ok {
+		return key, nil
+	}
+
+	err := validateKeyID(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := p.run("get", keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err = hex.DecodeString(strings.TrimSpace(out))
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed key returned by key plugin", ErrInvalidKey)
+	}
+
+	err = validateKey(keyID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	p.keys[keyID] = key
+
+	return key, nil
+}
+
+func (p *PluginKeyProvider) run(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.path, append(append([]string{}, p.args...), args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("%w: %v: %s", ErrKeyPluginFailure, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
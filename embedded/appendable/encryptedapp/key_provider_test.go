@@ -0,0 +1,226 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptedapp
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticKeyProvider(t *testing.T) {
+	_, err := NewStaticKeyProvider("k1", map[string][]byte{"k1": {1, 2, 3}})
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewStaticKeyProvider("k 1", map[string][]byte{"k 1": make([]byte, 16)})
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewStaticKeyProvider("-k1", map[string][]byte{"-k1": make([]byte, 16)})
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewStaticKeyProvider("k\x001", map[string][]byte{"k\x001": make([]byte, 16)})
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewStaticKeyProvider("k2", map[string][]byte{"k1": make([]byte, 16)})
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	kp := testKeyProvider(t)
+
+	keyID, key, err := kp.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+	require.Equal(t, bytes.Repeat([]byte{1}, 32), key)
+
+	key, err = kp.Key("k2")
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{2}, 16), key)
+
+	_, err = kp.Key("k3")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestKeyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := OpenKeyFile(filepath.Join(dir, "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	for _, d := range []struct {
+		n       string
+		content string
+		err     error
+	}{
+		{"empty", "# no keys\n", ErrKeyNotFound},
+		{"malformed", "k1\n", ErrInvalidKey},
+		{"not hex", "k1 zz\n", ErrInvalidKey},
+		{"short key", "k1 0102\n", ErrInvalidKey},
+		{"duplicated", "k1 00000000000000000000000000000000\nk1 00000000000000000000000000000000\n", ErrInvalidKey},
+	} {
+		t.Run(d.n, func(t *testing.T) {
+			path := filepath.Join(dir, "keyfile")
+
+			err := os.WriteFile(path, []byte(d.content), 0600)
+			require.NoError(t, err)
+
+			_, err = OpenKeyFile(path)
+			require.ErrorIs(t, err, d.err)
+		})
+	}
+
+	path := filepath.Join(dir, "keyfile")
+
+	err = os.WriteFile(path, []byte(
+		"# rotated keys are kept to decrypt existing files\n"+
+			"k1 0101010101010101010101010101010101010101010101010101010101010101\n"+
+			"\n"+
+			"k2 02020202020202020202020202020202\n",
+	), 0600)
+	require.NoError(t, err)
+
+	kp, err := OpenKeyFile(path)
+	require.NoError(t, err)
+
+	keyID, key, err := kp.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "k2", keyID)
+	require.Equal(t, bytes.Repeat([]byte{2}, 16), key)
+
+	key, err = kp.Key("k1")
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{1}, 32), key)
+}
+
+func TestPluginKeyProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not supported")
+	}
+
+	plugin := filepath.Join(t.TempDir(), "keyplugin.sh")
+
+	err := os.WriteFile(plugin, []byte(`#!/bin/sh
+case "$1" in
+current) echo "k2 02020202020202020202020202020202" ;;
+get)
+	case "$2" in
+	k1) echo "0101010101010101010101010101010101010101010101010101010101010101" ;;
+	k2) echo "02020202020202020202020202020202" ;;
+	k3) echo "0303" ;;
+	*) echo "unknown key" >&2; exit 1 ;;
+	esac ;;
+esac
+`), 0700)
+	require.NoError(t, err)
+
+	kp := NewPluginKeyProvider(plugin)
+
+	keyID, key, err := kp.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "k2", keyID)
+	require.Equal(t, bytes.Repeat([]byte{2}, 16), key)
+
+	key, err = kp.Key("k1")
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{1}, 32), key)
+
+	_, err = kp.Key("k3")
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = kp.Key("k4")
+	require.ErrorIs(t, err, ErrKeyPluginFailure)
+	require.Contains(t, err.Error(), "unknown key")
+
+	// invalid key ids are not handed to the plugin
+	for _, keyID := range []string{"", "--help", "k 1", "k1\n", string(make([]byte, MaxKeyIDLen+1))} {
+		_, err = kp.Key(keyID)
+		require.ErrorIs(t, err, ErrInvalidKey)
+		require.NotErrorIs(t, err, ErrKeyPluginFailure)
+	}
+
+	// keys are cached once retrieved
+	err = os.Remove(plugin)
+	require.NoError(t, err)
+
+	keyID, _, err = kp.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "k2", keyID)
+
+	_, err = kp.Key("k1")
+	require.NoError(t, err)
+
+	app := openTestApp(t, filepath.Join(t.TempDir(), "testdata.aof"), DefaultOptions().WithKeyProvider(kp))
+	require.Equal(t, "k2", app.(*AppendableFile).KeyID())
+	require.NoError(t, app.Close())
+
+	_, _, err = NewPluginKeyProvider(plugin).CurrentKey()
+	require.ErrorIs(t, err, ErrKeyPluginFailure)
+}
+
+func TestPluginKeyProviderRotation(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not supported")
+	}
+
+	dir := t.TempDir()
+
+	current := filepath.Join(dir, "current")
+	plugin := filepath.Join(dir, "keyplugin.sh")
+
+	err := os.WriteFile(plugin, []byte(`#!/bin/sh
+case "$1" in
+current) cat "`+current+`" ;;
+esac
+`), 0700)
+	require.NoError(t, err)
+
+	err = os.WriteFile(current, []byte("k1 01010101010101010101010101010101"), 0600)
+	require.NoError(t, err)
+
+	kp := NewPluginKeyProvider(plugin).WithCurrentKeyTTL(time.Hour)
+
+	keyID, _, err := kp.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+
+	err = os.WriteFile(current, []byte("k2 02020202020202020202020202020202"), 0600)
+	require.NoError(t, err)
+
+	// the current key is cached until its ttl expires
+	keyID, _, err = kp.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+
+	kp.WithCurrentKeyTTL(0)
+
+	app := openTestApp(t, filepath.Join(dir, "testdata.aof"), DefaultOptions().WithKeyProvider(kp))
+	require.Equal(t, "k2", app.(*AppendableFile).KeyID())
+	require.NoError(t, app.Close())
+
+	key, err := kp.Key("k1")
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{1}, 16), key)
+
+	err = os.WriteFile(current, []byte("k1 03030303030303030303030303030303"), 0600)
+	require.NoError(t, err)
+
+	_, _, err = kp.CurrentKey()
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
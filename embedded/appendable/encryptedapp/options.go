@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptedapp
+
+import "fmt"
+
+const DefaultFrameSize = 4096
+
+type Options struct {
+	keyProvider KeyProvider
+	readOnly    bool
+
+	// frameSize is the size on disk of each encrypted frame, including nonce and tag.
+	// It's only used when creating new files, existing ones keep their own frame size
+	frameSize int
+}
+
+func DefaultOptions() *Options {
+	return &Options{
+		frameSize: DefaultFrameSize,
+	}
+}
+
+func (opts *Options) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil options", ErrInvalidOptions)
+	}
+
+	if opts.keyProvider == nil {
+		return fmt.Errorf("%w: invalid keyProvider", ErrInvalidOptions)
+	}
+
+	if opts.frameSize < headerMaxSize {
+		return fmt.Errorf("%w: invalid frameSize", ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+func (opts *Options) WithKeyProvider(keyProvider KeyProvider) *Options {
+	opts.keyProvider = keyProvider
+	return opts
+}
+
+func (opts *Options) WithReadOnly(readOnly bool) *Options {
+	opts.readOnly = readOnly
+	return opts
+}
+
+func (opts *Options) WithFrameSize(frameSize int) *Options {
+	opts.frameSize = frameSize
+	return opts
+}
+
+func (opts *Options) GetKeyProvider() KeyProvider {
+	return opts.keyProvider
+}
+
+func (opts *Options) GetFrameSize() int {
+	return opts.frameSize
+}
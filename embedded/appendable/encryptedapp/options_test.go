@@ -0,0 +1,52 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptedapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidOptions(t *testing.T) {
+	for _, d := range []struct {
+		n    string
+		opts *Options
+	}{
+		{"nil", nil},
+		{"empty", &Options{}},
+		{"KeyProvider", DefaultOptions()},
+		{"FrameSize", DefaultOptions().WithKeyProvider(testKeyProvider(t)).WithFrameSize(headerMaxSize - 1)},
+	} {
+		t.Run(d.n, func(t *testing.T) {
+			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
+		})
+	}
+}
+
+func TestValidOptions(t *testing.T) {
+	kp := testKeyProvider(t)
+
+	opts := DefaultOptions().WithKeyProvider(kp)
+	require.NoError(t, opts.Validate())
+
+	require.Equal(t, kp, opts.GetKeyProvider())
+	require.Equal(t, DefaultFrameSize, opts.GetFrameSize())
+	require.Equal(t, 1024, opts.WithFrameSize(1024).GetFrameSize())
+	require.True(t, opts.WithReadOnly(true).readOnly)
+	require.NoError(t, opts.Validate())
+}
@@ -28,6 +28,7 @@ import (
 	"sync"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/fileutils"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 	"github.com/codenotary/immudb/embedded/cache"
@@ -38,6 +39,7 @@ var ErrIllegalArguments = errors.New("multiapp: illegal arguments")
 var ErrInvalidOptions = fmt.Errorf("%w: invalid options", ErrIllegalArguments)
 var ErrAlreadyClosed = errors.New("multiapp: already closed")
 var ErrReadOnly = errors.New("multiapp: read-only mode")
+var ErrCompactionUnsupported = errors.New("multiapp: compaction unsupported")
 
 const (
 	metaFileSize    = "FILE_SIZE"
@@ -108,6 +110,13 @@ type MultiFileAppendable struct {
 	fileExt        string
 	readBufferSize int
 	prealloc       bool
+	keyProvider    encryptedapp.KeyProvider
+
+	// compression settings of newly created files,
+	// existing ones are read according to their own settings
+	compressionFormat int
+	compressionLevel  int
+	compressionDict   []byte
 
 	writeBuffer []byte // shared write-buffer only used by active appendable
 
@@ -167,6 +176,7 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		WithFileMode(opts.fileMode).
 		WithCompressionFormat(opts.compressionFormat).
 		WithCompresionLevel(opts.compressionLevel).
+		WithCompressionDictionary(opts.compressionDict).
 		WithReadBufferSize(opts.readBufferSize).
 		WithWriteBuffer(writeBuffer).
 		WithMetadata(m.Bytes())
@@ -180,6 +190,11 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		return nil, err
 	}
 
+	currApp, err = encrypt(currApp, opts.keyProvider, opts.readOnly)
+	if err != nil {
+		return nil, err
+	}
+
 	cache, err := cache.NewCache(opts.maxOpenedFiles)
 	if err != nil {
 		return nil, err
@@ -200,12 +215,45 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		fileExt:        opts.fileExt,
 		readBufferSize: opts.readBufferSize,
 		prealloc:       opts.prealloc,
+		keyProvider:    opts.keyProvider,
 		writeBuffer:    writeBuffer,
 		closed:         false,
 		hooks:          hooks,
+
+		compressionFormat: opts.compressionFormat,
+		compressionLevel:  opts.compressionLevel,
+		compressionDict:   opts.compressionDict,
 	}, nil
 }
 
+// encrypt wraps the appendable with an encryption layer when a key provider is set
+func encrypt(app appendable.Appendable, keyProvider encryptedapp.KeyProvider, readOnly bool) (appendable.Appendable, error) {
+	if keyProvider == nil {
+		encrypted, err := encryptedapp.IsEncrypted(app)
+		if err == nil && encrypted {
+			err = encryptedapp.ErrKeyProviderRequired
+		}
+		if err != nil {
+			app.Close()
+			return nil, err
+		}
+
+		return app, nil
+	}
+
+	opts := encryptedapp.DefaultOptions().
+		WithKeyProvider(keyProvider).
+		WithReadOnly(readOnly)
+
+	eapp, err := encryptedapp.Open(app, opts)
+	if err != nil {
+		app.Close()
+		return nil, err
+	}
+
+	return eapp, nil
+}
+
 func appendableName(appID int64, ext string) string {
 	return fmt.Sprintf("%08d.%s", appID, ext)
 }
@@ -265,6 +313,180 @@ func copyFile(srcPath, dstPath string) (int64, error) {
 	return io.Copy(dstFile, srcFile)
 }
 
+// SupportsCompaction returns true when chunks are plain local files which can be rewritten in place
+func (mf *MultiFileAppendable) SupportsCompaction() bool {
+	_, defaultHooks := mf.hooks.(*DefaultMultiFileAppendableHooks)
+
+	return defaultHooks && mf.keyProvider == nil
+}
+
+// Compactable returns true when the given range is fully held by chunks which Compact rewrites,
+// that is, when none of its data is held by the active chunk
+func (mf *MultiFileAppendable) Compactable(r appendable.Range) bool {
+	mf.mutex.Lock()
+	defer mf.mutex.Unlock()
+
+	if r.Off < 0 || r.Len < 0 || appendableID(r.Off, mf.fileSize) >= mf.currAppID {
+		return false
+	}
+
+	if mf.compressionFormat != appendable.NoCompression {
+		// compressed data is never split across chunks
+		return true
+	}
+
+	return r.Off+r.Len <= mf.currAppID*int64(mf.fileSize)
+}
+
+// Compact rewrites the chunks holding data within the given ranges, leaving holes in place of such data.
+// Offsets are preserved, thus the data is no longer readable but the rest of the content is unaffected.
+// The active chunk is not rewritten. The number of rewritten chunks is returned.
+// Callers must ensure no concurrent reads are taking place over the affected ranges.
+func (mf *MultiFileAppendable) Compact(ranges []appendable.Range) (int, error) {
+	mf.mutex.Lock()
+	defer mf.mutex.Unlock()
+
+	if mf.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if mf.readOnly {
+		return 0, ErrReadOnly
+	}
+
+	if !mf.SupportsCompaction() {
+		return 0, ErrCompactionUnsupported
+	}
+
+	chunkOpts := singleapp.DefaultOptions().
+		WithReadOnly(true).
+		WithFileMode(mf.fileMode).
+		WithReadBufferSize(mf.readBufferSize)
+
+	chunks := make(map[int64]*singleapp.AppendableFile)
+	chunkRanges := make(map[int64][]appendable.Range)
+
+	defer func() {
+		for _, chunk := range chunks {
+			chunk.Close()
+		}
+	}()
+
+	chunkFor := func(appID int64) (*singleapp.AppendableFile, error) {
+		chunk, ok := chunks[appID]
+		if ok {
+			return chunk, nil
+		}
+
+		chunk, err := singleapp.Open(filepath.Join(mf.path, appendableName(appID, mf.fileExt)), chunkOpts)
+		if os.IsNotExist(err) {
+			// chunk was already discarded
+			chunks[appID] = nil
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		chunks[appID] = chunk
+
+		return chunk, nil
+	}
+
+	for _, r := range ranges {
+		if r.Off < 0 || r.Len < 0 {
+			return 0, ErrIllegalArguments
+		}
+
+		off := r.Off
+		n := r.Len
+
+		for {
+			appID := appendableID(off, mf.fileSize)
+			if appID >= mf.currAppID {
+				break
+			}
+
+			chunk, err := chunkFor(appID)
+			if err != nil {
+				return 0, err
+			}
+
+			chunkOff := off % int64(mf.fileSize)
+
+			if chunk != nil && chunk.CompressionFormat() != appendable.NoCompression {
+				// compressed data is never split across chunks
+				chunkRanges[appID] = append(chunkRanges[appID], appendable.Range{Off: chunkOff, Len: n})
+				break
+			}
+
+			l := n
+			if chunkOff+l > int64(mf.fileSize) {
+				l = int64(mf.fileSize) - chunkOff
+			}
+
+			if chunk != nil {
+				chunkRanges[appID] = append(chunkRanges[appID], appendable.Range{Off: chunkOff, Len: l})
+			}
+
+			off += l
+			n -= l
+
+			if n == 0 {
+				break
+			}
+		}
+	}
+
+	compacted := 0
+
+	for appID, ranges := range chunkRanges {
+		chunk := chunks[appID]
+
+		appFile := filepath.Join(mf.path, appendableName(appID, mf.fileExt))
+		tmpFile := appFile + ".compact"
+
+		err := chunk.CompactTo(tmpFile, ranges)
+		if err != nil {
+			os.Remove(tmpFile)
+			return compacted, err
+		}
+
+		err = chunk.Close()
+		delete(chunks, appID)
+		if err != nil {
+			os.Remove(tmpFile)
+			return compacted, err
+		}
+
+		// cached appendable must be reopened after the chunk is replaced
+		app, err := mf.appendables.Pop(appID)
+		if err == nil {
+			err = app.Close()
+		}
+		if err != nil && !errors.Is(err, cache.ErrKeyNotFound) {
+			os.Remove(tmpFile)
+			return compacted, err
+		}
+
+		err = os.Rename(tmpFile, appFile)
+		if err != nil {
+			return compacted, err
+		}
+
+		compacted++
+	}
+
+	if compacted > 0 {
+		err := fileutils.SyncDir(mf.path)
+		if err != nil {
+			return compacted, err
+		}
+	}
+
+	return compacted, nil
+}
+
 func (mf *MultiFileAppendable) CompressionFormat() int {
 	mf.mutex.Lock()
 	defer mf.mutex.Unlock()
@@ -389,8 +611,9 @@ func (mf *MultiFileAppendable) openAppendable(appname string, createIfNotExists,
 		WithFileMode(mf.fileMode).
 		WithCreateIfNotExists(createIfNotExists).
 		WithReadBufferSize(mf.readBufferSize).
-		WithCompressionFormat(mf.currApp.CompressionFormat()).
-		WithCompresionLevel(mf.currApp.CompressionLevel()).
+		WithCompressionFormat(mf.compressionFormat).
+		WithCompresionLevel(mf.compressionLevel).
+		WithCompressionDictionary(mf.compressionDict).
 		WithMetadata(mf.currApp.Metadata())
 
 	if mf.prealloc {
@@ -401,7 +624,13 @@ func (mf *MultiFileAppendable) openAppendable(appname string, createIfNotExists,
 		appendableOpts.WithWriteBuffer(mf.writeBuffer)
 	}
 
-	return mf.hooks.OpenAppendable(appendableOpts, appname, activeChunk)
+	app, err := mf.hooks.OpenAppendable(appendableOpts, appname, activeChunk)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the active chunk gets written
+	return encrypt(app, mf.keyProvider, mf.readOnly || !activeChunk)
 }
 
 func (mf *MultiFileAppendable) Offset() int64 {
@@ -706,6 +935,11 @@ func (mf *MultiFileAppendable) Close() error {
 	return mf.currApp.Close()
 }
 
+// FileSize returns the size of each chunk
+func (mf *MultiFileAppendable) FileSize() int {
+	return mf.fileSize
+}
+
 func (mf *MultiFileAppendable) CurrApp() (appendable.Appendable, int64) {
 	mf.mutex.Lock()
 	defer mf.mutex.Unlock()
@@ -713,6 +947,11 @@ func (mf *MultiFileAppendable) CurrApp() (appendable.Appendable, int64) {
 }
 
 func (mf *MultiFileAppendable) ReplaceCachedChunk(appID int64, app appendable.Appendable) (appendable.Appendable, error) {
+	app, err := encrypt(app, mf.keyProvider, true)
+	if err != nil {
+		return nil, err
+	}
+
 	return mf.appendables.Replace(appID, app)
 }
 
@@ -17,6 +17,7 @@ limitations under the License.
 package multiapp
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -24,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 
 	"github.com/stretchr/testify/require"
@@ -303,6 +305,203 @@ func TestMultiAppCompression(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMultiAppCompact(t *testing.T) {
+	a, err := Open(t.TempDir(), DefaultOptions().WithFileSize(8))
+	require.NoError(t, err)
+
+	defer a.Close()
+
+	require.True(t, a.SupportsCompaction())
+
+	var offs []int64
+
+	for i := 0; i < 4; i++ {
+		off, _, err := a.Append([]byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		offs = append(offs, off)
+	}
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	_, err = a.Compact([]appendable.Range{{Off: -1}})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	require.False(t, a.Compactable(appendable.Range{Off: -1}))
+	require.True(t, a.Compactable(appendable.Range{Off: offs[1], Len: 6}))
+	require.False(t, a.Compactable(appendable.Range{Off: offs[3], Len: 6}))
+
+	// value1 spans two chunks and value3 is in the active chunk
+	n, err := a.Compact([]appendable.Range{
+		{Off: offs[1], Len: 6},
+		{Off: offs[3], Len: 6},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	bs := make([]byte, 6)
+
+	for i, off := range offs {
+		_, err = a.ReadAt(bs, off)
+		require.NoError(t, err)
+
+		if i == 1 {
+			require.Equal(t, make([]byte, 6), bs)
+		} else {
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), bs)
+		}
+	}
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	_, err = a.Compact(nil)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
+
+func TestMultiAppCompactUnsupported(t *testing.T) {
+	a, err := Open(t.TempDir(), DefaultOptions().WithKeyProvider(testKeyProvider(t)))
+	require.NoError(t, err)
+
+	defer a.Close()
+
+	require.False(t, a.SupportsCompaction())
+
+	_, err = a.Compact(nil)
+	require.ErrorIs(t, err, ErrCompactionUnsupported)
+}
+
+func TestMultiAppMixedCompression(t *testing.T) {
+	path := t.TempDir()
+
+	dict := []byte("immudb-record-")
+
+	formats := []*Options{
+		DefaultOptions().WithCompressionFormat(appendable.ZLibCompression),
+		DefaultOptions().WithCompressionFormat(appendable.ZStdCompression).WithCompressionDictionary(dict),
+		DefaultOptions().WithCompressionFormat(appendable.LZ4Compression),
+		DefaultOptions(),
+	}
+
+	var offs []int64
+	var records [][]byte
+
+	for i, opts := range formats {
+		// each record fills up a chunk so every format gets its own files
+		a, err := Open(path, opts.WithFileSize(16))
+		require.NoError(t, err)
+
+		for j := 0; j < 3; j++ {
+			record := []byte(fmt.Sprintf("immudb-record-%d-%d", i, j))
+
+			off, _, err := a.Append(record)
+			require.NoError(t, err)
+
+			offs = append(offs, off)
+			records = append(records, record)
+		}
+
+		err = a.Close()
+		require.NoError(t, err)
+	}
+
+	a, err := Open(path, DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	for i, record := range records {
+		bs := make([]byte, len(record))
+		_, err = a.ReadAt(bs, offs[i])
+		require.NoError(t, err)
+		require.Equal(t, record, bs)
+	}
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
+func testKeyProvider(t *testing.T) *encryptedapp.StaticKeyProvider {
+	kp, err := encryptedapp.NewStaticKeyProvider("k1", map[string][]byte{
+		"k1": []byte("0123456789abcdef0123456789abcdef"),
+	})
+	require.NoError(t, err)
+
+	return kp
+}
+
+func TestMultiAppEncryption(t *testing.T) {
+	path := t.TempDir()
+
+	opts := DefaultOptions().
+		WithFileSize(10000).
+		WithKeyProvider(testKeyProvider(t))
+
+	a, err := Open(path, opts)
+	require.NoError(t, err)
+
+	data := make([]byte, 25000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	off, n, err := a.Append(data)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), off)
+	require.Equal(t, len(data), n)
+
+	bs := make([]byte, len(data))
+	_, err = a.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, data, bs)
+
+	backupPath := filepath.Join(t.TempDir(), "backup")
+
+	err = a.Copy(backupPath)
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	for _, p := range []string{path, backupPath} {
+		entries, err := os.ReadDir(p)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+
+		for _, e := range entries {
+			raw, err := os.ReadFile(filepath.Join(p, e.Name()))
+			require.NoError(t, err)
+			require.NotContains(t, string(raw), string(data[20000:20100]))
+		}
+
+		a, err = Open(p, opts)
+		require.NoError(t, err)
+
+		sz, err := a.Size()
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), sz)
+
+		bs := make([]byte, len(data))
+		_, err = a.ReadAt(bs, 0)
+		require.NoError(t, err)
+		require.Equal(t, data, bs)
+
+		err = a.Close()
+		require.NoError(t, err)
+	}
+
+	// encrypted data can not be opened with a different key
+	kp, err := encryptedapp.NewStaticKeyProvider("k1", map[string][]byte{
+		"k1": []byte("fedcba9876543210fedcba9876543210"),
+	})
+	require.NoError(t, err)
+
+	_, err = Open(path, opts.WithKeyProvider(kp))
+	require.ErrorIs(t, err, encryptedapp.ErrInvalidKey)
+
+	_, err = Open(path, opts.WithKeyProvider(nil))
+	require.ErrorIs(t, err, encryptedapp.ErrKeyProviderRequired)
+}
+
 func TestMultiAppAppendableForCurrentChunk(t *testing.T) {
 	path := t.TempDir()
 
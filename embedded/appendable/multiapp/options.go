@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 )
 
 const DefaultFileSize = 1 << 26 // 64Mb
@@ -47,6 +48,9 @@ type Options struct {
 	maxOpenedFiles    int
 	compressionFormat int
 	compressionLevel  int
+	compressionDict   []byte
+
+	keyProvider encryptedapp.KeyProvider // if set, underlying appendables are encrypted
 }
 
 func DefaultOptions() *Options {
@@ -90,6 +94,14 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid writeBufferSize", ErrInvalidOptions)
 	}
 
+	if opts.keyProvider != nil && opts.compressionFormat != appendable.NoCompression {
+		return fmt.Errorf("%w: compression is not supported with encryption", ErrInvalidOptions)
+	}
+
+	if opts.keyProvider != nil && opts.prealloc {
+		return fmt.Errorf("%w: preallocation is not supported with encryption", ErrInvalidOptions)
+	}
+
 	return nil
 }
 
@@ -143,6 +155,12 @@ func (opt *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opt
 }
 
+// WithCompressionDictionary sets the zstd dictionary used by newly created files
+func (opt *Options) WithCompressionDictionary(dict []byte) *Options {
+	opt.compressionDict = dict
+	return opt
+}
+
 func (opts *Options) WithReadBufferSize(size int) *Options {
 	opts.readBufferSize = size
 	return opts
@@ -158,6 +176,11 @@ func (opts *Options) WithPrealloc(prealloc bool) *Options {
 	return opts
 }
 
+func (opts *Options) WithKeyProvider(keyProvider encryptedapp.KeyProvider) *Options {
+	opts.keyProvider = keyProvider
+	return opts
+}
+
 func (opt *Options) GetFileExt() string {
 	return opt.fileExt
 }
@@ -166,6 +189,10 @@ func (opt *Options) GetFileMode() os.FileMode {
 	return opt.fileMode
 }
 
+func (opts *Options) GetReadOnly() bool {
+	return opts.readOnly
+}
+
 func (opts *Options) GetReadBufferSize() int {
 	return opts.readBufferSize
 }
@@ -177,3 +204,7 @@ func (opts *Options) GetWriteBufferSize() int {
 func (opts *Options) GetPrealloc() bool {
 	return opts.prealloc
 }
+
+func (opts *Options) GetKeyProvider() encryptedapp.KeyProvider {
+	return opts.keyProvider
+}
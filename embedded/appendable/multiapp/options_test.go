@@ -19,6 +19,7 @@ package multiapp
 import (
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,6 +36,8 @@ func TestInvalidOptions(t *testing.T) {
 		{"FileExt", DefaultOptions().WithFileExt("")},
 		{"ReadBufferSize", DefaultOptions().WithReadBufferSize(0)},
 		{"WriteBufferSize", DefaultOptions().WithReadOnly(false).WithWriteBufferSize(0)},
+		{"Encryption with compression", DefaultOptions().WithKeyProvider(testKeyProvider(t)).WithCompressionFormat(appendable.ZLibCompression)},
+		{"Encryption with prealloc", DefaultOptions().WithKeyProvider(testKeyProvider(t)).WithPrealloc(true)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -76,4 +79,8 @@ func TestValidOptions(t *testing.T) {
 
 	require.True(t, opts.WithReadOnly(true).readOnly)
 	require.NoError(t, opts.Validate())
+
+	kp := testKeyProvider(t)
+	require.Equal(t, kp, opts.WithKeyProvider(kp).GetKeyProvider())
+	require.NoError(t, opts.Validate())
 }
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/fileutils"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
@@ -247,7 +248,7 @@ func (r *RemoteStorageAppendable) uploadChunk(chunkID int64, dontRemoveFile bool
 			}
 			if err == nil {
 				err := oldApp.Close()
-				if err != nil && !errors.Is(err, singleapp.ErrAlreadyClosed) {
+				if err != nil && !errors.Is(err, singleapp.ErrAlreadyClosed) && !errors.Is(err, encryptedapp.ErrAlreadyClosed) {
 					return err
 				}
 			}
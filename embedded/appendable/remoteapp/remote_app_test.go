@@ -24,12 +24,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 	"github.com/codenotary/immudb/embedded/remotestorage"
@@ -331,6 +333,61 @@ func TestReopenFromRemoteStorageOnCleanShutdown(t *testing.T) {
 	require.Equal(t, dataWritten, dataRead)
 }
 
+func TestReopenEncryptedFromRemoteStorage(t *testing.T) {
+	path := t.TempDir()
+
+	kp, err := encryptedapp.NewStaticKeyProvider("k1", map[string][]byte{
+		"k1": []byte("0123456789abcdef"),
+	})
+	require.NoError(t, err)
+
+	mem := memory.Open()
+	opts := DefaultOptions()
+	opts.WithFileExt("tst")
+	opts.WithFileSize(100)
+	opts.WithKeyProvider(kp)
+	app, err := Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	dataWritten := []byte(strings.Repeat("Some pretty long string to cross a chunk boundary. ", 5))
+
+	offs, n, err := app.Append(dataWritten)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, offs)
+	require.EqualValues(t, len(dataWritten), n)
+	require.True(t, waitForRemoval(fmt.Sprintf("%s/00000001.tst", path)))
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	// remote chunks are encrypted
+	for i := 0; i < 3; i++ {
+		r, err := mem.Get(context.Background(), fmt.Sprintf("%08d.tst", i), 0, -1)
+		require.NoError(t, err)
+
+		raw, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+
+		require.NotContains(t, string(raw), "pretty long string")
+	}
+
+	err = os.RemoveAll(path)
+	require.NoError(t, err)
+
+	app, err = Open(path, "", mem, opts)
+	require.NoError(t, err)
+
+	dataRead := make([]byte, len(dataWritten))
+	n, err = app.ReadAt(dataRead, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, len(dataWritten), n)
+	require.Equal(t, dataWritten, dataRead)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
 func TestRemoteStorageMetrics(t *testing.T) {
 	mStarted := testutil.ToFloat64(metricsUploadStarted)
 	mFinished := testutil.ToFloat64(metricsUploadFinished)
@@ -63,7 +63,7 @@ func (r *remoteStorageReader) Metadata() []byte {
 }
 
 func (r *remoteStorageReader) Size() (int64, error) {
-	panic("unimplemented")
+	return int64(len(r.dataCache)), nil
 }
 
 func (r *remoteStorageReader) Offset() int64 {
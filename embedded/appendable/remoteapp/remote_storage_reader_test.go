@@ -37,7 +37,6 @@ func TestRemoteStorageReaderUnsupportedMethods(t *testing.T) {
 	r := remoteStorageReader{}
 
 	require.Panics(t, func() { r.Metadata() })
-	require.Panics(t, func() { r.Size() })
 	require.Panics(t, func() { r.Offset() })
 	require.Panics(t, func() { r.SetOffset(0) })
 	require.Panics(t, func() { r.Append([]byte{0}) })
@@ -150,3 +149,11 @@ func TestRemoteStorageOpenError(t *testing.T) {
 		require.Nil(t, r)
 	}
 }
+
+func TestRemoteStorageReaderSize(t *testing.T) {
+	r := remoteStorageReader{dataCache: []byte{1, 2, 3}}
+
+	sz, err := r.Size()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, sz)
+}
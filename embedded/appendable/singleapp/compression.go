@@ -0,0 +1,207 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleapp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// blockCodec compresses each appended record as a whole, unlike
+// stream-based formats which require a new writer per record
+type blockCodec interface {
+	compress(src []byte) ([]byte, error)
+	decompress(src []byte) ([]byte, error)
+	close()
+}
+
+func isBlockCompression(compressionFormat int) bool {
+	return compressionFormat == appendable.ZStdCompression || compressionFormat == appendable.LZ4Compression
+}
+
+func newBlockCodec(compressionFormat, compressionLevel int, dict []byte) (blockCodec, error) {
+	switch compressionFormat {
+	case appendable.ZStdCompression:
+		return &zstdCodec{level: zstdLevel(compressionLevel), dict: dict}, nil
+	case appendable.LZ4Compression:
+		return &lz4Codec{hc: lz4.CompressorHC{Level: lz4Level(compressionLevel)}}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported compression format %d", ErrIllegalArguments, compressionFormat)
+}
+
+func zstdLevel(compressionLevel int) zstd.EncoderLevel {
+	switch compressionLevel {
+	case appendable.DefaultCompression:
+		return zstd.SpeedDefault
+	case appendable.HuffmanOnly:
+		return zstd.SpeedFastest
+	}
+	return zstd.EncoderLevelFromZstd(compressionLevel)
+}
+
+func lz4Level(compressionLevel int) lz4.CompressionLevel {
+	if compressionLevel <= appendable.BestSpeed {
+		return lz4.Fast
+	}
+	if compressionLevel > appendable.BestCompression {
+		compressionLevel = appendable.BestCompression
+	}
+	return lz4.CompressionLevel(1 << (8 + compressionLevel))
+}
+
+// dictID derives a stable identifier for a raw dictionary, kept outside of
+// the range reserved by the zstd format for registered dictionaries
+func dictID(dict []byte) uint32 {
+	return crc32.ChecksumIEEE(dict) | 1<<31
+}
+
+type zstdCodec struct {
+	level zstd.EncoderLevel
+	dict  []byte
+
+	// encoder and decoder are lazily created as files are mostly either written or read
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func (c *zstdCodec) compress(src []byte) ([]byte, error) {
+	if c.encoder == nil {
+		opts := []zstd.EOption{
+			zstd.WithEncoderLevel(c.level),
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithLowerEncoderMem(true),
+		}
+
+		if len(c.dict) > 0 {
+			opts = append(opts, zstd.WithEncoderDictRaw(dictID(c.dict), c.dict))
+		}
+
+		encoder, err := zstd.NewWriter(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		c.encoder = encoder
+	}
+
+	return c.encoder.EncodeAll(src, nil), nil
+}
+
+func (c *zstdCodec) decompress(src []byte) ([]byte, error) {
+	if c.decoder == nil {
+		opts := []zstd.DOption{
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true),
+		}
+
+		if len(c.dict) > 0 {
+			opts = append(opts, zstd.WithDecoderDictRaw(dictID(c.dict), c.dict))
+		}
+
+		decoder, err := zstd.NewReader(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		c.decoder = decoder
+	}
+
+	return c.decoder.DecodeAll(src, nil)
+}
+
+func (c *zstdCodec) close() {
+	if c.encoder != nil {
+		c.encoder.Close()
+	}
+	if c.decoder != nil {
+		c.decoder.Close()
+	}
+}
+
+// lz4MaxRatio bounds the length of the data an lz4 block expands to, as each
+// byte of the block encodes at most 255 bytes of a repeated match
+const lz4MaxRatio = 255
+
+// lz4Codec stores the uncompressed length in front of each lz4 block.
+// Incompressible records are stored as-is, which is detected when
+// the block length matches the uncompressed one
+type lz4Codec struct {
+	// compressors are kept as they hold internal hash tables
+	fast lz4.Compressor
+	hc   lz4.CompressorHC
+}
+
+func (c *lz4Codec) compress(src []byte) ([]byte, error) {
+	dst := make([]byte, 4+lz4.CompressBlockBound(len(src)))
+	binary.BigEndian.PutUint32(dst, uint32(len(src)))
+
+	var n int
+	var err error
+
+	if c.hc.Level == lz4.Fast {
+		n, err = c.fast.CompressBlock(src, dst[4:])
+	} else {
+		n, err = c.hc.CompressBlock(src, dst[4:])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 || n >= len(src) {
+		n = copy(dst[4:], src)
+	}
+
+	return dst[:4+n], nil
+}
+
+func (c *lz4Codec) decompress(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, ErrCorruptedData
+	}
+
+	// the length is checked before allocating, so that corrupted records
+	// can not declare more data than their blocks are able to hold
+	dLen := uint64(binary.BigEndian.Uint32(src))
+	if dLen > lz4MaxRatio*uint64(len(src)-4) {
+		return nil, ErrCorruptedData
+	}
+
+	dst := make([]byte, dLen)
+
+	if len(src)-4 == len(dst) {
+		copy(dst, src[4:])
+		return dst, nil
+	}
+
+	n, err := lz4.UncompressBlock(src[4:], dst)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedData, err)
+	}
+	if n != len(dst) {
+		return nil, ErrCorruptedData
+	}
+
+	return dst, nil
+}
+
+func (c *lz4Codec) close() {
+}
@@ -41,6 +41,7 @@ type Options struct {
 
 	compressionFormat int
 	compressionLevel  int
+	compressionDict   []byte
 
 	preallocSize      int
 	createIfNotExists bool
@@ -75,6 +76,14 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid writeBuffer", ErrInvalidOptions)
 	}
 
+	if opts.compressionFormat < appendable.NoCompression || opts.compressionFormat > appendable.LZ4Compression {
+		return fmt.Errorf("%w: invalid compressionFormat", ErrInvalidOptions)
+	}
+
+	if len(opts.compressionDict) > 0 && opts.compressionFormat != appendable.ZStdCompression {
+		return fmt.Errorf("%w: compression dictionaries are only supported with zstd", ErrInvalidOptions)
+	}
+
 	if opts.preallocSize < 0 {
 		return fmt.Errorf("%w: invalid preallocSize", ErrInvalidOptions)
 	}
@@ -142,6 +151,17 @@ func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opts
 }
 
+// WithCompressionDictionary sets a raw dictionary used by zstd compression.
+// The dictionary is recorded in the header of newly created files
+func (opts *Options) WithCompressionDictionary(dict []byte) *Options {
+	opts.compressionDict = dict
+	return opts
+}
+
+func (opts *Options) GetCompressionDictionary() []byte {
+	return opts.compressionDict
+}
+
 func (opts *Options) WithMetadata(metadata []byte) *Options {
 	opts.metadata = metadata
 	return opts
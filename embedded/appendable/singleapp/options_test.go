@@ -19,6 +19,8 @@ package singleapp
 import (
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,6 +33,8 @@ func TestInvalidOptions(t *testing.T) {
 		{"empty", &Options{}},
 		{"ReadBufferSize", DefaultOptions().WithReadBufferSize(0)},
 		{"WriteBuffer", DefaultOptions().WithReadOnly(false).WithWriteBuffer(nil)},
+		{"CompressionFormat", DefaultOptions().WithCompressionFormat(appendable.LZ4Compression + 1)},
+		{"CompressionDictionary", DefaultOptions().WithCompressionFormat(appendable.LZ4Compression).WithCompressionDictionary([]byte{1})},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -51,6 +55,8 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, DefaultCompressionFormat, opts.WithCompressionFormat(DefaultCompressionFormat).GetCompressionFormat())
 	require.Equal(t, DefaultCompressionLevel, opts.WithCompresionLevel(DefaultCompressionLevel).compressionLevel)
 	require.Equal(t, DefaultCompressionLevel, opts.WithCompresionLevel(DefaultCompressionLevel).GetCompressionLevel())
+	require.Equal(t, []byte{1}, opts.WithCompressionDictionary([]byte{1}).GetCompressionDictionary())
+	opts.WithCompressionDictionary(nil)
 
 	require.True(t, opts.WithRetryableSync(true).retryableSync)
 	require.True(t, opts.WithAutoSync(true).autoSync)
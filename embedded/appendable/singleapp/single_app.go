@@ -29,6 +29,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 
 	"github.com/codenotary/immudb/embedded/appendable"
@@ -41,6 +42,7 @@ var ErrInvalidOptions = fmt.Errorf("%w: invalid options", ErrIllegalArguments)
 var ErrAlreadyClosed = errors.New("singleapp: already closed")
 var ErrReadOnly = errors.New("singleapp: read-only mode")
 var ErrCorruptedMetadata = errors.New("singleapp: corrupted metadata")
+var ErrCorruptedData = errors.New("singleapp: corrupted data")
 var ErrBufferFull = errors.New("singleapp: buffer full")
 var ErrNegativeOffset = errors.New("singleapp: negative offset")
 
@@ -48,6 +50,7 @@ const (
 	metaPreallocSize      = "PREALLOC_SIZE"
 	metaCompressionFormat = "COMPRESSION_FORMAT"
 	metaCompressionLevel  = "COMPRESSION_LEVEL"
+	metaCompressionDict   = "COMPRESSION_DICT"
 	metaWrappedMeta       = "WRAPPED_METADATA"
 )
 
@@ -70,6 +73,10 @@ type AppendableFile struct {
 
 	compressionFormat int
 	compressionLevel  int
+	compressionDict   []byte
+
+	// codec is only used by block compression formats
+	codec blockCodec
 
 	preallocSize int
 
@@ -109,6 +116,7 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 	var metadata []byte
 	var compressionFormat int
 	var compressionLevel int
+	var compressionDict []byte
 	var fileBaseOffset int64
 
 	var preallocSize int
@@ -118,6 +126,9 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		m.PutInt(metaPreallocSize, opts.preallocSize)
 		m.PutInt(metaCompressionFormat, opts.compressionFormat)
 		m.PutInt(metaCompressionLevel, opts.compressionLevel)
+		if len(opts.compressionDict) > 0 {
+			m.Put(metaCompressionDict, opts.compressionDict)
+		}
 		m.Put(metaWrappedMeta, opts.metadata)
 
 		mBs := m.Bytes()
@@ -166,6 +177,7 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		preallocSize = opts.preallocSize
 		compressionFormat = opts.compressionFormat
 		compressionLevel = opts.compressionLevel
+		compressionDict = opts.compressionDict
 		metadata = opts.metadata
 
 		fileBaseOffset = int64(4 + len(mBs))
@@ -203,6 +215,9 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		}
 		compressionLevel = cl
 
+		// files created without a dictionary have no entry for it
+		compressionDict, _ = m.Get(metaCompressionDict)
+
 		metadata, ok = m.Get(metaWrappedMeta)
 		if !ok {
 			return nil, ErrCorruptedMetadata
@@ -216,6 +231,16 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		return nil, err
 	}
 
+	var codec blockCodec
+
+	if isBlockCompression(compressionFormat) {
+		codec, err = newBlockCodec(compressionFormat, compressionLevel, compressionDict)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
 	return &AppendableFile{
 		f:                 f,
 		fileBaseOffset:    fileBaseOffset,
@@ -224,6 +249,8 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		readBufferSize:    opts.readBufferSize,
 		compressionFormat: compressionFormat,
 		compressionLevel:  compressionLevel,
+		compressionDict:   compressionDict,
+		codec:             codec,
 		preallocSize:      preallocSize,
 		metadata:          metadata,
 		readOnly:          opts.readOnly,
@@ -267,6 +294,113 @@ func (aof *AppendableFile) Copy(dstPath string) error {
 	return dstFile.Sync()
 }
 
+// CompactTo writes a copy of the file into dstPath where data within the given ranges is left out,
+// offsets are preserved by leaving holes in place of the discarded data, which take no space
+// on filesystems supporting sparse files. When data is compressed, the whole chunk of data
+// appended at the offset of the range is discarded.
+func (aof *AppendableFile) CompactTo(dstPath string, ranges []appendable.Range) error {
+	aof.mutex.Lock()
+	defer aof.mutex.Unlock()
+
+	if aof.closed {
+		return ErrAlreadyClosed
+	}
+
+	if !aof.readOnly {
+		err := aof.flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	holes := make([]appendable.Range, 0, len(ranges))
+
+	for _, r := range ranges {
+		if r.Off < 0 || r.Len < 0 {
+			return ErrIllegalArguments
+		}
+
+		off := r.Off
+		n := r.Len
+
+		if aof.compressionFormat != appendable.NoCompression {
+			var clenBs [4]byte
+
+			_, err := aof.readAt(clenBs[:], r.Off)
+			if err != nil {
+				return err
+			}
+
+			// the length of the compressed chunk is preserved
+			off = r.Off + 4
+			n = int64(binary.BigEndian.Uint32(clenBs[:]))
+		}
+
+		if off+n > aof.fileOffset {
+			n = aof.fileOffset - off
+		}
+
+		if n > 0 {
+			holes = append(holes, appendable.Range{Off: aof.fileBaseOffset + off, Len: n})
+		}
+	}
+
+	sort.Slice(holes, func(i, j int) bool {
+		return holes[i].Off < holes[j].Off
+	})
+
+	finfo, err := aof.f.Stat()
+	if err != nil {
+		return err
+	}
+
+	dstFile, err := os.OpenFile(dstPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, finfo.Mode())
+	if err != nil {
+		return err
+	}
+	defer dstFile.Close()
+
+	var pos int64
+
+	copyUpto := func(end int64) error {
+		if end <= pos {
+			return nil
+		}
+
+		_, err := dstFile.Seek(pos, io.SeekStart)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(dstFile, io.NewSectionReader(aof.f, pos, end-pos))
+		return err
+	}
+
+	for _, h := range holes {
+		err = copyUpto(h.Off)
+		if err != nil {
+			return err
+		}
+
+		if h.Off+h.Len > pos {
+			pos = h.Off + h.Len
+		}
+	}
+
+	err = copyUpto(finfo.Size())
+	if err != nil {
+		return err
+	}
+
+	// trailing holes are not allocated either
+	err = dstFile.Truncate(finfo.Size())
+	if err != nil {
+		return err
+	}
+
+	return dstFile.Sync()
+}
+
 func (aof *AppendableFile) CompressionFormat() int {
 	return aof.compressionFormat
 }
@@ -275,6 +409,11 @@ func (aof *AppendableFile) CompressionLevel() int {
 	return aof.compressionLevel
 }
 
+// CompressionDictionary returns the dictionary employed by zstd compression, if any
+func (aof *AppendableFile) CompressionDictionary() []byte {
+	return aof.compressionDict
+}
+
 func (aof *AppendableFile) Metadata() []byte {
 	return aof.metadata
 }
@@ -386,6 +525,45 @@ func (aof *AppendableFile) reader(r io.Reader) (reader io.ReadCloser, err error)
 	return
 }
 
+func (aof *AppendableFile) compress(bs []byte) ([]byte, error) {
+	if aof.codec != nil {
+		return aof.codec.compress(bs)
+	}
+
+	var b bytes.Buffer
+
+	w, err := aof.writer(&b)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.Write(bs)
+	if err != nil {
+		return nil, err
+	}
+
+	w.(io.Closer).Close()
+
+	return b.Bytes(), nil
+}
+
+func (aof *AppendableFile) decompress(bs []byte) ([]byte, error) {
+	if aof.codec != nil {
+		return aof.codec.decompress(bs)
+	}
+
+	r, err := aof.reader(bytes.NewReader(bs))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	return buf.Bytes(), nil
+}
+
 func (aof *AppendableFile) Append(bs []byte) (off int64, n int, err error) {
 	aof.mutex.Lock()
 	defer aof.mutex.Unlock()
@@ -409,22 +587,11 @@ func (aof *AppendableFile) Append(bs []byte) (off int64, n int, err error) {
 		return off, n, err
 	}
 
-	var b bytes.Buffer
-
-	w, err := aof.writer(&b)
-	if err != nil {
-		return 0, 0, err
-	}
-
-	_, err = w.Write(bs)
+	bb, err := aof.compress(bs)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	w.(io.Closer).Close()
-
-	bb := b.Bytes()
-
 	bbLenBs := make([]byte, 4)
 	binary.BigEndian.PutUint32(bbLenBs, uint32(len(bb)))
 
@@ -542,15 +709,10 @@ func (aof *AppendableFile) ReadAt(bs []byte, off int64) (n int, err error) {
 		return 0, err
 	}
 
-	r, err := aof.reader(bytes.NewReader(cBs))
+	rbs, err := aof.decompress(cBs)
 	if err != nil {
 		return 0, err
 	}
-	defer r.Close()
-
-	var buf bytes.Buffer
-	buf.ReadFrom(r)
-	rbs := buf.Bytes()
 
 	n = minInt(len(rbs), len(bs))
 
@@ -727,6 +889,10 @@ func (aof *AppendableFile) Close() error {
 
 	aof.closed = true
 
+	if aof.codec != nil {
+		aof.codec.close()
+	}
+
 	return aof.f.Close()
 }
 
@@ -19,11 +19,13 @@ package singleapp
 import (
 	"bufio"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/appendable"
@@ -479,6 +481,92 @@ func TestSingleAppLZWCompression(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSingleAppBlockCompression(t *testing.T) {
+	rndData := make([]byte, 1024)
+	rand.Read(rndData)
+
+	records := [][]byte{
+		{1, 2, 3},
+		[]byte(strings.Repeat(`{"name":"immudb","kind":"database"}`, 32)),
+		rndData,
+	}
+
+	for _, d := range []struct {
+		name   string
+		format int
+		levels []int
+		dict   []byte
+	}{
+		{"zstd", appendable.ZStdCompression, []int{appendable.BestSpeed, appendable.BestCompression, appendable.DefaultCompression, appendable.HuffmanOnly}, nil},
+		{"zstd with dictionary", appendable.ZStdCompression, []int{appendable.BestSpeed}, []byte(`{"name":"immudb","kind":"database"}`)},
+		{"lz4", appendable.LZ4Compression, []int{appendable.BestSpeed, appendable.BestCompression, appendable.DefaultCompression, appendable.HuffmanOnly}, nil},
+	} {
+		for _, level := range d.levels {
+			t.Run(fmt.Sprintf("%s/level %d", d.name, level), func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "testdata.aof")
+
+				opts := DefaultOptions().
+					WithCompressionFormat(d.format).
+					WithCompresionLevel(level).
+					WithCompressionDictionary(d.dict)
+
+				a, err := Open(path, opts)
+				require.NoError(t, err)
+
+				offs := make([]int64, len(records))
+
+				for i, r := range records {
+					offs[i], _, err = a.Append(r)
+					require.NoError(t, err)
+				}
+
+				err = a.Close()
+				require.NoError(t, err)
+
+				// compression settings are taken from the file header
+				a, err = Open(path, DefaultOptions().WithReadOnly(true))
+				require.NoError(t, err)
+				require.Equal(t, d.format, a.CompressionFormat())
+				require.Equal(t, level, a.CompressionLevel())
+				require.Equal(t, d.dict, a.CompressionDictionary())
+
+				for i, r := range records {
+					bs := make([]byte, len(r))
+					_, err = a.ReadAt(bs, offs[i])
+					require.NoError(t, err)
+					require.Equal(t, r, bs)
+				}
+
+				err = a.Close()
+				require.NoError(t, err)
+			})
+		}
+	}
+}
+
+func TestSingleAppCorruptedLZ4Block(t *testing.T) {
+	a, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), DefaultOptions().WithCompressionFormat(appendable.LZ4Compression))
+	require.NoError(t, err)
+	defer a.Close()
+
+	off, _, err := a.Append(make([]byte, 3))
+	require.NoError(t, err)
+
+	// lz4 records are prefixed with the compressed length and the uncompressed one
+	_, _, err = a.Append([]byte{0, 0, 0, 2, 0, 0, 0, 100, 1, 2})
+	require.NoError(t, err)
+
+	_, err = a.ReadAt(make([]byte, 3), off+4+4+3+4)
+	require.ErrorIs(t, err, ErrCorruptedData)
+
+	// the declared length is checked against the length of the block before allocating memory
+	off, _, err = a.Append([]byte{0, 0, 0, 6, 0xff, 0xff, 0xff, 0xff, 1, 2})
+	require.NoError(t, err)
+
+	_, err = a.ReadAt(make([]byte, 3), off+4+4)
+	require.ErrorIs(t, err, ErrCorruptedData)
+}
+
 func TestSingleAppCantCreateFile(t *testing.T) {
 	dir := t.TempDir()
 	os.Mkdir(filepath.Join(dir, "exists"), 0644)
@@ -512,6 +600,66 @@ func TestSingleAppDiscard(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSingleAppCompactTo(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, compressionFormat := range []int{appendable.NoCompression, appendable.ZLibCompression} {
+		t.Run(fmt.Sprintf("compression format %d", compressionFormat), func(t *testing.T) {
+			app, err := Open(filepath.Join(dir, fmt.Sprintf("src_%d.aof", compressionFormat)), DefaultOptions().WithCompressionFormat(compressionFormat))
+			require.NoError(t, err)
+
+			var offs []int64
+
+			for i := 0; i < 3; i++ {
+				off, _, err := app.Append([]byte(fmt.Sprintf("value%d", i)))
+				require.NoError(t, err)
+
+				offs = append(offs, off)
+			}
+
+			dstPath := filepath.Join(dir, fmt.Sprintf("dst_%d.aof", compressionFormat))
+
+			err = app.CompactTo(dstPath, []appendable.Range{{Off: -1, Len: 1}})
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			err = app.CompactTo(dstPath, []appendable.Range{
+				{Off: offs[2], Len: 6},
+				{Off: offs[0], Len: 6},
+			})
+			require.NoError(t, err)
+
+			err = app.Close()
+			require.NoError(t, err)
+
+			err = app.CompactTo(dstPath, nil)
+			require.ErrorIs(t, err, ErrAlreadyClosed)
+
+			compacted, err := Open(dstPath, DefaultOptions().WithReadOnly(true))
+			require.NoError(t, err)
+			defer compacted.Close()
+
+			bs := make([]byte, 6)
+
+			_, err = compacted.ReadAt(bs, offs[1])
+			require.NoError(t, err)
+			require.Equal(t, []byte("value1"), bs)
+
+			if compressionFormat == appendable.NoCompression {
+				_, err = compacted.ReadAt(bs, offs[0])
+				require.NoError(t, err)
+				require.Equal(t, make([]byte, 6), bs)
+
+				_, err = compacted.ReadAt(bs, offs[2])
+				require.NoError(t, err)
+				require.Equal(t, make([]byte, 6), bs)
+			} else {
+				_, err = compacted.ReadAt(bs, offs[0])
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func BenchmarkAppendFlush(b *testing.B) {
 	opts := DefaultOptions().
 		WithRetryableSync(false).
@@ -560,3 +708,44 @@ func BenchmarkAppendFlushless(b *testing.B) {
 	err = app.Close()
 	require.NoError(b, err)
 }
+
+func BenchmarkAppendCompressed(b *testing.B) {
+	chunck := []byte(strings.Repeat(`{"id":1234,"name":"immudb","tags":["immutable","database"]}`, 8))
+
+	for _, d := range []struct {
+		name   string
+		format int
+	}{
+		{"none", appendable.NoCompression},
+		{"flate", appendable.FlateCompression},
+		{"gzip", appendable.GZipCompression},
+		{"lzw", appendable.LZWCompression},
+		{"zlib", appendable.ZLibCompression},
+		{"zstd", appendable.ZStdCompression},
+		{"lz4", appendable.LZ4Compression},
+	} {
+		b.Run(d.name, func(b *testing.B) {
+			opts := DefaultOptions().
+				WithRetryableSync(false).
+				WithCompressionFormat(d.format)
+
+			app, err := Open(filepath.Join(b.TempDir(), "testdata_benchmark_compressed.aof"), opts)
+			require.NoError(b, err)
+
+			b.SetBytes(int64(len(chunck)))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, _, err = app.Append(chunck)
+				require.NoError(b, err)
+			}
+
+			b.StopTimer()
+
+			b.ReportMetric(float64(app.Offset())/float64(b.N*len(chunck)), "ratio")
+
+			err = app.Close()
+			require.NoError(b, err)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/remotestorage"
+)
+
+// metadata keys as written by singleapp
+const (
+	metaCompressionFormat = "COMPRESSION_FORMAT"
+	metaWrappedMeta       = "WRAPPED_METADATA"
+)
+
+// coldChunkReader reads a chunk stored in the cold tier,
+// data is fetched from the cold storage on each read
+type coldChunkReader struct {
+	storage    remotestorage.Storage
+	name       string
+	baseOffset int64
+	size       int64
+	metadata   []byte
+	closed     bool
+}
+
+func openColdChunkReader(storage remotestorage.Storage, name string, size int64) (*coldChunkReader, error) {
+	var mLenBs [4]byte
+
+	err := readFull(storage, name, mLenBs[:], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	mLen := int64(binary.BigEndian.Uint32(mLenBs[:]))
+	baseOffset := int64(len(mLenBs)) + mLen
+
+	if baseOffset > size {
+		return nil, ErrCorruptedMetadata
+	}
+
+	mBs := make([]byte, mLen)
+
+	err = readFull(storage, name, mBs, int64(len(mLenBs)))
+	if err != nil {
+		return nil, err
+	}
+
+	m := appendable.NewMetadata(mBs)
+
+	compressionFormat, ok := m.GetInt(metaCompressionFormat)
+	if !ok {
+		return nil, ErrCorruptedMetadata
+	}
+
+	if compressionFormat != appendable.NoCompression {
+		return nil, ErrCompressionNotSupported
+	}
+
+	metadata, _ := m.Get(metaWrappedMeta)
+
+	return &coldChunkReader{
+		storage:    storage,
+		name:       name,
+		baseOffset: baseOffset,
+		size:       size - baseOffset,
+		metadata:   metadata,
+	}, nil
+}
+
+func readFull(storage remotestorage.Storage, name string, bs []byte, off int64) error {
+	if len(bs) == 0 {
+		return nil
+	}
+
+	r, err := storage.Get(context.Background(), name, off, int64(len(bs)))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = io.ReadFull(r, bs)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return io.EOF
+	}
+
+	return err
+}
+
+func (r *coldChunkReader) Metadata() []byte {
+	return r.metadata
+}
+
+func (r *coldChunkReader) Size() (int64, error) {
+	return r.size, nil
+}
+
+func (r *coldChunkReader) Offset() int64 {
+	return r.size
+}
+
+func (r *coldChunkReader) SetOffset(off int64) error {
+	return ErrReadOnlyChunk
+}
+
+func (r *coldChunkReader) DiscardUpto(off int64) error {
+	return ErrReadOnlyChunk
+}
+
+func (r *coldChunkReader) Append(bs []byte) (off int64, n int, err error) {
+	return 0, 0, ErrReadOnlyChunk
+}
+
+func (r *coldChunkReader) CompressionFormat() int {
+	return appendable.NoCompression
+}
+
+func (r *coldChunkReader) CompressionLevel() int {
+	return appendable.DefaultCompressionLevel
+}
+
+func (r *coldChunkReader) Flush() error {
+	return nil
+}
+
+func (r *coldChunkReader) Sync() error {
+	return nil
+}
+
+func (r *coldChunkReader) SwitchToReadOnlyMode() error {
+	return nil
+}
+
+func (r *coldChunkReader) ReadAt(bs []byte, off int64) (int, error) {
+	if r.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if off < 0 {
+		return 0, ErrIllegalArguments
+	}
+
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	n := len(bs)
+	if int64(n) > r.size-off {
+		n = int(r.size - off)
+	}
+
+	metricsColdReads.Inc()
+
+	err := readFull(r.storage, r.name, bs[:n], r.baseOffset+off)
+	if err != nil {
+		metricsColdReadErrors.Inc()
+		return 0, err
+	}
+
+	metricsColdReadBytes.Add(float64(n))
+
+	if n < len(bs) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+func (r *coldChunkReader) Close() error {
+	if r.closed {
+		return ErrAlreadyClosed
+	}
+
+	r.closed = true
+
+	return nil
+}
+
+func (r *coldChunkReader) Copy(dstPath string) error {
+	rc, err := r.storage.Get(context.Background(), r.name, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return copyToFile(rc, dstPath)
+}
+
+var _ appendable.Appendable = (*coldChunkReader)(nil)
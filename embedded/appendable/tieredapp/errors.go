@@ -0,0 +1,29 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import "errors"
+
+var (
+	ErrIllegalArguments        = errors.New("tieredapp: illegal arguments")
+	ErrInvalidOptions          = errors.New("tieredapp: invalid options")
+	ErrCompressionNotSupported = errors.New("tieredapp: compression is not supported")
+	ErrInvalidLocalStorage     = errors.New("tieredapp: invalid local storage")
+	ErrInvalidColdStorage      = errors.New("tieredapp: invalid cold storage")
+	ErrCorruptedMetadata       = errors.New("tieredapp: corrupted metadata in a cold chunk")
+	ErrReadOnlyChunk           = errors.New("tieredapp: cold chunks are read-only")
+	ErrAlreadyClosed           = errors.New("tieredapp: already closed")
+)
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// ---- Migrations ---------------------------------------
+
+	metricsMigrationEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_tieredapp_migration_events",
+		Help: "Immudb tiered storage migration event counters",
+	}, []string{"event"})
+
+	metricsMigrationStarted   = metricsMigrationEvents.WithLabelValues("started")
+	metricsMigrationFailed    = metricsMigrationEvents.WithLabelValues("failed")
+	metricsMigrationSucceeded = metricsMigrationEvents.WithLabelValues("succeeded")
+
+	metricsMigrationTime = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "immudb_tieredapp_migration_time",
+		Help:    "Histogram of the total time required to migrate a chunk to the cold tier",
+		Buckets: []float64{.1, .25, .5, 1, 2.5, 5, 10, 25, 50},
+	})
+
+	metricsMigratedBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "immudb_tieredapp_migrated_bytes",
+		Help: "Total number of bytes migrated to the cold tier",
+	})
+
+	// ---- Cold reads ---------------------------------------
+
+	metricsColdReadEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_tieredapp_cold_read_events",
+		Help: "Read event counters for chunks stored in the cold tier",
+	}, []string{"event"})
+
+	metricsColdReads      = metricsColdReadEvents.WithLabelValues("total_reads")
+	metricsColdReadErrors = metricsColdReadEvents.WithLabelValues("errors")
+	metricsColdReadBytes  = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "immudb_tieredapp_cold_read_bytes",
+		Help: "Total number of bytes read from chunks stored in the cold tier",
+	})
+
+	// ---- Fetches ---------------------------------------
+
+	metricsFetchEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_tieredapp_fetch_events",
+		Help: "Counters of cold chunks fetched back into the hot tier",
+	}, []string{"event"})
+
+	metricsFetchFailed    = metricsFetchEvents.WithLabelValues("failed")
+	metricsFetchSucceeded = metricsFetchEvents.WithLabelValues("succeeded")
+
+	// ---- Chunk statistics --------------------------------
+
+	metricsChunkCounts = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "immudb_tieredapp_chunk_count",
+		Help: "Number of chunks stored in each tier",
+	}, []string{"path", "tier"})
+
+	metricsChunkDataBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "immudb_tieredapp_chunk_bytes",
+		Help: "Total number of bytes stored in chunks of each tier",
+	}, []string{"path", "tier"})
+)
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+)
+
+const DefaultMigrationInterval = time.Minute
+
+type Options struct {
+	multiapp.Options
+
+	policy            MigrationPolicy
+	migrationInterval time.Duration
+}
+
+func DefaultOptions() *Options {
+	return &Options{
+		Options:           *multiapp.DefaultOptions(),
+		migrationInterval: DefaultMigrationInterval,
+	}
+}
+
+func (opts *Options) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil options", ErrInvalidOptions)
+	}
+
+	err := opts.Options.Validate()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidOptions, err)
+	}
+
+	if opts.GetPrealloc() {
+		return fmt.Errorf("%w: preallocation is not supported", ErrInvalidOptions)
+	}
+
+	if opts.migrationInterval <= 0 {
+		return fmt.Errorf("%w: invalid migrationInterval", ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+// WithMigrationPolicy sets the policy used to decide which chunks are moved to the cold tier
+// in background, when no policy is set chunks are only migrated on demand
+func (opts *Options) WithMigrationPolicy(policy MigrationPolicy) *Options {
+	opts.policy = policy
+	return opts
+}
+
+// WithMigrationInterval sets how often the migration policy is evaluated
+func (opts *Options) WithMigrationInterval(migrationInterval time.Duration) *Options {
+	opts.migrationInterval = migrationInterval
+	return opts
+}
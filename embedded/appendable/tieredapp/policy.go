@@ -0,0 +1,58 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import "time"
+
+// ChunkInfo describes a sealed chunk still stored in the hot tier
+type ChunkInfo struct {
+	ID      int64
+	Size    int64
+	ModTime time.Time // time the chunk was last written
+
+	// Newer is the number of chunks created after this one, including the active one
+	Newer int64
+}
+
+// MigrationPolicy returns true when the chunk must be moved to the cold tier
+type MigrationPolicy func(chunk ChunkInfo, now time.Time) bool
+
+// MaxAgePolicy migrates chunks which were not written during the given period of time
+func MaxAgePolicy(maxAge time.Duration) MigrationPolicy {
+	return func(chunk ChunkInfo, now time.Time) bool {
+		return now.Sub(chunk.ModTime) > maxAge
+	}
+}
+
+// MaxHotChunksPolicy keeps the given number of most recent chunks in the hot tier,
+// the active chunk is included
+func MaxHotChunksPolicy(hotChunks int) MigrationPolicy {
+	return func(chunk ChunkInfo, now time.Time) bool {
+		return chunk.Newer >= int64(hotChunks)
+	}
+}
+
+// AnyPolicy migrates chunks satisfying any of the given policies
+func AnyPolicy(policies ...MigrationPolicy) MigrationPolicy {
+	return func(chunk ChunkInfo, now time.Time) bool {
+		for _, policy := range policies {
+			if policy(chunk, now) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,606 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/fileutils"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+	"github.com/codenotary/immudb/embedded/cache"
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type chunkTier int
+
+const (
+	chunkTier_Missing chunkTier = iota // discarded chunk
+	chunkTier_Hot
+	chunkTier_Migrating
+	chunkTier_Cold
+)
+
+var chunkTierNames = []string{
+	"missing",
+	"hot",
+	"migrating",
+	"cold",
+}
+
+func (t chunkTier) String() string {
+	if t < 0 || int(t) >= len(chunkTierNames) {
+		return fmt.Sprintf("chunkTier(%d)", t)
+	}
+	return chunkTierNames[t]
+}
+
+type chunkInfo struct {
+	tier chunkTier
+	size int64 // storage size in bytes
+}
+
+// TieredAppendable keeps the most recent chunks in a local directory (hot tier)
+// while older chunks are migrated into a cold storage, e.g. an object store or a slower mount point.
+// Chunks in the cold tier are transparently read from the cold storage.
+type TieredAppendable struct {
+	*multiapp.MultiFileAppendable
+
+	coldStorage remotestorage.Storage
+	path        string
+	coldPath    string
+	fileExt     string
+	fileMode    os.FileMode
+
+	policy            MigrationPolicy
+	migrationInterval time.Duration
+
+	mutex             sync.Mutex
+	chunkInfos        []chunkInfo // indexed by chunk ID
+	migrationFinished *sync.Cond
+
+	migrationMutex sync.Mutex // migrations are done one at a time
+
+	mainContext    context.Context
+	mainCancelFunc context.CancelFunc
+	migratorWG     sync.WaitGroup
+}
+
+func Open(path string, coldPath string, coldStorage remotestorage.Storage, opts *Options) (*TieredAppendable, error) {
+	if coldStorage == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if coldPath != "" && !strings.HasSuffix(coldPath, "/") ||
+		strings.HasPrefix(coldPath, "/") ||
+		strings.Contains(coldPath, "//") {
+		return nil, fmt.Errorf("%w: invalid cold path", ErrIllegalArguments)
+	}
+
+	mainContext, mainCancelFunc := context.WithCancel(context.Background())
+
+	t := &TieredAppendable{
+		coldStorage:       coldStorage,
+		path:              path,
+		coldPath:          coldPath,
+		fileExt:           opts.GetFileExt(),
+		fileMode:          opts.GetFileMode(),
+		policy:            opts.policy,
+		migrationInterval: opts.migrationInterval,
+		mainContext:       mainContext,
+		mainCancelFunc:    mainCancelFunc,
+	}
+	t.migrationFinished = sync.NewCond(&t.mutex)
+
+	mApp, err := multiapp.OpenWithHooks(path, t, &opts.Options)
+	if err != nil {
+		mainCancelFunc()
+		return nil, err
+	}
+
+	t.MultiFileAppendable = mApp
+
+	t.updateChunkMetrics()
+
+	if t.policy != nil && !opts.Options.GetReadOnly() {
+		t.startMigrator()
+	}
+
+	return t, nil
+}
+
+func chunkIDFromName(filename string) (int64, error) {
+	return strconv.ParseInt(strings.TrimSuffix(filename, filepath.Ext(filename)), 10, 64)
+}
+
+func (t *TieredAppendable) appendableName(chunkID int64) string {
+	return fmt.Sprintf("%08d.%s", chunkID, t.fileExt)
+}
+
+func (t *TieredAppendable) chunkInfoFor(chunkID int64) *chunkInfo {
+	for int64(len(t.chunkInfos)) <= chunkID {
+		t.chunkInfos = append(t.chunkInfos, chunkInfo{tier: chunkTier_Missing})
+	}
+	return &t.chunkInfos[chunkID]
+}
+
+func (t *TieredAppendable) OpenInitialAppendable(opts *multiapp.Options, singleAppOpts *singleapp.Options) (appendable.Appendable, int64, error) {
+	entries, err := os.ReadDir(t.path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), tmpFetchSuffix) {
+			// partially fetched chunk
+			err = os.Remove(filepath.Join(t.path, e.Name()))
+			if err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+
+		chunkID, err := chunkIDFromName(e.Name())
+		if err != nil || t.appendableName(chunkID) != e.Name() {
+			return nil, 0, fmt.Errorf("%w: unexpected file '%s'", ErrInvalidLocalStorage, e.Name())
+		}
+
+		fi, err := e.Info()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		info := t.chunkInfoFor(chunkID)
+		info.tier = chunkTier_Hot
+		info.size = fi.Size()
+	}
+
+	coldEntries, _, err := t.coldStorage.ListEntries(context.Background(), t.coldPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, e := range coldEntries {
+		chunkID, err := chunkIDFromName(e.Name)
+		if err != nil || t.appendableName(chunkID) != e.Name {
+			return nil, 0, fmt.Errorf("%w: unexpected object '%s'", ErrInvalidColdStorage, e.Name)
+		}
+
+		info := t.chunkInfoFor(chunkID)
+
+		// a chunk found in both tiers was not fully migrated, the local copy is kept
+		if info.tier != chunkTier_Hot {
+			info.tier = chunkTier_Cold
+			info.size = e.Size
+		}
+	}
+
+	if len(t.chunkInfos) == 0 {
+		// the first chunk will be created
+		t.chunkInfos = append(t.chunkInfos, chunkInfo{tier: chunkTier_Hot})
+	}
+
+	chunkID := int64(len(t.chunkInfos) - 1)
+
+	app, err := t.OpenAppendable(singleAppOpts, t.appendableName(chunkID), true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return app, chunkID, nil
+}
+
+func (t *TieredAppendable) OpenAppendable(options *singleapp.Options, appname string, activeChunk bool) (appendable.Appendable, error) {
+	if options.GetCompressionFormat() != appendable.NoCompression {
+		return nil, ErrCompressionNotSupported
+	}
+
+	chunkID, err := chunkIDFromName(appname)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	info := t.chunkInfoFor(chunkID)
+
+	for {
+		switch info.tier {
+		case chunkTier_Missing:
+			if activeChunk {
+				// a new chunk is being created
+				info.tier = chunkTier_Hot
+			}
+			return singleapp.Open(filepath.Join(t.path, appname), options)
+
+		case chunkTier_Hot:
+			return singleapp.Open(filepath.Join(t.path, appname), options)
+
+		case chunkTier_Migrating:
+			if !activeChunk {
+				return singleapp.Open(filepath.Join(t.path, appname), options)
+			}
+
+			t.migrationFinished.Wait()
+
+			info = t.chunkInfoFor(chunkID)
+
+		case chunkTier_Cold:
+			if !activeChunk {
+				return openColdChunkReader(t.coldStorage, t.coldPath+appname, info.size)
+			}
+
+			// chunks must be written locally
+			err := t.fetchChunk(appname)
+			if err != nil {
+				metricsFetchFailed.Inc()
+				return nil, err
+			}
+
+			metricsFetchSucceeded.Inc()
+
+			info.tier = chunkTier_Hot
+
+		default:
+			return nil, fmt.Errorf("%w: unexpected tier of chunk %d", ErrInvalidLocalStorage, chunkID)
+		}
+	}
+}
+
+const tmpFetchSuffix = ".tmp_fetch"
+
+// fetchChunk brings a chunk back into the hot tier, the copy in the cold tier is kept
+// until the chunk gets migrated again
+func (t *TieredAppendable) fetchChunk(appname string) error {
+	r, err := t.coldStorage.Get(t.mainContext, t.coldPath+appname, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	fileName := filepath.Join(t.path, appname)
+	tmpFileName := fileName + tmpFetchSuffix
+
+	err = copyToFile(r, tmpFileName)
+	if err != nil {
+		os.Remove(tmpFileName)
+		return err
+	}
+
+	err = os.Rename(tmpFileName, fileName)
+	if err != nil {
+		return err
+	}
+
+	return fileutils.SyncDir(t.path)
+}
+
+func copyToFile(r io.Reader, fileName string) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// MigrateUpto moves to the cold tier every sealed chunk holding data only below the given offset.
+// The number of migrated chunks is returned.
+func (t *TieredAppendable) MigrateUpto(off int64) (int, error) {
+	_, currChunkID := t.CurrApp()
+
+	uptoChunkID := off / int64(t.FileSize())
+	if uptoChunkID > currChunkID {
+		uptoChunkID = currChunkID
+	}
+
+	migrated := 0
+
+	for chunkID := int64(0); chunkID < uptoChunkID; chunkID++ {
+		ok, err := t.migrateChunk(t.mainContext, chunkID)
+		if err != nil {
+			return migrated, err
+		}
+		if ok {
+			migrated++
+		}
+	}
+
+	return migrated, nil
+}
+
+// migrate moves to the cold tier the sealed chunks selected by the migration policy
+func (t *TieredAppendable) migrate(ctx context.Context, now time.Time) (int, error) {
+	_, currChunkID := t.CurrApp()
+
+	var candidates []int64
+
+	t.mutex.Lock()
+
+	for chunkID := int64(0); chunkID < currChunkID && chunkID < int64(len(t.chunkInfos)); chunkID++ {
+		if t.chunkInfos[chunkID].tier != chunkTier_Hot {
+			continue
+		}
+
+		fi, err := os.Stat(filepath.Join(t.path, t.appendableName(chunkID)))
+		if err != nil {
+			continue
+		}
+
+		chunk := ChunkInfo{
+			ID:      chunkID,
+			Size:    fi.Size(),
+			ModTime: fi.ModTime(),
+			Newer:   currChunkID - chunkID,
+		}
+
+		if t.policy(chunk, now) {
+			candidates = append(candidates, chunkID)
+		}
+	}
+
+	t.mutex.Unlock()
+
+	migrated := 0
+
+	for _, chunkID := range candidates {
+		ok, err := t.migrateChunk(ctx, chunkID)
+		if err != nil {
+			return migrated, err
+		}
+		if ok {
+			migrated++
+		}
+	}
+
+	return migrated, nil
+}
+
+func (t *TieredAppendable) startMigrator() {
+	t.migratorWG.Add(1)
+
+	go func() {
+		defer t.migratorWG.Done()
+
+		ticker := time.NewTicker(t.migrationInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-t.mainContext.Done():
+				return
+			case <-ticker.C:
+			}
+
+			_, err := t.migrate(t.mainContext, time.Now())
+			if err != nil && t.mainContext.Err() == nil {
+				log.Printf("Migration of chunks at '%s' failed: %v", t.path, err)
+			}
+		}
+	}()
+}
+
+func (t *TieredAppendable) setChunkTier(chunkID int64, tier chunkTier, size int64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.chunkInfos[chunkID].tier = tier
+	t.chunkInfos[chunkID].size = size
+	t.migrationFinished.Broadcast()
+}
+
+// migrateChunk moves a sealed chunk to the cold tier,
+// false is returned if the chunk was not stored in the hot tier
+func (t *TieredAppendable) migrateChunk(ctx context.Context, chunkID int64) (bool, error) {
+	t.migrationMutex.Lock()
+	defer t.migrationMutex.Unlock()
+
+	_, currChunkID := t.CurrApp()
+	if chunkID >= currChunkID {
+		// the active chunk is always kept in the hot tier
+		return false, nil
+	}
+
+	appname := t.appendableName(chunkID)
+	fileName := filepath.Join(t.path, appname)
+
+	t.mutex.Lock()
+
+	if chunkID >= int64(len(t.chunkInfos)) || t.chunkInfos[chunkID].tier != chunkTier_Hot {
+		t.mutex.Unlock()
+		return false, nil
+	}
+
+	t.chunkInfos[chunkID].tier = chunkTier_Migrating
+
+	t.mutex.Unlock()
+
+	metricsMigrationStarted.Inc()
+	timer := prometheus.NewTimer(metricsMigrationTime)
+
+	coldApp, size, err := t.uploadChunk(ctx, appname, fileName)
+	if err != nil {
+		metricsMigrationFailed.Inc()
+		t.setChunkTier(chunkID, chunkTier_Hot, size)
+		return false, err
+	}
+
+	t.setChunkTier(chunkID, chunkTier_Cold, size)
+
+	// the cached local chunk, if any, is replaced by the cold one
+	oldApp, err := t.ReplaceCachedChunk(chunkID, coldApp)
+	if err == nil {
+		err = oldApp.Close()
+	}
+	if err != nil && !errors.Is(err, cache.ErrKeyNotFound) {
+		metricsMigrationFailed.Inc()
+		return false, err
+	}
+
+	err = os.Remove(fileName)
+	if err != nil {
+		metricsMigrationFailed.Inc()
+		return false, err
+	}
+
+	err = fileutils.SyncDir(t.path)
+	if err != nil {
+		metricsMigrationFailed.Inc()
+		return false, err
+	}
+
+	timer.ObserveDuration()
+	metricsMigrationSucceeded.Inc()
+	metricsMigratedBytes.Add(float64(size))
+
+	t.updateChunkMetrics()
+
+	return true, nil
+}
+
+func (t *TieredAppendable) uploadChunk(ctx context.Context, appname, fileName string) (appendable.Appendable, int64, error) {
+	fi, err := os.Stat(fileName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = t.coldStorage.Put(ctx, t.coldPath+appname, fileName)
+	if err != nil {
+		return nil, fi.Size(), err
+	}
+
+	// stored objects may not be immediately available
+	for delay := time.Millisecond; ; delay *= 2 {
+		exists, err := t.coldStorage.Exists(ctx, t.coldPath+appname)
+		if err != nil {
+			return nil, fi.Size(), err
+		}
+		if exists {
+			break
+		}
+
+		if delay > time.Second {
+			delay = time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fi.Size(), ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	coldApp, err := openColdChunkReader(t.coldStorage, t.coldPath+appname, fi.Size())
+	if err != nil {
+		return nil, fi.Size(), err
+	}
+
+	return coldApp, fi.Size(), nil
+}
+
+// DiscardUpto deletes the chunks holding data only below the given offset from both tiers
+func (t *TieredAppendable) DiscardUpto(off int64) error {
+	err := t.MultiFileAppendable.DiscardUpto(off)
+	if err != nil {
+		return err
+	}
+
+	_, currChunkID := t.CurrApp()
+
+	uptoChunkID := off / int64(t.FileSize())
+	if uptoChunkID > currChunkID {
+		uptoChunkID = currChunkID
+	}
+
+	t.migrationMutex.Lock()
+	defer t.migrationMutex.Unlock()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for chunkID := int64(0); chunkID < uptoChunkID && chunkID < int64(len(t.chunkInfos)); chunkID++ {
+		if t.chunkInfos[chunkID].tier == chunkTier_Cold {
+			err := t.coldStorage.Remove(t.mainContext, t.coldPath+t.appendableName(chunkID))
+			if err != nil {
+				return err
+			}
+		}
+
+		t.chunkInfos[chunkID] = chunkInfo{tier: chunkTier_Missing}
+	}
+
+	return nil
+}
+
+func (t *TieredAppendable) Close() error {
+	t.mainCancelFunc()
+	t.migratorWG.Wait()
+
+	return t.MultiFileAppendable.Close()
+}
+
+func (t *TieredAppendable) updateChunkMetrics() {
+	counts := make(map[string]int64, len(chunkTierNames))
+	sizes := make(map[string]int64, len(chunkTierNames))
+
+	for _, name := range chunkTierNames {
+		counts[name] = 0
+		sizes[name] = 0
+	}
+
+	t.mutex.Lock()
+
+	for _, info := range t.chunkInfos {
+		counts[info.tier.String()]++
+		sizes[info.tier.String()] += info.size
+	}
+
+	t.mutex.Unlock()
+
+	for tier, count := range counts {
+		metricsChunkCounts.With(prometheus.Labels{"path": t.path, "tier": tier}).Set(float64(count))
+	}
+
+	for tier, size := range sizes {
+		metricsChunkDataBytes.With(prometheus.Labels{"path": t.path, "tier": tier}).Set(float64(size))
+	}
+}
+
+var _ appendable.Appendable = (*TieredAppendable)(nil)
@@ -0,0 +1,273 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tieredapp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/remotestorage"
+	"github.com/codenotary/immudb/embedded/remotestorage/local"
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func testOptions() *Options {
+	opts := DefaultOptions()
+	opts.WithFileSize(16)
+	return opts
+}
+
+func testData(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return data
+}
+
+func localChunks(t *testing.T, path string) []string {
+	entries, err := os.ReadDir(path)
+	require.NoError(t, err)
+
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func coldChunks(t *testing.T, storage remotestorage.Storage, coldPath string) []string {
+	entries, _, err := storage.ListEntries(context.Background(), coldPath)
+	require.NoError(t, err)
+
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+func requireData(t *testing.T, app appendable.Appendable, off int64, expected []byte) {
+	bs := make([]byte, len(expected))
+
+	_, err := app.ReadAt(bs, off)
+	require.NoError(t, err)
+	require.Equal(t, expected, bs)
+}
+
+func TestOpenIllegalArguments(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := Open(dir, "", nil, DefaultOptions())
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = Open(dir, "", memory.Open(), nil)
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, err = Open(dir, "", memory.Open(), DefaultOptions().WithMigrationInterval(0))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	opts := DefaultOptions()
+	opts.WithPrealloc(true)
+
+	_, err = Open(dir, "", memory.Open(), opts)
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	for _, coldPath := range []string{"cold", "/cold/", "cold//path/"} {
+		_, err = Open(dir, coldPath, memory.Open(), DefaultOptions())
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	}
+
+	opts = DefaultOptions()
+	opts.WithCompressionFormat(appendable.ZLibCompression)
+
+	_, err = Open(dir, "", memory.Open(), opts)
+	require.ErrorIs(t, err, ErrCompressionNotSupported)
+
+	err = os.WriteFile(filepath.Join(dir, "unexpected"), nil, 0600)
+	require.NoError(t, err)
+
+	_, err = Open(dir, "", memory.Open(), DefaultOptions())
+	require.ErrorIs(t, err, ErrInvalidLocalStorage)
+}
+
+func TestTieredAppendableMigrateUpto(t *testing.T) {
+	path := t.TempDir()
+
+	coldStorage, err := local.Open(t.TempDir(), 0700)
+	require.NoError(t, err)
+
+	app, err := Open(path, "vlog/", coldStorage, testOptions())
+	require.NoError(t, err)
+
+	data := testData(100)
+
+	_, _, err = app.Append(data)
+	require.NoError(t, err)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	// only chunks fully below the offset are migrated
+	n, err := app.MigrateUpto(40)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	require.Equal(t, []string{"00000000.aof", "00000001.aof"}, coldChunks(t, coldStorage, "vlog/"))
+
+	n, err = app.MigrateUpto(app.Offset())
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	n, err = app.MigrateUpto(app.Offset())
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	// the active chunk is kept in the hot tier
+	require.Equal(t, []string{"00000006.aof"}, localChunks(t, path))
+	require.Len(t, coldChunks(t, coldStorage, "vlog/"), 6)
+
+	requireData(t, app, 0, data)
+	requireData(t, app, 30, data[30:50])
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	app, err = Open(path, "vlog/", coldStorage, testOptions())
+	require.NoError(t, err)
+
+	requireData(t, app, 0, data)
+
+	off, _, err := app.Append([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), off)
+
+	requireData(t, app, 98, []byte{data[98], data[99], 1, 2, 3})
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
+func TestTieredAppendableMigrationPolicy(t *testing.T) {
+	path := t.TempDir()
+	coldStorage := memory.Open()
+
+	opts := testOptions().
+		WithMigrationPolicy(MaxHotChunksPolicy(2)).
+		WithMigrationInterval(10 * time.Millisecond)
+
+	app, err := Open(path, "", coldStorage, opts)
+	require.NoError(t, err)
+
+	defer app.Close()
+
+	data := testData(100)
+
+	_, _, err = app.Append(data)
+	require.NoError(t, err)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(coldChunks(t, coldStorage, "")) == 5
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, []string{"00000005.aof", "00000006.aof"}, localChunks(t, path))
+
+	requireData(t, app, 0, data)
+}
+
+func TestTieredAppendableSetOffsetIntoColdChunk(t *testing.T) {
+	path := t.TempDir()
+	coldStorage := memory.Open()
+
+	app, err := Open(path, "", coldStorage, testOptions())
+	require.NoError(t, err)
+
+	defer app.Close()
+
+	data := testData(40)
+
+	_, _, err = app.Append(data)
+	require.NoError(t, err)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	n, err := app.MigrateUpto(app.Offset())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// the cold chunk is fetched back into the hot tier to be written
+	err = app.SetOffset(20)
+	require.NoError(t, err)
+
+	require.Contains(t, localChunks(t, path), "00000001.aof")
+
+	_, _, err = app.Append([]byte{255, 255})
+	require.NoError(t, err)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	requireData(t, app, 0, append(data[:20:20], 255, 255))
+}
+
+func TestTieredAppendableDiscardUpto(t *testing.T) {
+	path := t.TempDir()
+	coldStorage := memory.Open()
+
+	app, err := Open(path, "", coldStorage, testOptions())
+	require.NoError(t, err)
+
+	defer app.Close()
+
+	_, _, err = app.Append(testData(100))
+	require.NoError(t, err)
+
+	_, err = app.MigrateUpto(48)
+	require.NoError(t, err)
+
+	err = app.DiscardUpto(64)
+	require.NoError(t, err)
+
+	require.Empty(t, coldChunks(t, coldStorage, ""))
+	require.Equal(t, []string{"00000004.aof", "00000005.aof", "00000006.aof"}, localChunks(t, path))
+
+	requireData(t, app, 64, testData(100)[64:])
+}
+
+func TestMigrationPolicies(t *testing.T) {
+	now := time.Now()
+
+	chunk := ChunkInfo{ID: 1, ModTime: now.Add(-time.Hour), Newer: 3}
+
+	require.True(t, MaxAgePolicy(time.Minute)(chunk, now))
+	require.False(t, MaxAgePolicy(2*time.Hour)(chunk, now))
+
+	require.True(t, MaxHotChunksPolicy(3)(chunk, now))
+	require.False(t, MaxHotChunksPolicy(4)(chunk, now))
+
+	require.True(t, AnyPolicy(MaxAgePolicy(2*time.Hour), MaxHotChunksPolicy(3))(chunk, now))
+	require.False(t, AnyPolicy(MaxAgePolicy(2*time.Hour), MaxHotChunksPolicy(4))(chunk, now))
+	require.False(t, AnyPolicy()(chunk, now))
+}
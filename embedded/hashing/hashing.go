@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hashing
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+
+	"lukechampine.com/blake3"
+)
+
+var ErrUnsupportedAlgorithm = errors.New("hashing: unsupported hash algorithm")
+
+// Size is the length of the digests produced by every supported algorithm,
+// it matches sha256.Size so digests can be stored and transmitted unchanged
+const Size = sha256.Size
+
+// Algorithm identifies the hash function used to build the Merkle structures
+// and the accumulative linear hash of a database
+type Algorithm int
+
+const (
+	SHA256 Algorithm = iota
+	SHA512_256
+	BLAKE3
+)
+
+const DefaultAlgorithm = SHA256
+
+var algorithmNames = map[Algorithm]string{
+	SHA256:     "sha256",
+	SHA512_256: "sha512_256",
+	BLAKE3:     "blake3",
+}
+
+// ParseAlgorithm returns the algorithm with the given name,
+// an empty name is resolved to the default algorithm
+func ParseAlgorithm(name string) (Algorithm, error) {
+	if name == "" {
+		return DefaultAlgorithm, nil
+	}
+
+	for alg, algName := range algorithmNames {
+		if strings.EqualFold(name, algName) {
+			return alg, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: '%s'", ErrUnsupportedAlgorithm, name)
+}
+
+func (alg Algorithm) IsValid() bool {
+	_, ok := algorithmNames[alg]
+	return ok
+}
+
+func (alg Algorithm) Validate() error {
+	if !alg.IsValid() {
+		return fmt.Errorf("%w: %d", ErrUnsupportedAlgorithm, alg)
+	}
+	return nil
+}
+
+func (alg Algorithm) String() string {
+	name, ok := algorithmNames[alg]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", int(alg))
+	}
+	return name
+}
+
+// Sum returns the digest of data.
+//
+// Algorithms are validated when they are configured, read from metadata or
+// received from a peer, so hashing with an unknown algorithm is a programming
+// error and panics instead of silently producing digests of another algorithm.
+func (alg Algorithm) Sum(data []byte) [Size]byte {
+	switch alg {
+	case SHA256:
+		return sha256.Sum256(data)
+	case SHA512_256:
+		return sha512.Sum512_256(data)
+	case BLAKE3:
+		return blake3.Sum256(data)
+	}
+	panic(fmt.Sprintf("%v: %d", ErrUnsupportedAlgorithm, int(alg)))
+}
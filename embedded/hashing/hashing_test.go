@@ -0,0 +1,65 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hashing
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"lukechampine.com/blake3"
+)
+
+func TestParseAlgorithm(t *testing.T) {
+	for _, alg := range []Algorithm{SHA256, SHA512_256, BLAKE3} {
+		require.True(t, alg.IsValid())
+		require.NoError(t, alg.Validate())
+
+		parsed, err := ParseAlgorithm(alg.String())
+		require.NoError(t, err)
+		require.Equal(t, alg, parsed)
+	}
+
+	alg, err := ParseAlgorithm("")
+	require.NoError(t, err)
+	require.Equal(t, DefaultAlgorithm, alg)
+
+	alg, err = ParseAlgorithm("BLAKE3")
+	require.NoError(t, err)
+	require.Equal(t, BLAKE3, alg)
+
+	_, err = ParseAlgorithm("md5")
+	require.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	require.False(t, Algorithm(99).IsValid())
+	require.ErrorIs(t, Algorithm(99).Validate(), ErrUnsupportedAlgorithm)
+	require.Equal(t, "unknown(99)", Algorithm(99).String())
+}
+
+func TestSum(t *testing.T) {
+	data := []byte("immudb")
+
+	require.Equal(t, sha256.Sum256(data), SHA256.Sum(data))
+	require.Equal(t, sha512.Sum512_256(data), SHA512_256.Sum(data))
+	require.Equal(t, blake3.Sum256(data), BLAKE3.Sum(data))
+
+	require.NotEqual(t, SHA256.Sum(data), SHA512_256.Sum(data))
+	require.NotEqual(t, SHA256.Sum(data), BLAKE3.Sum(data))
+
+	require.Panics(t, func() { Algorithm(99).Sum(data) })
+}
@@ -20,6 +20,8 @@ import (
 	"crypto/sha256"
 	"errors"
 	"math/bits"
+
+	"github.com/codenotary/immudb/embedded/hashing"
 )
 
 var ErrMaxWidthExceeded = errors.New("htree: max width exceeded")
@@ -30,10 +32,11 @@ const LeafPrefix = byte(0)
 const NodePrefix = byte(1)
 
 type HTree struct {
-	levels   [][][sha256.Size]byte
-	maxWidth int
-	width    int
-	root     [sha256.Size]byte
+	levels        [][][sha256.Size]byte
+	maxWidth      int
+	width         int
+	root          [sha256.Size]byte
+	hashAlgorithm hashing.Algorithm
 }
 
 type InclusionProof struct {
@@ -43,6 +46,16 @@ type InclusionProof struct {
 }
 
 func New(maxWidth int) (*HTree, error) {
+	return NewWith(maxWidth, hashing.SHA256)
+}
+
+// NewWith creates a tree whose nodes are hashed with the specified algorithm
+func NewWith(maxWidth int, hashAlgorithm hashing.Algorithm) (*HTree, error) {
+	err := hashAlgorithm.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	var levels [][][sha256.Size]byte
 
 	if maxWidth > 0 {
@@ -60,8 +73,9 @@ func New(maxWidth int) (*HTree, error) {
 	}
 
 	return &HTree{
-		levels:   levels,
-		maxWidth: maxWidth,
+		levels:        levels,
+		maxWidth:      maxWidth,
+		hashAlgorithm: hashAlgorithm,
 	}, nil
 }
 
@@ -72,14 +86,14 @@ func (t *HTree) BuildWith(digests [][sha256.Size]byte) error {
 
 	if len(digests) == 0 {
 		t.width = 0
-		t.root = sha256.Sum256(nil)
+		t.root = t.hashAlgorithm.Sum(nil)
 		return nil
 	}
 
 	for i, d := range digests {
 		leaf := [1 + sha256.Size]byte{LeafPrefix}
 		copy(leaf[1:], d[:])
-		t.levels[0][i] = sha256.Sum256(leaf[:])
+		t.levels[0][i] = t.hashAlgorithm.Sum(leaf[:])
 	}
 
 	l := 0
@@ -93,7 +107,7 @@ func (t *HTree) BuildWith(digests [][sha256.Size]byte) error {
 		for i := 0; i+1 < w; i += 2 {
 			copy(b[1:], t.levels[l][i][:])
 			copy(b[1+sha256.Size:], t.levels[l][i+1][:])
-			t.levels[l+1][wn] = sha256.Sum256(b[:])
+			t.levels[l+1][wn] = t.hashAlgorithm.Sum(b[:])
 			wn++
 		}
 
@@ -116,6 +130,10 @@ func (t *HTree) Root() [sha256.Size]byte {
 	return t.root
 }
 
+func (t *HTree) HashAlgorithm() hashing.Algorithm {
+	return t.hashAlgorithm
+}
+
 // InclusionProof returns the shortest list of additional nodes required to compute the root
 // It's an adaption from the algorithm for proof construction at github.com/codenotary/merkletree
 func (t *HTree) InclusionProof(i int) (proof *InclusionProof, err error) {
@@ -164,14 +182,19 @@ func (t *HTree) InclusionProof(i int) (proof *InclusionProof, err error) {
 }
 
 func VerifyInclusion(proof *InclusionProof, digest, root [sha256.Size]byte) bool {
-	if proof == nil {
+	return VerifyInclusionWith(hashing.SHA256, proof, digest, root)
+}
+
+// VerifyInclusionWith verifies an inclusion proof of a tree built with the specified hash algorithm
+func VerifyInclusionWith(hashAlgorithm hashing.Algorithm, proof *InclusionProof, digest, root [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || proof == nil {
 		return false
 	}
 
 	leaf := [1 + sha256.Size]byte{LeafPrefix}
 	copy(leaf[1:], digest[:])
 
-	calcRoot := sha256.Sum256(leaf[:])
+	calcRoot := hashAlgorithm.Sum(leaf[:])
 	i := proof.Leaf
 	r := proof.Width - 1
 
@@ -186,7 +209,7 @@ func VerifyInclusion(proof *InclusionProof, digest, root [sha256.Size]byte) bool
 			copy(b[1+sha256.Size:], calcRoot[:])
 		}
 
-		calcRoot = sha256.Sum256(b[:])
+		calcRoot = hashAlgorithm.Sum(b[:])
 		i /= 2
 		r /= 2
 	}
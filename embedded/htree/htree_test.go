@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/stretchr/testify/require"
 )
 
@@ -85,3 +86,46 @@ func TestHTree(t *testing.T) {
 	_, err = tree.InclusionProof(maxWidth)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 }
+
+func TestHTreeWithHashAlgorithm(t *testing.T) {
+	const maxWidth = 100
+
+	_, err := NewWith(maxWidth, hashing.Algorithm(99))
+	require.ErrorIs(t, err, hashing.ErrUnsupportedAlgorithm)
+
+	digests := make([][sha256.Size]byte, maxWidth)
+
+	for i := 0; i < len(digests); i++ {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uint64(i))
+		digests[i] = sha256.Sum256(b[:])
+	}
+
+	sha256Tree, err := New(maxWidth)
+	require.NoError(t, err)
+
+	err = sha256Tree.BuildWith(digests)
+	require.NoError(t, err)
+
+	for _, alg := range []hashing.Algorithm{hashing.SHA512_256, hashing.BLAKE3} {
+		tree, err := NewWith(maxWidth, alg)
+		require.NoError(t, err)
+		require.Equal(t, alg, tree.HashAlgorithm())
+
+		err = tree.BuildWith(nil)
+		require.NoError(t, err)
+		require.Equal(t, alg.Sum(nil), tree.Root())
+
+		err = tree.BuildWith(digests)
+		require.NoError(t, err)
+		require.NotEqual(t, sha256Tree.Root(), tree.Root())
+
+		for i := 0; i < len(digests); i++ {
+			proof, err := tree.InclusionProof(i)
+			require.NoError(t, err)
+
+			require.True(t, VerifyInclusionWith(alg, proof, digests[i], tree.Root()))
+			require.False(t, VerifyInclusion(proof, digests[i], tree.Root()))
+		}
+	}
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/appendable/fileutils"
+	"github.com/codenotary/immudb/embedded/remotestorage"
+)
+
+var (
+	ErrInvalidArguments = errors.New("invalid arguments")
+)
+
+// Storage implements a remote storage backed by a local directory,
+// e.g. a slower or cheaper mount point
+type Storage struct {
+	root     string
+	fileMode os.FileMode
+}
+
+func Open(root string, fileMode os.FileMode) (*Storage, error) {
+	err := os.MkdirAll(root, fileMode|0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Storage{
+		root:     root,
+		fileMode: fileMode,
+	}, nil
+}
+
+func (r *Storage) Kind() string {
+	return "local"
+}
+
+func (r *Storage) String() string {
+	return fmt.Sprintf("local:%s", r.root)
+}
+
+func (r *Storage) objectPath(name string) (string, error) {
+	if name == "" || strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") || !validName(name) {
+		return "", ErrInvalidArguments
+	}
+
+	return filepath.Join(r.root, filepath.FromSlash(name)), nil
+}
+
+// Get opens a stream of data for given object
+func (r *Storage) Get(ctx context.Context, name string, offs, size int64) (io.ReadCloser, error) {
+	if offs < 0 || size == 0 {
+		return nil, ErrInvalidArguments
+	}
+
+	objectPath, err := r.objectPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(objectPath)
+	if os.IsNotExist(err) {
+		return nil, remotestorage.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = f.Seek(offs, io.SeekStart)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if size < 0 {
+		return f, nil
+	}
+
+	return &limitedReadCloser{
+		Reader: io.LimitReader(f, size),
+		Closer: f,
+	}, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Put copies a local file into the storage, the object becomes visible once fully written
+func (r *Storage) Put(ctx context.Context, name string, fileName string) error {
+	objectPath, err := r.objectPath(name)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dir := filepath.Dir(objectPath)
+
+	err = os.MkdirAll(dir, r.fileMode|0700)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := objectPath + ".tmp_put"
+
+	dst, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, r.fileMode)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	_, err = io.Copy(dst, src)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if err != nil {
+		dst.Close()
+		return err
+	}
+
+	err = dst.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmpPath, objectPath)
+	if err != nil {
+		return err
+	}
+
+	return fileutils.SyncDir(dir)
+}
+
+func (r *Storage) Remove(ctx context.Context, name string) error {
+	objectPath, err := r.objectPath(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(objectPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *Storage) RemoveAll(ctx context.Context, path string) error {
+	if !validPath(path) {
+		return ErrInvalidArguments
+	}
+
+	if path == "" {
+		entries, err := os.ReadDir(r.root)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			err = os.RemoveAll(filepath.Join(r.root, e.Name()))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return os.RemoveAll(filepath.Join(r.root, filepath.FromSlash(path)))
+}
+
+// Exists checks if a resource exists and can be read
+func (r *Storage) Exists(ctx context.Context, name string) (bool, error) {
+	objectPath, err := r.objectPath(name)
+	if err != nil {
+		return false, err
+	}
+
+	fi, err := os.Stat(objectPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return !fi.IsDir(), nil
+}
+
+func (r *Storage) ListEntries(ctx context.Context, path string) ([]remotestorage.EntryInfo, []string, error) {
+	if !validPath(path) {
+		return nil, nil, ErrInvalidArguments
+	}
+
+	dirEntries, err := os.ReadDir(filepath.Join(r.root, filepath.FromSlash(path)))
+	if os.IsNotExist(err) {
+		return []remotestorage.EntryInfo{}, []string{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := []remotestorage.EntryInfo{}
+	subPaths := []string{}
+
+	for _, e := range dirEntries {
+		if e.IsDir() {
+			subPaths = append(subPaths, e.Name())
+			continue
+		}
+
+		if strings.HasSuffix(e.Name(), ".tmp_put") {
+			// partially written object
+			continue
+		}
+
+		fi, err := e.Info()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		entries = append(entries, remotestorage.EntryInfo{
+			Name: e.Name(),
+			Size: fi.Size(),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	sort.Strings(subPaths)
+
+	return entries, subPaths, nil
+}
+
+func validName(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func validPath(path string) bool {
+	return path == "" || (strings.HasSuffix(path, "/") && path != "/" && validName(strings.TrimSuffix(path, "/")))
+}
+
+var _ remotestorage.Storage = (*Storage)(nil)
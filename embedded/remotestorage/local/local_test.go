@@ -0,0 +1,138 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/remotestorage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func storeData(t *testing.T, s *Storage, name, data string) {
+	fileName := filepath.Join(t.TempDir(), "data")
+
+	err := os.WriteFile(fileName, []byte(data), 0600)
+	require.NoError(t, err)
+
+	err = s.Put(context.Background(), name, fileName)
+	require.NoError(t, err)
+}
+
+func readData(t *testing.T, s *Storage, name string, offs, size int64) string {
+	r, err := s.Get(context.Background(), name, offs, size)
+	require.NoError(t, err)
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestRemoteStorageAPILocal(t *testing.T) {
+	storage, err := Open(t.TempDir(), 0700)
+	require.NoError(t, err)
+
+	require.Equal(t, "local", storage.Kind())
+	require.Contains(t, storage.String(), "local:")
+
+	ctx := context.Background()
+
+	_, err = storage.Get(ctx, "does-not-exist", 0, -1)
+	require.ErrorIs(t, err, remotestorage.ErrNotFound)
+
+	exists, err := storage.Exists(ctx, "does-not-exist")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	for _, name := range []string{"", "/abs", "dir/", "a/../b", "a//b"} {
+		_, err = storage.Get(ctx, name, 0, -1)
+		require.ErrorIs(t, err, ErrInvalidArguments)
+
+		_, err = storage.Exists(ctx, name)
+		require.ErrorIs(t, err, ErrInvalidArguments)
+	}
+
+	_, err = storage.Get(ctx, "object", -1, -1)
+	require.ErrorIs(t, err, ErrInvalidArguments)
+
+	storeData(t, storage, "object", "0123456789")
+	storeData(t, storage, "dir1/object1", "abc")
+	storeData(t, storage, "dir1/object2", "def")
+	storeData(t, storage, "dir2/dir3/object3", "ghi")
+
+	exists, err = storage.Exists(ctx, "dir1/object1")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.Equal(t, "0123456789", readData(t, storage, "object", 0, -1))
+	require.Equal(t, "234", readData(t, storage, "object", 2, 3))
+	require.Equal(t, "89", readData(t, storage, "object", 8, 10))
+	require.Equal(t, "", readData(t, storage, "object", 20, -1))
+
+	entries, subPaths, err := storage.ListEntries(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []remotestorage.EntryInfo{{Name: "object", Size: 10}}, entries)
+	require.Equal(t, []string{"dir1", "dir2"}, subPaths)
+
+	entries, subPaths, err = storage.ListEntries(ctx, "dir1/")
+	require.NoError(t, err)
+	require.Equal(t, []remotestorage.EntryInfo{{Name: "object1", Size: 3}, {Name: "object2", Size: 3}}, entries)
+	require.Empty(t, subPaths)
+
+	entries, subPaths, err = storage.ListEntries(ctx, "does-not-exist/")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	require.Empty(t, subPaths)
+
+	_, _, err = storage.ListEntries(ctx, "dir1")
+	require.ErrorIs(t, err, ErrInvalidArguments)
+
+	err = storage.Remove(ctx, "dir1/object1")
+	require.NoError(t, err)
+
+	err = storage.Remove(ctx, "dir1/object1")
+	require.NoError(t, err)
+
+	exists, err = storage.Exists(ctx, "dir1/object1")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	err = storage.RemoveAll(ctx, "dir1")
+	require.ErrorIs(t, err, ErrInvalidArguments)
+
+	err = storage.RemoveAll(ctx, "dir2/")
+	require.NoError(t, err)
+
+	_, subPaths, err = storage.ListEntries(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"dir1"}, subPaths)
+
+	err = storage.RemoveAll(ctx, "")
+	require.NoError(t, err)
+
+	entries, subPaths, err = storage.ListEntries(ctx, "")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	require.Empty(t, subPaths)
+}
@@ -0,0 +1,524 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smt
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/hashing"
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+var ErrIllegalArguments = errors.New("smt: illegal arguments")
+var ErrVersionNotFound = errors.New("smt: version not found")
+var ErrCorruptedNode = errors.New("smt: corrupted node")
+
+const LeafPrefix = byte(0)
+const NodePrefix = byte(1)
+
+// Depth is the number of bits of a key digest, thus the maximum height of the tree
+const Depth = sha256.Size * 8
+
+const (
+	depthSize       = 2
+	txIDSize        = 8
+	leafNodeSize    = 1 + sha256.Size + txIDSize + sha256.Size + sha256.Size
+	innerNodeSize   = 1 + sha256.Size
+	MaxNodeKeySize  = depthSize + sha256.Size
+	MaxNodeDataSize = leafNodeSize
+)
+
+// Entry sets the value digest of a key
+type Entry struct {
+	Key         []byte
+	ValueDigest [sha256.Size]byte
+}
+
+// Leaf holds the latest value digest of a key and the version (transaction) in which it was set
+type Leaf struct {
+	KeyDigest   [sha256.Size]byte
+	TxID        uint64
+	ValueDigest [sha256.Size]byte
+}
+
+// Proof is a proof of membership or non-membership of a key
+type Proof struct {
+	// Siblings holds the digests of the siblings along the path of the key, from the root downwards
+	Siblings [][sha256.Size]byte
+	// Leaf is the leaf found at the end of the path, nil when the path ends in an empty subtree
+	Leaf *Leaf
+}
+
+// node is either a leaf or an inner node, empty subtrees have no node
+type node struct {
+	leaf *Leaf
+	h    [sha256.Size]byte
+}
+
+// Tree is a versioned sparse Merkle tree indexed by the digest of the keys.
+// Subtrees holding a single leaf are collapsed into that leaf and empty subtrees
+// are represented by a zero digest, so the tree only grows with the number of keys.
+//
+// Nodes are addressed by their position (depth and path) and stored into a tbtree
+// using the version in which they were written as timestamp, thus every version
+// of the tree is readable without keeping the tree in memory. Versions are first
+// kept in memory so they can be rolled back, until they are persisted.
+type Tree struct {
+	hashAlgorithm hashing.Algorithm
+
+	nodes *tbtree.TBtree
+
+	// persistedVersion is the latest version stored into nodes
+	persistedVersion uint64
+
+	// pending[i] holds the nodes written by version persistedVersion+i+1
+	pending []map[string][]byte
+
+	mutex sync.RWMutex
+}
+
+// Open opens the tree stored at path, the latest persisted version of the tree is the latest timestamp of the tbtree
+func Open(path string, hashAlgorithm hashing.Algorithm, opts *tbtree.Options) (*Tree, error) {
+	err := hashAlgorithm.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		return nil, fmt.Errorf("%w: nil options", ErrIllegalArguments)
+	}
+
+	nodes, err := tbtree.Open(path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tree{
+		hashAlgorithm:    hashAlgorithm,
+		nodes:            nodes,
+		persistedVersion: nodes.Ts(),
+	}, nil
+}
+
+func (t *Tree) HashAlgorithm() hashing.Algorithm {
+	return t.hashAlgorithm
+}
+
+// Version returns the latest version of the tree, zero when no version was appended
+func (t *Tree) Version() uint64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.version()
+}
+
+// PersistedVersion returns the latest version of the tree which can no longer be rolled back
+func (t *Tree) PersistedVersion() uint64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.persistedVersion
+}
+
+func (t *Tree) version() uint64 {
+	return t.persistedVersion + uint64(len(t.pending))
+}
+
+// Append creates a new version of the tree by setting the given entries,
+// version must follow the latest version of the tree
+func (t *Tree) Append(version uint64, entries []Entry) ([sha256.Size]byte, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if version != t.version()+1 {
+		return [sha256.Size]byte{}, fmt.Errorf("%w: expected version %d but %d was provided", ErrIllegalArguments, t.version()+1, version)
+	}
+
+	t.pending = append(t.pending, make(map[string][]byte))
+
+	for _, e := range entries {
+		err := t.insert(version, Leaf{
+			KeyDigest:   t.hashAlgorithm.Sum(e.Key),
+			TxID:        version,
+			ValueDigest: e.ValueDigest,
+		})
+		if err != nil {
+			t.pending = t.pending[:len(t.pending)-1]
+			return [sha256.Size]byte{}, err
+		}
+	}
+
+	root, err := t.nodeAt(version, nodeKey(nil, 0))
+	if err != nil {
+		t.pending = t.pending[:len(t.pending)-1]
+		return [sha256.Size]byte{}, err
+	}
+
+	return digestOf(root), nil
+}
+
+// Rollback discards every version newer than the specified one,
+// persisted versions can not be rolled back
+func (t *Tree) Rollback(version uint64) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if version > t.version() {
+		return fmt.Errorf("%w: version %d", ErrVersionNotFound, version)
+	}
+
+	if version < t.persistedVersion {
+		return fmt.Errorf("%w: version %d is already persisted", ErrIllegalArguments, version)
+	}
+
+	for i := version - t.persistedVersion; i < uint64(len(t.pending)); i++ {
+		t.pending[i] = nil
+	}
+
+	t.pending = t.pending[:version-t.persistedVersion]
+
+	return nil
+}
+
+// Persist stores the versions up to the specified one, which are released from memory
+func (t *Tree) Persist(version uint64) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if version > t.version() {
+		return fmt.Errorf("%w: version %d", ErrVersionNotFound, version)
+	}
+
+	for t.persistedVersion < version {
+		v := t.persistedVersion + 1
+
+		var err error
+
+		if len(t.pending[0]) == 0 {
+			err = t.nodes.IncreaseTs(v)
+		} else {
+			kvts := make([]*tbtree.KVT, 0, len(t.pending[0]))
+
+			for k, n := range t.pending[0] {
+				kvts = append(kvts, &tbtree.KVT{K: []byte(k), V: n, T: v})
+			}
+
+			err = t.nodes.BulkInsert(kvts)
+		}
+		if err != nil {
+			return err
+		}
+
+		t.pending[0] = nil
+		t.pending = t.pending[1:]
+		t.persistedVersion = v
+	}
+
+	return nil
+}
+
+// Flush writes the persisted versions to disk
+func (t *Tree) Flush() error {
+	_, _, err := t.nodes.Flush()
+	return err
+}
+
+// Close closes the tree, versions not persisted are discarded
+func (t *Tree) Close() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.pending = nil
+
+	return t.nodes.Close()
+}
+
+// RootAt returns the root of the tree at the specified version,
+// the root of the empty tree (version zero) is a zero digest
+func (t *Tree) RootAt(version uint64) ([sha256.Size]byte, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if version > t.version() {
+		return [sha256.Size]byte{}, fmt.Errorf("%w: version %d", ErrVersionNotFound, version)
+	}
+
+	root, err := t.nodeAt(version, nodeKey(nil, 0))
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+
+	return digestOf(root), nil
+}
+
+// ProofAt returns a proof of membership or non-membership of the key at the specified version
+func (t *Tree) ProofAt(version uint64, key []byte) (*Proof, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if version > t.version() {
+		return nil, fmt.Errorf("%w: version %d", ErrVersionNotFound, version)
+	}
+
+	kd := t.hashAlgorithm.Sum(key)
+
+	proof := &Proof{}
+
+	for depth := 0; depth <= Depth; depth++ {
+		n, err := t.nodeAt(version, nodeKey(kd[:], depth))
+		if err != nil {
+			return nil, err
+		}
+
+		if n == nil {
+			return proof, nil
+		}
+
+		if n.leaf != nil {
+			leaf := *n.leaf
+			proof.Leaf = &leaf
+			return proof, nil
+		}
+
+		sibling, err := t.nodeAt(version, siblingKey(kd, depth))
+		if err != nil {
+			return nil, err
+		}
+
+		proof.Siblings = append(proof.Siblings, digestOf(sibling))
+	}
+
+	return nil, fmt.Errorf("%w: path exceeds the depth of the tree", ErrCorruptedNode)
+}
+
+// insert sets the leaf into the latest (pending) version of the tree
+func (t *Tree) insert(version uint64, leaf Leaf) error {
+	kd := leaf.KeyDigest
+
+	depth := 0
+
+	for ; ; depth++ {
+		if depth > Depth {
+			return fmt.Errorf("%w: path exceeds the depth of the tree", ErrCorruptedNode)
+		}
+
+		n, err := t.nodeAt(version, nodeKey(kd[:], depth))
+		if err != nil {
+			return err
+		}
+
+		if n == nil {
+			break
+		}
+
+		if n.leaf == nil {
+			continue
+		}
+
+		if n.leaf.KeyDigest == kd {
+			break
+		}
+
+		// the subtree holding a single leaf is split until both leaves are on distinct paths
+		existing := *n.leaf
+
+		for bitAt(existing.KeyDigest, depth) == bitAt(kd, depth) {
+			depth++
+		}
+
+		t.setNode(nodeKey(existing.KeyDigest[:], depth+1), encodeLeaf(t.hashAlgorithm, &existing))
+
+		depth++
+
+		break
+	}
+
+	h := leafDigest(t.hashAlgorithm, &leaf)
+
+	t.setNode(nodeKey(kd[:], depth), encodeLeaf(t.hashAlgorithm, &leaf))
+
+	// digests are updated upwards along the path of the key
+	for d := depth - 1; d >= 0; d-- {
+		sibling, err := t.nodeAt(version, siblingKey(kd, d))
+		if err != nil {
+			return err
+		}
+
+		if bitAt(kd, d) == 0 {
+			h = innerDigest(t.hashAlgorithm, h, digestOf(sibling))
+		} else {
+			h = innerDigest(t.hashAlgorithm, digestOf(sibling), h)
+		}
+
+		t.setNode(nodeKey(kd[:], d), encodeInner(h))
+	}
+
+	return nil
+}
+
+func (t *Tree) setNode(key []byte, n []byte) {
+	t.pending[len(t.pending)-1][string(key)] = n
+}
+
+// nodeAt returns the node at the given position as of the specified version, nil if there is none
+func (t *Tree) nodeAt(version uint64, key []byte) (*node, error) {
+	if version > t.persistedVersion {
+		for i := int(version - t.persistedVersion); i > 0; i-- {
+			bs, ok := t.pending[i-1][string(key)]
+			if ok {
+				return decodeNode(t.hashAlgorithm, bs)
+			}
+		}
+
+		version = t.persistedVersion
+	}
+
+	if version == 0 {
+		return nil, nil
+	}
+
+	bs, _, _, err := t.nodes.GetBetween(key, 0, version)
+	if errors.Is(err, tbtree.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeNode(t.hashAlgorithm, bs)
+}
+
+// nodeKey encodes the position of the node at the given depth along the path of the key digest
+func nodeKey(kd []byte, depth int) []byte {
+	n := (depth + 7) / 8
+
+	key := make([]byte, depthSize+n)
+	binary.BigEndian.PutUint16(key, uint16(depth))
+	copy(key[depthSize:], kd[:n])
+
+	// bits below the depth of the node are not part of its position
+	if depth%8 > 0 {
+		key[len(key)-1] &= byte(0xff) << (8 - uint(depth%8))
+	}
+
+	return key
+}
+
+// siblingKey encodes the position of the sibling of the node at depth+1 along the path of the key digest
+func siblingKey(kd [sha256.Size]byte, depth int) []byte {
+	kd[depth/8] ^= 1 << (7 - uint(depth%8))
+	return nodeKey(kd[:], depth+1)
+}
+
+func encodeLeaf(hashAlgorithm hashing.Algorithm, leaf *Leaf) []byte {
+	var b [leafNodeSize]byte
+
+	i := 0
+
+	b[i] = LeafPrefix
+	i++
+
+	copy(b[i:], leaf.KeyDigest[:])
+	i += sha256.Size
+
+	binary.BigEndian.PutUint64(b[i:], leaf.TxID)
+	i += txIDSize
+
+	copy(b[i:], leaf.ValueDigest[:])
+	i += sha256.Size
+
+	h := leafDigest(hashAlgorithm, leaf)
+	copy(b[i:], h[:])
+
+	return b[:]
+}
+
+func encodeInner(h [sha256.Size]byte) []byte {
+	var b [innerNodeSize]byte
+
+	b[0] = NodePrefix
+	copy(b[1:], h[:])
+
+	return b[:]
+}
+
+func decodeNode(hashAlgorithm hashing.Algorithm, b []byte) (*node, error) {
+	if len(b) == innerNodeSize && b[0] == NodePrefix {
+		n := &node{}
+		copy(n.h[:], b[1:])
+		return n, nil
+	}
+
+	if len(b) != leafNodeSize || b[0] != LeafPrefix {
+		return nil, ErrCorruptedNode
+	}
+
+	leaf := &Leaf{}
+
+	i := 1
+
+	copy(leaf.KeyDigest[:], b[i:])
+	i += sha256.Size
+
+	leaf.TxID = binary.BigEndian.Uint64(b[i:])
+	i += txIDSize
+
+	copy(leaf.ValueDigest[:], b[i:])
+	i += sha256.Size
+
+	n := &node{leaf: leaf}
+	copy(n.h[:], b[i:])
+
+	return n, nil
+}
+
+func digestOf(n *node) [sha256.Size]byte {
+	if n == nil {
+		return [sha256.Size]byte{}
+	}
+	return n.h
+}
+
+func leafDigest(hashAlgorithm hashing.Algorithm, leaf *Leaf) [sha256.Size]byte {
+	var b [1 + sha256.Size + 8 + sha256.Size]byte
+
+	b[0] = LeafPrefix
+	copy(b[1:], leaf.KeyDigest[:])
+	binary.BigEndian.PutUint64(b[1+sha256.Size:], leaf.TxID)
+	copy(b[1+sha256.Size+8:], leaf.ValueDigest[:])
+
+	return hashAlgorithm.Sum(b[:])
+}
+
+func innerDigest(hashAlgorithm hashing.Algorithm, left, right [sha256.Size]byte) [sha256.Size]byte {
+	var b [1 + 2*sha256.Size]byte
+
+	b[0] = NodePrefix
+	copy(b[1:], left[:])
+	copy(b[1+sha256.Size:], right[:])
+
+	return hashAlgorithm.Sum(b[:])
+}
+
+// bitAt returns the bit of the digest at the given position, starting from the most significant one
+func bitAt(d [sha256.Size]byte, i int) byte {
+	return (d[i/8] >> (7 - uint(i%8))) & 1
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smt
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/hashing"
+	"github.com/codenotary/immudb/embedded/tbtree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree(t *testing.T) {
+	_, err := Open(t.TempDir(), hashing.Algorithm(-1), tbtree.DefaultOptions())
+	require.ErrorIs(t, err, hashing.ErrUnsupportedAlgorithm)
+
+	_, err = Open(t.TempDir(), hashing.SHA256, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	tree, err := Open(t.TempDir(), hashing.SHA256, tbtree.DefaultOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	require.Equal(t, hashing.SHA256, tree.HashAlgorithm())
+	require.Zero(t, tree.Version())
+
+	root, err := tree.RootAt(0)
+	require.NoError(t, err)
+	require.Zero(t, root)
+
+	_, err = tree.RootAt(1)
+	require.ErrorIs(t, err, ErrVersionNotFound)
+
+	_, err = tree.Append(2, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	proof, err := tree.ProofAt(0, []byte("key"))
+	require.NoError(t, err)
+	require.True(t, VerifyNonMembership(hashing.SHA256, proof, []byte("key"), root))
+
+	const txCount = 50
+	const entriesPerTx = 20
+
+	roots := make([][sha256.Size]byte, txCount+1)
+
+	for txID := uint64(1); txID <= txCount; txID++ {
+		entries := make([]Entry, entriesPerTx)
+
+		for i := range entries {
+			// keys are updated every other transaction
+			key := []byte(fmt.Sprintf("key%d_%d", (txID+1)/2, i))
+			entries[i] = Entry{Key: key, ValueDigest: sha256.Sum256([]byte(fmt.Sprintf("value%d_%d", txID, i)))}
+		}
+
+		roots[txID], err = tree.Append(txID, entries)
+		require.NoError(t, err)
+		require.NotEqual(t, roots[txID-1], roots[txID])
+
+		// versions are read from both persisted and pending nodes
+		if txID == txCount/4 {
+			err = tree.Persist(txID)
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, uint64(txCount), tree.Version())
+
+	for txID := uint64(1); txID <= txCount; txID++ {
+		root, err := tree.RootAt(txID)
+		require.NoError(t, err)
+		require.Equal(t, roots[txID], root)
+
+		for i := 0; i < entriesPerTx; i++ {
+			key := []byte(fmt.Sprintf("key%d_%d", (txID+1)/2, i))
+			valueDigest := sha256.Sum256([]byte(fmt.Sprintf("value%d_%d", txID, i)))
+
+			proof, err := tree.ProofAt(txID, key)
+			require.NoError(t, err)
+			require.True(t, VerifyMembership(hashing.SHA256, proof, key, txID, valueDigest, root))
+			require.False(t, VerifyNonMembership(hashing.SHA256, proof, key, root))
+			require.False(t, VerifyMembership(hashing.SHA256, proof, key, txID-1, valueDigest, root))
+			require.False(t, VerifyMembership(hashing.SHA256, proof, key, txID, sha256.Sum256(nil), root))
+			require.False(t, VerifyMembership(hashing.SHA256, proof, key, txID, valueDigest, roots[txID-1]))
+
+			// keys set in later transactions are not part of previous versions
+			nextKey := []byte(fmt.Sprintf("key%d_%d", (txID+1)/2+1, i))
+
+			proof, err = tree.ProofAt(txID, nextKey)
+			require.NoError(t, err)
+			require.True(t, VerifyNonMembership(hashing.SHA256, proof, nextKey, root))
+			require.False(t, VerifyNonMembership(hashing.SHA256, proof, key, root))
+
+			if proof.Leaf != nil {
+				require.False(t, VerifyMembership(hashing.SHA256, proof, nextKey, proof.Leaf.TxID, proof.Leaf.ValueDigest, root))
+			}
+		}
+	}
+
+	err = tree.Rollback(txCount + 1)
+	require.ErrorIs(t, err, ErrVersionNotFound)
+
+	err = tree.Rollback(txCount/4 - 1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = tree.Rollback(txCount / 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(txCount/2), tree.Version())
+
+	_, err = tree.ProofAt(txCount, []byte("key1_0"))
+	require.ErrorIs(t, err, ErrVersionNotFound)
+
+	root, err = tree.Append(txCount/2+1, []Entry{{Key: []byte(fmt.Sprintf("key%d_0", txCount/4+1)), ValueDigest: sha256.Sum256(nil)}})
+	require.NoError(t, err)
+	require.NotEqual(t, roots[txCount/2+1], root)
+}
+
+func TestTreePersistence(t *testing.T) {
+	dir := t.TempDir()
+
+	tree, err := Open(dir, hashing.SHA256, tbtree.DefaultOptions())
+	require.NoError(t, err)
+
+	const txCount = 10
+
+	roots := make([][sha256.Size]byte, txCount+1)
+
+	for txID := uint64(1); txID <= txCount; txID++ {
+		var entries []Entry
+
+		// versions without entries are persisted as well
+		if txID%3 != 0 {
+			entries = []Entry{{Key: []byte(fmt.Sprintf("key%d", txID%4)), ValueDigest: sha256.Sum256([]byte{byte(txID)})}}
+		}
+
+		roots[txID], err = tree.Append(txID, entries)
+		require.NoError(t, err)
+	}
+
+	err = tree.Persist(txCount + 1)
+	require.ErrorIs(t, err, ErrVersionNotFound)
+
+	err = tree.Persist(txCount - 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(txCount-2), tree.PersistedVersion())
+	require.Equal(t, uint64(txCount), tree.Version())
+
+	err = tree.Flush()
+	require.NoError(t, err)
+
+	err = tree.Close()
+	require.NoError(t, err)
+
+	// versions not persisted are discarded
+	tree, err = Open(dir, hashing.SHA256, tbtree.DefaultOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	require.Equal(t, uint64(txCount-2), tree.Version())
+
+	for txID := uint64(1); txID <= txCount-2; txID++ {
+		root, err := tree.RootAt(txID)
+		require.NoError(t, err)
+		require.Equal(t, roots[txID], root)
+	}
+
+	proof, err := tree.ProofAt(txCount-2, []byte("key0"))
+	require.NoError(t, err)
+	require.True(t, VerifyMembership(hashing.SHA256, proof, []byte("key0"), 8, sha256.Sum256([]byte{8}), roots[txCount-2]))
+
+	root, err := tree.Append(txCount-1, []Entry{{Key: []byte("key1")}})
+	require.NoError(t, err)
+	require.NotEqual(t, roots[txCount-2], root)
+}
+
+func TestTreeWithHashAlgorithm(t *testing.T) {
+	for _, alg := range []hashing.Algorithm{hashing.SHA512_256, hashing.BLAKE3} {
+		t.Run(alg.String(), func(t *testing.T) {
+			tree, err := Open(t.TempDir(), alg, tbtree.DefaultOptions())
+			require.NoError(t, err)
+			defer tree.Close()
+
+			root, err := tree.Append(1, []Entry{
+				{Key: []byte("key1"), ValueDigest: alg.Sum([]byte("value1"))},
+				{Key: []byte("key2"), ValueDigest: alg.Sum([]byte("value2"))},
+			})
+			require.NoError(t, err)
+
+			proof, err := tree.ProofAt(1, []byte("key1"))
+			require.NoError(t, err)
+			require.True(t, VerifyMembership(alg, proof, []byte("key1"), 1, alg.Sum([]byte("value1")), root))
+			require.False(t, VerifyMembership(hashing.SHA256, proof, []byte("key1"), 1, alg.Sum([]byte("value1")), root))
+
+			proof, err = tree.ProofAt(1, []byte("key3"))
+			require.NoError(t, err)
+			require.True(t, VerifyNonMembership(alg, proof, []byte("key3"), root))
+		})
+	}
+}
+
+func TestProofTampering(t *testing.T) {
+	tree, err := Open(t.TempDir(), hashing.SHA256, tbtree.DefaultOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	entries := make([]Entry, 100)
+	for i := range entries {
+		entries[i] = Entry{Key: []byte(fmt.Sprintf("key%d", i)), ValueDigest: sha256.Sum256([]byte{byte(i)})}
+	}
+
+	root, err := tree.Append(1, entries)
+	require.NoError(t, err)
+
+	proof, err := tree.ProofAt(1, []byte("key0"))
+	require.NoError(t, err)
+	require.NotEmpty(t, proof.Siblings)
+
+	// a membership proof can not be turned into a non-membership proof
+	leaf := proof.Leaf
+	proof.Leaf = nil
+	require.False(t, VerifyNonMembership(hashing.SHA256, proof, []byte("key0"), root))
+	proof.Leaf = leaf
+
+	proof.Siblings[0][0] ^= 1
+	require.False(t, VerifyMembership(hashing.SHA256, proof, []byte("key0"), 1, sha256.Sum256([]byte{0}), root))
+
+	require.False(t, VerifyMembership(hashing.SHA256, nil, []byte("key0"), 1, sha256.Sum256([]byte{0}), root))
+	require.False(t, VerifyNonMembership(hashing.SHA256, nil, []byte("key0"), root))
+	require.False(t, VerifyNonMembership(hashing.SHA256, &Proof{Siblings: make([][sha256.Size]byte, Depth+1)}, []byte("key0"), root))
+
+	// the leaf of a non-membership proof must share the path of the key
+	proof, err = tree.ProofAt(1, []byte("missing"))
+	require.NoError(t, err)
+	require.True(t, VerifyNonMembership(hashing.SHA256, proof, []byte("missing"), root))
+
+	other, err := tree.ProofAt(1, []byte("key1"))
+	require.NoError(t, err)
+
+	proof.Leaf = other.Leaf
+	require.False(t, VerifyNonMembership(hashing.SHA256, proof, []byte("missing"), root))
+}
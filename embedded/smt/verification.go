@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smt
+
+import (
+	"crypto/sha256"
+
+	"github.com/codenotary/immudb/embedded/hashing"
+)
+
+// VerifyMembership returns true if the proof shows that the key was set with the given
+// value digest at transaction txID, and not updated afterwards, in the tree with the specified root
+func VerifyMembership(hashAlgorithm hashing.Algorithm, proof *Proof, key []byte, txID uint64, valueDigest, root [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || proof == nil || proof.Leaf == nil || len(proof.Siblings) > Depth {
+		return false
+	}
+
+	kd := hashAlgorithm.Sum(key)
+
+	if proof.Leaf.KeyDigest != kd || proof.Leaf.TxID != txID || proof.Leaf.ValueDigest != valueDigest {
+		return false
+	}
+
+	return evalPath(hashAlgorithm, kd, leafDigest(hashAlgorithm, proof.Leaf), proof.Siblings) == root
+}
+
+// VerifyNonMembership returns true if the proof shows that the key is not
+// part of the tree with the specified root
+func VerifyNonMembership(hashAlgorithm hashing.Algorithm, proof *Proof, key []byte, root [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || proof == nil || len(proof.Siblings) > Depth {
+		return false
+	}
+
+	kd := hashAlgorithm.Sum(key)
+
+	// the path of the key ends in an empty subtree
+	var start [sha256.Size]byte
+
+	if proof.Leaf != nil {
+		// or in a subtree holding a single leaf of another key sharing the same path
+		if proof.Leaf.KeyDigest == kd {
+			return false
+		}
+
+		for i := 0; i < len(proof.Siblings); i++ {
+			if bitAt(proof.Leaf.KeyDigest, i) != bitAt(kd, i) {
+				return false
+			}
+		}
+
+		start = leafDigest(hashAlgorithm, proof.Leaf)
+	}
+
+	return evalPath(hashAlgorithm, kd, start, proof.Siblings) == root
+}
+
+func evalPath(hashAlgorithm hashing.Algorithm, kd, h [sha256.Size]byte, siblings [][sha256.Size]byte) [sha256.Size]byte {
+	for i := len(siblings) - 1; i >= 0; i-- {
+		if bitAt(kd, i) == 0 {
+			h = innerDigest(hashAlgorithm, h, siblings[i])
+		} else {
+			h = innerDigest(hashAlgorithm, siblings[i], h)
+		}
+	}
+
+	return h
+}
@@ -547,3 +547,91 @@ func (v *AVGValue) isConstant() bool {
 func (v *AVGValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
 	return nil
 }
+
+// JSONAggValue aggregates the values of a column into a JSON array
+type JSONAggValue struct {
+	elems []interface{}
+	sel   string
+}
+
+func (v *JSONAggValue) Selector() string {
+	return v.sel
+}
+
+func (v *JSONAggValue) ColBounded() bool {
+	return true
+}
+
+func (v *JSONAggValue) Type() SQLValueType {
+	return JSONType
+}
+
+func (v *JSONAggValue) IsNull() bool {
+	return v.elems == nil
+}
+
+func (v *JSONAggValue) asJSON() TypedValue {
+	if v.elems == nil {
+		return &NullValue{t: JSONType}
+	}
+	return NewJson(v.elems)
+}
+
+func (v *JSONAggValue) String() string {
+	return v.asJSON().String()
+}
+
+func (v *JSONAggValue) RawValue() interface{} {
+	return v.asJSON().RawValue()
+}
+
+func (v *JSONAggValue) Compare(val TypedValue) (int, error) {
+	return v.asJSON().Compare(val)
+}
+
+func (v *JSONAggValue) updateWith(val TypedValue) error {
+	// as in postgres, null values are included in the array
+	v.elems = append(v.elems, jsonValueOf(val))
+	return nil
+}
+
+// ValueExp
+
+func (v *JSONAggValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return JSONType, nil
+}
+
+func (v *JSONAggValue) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != JSONType {
+		return ErrNotComparableValues
+	}
+	return nil
+}
+
+func (v *JSONAggValue) jointColumnTo(col *Column, tableAlias string) (*ColSelector, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *JSONAggValue) substitute(params map[string]interface{}) (ValueExp, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *JSONAggValue) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *JSONAggValue) selectors() []Selector {
+	return nil
+}
+
+func (v *JSONAggValue) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return nil
+}
+
+func (v *JSONAggValue) isConstant() bool {
+	return false
+}
+
+func (v *JSONAggValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,15 +32,42 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultSchema is the schema tables belong to when created without specifying one.
+// Tables of the default schema are registered in the catalog using their unqualified names.
+const DefaultSchema = "public"
+
+// systemCatalogSchema is the schema built-in tables belong to.
+const systemCatalogSchema = "pg_catalog"
+
 // Catalog represents a database catalog containing metadata for all tables in the database.
 type Catalog struct {
 	enginePrefix []byte
 
-	tables       []*Table
-	tablesByID   map[uint32]*Table
-	tablesByName map[string]*Table
+	schemas       []*Schema
+	schemasByName map[string]*Schema
+
+	tables        []*Table
+	tablesByID    map[uint32]*Table
+	tablesByName  map[string]*Table
+	builtinTables map[string]*Table
+
+	routinesByName map[string]*Routine
+
+	// searchPath holds the schemas in which unqualified table names are looked up, in order.
+	// New tables are created in the first schema of the search path that exists.
+	searchPath []string
+
+	maxSchemaID uint32
+	maxTableID  uint32 // The maxTableID variable is used to assign unique ids to new tables as they are created.
+
+	// version is the id of the latest transaction which updated the catalog when it was loaded.
+	// As catalog entries are never removed but logically deleted, it changes whenever the catalog does.
+	version uint64
+}
 
-	maxTableID uint32 // The maxTableID variable is used to assign unique ids to new tables as they are created.
+type Schema struct {
+	id   uint32
+	name string
 }
 
 type Constraint interface{}
@@ -52,9 +80,15 @@ type CheckConstraint struct {
 	exp  ValueExp
 }
 
+// Name returns the name of the check constraint.
+func (c CheckConstraint) Name() string {
+	return c.name
+}
+
 type Table struct {
 	catalog          *Catalog
 	id               uint32
+	schema           string
 	name             string
 	cols             []*Column
 	colsByID         map[uint32]*Column
@@ -66,6 +100,15 @@ type Table struct {
 	primaryIndex     *Index
 	autoIncrementPK  bool
 	maxPK            int64
+	stats            *TableStats
+	view             *MaterializedView
+	triggers         []*Trigger
+
+	// ids used by the table before being truncated, oldest first
+	truncatedIDs []uint32
+
+	// jsonPathCols holds the columns of JSON path indexes
+	jsonPathCols map[uint32]*Column
 
 	maxColID   uint32
 	maxIndexID uint32
@@ -87,15 +130,30 @@ type Column struct {
 	maxLen        int
 	autoIncrement bool
 	notNull       bool
+
+	// source and path are set on the columns of JSON path indexes,
+	// whose values are the texts found at path within the source column
+	source *Column
+	path   []string
 }
 
 func newCatalog(enginePrefix []byte) *Catalog {
 	ctlg := &Catalog{
-		enginePrefix: enginePrefix,
-		tablesByID:   make(map[uint32]*Table),
-		tablesByName: make(map[string]*Table),
+		enginePrefix:  enginePrefix,
+		schemasByName: make(map[string]*Schema),
+		tablesByID:    make(map[uint32]*Table),
+		tablesByName:  make(map[string]*Table),
+		builtinTables: make(map[string]*Table),
+		searchPath:    []string{DefaultSchema},
+
+		routinesByName: make(map[string]*Routine),
 	}
 
+	defaultSchema := &Schema{name: DefaultSchema}
+
+	ctlg.schemas = append(ctlg.schemas, defaultSchema)
+	ctlg.schemasByName[defaultSchema.name] = defaultSchema
+
 	pgTypeTable := &Table{
 		catalog: ctlg,
 		name:    "pg_type",
@@ -137,13 +195,157 @@ func newCatalog(enginePrefix []byte) *Catalog {
 	}
 
 	pgTypeTable.primaryIndex = pgTypeTable.indexes[0]
-	ctlg.tablesByName[pgTypeTable.name] = pgTypeTable
+	ctlg.builtinTables[pgTypeTable.name] = pgTypeTable
 
 	return ctlg
 }
 
+// qualifiedTableName returns the name under which a table of the given schema is registered in the catalog.
+func qualifiedTableName(schema, table string) string {
+	if schema == "" || schema == DefaultSchema {
+		return table
+	}
+	return schema + "." + table
+}
+
+// splitTableName splits a possibly schema-qualified table name.
+// An empty schema is returned when the name is not qualified.
+func splitTableName(name string) (schema, table string) {
+	i := strings.Index(name, ".")
+	if i < 0 {
+		return "", name
+	}
+	return name[:i], name[i+1:]
+}
+
+func (catlg *Catalog) SearchPath() []string {
+	return catlg.searchPath
+}
+
+func (catlg *Catalog) ExistSchema(schema string) bool {
+	_, exists := catlg.schemasByName[schema]
+	return exists
+}
+
+func (catlg *Catalog) GetSchemas() []*Schema {
+	ss := make([]*Schema, 0, len(catlg.schemas))
+
+	ss = append(ss, catlg.schemas...)
+
+	return ss
+}
+
+func (catlg *Catalog) GetSchemaByName(name string) (*Schema, error) {
+	schema, exists := catlg.schemasByName[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrSchemaDoesNotExist, name)
+	}
+	return schema, nil
+}
+
+func (catlg *Catalog) newSchema(name string) (*Schema, error) {
+	if len(name) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	if name == systemCatalogSchema {
+		return nil, fmt.Errorf("%w: schema name %s is reserved", ErrIllegalArguments, name)
+	}
+
+	if catlg.ExistSchema(name) {
+		return nil, fmt.Errorf("%w (%s)", ErrSchemaAlreadyExists, name)
+	}
+
+	schema := &Schema{
+		id:   catlg.maxSchemaID + 1,
+		name: name,
+	}
+
+	catlg.schemas = append(catlg.schemas, schema)
+	catlg.schemasByName[name] = schema
+
+	catlg.maxSchemaID++
+
+	return schema, nil
+}
+
+func (catlg *Catalog) deleteSchema(schema *Schema) error {
+	if schema.name == DefaultSchema {
+		return fmt.Errorf("%w: schema %s can not be dropped", ErrIllegalArguments, DefaultSchema)
+	}
+
+	for _, t := range catlg.tables {
+		if t.schema == schema.name {
+			return fmt.Errorf("%w (%s)", ErrSchemaNotEmpty, schema.name)
+		}
+	}
+
+	newSchemas := make([]*Schema, 0, len(catlg.schemas)-1)
+
+	for _, s := range catlg.schemas {
+		if s != schema {
+			newSchemas = append(newSchemas, s)
+		}
+	}
+
+	catlg.schemas = newSchemas
+	delete(catlg.schemasByName, schema.name)
+
+	return nil
+}
+
+func (s *Schema) ID() uint32 {
+	return s.id
+}
+
+func (s *Schema) Name() string {
+	return s.name
+}
+
+// qualifyNewTableName returns the name under which a new table is registered.
+// Unqualified names are qualified with the first existing schema of the search path.
+func (catlg *Catalog) qualifyNewTableName(name string) (string, error) {
+	schema, table := splitTableName(name)
+	if schema != "" {
+		if !catlg.ExistSchema(schema) {
+			return "", fmt.Errorf("%w (%s)", ErrSchemaDoesNotExist, schema)
+		}
+		return qualifiedTableName(schema, table), nil
+	}
+
+	for _, schema := range catlg.searchPath {
+		if catlg.ExistSchema(schema) {
+			return qualifiedTableName(schema, table), nil
+		}
+	}
+	return "", fmt.Errorf("%w: no schema has been selected to create in", ErrSchemaDoesNotExist)
+}
+
+func (catlg *Catalog) lookupTable(name string) (*Table, bool) {
+	schema, tableName := splitTableName(name)
+	if schema == systemCatalogSchema {
+		table, exists := catlg.builtinTables[tableName]
+		return table, exists
+	}
+
+	if schema != "" {
+		table, exists := catlg.tablesByName[qualifiedTableName(schema, tableName)]
+		return table, exists
+	}
+
+	for _, schema := range catlg.searchPath {
+		table, exists := catlg.tablesByName[qualifiedTableName(schema, tableName)]
+		if exists {
+			return table, true
+		}
+	}
+
+	table, exists := catlg.builtinTables[name]
+	return table, exists
+}
+
 func (catlg *Catalog) ExistTable(table string) bool {
-	_, exists := catlg.tablesByName[table]
+	_, exists := catlg.lookupTable(table)
 	return exists
 }
 
@@ -155,8 +357,10 @@ func (catlg *Catalog) GetTables() []*Table {
 	return ts
 }
 
+// GetTableByName returns the table with the given name. Unqualified names
+// are looked up in the schemas of the search path.
 func (catlg *Catalog) GetTableByName(name string) (*Table, error) {
-	table, exists := catlg.tablesByName[name]
+	table, exists := catlg.lookupTable(name)
 	if !exists {
 		return nil, fmt.Errorf("%w (%s)", ErrTableDoesNotExist, name)
 	}
@@ -193,7 +397,21 @@ func (t *Table) ColsByName() map[string]*Column {
 	return cs
 }
 
+// Name returns the name of the table, qualified with its schema unless it belongs to the default schema.
 func (t *Table) Name() string {
+	return qualifiedTableName(t.schema, t.name)
+}
+
+// Schema returns the name of the schema the table belongs to.
+func (t *Table) Schema() string {
+	if t.schema == "" {
+		return DefaultSchema
+	}
+	return t.schema
+}
+
+// UnqualifiedName returns the name of the table without its schema.
+func (t *Table) UnqualifiedName() string {
 	return t.name
 }
 
@@ -201,6 +419,20 @@ func (t *Table) PrimaryIndex() *Index {
 	return t.primaryIndex
 }
 
+// CheckConstraints returns the check constraints of the table sorted by name.
+func (t *Table) CheckConstraints() []CheckConstraint {
+	checks := make([]CheckConstraint, 0, len(t.checkConstraints))
+	for _, check := range t.checkConstraints {
+		checks = append(checks, check)
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].name < checks[j].name
+	})
+
+	return checks
+}
+
 func (t *Table) IsIndexed(colName string) (indexed bool, err error) {
 	col, err := t.GetColumnByName(colName)
 	if err != nil {
@@ -307,7 +539,7 @@ func (i *Index) hasPrefix(columns []*Column, ordExps []*OrdExp) bool {
 			return false
 		}
 
-		col, err := i.table.GetColumnByName(colName)
+		col, err := i.table.getIndexableColumnByName(colName)
 		if err != nil || col.id != columns[j].id {
 			return false
 		}
@@ -327,7 +559,7 @@ func (i *Index) sortableUsing(columns []*OrdExp, rangesByColID map[uint32]*typed
 		return false
 	}
 
-	firstCol, err := i.table.GetColumnByName(colName)
+	firstCol, err := i.table.getIndexableColumnByName(colName)
 	if err != nil {
 		return false
 	}
@@ -393,7 +625,18 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 		}
 	}
 
-	exists := catlg.ExistTable(name)
+	schema, tableName := splitTableName(name)
+	if schema == DefaultSchema {
+		schema = ""
+	}
+
+	if schema != "" && !catlg.ExistSchema(schema) {
+		return nil, fmt.Errorf("%w (%s)", ErrSchemaDoesNotExist, schema)
+	}
+
+	name = qualifiedTableName(schema, tableName)
+
+	_, exists := catlg.tablesByName[name]
 	if exists {
 		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, name)
 	}
@@ -412,7 +655,8 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 	table = &Table{
 		id:               id,
 		catalog:          catlg,
-		name:             name,
+		schema:           schema,
+		name:             tableName,
 		cols:             make([]*Column, 0, len(colsSpec)),
 		colsByID:         make(map[uint32]*Column),
 		colsByName:       make(map[string]*Column),
@@ -463,7 +707,7 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 
 	catlg.tables = append(catlg.tables, table)
 	catlg.tablesByID[table.id] = table
-	catlg.tablesByName[table.name] = table
+	catlg.tablesByName[name] = table
 
 	// increment table count on successfull table creation.
 	// This ensures that each new table is assigned a unique ID
@@ -489,7 +733,7 @@ func (catlg *Catalog) deleteTable(table *Table) error {
 
 	catlg.tables = newTables
 	delete(catlg.tablesByID, table.id)
-	delete(catlg.tablesByName, table.name)
+	delete(catlg.tablesByName, table.Name())
 
 	return nil
 }
@@ -504,7 +748,7 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 	colsByID := make(map[uint32]*Column, len(colIDs))
 
 	for i, colID := range colIDs {
-		col, err := t.GetColumnByID(colID)
+		col, err := t.getIndexableColumnByID(colID)
 		if err != nil {
 			return nil, err
 		}
@@ -537,6 +781,11 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 	// having a direct way to get the indexes by colID
 	for _, col := range index.cols {
 		t.indexesByColID[col.id] = append(t.indexesByColID[col.id], index)
+
+		if col.source != nil {
+			// the source column of a JSON path is also indexed
+			t.indexesByColID[col.source.id] = append(t.indexesByColID[col.source.id], index)
+		}
 	}
 
 	if index.id == PKIndexID {
@@ -552,6 +801,63 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 	return index, nil
 }
 
+// jsonPathColumn returns the column of JSON path indexes holding the texts found at path within
+// the given JSON column. Such columns are not stored but computed from their source column.
+func (t *Table) jsonPathColumn(source *Column, path []string) (*Column, error) {
+	if source.colType != JSONType {
+		return nil, fmt.Errorf("%w: column '%s' is not of type %s", ErrInvalidTypes, source.colName, JSONType)
+	}
+
+	name := jsonPathColName(source.colName, path)
+
+	for _, col := range t.jsonPathCols {
+		if col.colName == name {
+			return col, nil
+		}
+	}
+
+	if t.jsonPathCols == nil {
+		t.jsonPathCols = make(map[uint32]*Column)
+	}
+
+	col := &Column{
+		table:   t,
+		id:      firstJSONPathColID + uint32(len(t.jsonPathCols)),
+		colName: name,
+		colType: VarcharType,
+		maxLen:  JSONPathKeyLen,
+		source:  source,
+		path:    path,
+	}
+
+	t.jsonPathCols[col.id] = col
+
+	return col, nil
+}
+
+// getIndexableColumnByName returns the table column or the JSON path column with the given name
+func (t *Table) getIndexableColumnByName(name string) (*Column, error) {
+	col, err := t.GetColumnByName(name)
+	if err == nil {
+		return col, nil
+	}
+
+	for _, jcol := range t.jsonPathCols {
+		if jcol.colName == name {
+			return jcol, nil
+		}
+	}
+	return nil, err
+}
+
+func (t *Table) getIndexableColumnByID(id uint32) (*Column, error) {
+	col, exists := t.jsonPathCols[id]
+	if exists {
+		return col, nil
+	}
+	return t.GetColumnByID(id)
+}
+
 func (t *Table) newColumn(spec *ColSpec) (*Column, error) {
 	if isReservedCol(spec.colName) {
 		return nil, fmt.Errorf("%w(%s)", ErrReservedWord, spec.colName)
@@ -603,15 +909,18 @@ func (ctlg *Catalog) renameTable(oldName, newName string) (*Table, error) {
 		return nil, err
 	}
 
-	_, err = ctlg.GetTableByName(newName)
-	if err == nil {
-		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, newName)
+	// the table is kept within the same schema
+	newQualifiedName := qualifiedTableName(t.schema, newName)
+
+	_, exists := ctlg.tablesByName[newQualifiedName]
+	if exists {
+		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, newQualifiedName)
 	}
 
-	t.name = newName
+	delete(ctlg.tablesByName, t.Name())
 
-	delete(ctlg.tablesByName, oldName)
-	ctlg.tablesByName[newName] = t
+	t.name = newName
+	ctlg.tablesByName[newQualifiedName] = t
 
 	return t, nil
 }
@@ -702,6 +1011,36 @@ func (c *Column) ID() uint32 {
 	return c.id
 }
 
+// JSONPath returns the source column and the path of the columns of JSON path indexes
+func (c *Column) JSONPath() (source *Column, path []string) {
+	return c.source, c.path
+}
+
+// valueFrom returns the value of the column given the values of the table columns
+func (c *Column) valueFrom(valuesByColID map[uint32]TypedValue) TypedValue {
+	if c.source != nil {
+		sel := &JSONSelector{fields: c.path, text: true}
+
+		val, specified := valuesByColID[c.source.id]
+		if !specified || val.IsNull() {
+			return sel.lookup(nil)
+		}
+
+		// values being written may still be in textual form
+		jsonVal, err := jsonArgument(val)
+		if err != nil {
+			return sel.lookup(nil)
+		}
+		return sel.lookup(jsonVal)
+	}
+
+	val, specified := valuesByColID[c.id]
+	if !specified {
+		return &NullValue{t: c.colType}
+	}
+	return val
+}
+
 func (c *Column) Name() string {
 	return c.colName
 }
@@ -757,9 +1096,14 @@ func (catlg *Catalog) load(ctx context.Context, tx *store.OngoingTx) error {
 }
 
 func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	err := catlg.loadSchemas(ctx, tx, copyToTx)
+	if err != nil {
+		return err
+	}
+
 	prefix := MapKey(catlg.enginePrefix, catalogTablePrefix, EncodeID(1))
 
-	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+	err = catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
 		dbID, tableID, err := unmapTableID(catlg.enginePrefix, key)
 		if err != nil {
 			return err
@@ -774,12 +1118,12 @@ func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copy
 			return nil
 		}
 
-		colSpecs, maxColID, err := loadColSpecs(ctx, tableID, tx, catlg.enginePrefix, copyToTx)
+		colSpecs, maxColID, err := catlg.loadColSpecs(ctx, tableID, tx, copyToTx)
 		if err != nil {
 			return err
 		}
 
-		checks, err := loadCheckConstraints(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		checks, err := catlg.loadCheckConstraints(ctx, dbID, tableID, tx, copyToTx)
 		if err != nil {
 			return err
 		}
@@ -800,6 +1144,61 @@ func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copy
 		}
 		return table.loadIndexes(ctx, catlg.enginePrefix, tx, copyToTx)
 	})
+	if err != nil {
+		return err
+	}
+
+	err = catlg.loadStats(ctx, tx, copyToTx)
+	if err != nil {
+		return err
+	}
+
+	err = catlg.loadViews(ctx, tx, copyToTx)
+	if err != nil {
+		return err
+	}
+
+	err = catlg.loadTriggers(ctx, tx, copyToTx)
+	if err != nil {
+		return err
+	}
+
+	err = catlg.loadTruncatedIDs(ctx, tx, copyToTx)
+	if err != nil {
+		return err
+	}
+
+	return catlg.loadRoutines(ctx, tx, copyToTx)
+}
+
+func (catlg *Catalog) loadSchemas(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogSchemaPrefix, EncodeID(DatabaseID))
+
+	return catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		schemaID, err := unmapSchemaID(catlg.enginePrefix, key)
+		if err != nil {
+			return err
+		}
+
+		if deleted {
+			catlg.maxSchemaID++
+			return nil
+		}
+
+		schema, err := catlg.newSchema(string(value))
+		if err != nil {
+			return err
+		}
+
+		if schemaID != schema.id {
+			return ErrCorruptedData
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
 }
 
 func loadMaxPK(ctx context.Context, sqlPrefix []byte, tx *store.OngoingTx, table *Table) ([]byte, error) {
@@ -822,19 +1221,19 @@ func loadMaxPK(ctx context.Context, sqlPrefix []byte, tx *store.OngoingTx, table
 	return unmapIndexEntry(table.primaryIndex, sqlPrefix, mkey)
 }
 
-func loadColSpecs(ctx context.Context, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[uint32]*ColSpec, uint32, error) {
-	prefix := MapKey(sqlPrefix, catalogColumnPrefix, EncodeID(1), EncodeID(tableID))
+func (catlg *Catalog) loadColSpecs(ctx context.Context, tableID uint32, tx *store.OngoingTx, copyToTx bool) (map[uint32]*ColSpec, uint32, error) {
+	prefix := MapKey(catlg.enginePrefix, catalogColumnPrefix, EncodeID(1), EncodeID(tableID))
 
 	var maxColID uint32
 	specs := make(map[uint32]*ColSpec)
 
-	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+	err := catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
 		if deleted {
 			maxColID++
 			return nil
 		}
 
-		colSpec, colID, err := loadColSpec(sqlPrefix, key, value, tableID)
+		colSpec, colID, err := loadColSpec(catlg.enginePrefix, key, value, tableID)
 		if err != nil {
 			return err
 		}
@@ -874,16 +1273,16 @@ func loadColSpec(sqlPrefix, key, value []byte, tableID uint32) (*ColSpec, uint32
 	}, colID, nil
 }
 
-func loadCheckConstraints(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]CheckConstraint, error) {
-	prefix := MapKey(sqlPrefix, catalogCheckPrefix, EncodeID(dbID), EncodeID(tableID))
+func (catlg *Catalog) loadCheckConstraints(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, copyToTx bool) (map[string]CheckConstraint, error) {
+	prefix := MapKey(catlg.enginePrefix, catalogCheckPrefix, EncodeID(dbID), EncodeID(tableID))
 	checks := make(map[string]CheckConstraint)
 
-	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+	err := catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
 		if deleted {
 			return nil
 		}
 
-		check, err := parseCheckConstraint(sqlPrefix, key, value)
+		check, err := parseCheckConstraint(catlg.enginePrefix, key, value)
 		if err != nil {
 			return err
 		}
@@ -900,7 +1299,7 @@ func loadCheckConstraints(ctx context.Context, dbID, tableID uint32, tx *store.O
 func (table *Table) loadIndexes(ctx context.Context, sqlPrefix []byte, tx *store.OngoingTx, copyToTx bool) error {
 	prefix := MapKey(sqlPrefix, catalogIndexPrefix, EncodeID(1), EncodeID(table.id))
 
-	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+	return table.catalog.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
 		dbID, tableID, indexID, err := unmapIndex(sqlPrefix, key)
 		if err != nil {
 			return err
@@ -922,16 +1321,55 @@ func (table *Table) loadIndexes(ctx context.Context, sqlPrefix []byte, tx *store
 		} else {
 			// v={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
 			colSpecLen := EncIDLen + 1
-			if len(value) < 1+colSpecLen || len(value)%colSpecLen != 1 {
+			if len(value) < 1+colSpecLen {
 				return ErrCorruptedData
 			}
 
 			var colIDs []uint32
-			for i := 1; i < len(value); i += colSpecLen {
+			for i := 1; i < len(value); {
+				if len(value)-i < colSpecLen {
+					return ErrCorruptedData
+				}
+
 				colID := binary.BigEndian.Uint32(value[i:])
+				spec := value[i+EncIDLen]
+				i += colSpecLen
+
+				if spec == jsonPathColSpec {
+					// {sourceColID}{0x02}{pathLen}{path}
+					if len(value)-i < EncIDLen {
+						return ErrCorruptedData
+					}
+
+					pathLen := int(binary.BigEndian.Uint32(value[i:]))
+					i += EncIDLen
+
+					if len(value)-i < pathLen {
+						return ErrCorruptedData
+					}
+
+					var path []string
+					if err := json.Unmarshal(value[i:i+pathLen], &path); err != nil {
+						return ErrCorruptedData
+					}
+					i += pathLen
+
+					source, err := table.GetColumnByID(colID)
+					if err != nil {
+						return err
+					}
+
+					col, err := table.jsonPathColumn(source, path)
+					if err != nil {
+						return err
+					}
+
+					colIDs = append(colIDs, col.id)
+					continue
+				}
 
 				// TODO: currently only ASC order is supported
-				if value[i+EncIDLen] != 0 {
+				if spec != 0 {
 					return ErrCorruptedData
 				}
 				colIDs = append(colIDs, colID)
@@ -960,6 +1398,23 @@ func trimPrefix(prefix, mkey []byte, mappingPrefix []byte) ([]byte, error) {
 	return mkey[len(prefix)+len(mappingPrefix):], nil
 }
 
+func unmapSchemaID(prefix, mkey []byte) (uint32, error) {
+	encID, err := trimPrefix(prefix, mkey, []byte(catalogSchemaPrefix))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(encID) != EncIDLen*2 {
+		return 0, ErrCorruptedData
+	}
+
+	if binary.BigEndian.Uint32(encID) != DatabaseID {
+		return 0, ErrCorruptedData
+	}
+
+	return binary.BigEndian.Uint32(encID[EncIDLen:]), nil
+}
+
 func unmapTableID(prefix, mkey []byte) (dbID, tableID uint32, err error) {
 	encID, err := trimPrefix(prefix, mkey, []byte(catalogTablePrefix))
 	if err != nil {
@@ -1642,7 +2097,7 @@ func (catlg *Catalog) addSchemaToTx(ctx context.Context, tx *store.OngoingTx) er
 	return catlg.loadCatalog(ctx, tx, true)
 }
 
-func iteratePrefix(ctx context.Context, tx *store.OngoingTx, prefix []byte, onSpec func(key, value []byte, deleted bool) error) error {
+func (catlg *Catalog) iteratePrefix(ctx context.Context, tx *store.OngoingTx, prefix []byte, onSpec func(key, value []byte, deleted bool) error) error {
 	dbReaderSpec := store.KeyReaderSpec{
 		Prefix: prefix,
 	}
@@ -1667,6 +2122,10 @@ func iteratePrefix(ctx context.Context, tx *store.OngoingTx, prefix []byte, onSp
 			return ErrBrokenCatalogColSpecExpirable
 		}
 
+		if vref.Tx() > catlg.version {
+			catlg.version = vref.Tx()
+		}
+
 		deleted := md != nil && md.Deleted()
 		var v []byte
 		if !deleted {
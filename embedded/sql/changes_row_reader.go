@@ -0,0 +1,581 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+const (
+	opCol           = "_op"
+	beforeColPrefix = "_before_"
+
+	// number of columns preceding the values of the row
+	changesExtraCols = 6
+
+	insertOp = "INSERT"
+	updateOp = "UPDATE"
+	deleteOp = "DELETE"
+)
+
+// changesDataSource represents the net changes made to the rows of a table within a range of transactions.
+// One row is produced for each primary key whose value differs between the beginning and the end of the range,
+// including the kind of operation, the last tx changing the row, the values after the change and the values before it.
+type changesDataSource struct {
+	table  string
+	period period
+	as     string
+}
+
+func (ds *changesDataSource) readOnly() bool {
+	return true
+}
+
+func (ds *changesDataSource) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeSelect}
+}
+
+func (ds *changesDataSource) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (ds *changesDataSource) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	return tx, nil
+}
+
+func (ds *changesDataSource) referencedTable(tx *SQLTx) (*Table, error) {
+	return tx.catalog.GetTableByName(ds.table)
+}
+
+func (ds *changesDataSource) Resolve(ctx context.Context, tx *SQLTx, params map[string]interface{}, scanSpecs *ScanSpecs) (RowReader, error) {
+	if tx == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	table, err := ds.referencedTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newChangesRowReader(tx, params, table, ds.period, ds.Alias())
+	r.truncatedIDs = table.truncatedIDs
+
+	return r, nil
+}
+
+func (ds *changesDataSource) Alias() string {
+	if ds.as == "" {
+		_, table := splitTableName(ds.table)
+		return table
+	}
+	return ds.as
+}
+
+type changesRowReader struct {
+	tx         *SQLTx
+	table      *Table
+	tableAlias string
+	colsByPos  []ColDescriptor
+	colsBySel  map[string]ColDescriptor
+
+	period period
+	params map[string]interface{}
+
+	txRange *txRange
+
+	// ids used by the table before being truncated, only the changes made under the current id are read if not set
+	truncatedIDs []uint32
+
+	// ids under which the rows of the table were kept at the beginning and at the end of the range,
+	// which differ when the table was truncated within the range
+	beforeID   uint32
+	afterID    uint32
+	truncation store.ValueRef
+
+	// encoded primary keys of the rows changed within the range of transactions, sorted in ascending order
+	changedKeys [][]byte
+	read        int
+
+	memBudget *memoryBudget
+
+	onCloseCallback func()
+}
+
+func newChangesRowReader(tx *SQLTx, params map[string]interface{}, table *Table, period period, tableAlias string) *changesRowReader {
+	cols := []ColDescriptor{
+		{Table: tableAlias, Column: opCol, Type: VarcharType},
+		{Table: tableAlias, Column: revCol, Type: IntegerType},
+		{Table: tableAlias, Column: txMetadataCol, Type: JSONType},
+		{Table: tableAlias, Column: txIDCol, Type: IntegerType},
+		{Table: tableAlias, Column: txTimestampCol, Type: TimestampType},
+		{Table: tableAlias, Column: txUserCol, Type: VarcharType},
+	}
+
+	for _, c := range table.cols {
+		cols = append(cols, ColDescriptor{Table: tableAlias, Column: c.colName, Type: c.colType})
+	}
+
+	for _, c := range table.cols {
+		cols = append(cols, ColDescriptor{Table: tableAlias, Column: beforeColPrefix + c.colName, Type: c.colType})
+	}
+
+	colsBySel := make(map[string]ColDescriptor, len(cols))
+	for _, c := range cols {
+		colsBySel[c.Selector()] = c
+	}
+
+	return &changesRowReader{
+		tx:         tx,
+		table:      table,
+		tableAlias: tableAlias,
+		colsByPos:  cols,
+		colsBySel:  colsBySel,
+		period:     period,
+		params:     params,
+		memBudget:  tx.memBudget,
+	}
+}
+
+func (r *changesRowReader) onClose(callback func()) {
+	r.onCloseCallback = callback
+}
+
+func (r *changesRowReader) Tx() *SQLTx {
+	return r.tx
+}
+
+func (r *changesRowReader) TableAlias() string {
+	return r.tableAlias
+}
+
+func (r *changesRowReader) Parameters() map[string]interface{} {
+	return r.params
+}
+
+func (r *changesRowReader) OrderBy() []ColDescriptor {
+	return nil
+}
+
+func (r *changesRowReader) ScanSpecs() *ScanSpecs {
+	return nil
+}
+
+func (r *changesRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	ret := make([]ColDescriptor, len(r.colsByPos))
+	copy(ret, r.colsByPos)
+	return ret, nil
+}
+
+func (r *changesRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	ret := make(map[string]ColDescriptor, len(r.colsBySel))
+	for sel, c := range r.colsBySel {
+		ret[sel] = c
+	}
+	return ret, nil
+}
+
+func (r *changesRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	if r.period.start != nil {
+		_, err := r.period.start.instant.exp.inferType(r.colsBySel, params, r.tableAlias)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.period.end != nil {
+		_, err := r.period.end.instant.exp.inferType(r.colsBySel, params, r.tableAlias)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveTxRange returns the range of transactions specified by the period,
+// which defaults to all the committed transactions
+func (r *changesRowReader) resolveTxRange() (*txRange, error) {
+	rng := &txRange{
+		initialTxID: 1,
+		finalTxID:   r.tx.engine.store.LastCommittedTxID(),
+	}
+
+	if r.period.start != nil {
+		txID, err := r.period.start.instant.resolve(r.tx, r.params, true, r.period.start.inclusive)
+		if err != nil {
+			return nil, err
+		}
+		rng.initialTxID = txID
+	}
+
+	if r.period.end != nil {
+		txID, err := r.period.end.instant.resolve(r.tx, r.params, false, r.period.end.inclusive)
+		if err != nil {
+			return nil, err
+		}
+
+		if txID < rng.finalTxID {
+			rng.finalTxID = txID
+		}
+	}
+
+	return rng, nil
+}
+
+// loadChangedKeys scans the entries of the transactions within the range,
+// collecting the primary keys of the rows changed by them
+func (r *changesRowReader) loadChangedKeys(ctx context.Context) error {
+	st := r.tx.engine.store
+
+	// the range may be already set when the reader is used internally
+	if r.txRange == nil {
+		rng, err := r.resolveTxRange()
+		if err != nil {
+			return err
+		}
+		r.txRange = rng
+	}
+
+	r.changedKeys = [][]byte{}
+
+	if r.txRange.initialTxID > r.txRange.finalTxID {
+		return nil
+	}
+
+	err := r.resolveTableIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// values are read from the index, so it must include the whole range
+	err = st.WaitForIndexingUpto(ctx, r.txRange.finalTxID)
+	if err != nil {
+		return err
+	}
+
+	txr, err := st.NewTxReader(r.txRange.initialTxID, false, store.NewTx(st.MaxTxEntries(), st.MaxKeyLen()))
+	if err != nil {
+		return err
+	}
+
+	rowPrefix := MapKey(r.tx.sqlPrefix(), RowPrefix, EncodeID(DatabaseID), EncodeID(r.afterID), EncodeID(r.table.primaryIndex.id))
+
+	changed := make(map[string]struct{})
+
+	addChangedKey := func(pkEncVals []byte) error {
+		if _, ok := changed[string(pkEncVals)]; ok {
+			return nil
+		}
+
+		err := r.memBudget.consume(rowMemOverhead + len(pkEncVals))
+		if err != nil {
+			return err
+		}
+
+		changed[string(pkEncVals)] = struct{}{}
+		r.changedKeys = append(r.changedKeys, pkEncVals)
+
+		return nil
+	}
+
+	// all the rows kept under the previous id were deleted by the truncation
+	if r.beforeID != r.afterID {
+		err := r.loadKeysOf(ctx, r.beforeID, addChangedKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		tx, err := txr.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if tx.Header().ID > r.txRange.finalTxID {
+			break
+		}
+
+		for _, e := range tx.Entries() {
+			if !bytes.HasPrefix(e.Key(), rowPrefix) {
+				continue
+			}
+
+			err := addChangedKey(e.Key()[len(rowPrefix):])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	sort.Slice(r.changedKeys, func(i, j int) bool {
+		return bytes.Compare(r.changedKeys[i], r.changedKeys[j]) < 0
+	})
+
+	return nil
+}
+
+// resolveTableIDs sets the ids under which the rows of the table were kept at the beginning and at the end of the range
+func (r *changesRowReader) resolveTableIDs(ctx context.Context) error {
+	r.beforeID = r.table.id
+	r.afterID = r.table.id
+
+	if len(r.truncatedIDs) == 0 {
+		return nil
+	}
+
+	ids := append(append([]uint32{}, r.truncatedIDs...), r.table.id)
+	truncations := make([]store.ValueRef, len(ids))
+
+	for i, id := range r.truncatedIDs {
+		truncation, err := truncationOf(ctx, r.tx, id)
+		if err != nil {
+			return err
+		}
+		truncations[i] = truncation
+	}
+
+	// rows are kept under an id until the transaction truncating the table
+	idAt := func(txID uint64) int {
+		for i := range ids {
+			if truncations[i] == nil || truncations[i].Tx() > txID {
+				return i
+			}
+		}
+		return len(ids) - 1
+	}
+
+	before := idAt(r.txRange.initialTxID - 1)
+	after := idAt(r.txRange.finalTxID)
+
+	r.beforeID = ids[before]
+	r.afterID = ids[after]
+
+	for _, id := range []uint32{r.beforeID, r.afterID} {
+		if id == r.table.id {
+			continue
+		}
+
+		err := r.tx.useTruncatedIndex(r.table, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if before != after {
+		r.truncation = truncations[before]
+	}
+	return nil
+}
+
+// loadKeysOf reads the primary keys of all the rows ever kept under the given table id
+func (r *changesRowReader) loadKeysOf(ctx context.Context, tableID uint32, fn func(pkEncVals []byte) error) error {
+	prefix := MapKey(r.tx.sqlPrefix(), MappedPrefix, EncodeID(tableID), EncodeID(r.table.primaryIndex.id))
+
+	reader, err := r.tx.newKeyReader(store.KeyReaderSpec{Prefix: prefix})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		key, _, err := reader.Read(ctx)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// key=M.{tableID}{0}{pkVals}{pkVals}
+		encVals := key[len(prefix):]
+
+		err = fn(encVals[:len(encVals)/2])
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *changesRowReader) rowKey(tableID uint32, pkEncVals []byte) []byte {
+	return MapKey(r.tx.sqlPrefix(), MappedPrefix, EncodeID(tableID), EncodeID(r.table.primaryIndex.id), pkEncVals, pkEncVals)
+}
+
+// valueAt returns the latest value of the key within the range of transactions,
+// nil is returned if the row did not exist at the end of the range
+func (r *changesRowReader) valueAt(ctx context.Context, key []byte, initialTxID, finalTxID uint64) (store.ValueRef, error) {
+	if initialTxID == 0 || initialTxID > finalTxID {
+		return nil, nil
+	}
+
+	vref, err := r.tx.engine.store.GetBetween(ctx, key, initialTxID, finalTxID)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if md := vref.KVMetadata(); md != nil && md.Deleted() {
+		return nil, nil
+	}
+	return vref, nil
+}
+
+func (r *changesRowReader) Read(ctx context.Context) (*Row, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.changedKeys == nil {
+		err := r.loadChangedKeys(ctx)
+		if errors.Is(err, store.ErrTxNotFound) {
+			return nil, ErrNoMoreRows
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for r.read < len(r.changedKeys) {
+		pkEncVals := r.changedKeys[r.read]
+		r.read++
+
+		before, err := r.valueAt(ctx, r.rowKey(r.beforeID, pkEncVals), 1, r.txRange.initialTxID-1)
+		if err != nil {
+			return nil, err
+		}
+
+		// the latest entry is read even if it is a deletion
+		last, err := r.tx.engine.store.GetBetween(ctx, r.rowKey(r.afterID, pkEncVals), r.txRange.initialTxID, r.txRange.finalTxID)
+		if errors.Is(err, store.ErrKeyNotFound) && r.truncation != nil {
+			if before == nil {
+				continue
+			}
+
+			// the row was deleted by the truncation of the table
+			return r.buildRow(deleteOp, before.HC(), r.truncation.Tx(), r.truncation.TxMetadata(), before, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var after store.ValueRef
+		if md := last.KVMetadata(); md == nil || !md.Deleted() {
+			after = last
+		}
+
+		var op string
+
+		switch {
+		case before == nil && after == nil:
+			// the row was created and deleted within the range
+			continue
+		case before == nil:
+			op = insertOp
+		case after == nil:
+			op = deleteOp
+		default:
+			op = updateOp
+		}
+
+		return r.buildRow(op, last.HC(), last.Tx(), last.TxMetadata(), before, after)
+	}
+
+	return nil, ErrNoMoreRows
+}
+
+func (r *changesRowReader) buildRow(op string, rev, txID uint64, md *store.TxMetadata, before, after store.ValueRef) (*Row, error) {
+	txmd, err := r.tx.engine.txMetadataValue(md)
+	if err != nil {
+		return nil, err
+	}
+
+	txTs, err := r.tx.engine.txTimestampValue(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	txUser, err := r.tx.engine.txUserValue(md)
+	if err != nil {
+		return nil, err
+	}
+
+	nCols := len(r.table.cols)
+
+	valuesByPosition := make([]TypedValue, len(r.colsByPos))
+	valuesByPosition[0] = &Varchar{val: op}
+	valuesByPosition[1] = &Integer{val: int64(rev)}
+	valuesByPosition[2] = txmd
+	valuesByPosition[3] = &Integer{val: int64(txID)}
+	valuesByPosition[4] = txTs
+	valuesByPosition[5] = txUser
+
+	for i, col := range r.table.cols {
+		valuesByPosition[changesExtraCols+i] = &NullValue{t: col.colType}
+		valuesByPosition[changesExtraCols+nCols+i] = &NullValue{t: col.colType}
+	}
+
+	decode := func(vref store.ValueRef, off int) error {
+		if vref == nil {
+			return nil
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		return decodeRowValue(r.table, v, func(pos int, _ *Column, val TypedValue) {
+			valuesByPosition[off+pos] = val
+		})
+	}
+
+	if err := decode(after, changesExtraCols); err != nil {
+		return nil, err
+	}
+
+	if err := decode(before, changesExtraCols+nCols); err != nil {
+		return nil, err
+	}
+
+	valuesBySelector := make(map[string]TypedValue, len(r.colsByPos))
+	for i, col := range r.colsByPos {
+		valuesBySelector[col.Selector()] = valuesByPosition[i]
+	}
+
+	return &Row{ValuesByPosition: valuesByPosition, ValuesBySelector: valuesBySelector}, nil
+}
+
+func (r *changesRowReader) Close() error {
+	if r.onCloseCallback != nil {
+		r.onCloseCallback()
+	}
+	return nil
+}
@@ -0,0 +1,384 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// CursorRegistry keeps the cursors declared within a session,
+// so their rows can be fetched by the statements of later transactions
+type CursorRegistry interface {
+	AddCursor(cursor *Cursor) error
+	GetCursor(name string) (*Cursor, error)
+	CloseCursor(name string) error
+}
+
+// Cursor is a query whose rows are fetched in batches over several calls.
+// Rows are read within a dedicated read-only transaction, so every batch
+// is read from the same snapshot, regardless of the transactions committed
+// after the cursor was declared.
+type Cursor struct {
+	mutex sync.Mutex
+
+	name   string
+	tx     *SQLTx
+	reader RowReader
+	cols   []ColDescriptor
+
+	exhausted bool
+	closed    bool
+}
+
+// cursorContext keeps the values of the context the cursor is declared with,
+// without being canceled once the call declaring the cursor completes
+type cursorContext struct {
+	context.Context
+}
+
+func (cursorContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (cursorContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (cursorContext) Err() error {
+	return nil
+}
+
+// DeclareCursor resolves the query within a new read-only transaction, which is kept open until the cursor is closed
+func (e *Engine) DeclareCursor(ctx context.Context, opts *TxOptions, name string, stmt DataSource, params map[string]interface{}) (cursor *Cursor, err error) {
+	if name == "" || stmt == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if opts == nil {
+		opts = DefaultTxOptions()
+	}
+
+	cursorOpts := DefaultTxOptions().
+		WithReadOnly(true).
+		WithSearchPath(opts.SearchPath).
+		WithExtra(opts.Extra)
+
+	cctx := cursorContext{ctx}
+
+	tx, err := e.NewTx(cctx, cursorOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Cancel()
+		}
+	}()
+
+	reader, err := e.resolveQuery(cctx, tx, stmt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := reader.Columns(cctx)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+
+	return &Cursor{
+		name:   name,
+		tx:     tx,
+		reader: reader,
+		cols:   cols,
+	}, nil
+}
+
+func (c *Cursor) Name() string {
+	return c.name
+}
+
+func (c *Cursor) Columns() []ColDescriptor {
+	return c.cols
+}
+
+// Exhausted returns true once all the rows of the cursor have been fetched
+func (c *Cursor) Exhausted() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.exhausted
+}
+
+// Fetch returns the next n rows of the cursor, or all the remaining ones when n is zero.
+// Fewer rows are returned once the cursor is exhausted.
+func (c *Cursor) Fetch(ctx context.Context, n int) ([]*Row, error) {
+	if n < 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	var rows []*Row
+
+	for n == 0 || len(rows) < n {
+		row, err := c.next(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (c *Cursor) next(ctx context.Context) (*Row, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("%w (%s)", ErrCursorClosed, c.name)
+	}
+
+	if c.exhausted {
+		return nil, ErrNoMoreRows
+	}
+
+	row, err := c.reader.Read(ctx)
+	if errors.Is(err, ErrNoMoreRows) {
+		c.exhausted = true
+	}
+	return row, err
+}
+
+// Close releases the reader of the cursor along with the snapshot it was reading from
+func (c *Cursor) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	c.closed = true
+
+	err := c.reader.Close()
+
+	if cerr := c.tx.Cancel(); err == nil && !errors.Is(cerr, ErrAlreadyClosed) {
+		err = cerr
+	}
+	return err
+}
+
+// cursorRowReader reads the next rows of a cursor. Closing it leaves the cursor open
+type cursorRowReader struct {
+	tx     *SQLTx
+	cursor *Cursor
+
+	limit int // zero means all the remaining rows
+	read  int
+
+	onCloseCallback func()
+}
+
+func newCursorRowReader(tx *SQLTx, cursor *Cursor, limit int) *cursorRowReader {
+	return &cursorRowReader{
+		tx:     tx,
+		cursor: cursor,
+		limit:  limit,
+	}
+}
+
+func (cr *cursorRowReader) onClose(callback func()) {
+	cr.onCloseCallback = callback
+}
+
+func (cr *cursorRowReader) Tx() *SQLTx {
+	return cr.tx
+}
+
+func (cr *cursorRowReader) TableAlias() string {
+	return cr.cursor.reader.TableAlias()
+}
+
+func (cr *cursorRowReader) Parameters() map[string]interface{} {
+	return cr.cursor.reader.Parameters()
+}
+
+func (cr *cursorRowReader) OrderBy() []ColDescriptor {
+	return cr.cursor.reader.OrderBy()
+}
+
+func (cr *cursorRowReader) ScanSpecs() *ScanSpecs {
+	return cr.cursor.reader.ScanSpecs()
+}
+
+func (cr *cursorRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	return cr.cursor.cols, nil
+}
+
+func (cr *cursorRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	colsBySel := make(map[string]ColDescriptor, len(cr.cursor.cols))
+
+	for _, col := range cr.cursor.cols {
+		colsBySel[col.Selector()] = col
+	}
+	return colsBySel, nil
+}
+
+func (cr *cursorRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (cr *cursorRowReader) Read(ctx context.Context) (*Row, error) {
+	if cr.limit > 0 && cr.read >= cr.limit {
+		return nil, ErrNoMoreRows
+	}
+
+	row, err := cr.cursor.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cr.read++
+
+	return row, nil
+}
+
+func (cr *cursorRowReader) Close() error {
+	if cr.onCloseCallback != nil {
+		cr.onCloseCallback()
+	}
+	return nil
+}
+
+// DeclareCursorStmt represents a statement to declare a cursor over the rows of a query
+type DeclareCursorStmt struct {
+	cursor string
+	query  DataSource
+}
+
+func (stmt *DeclareCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *DeclareCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return stmt.query.requiredPrivileges()
+}
+
+func (stmt *DeclareCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return stmt.query.inferParameters(ctx, tx, params)
+}
+
+func (stmt *DeclareCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.opts.Cursors == nil {
+		return nil, ErrCursorsNotAvailable
+	}
+
+	cursor, err := tx.engine.DeclareCursor(ctx, tx.opts, stmt.cursor, stmt.query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.opts.Cursors.AddCursor(cursor)
+	if err != nil {
+		cursor.Close()
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// FetchCursorStmt represents a statement to read the next rows of a cursor
+type FetchCursorStmt struct {
+	cursor string
+	count  uint64
+	all    bool
+}
+
+func (stmt *FetchCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *FetchCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *FetchCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *FetchCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	return tx, nil
+}
+
+func (stmt *FetchCursorStmt) Resolve(ctx context.Context, tx *SQLTx, params map[string]interface{}, scanSpecs *ScanSpecs) (RowReader, error) {
+	if tx.opts.Cursors == nil {
+		return nil, ErrCursorsNotAvailable
+	}
+
+	if !stmt.all && (stmt.count == 0 || stmt.count > math.MaxInt32) {
+		return nil, fmt.Errorf("%w: invalid number of rows to fetch", ErrIllegalArguments)
+	}
+
+	cursor, err := tx.opts.Cursors.GetCursor(stmt.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCursorRowReader(tx, cursor, int(stmt.count)), nil
+}
+
+func (stmt *FetchCursorStmt) Alias() string {
+	return stmt.cursor
+}
+
+// CloseCursorStmt represents a statement to close a cursor
+type CloseCursorStmt struct {
+	cursor string
+}
+
+func (stmt *CloseCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *CloseCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *CloseCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CloseCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.opts.Cursors == nil {
+		return nil, ErrCursorsNotAvailable
+	}
+
+	err := tx.opts.Cursors.CloseCursor(stmt.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
@@ -25,20 +25,29 @@ type distinctRowReader struct {
 	rowReader RowReader
 	cols      []ColDescriptor
 
-	readRows map[[sha256.Size]byte]struct{}
+	readRows  map[[sha256.Size]byte]struct{}
+	memBudget *memoryBudget
 }
 
+// distinctEntryMemSize is the estimated memory held by each distinct row digest
+const distinctEntryMemSize = sha256.Size + 16
+
 func newDistinctRowReader(ctx context.Context, rowReader RowReader) (*distinctRowReader, error) {
 	cols, err := rowReader.Columns(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &distinctRowReader{
+	dr := &distinctRowReader{
 		rowReader: rowReader,
 		cols:      cols,
 		readRows:  make(map[[sha256.Size]byte]struct{}),
-	}, nil
+	}
+
+	if tx := rowReader.Tx(); tx != nil {
+		dr.memBudget = tx.memBudget
+	}
+	return dr, nil
 }
 
 func (dr *distinctRowReader) onClose(callback func()) {
@@ -98,6 +107,11 @@ func (dr *distinctRowReader) Read(ctx context.Context) (*Row, error) {
 			continue
 		}
 
+		err = dr.memBudget.consume(distinctEntryMemSize)
+		if err != nil {
+			return nil, err
+		}
+
 		dr.readRows[digest] = struct{}{}
 
 		return row, nil
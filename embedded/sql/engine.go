@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
 )
@@ -37,6 +39,9 @@ var (
 	ErrDatabaseAlreadyExists                  = errors.New("database already exists")
 	ErrTableAlreadyExists                     = errors.New("table already exists")
 	ErrTableDoesNotExist                      = errors.New("table does not exist")
+	ErrSchemaAlreadyExists                    = errors.New("schema already exists")
+	ErrSchemaDoesNotExist                     = errors.New("schema does not exist")
+	ErrSchemaNotEmpty                         = errors.New("schema is not empty")
 	ErrColumnDoesNotExist                     = errors.New("column does not exist")
 	ErrColumnAlreadyExists                    = errors.New("column already exists")
 	ErrCannotDropColumn                       = errors.New("cannot drop column")
@@ -99,6 +104,24 @@ var (
 	ErrCannotIndexJson                        = errors.New("cannot index column of type JSON")
 	ErrInvalidTxMetadata                      = errors.New("invalid transaction metadata")
 	ErrAccessDenied                           = errors.New("access denied")
+	ErrStatementTimeout                       = errors.New("canceling statement due to statement timeout")
+	ErrQueryMemoryLimitExceeded               = errors.New("query memory limit exceeded")
+	ErrInvalidMaterializedView                = errors.New("invalid materialized view")
+	ErrUnsupportedIncrementalRefresh          = fmt.Errorf("%w: the query can not be incrementally refreshed", ErrInvalidMaterializedView)
+	ErrNotMaterializedView                    = errors.New("not a materialized view")
+	ErrMaterializedViewIsReadOnly             = errors.New("materialized views can only be modified by refreshing them")
+	ErrTriggerAlreadyExists                   = errors.New("trigger already exists")
+	ErrTriggerDoesNotExist                    = errors.New("trigger does not exist")
+	ErrMaxTriggerDepthExceeded                = errors.New("max trigger depth exceeded")
+	ErrRoutineAlreadyExists                   = errors.New("function or procedure already exists")
+	ErrRoutineDoesNotExist                    = errors.New("function or procedure does not exist")
+	ErrMaxRoutineDepthExceeded                = errors.New("max routine depth exceeded")
+	ErrRaisedException                        = errors.New("exception raised")
+	ErrCursorsNotAvailable                    = errors.New("cursors can only be used within a session")
+	ErrCursorAlreadyExists                    = errors.New("cursor already exists")
+	ErrCursorDoesNotExist                     = errors.New("cursor does not exist")
+	ErrCursorClosed                           = errors.New("cursor is closed")
+	ErrInvalidTableQuery                      = errors.New("the table can not be created from the query")
 )
 
 var MaxKeyLen = 512
@@ -110,6 +133,12 @@ const (
 
 const MaxNumberOfColumnsInIndex = 8
 
+// JSONPathKeyLen is the maximum length of the texts indexed by JSON path indexes
+const JSONPathKeyLen = 256
+
+// columns of JSON path indexes are assigned ids starting from firstJSONPathColID
+const firstJSONPathColID = uint32(1 << 31)
+
 type Engine struct {
 	store *store.ImmuStore
 
@@ -119,8 +148,17 @@ type Engine struct {
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
 	parseTxMetadata               func([]byte) (map[string]interface{}, error)
+	txUserMetadataKey             string
+	statementTimeout              time.Duration
+	queryMemoryLimit              int64
+	maxTriggerDepth               int
 	multidbHandler                MultiDBHandler
 	tableResolvers                map[string]TableResolver
+	stmtCache                     *stmtCache
+
+	// transactions reading the primary index of each truncated table id
+	truncatedIndexes    map[uint32]int
+	truncatedIndexesMux sync.Mutex
 }
 
 type MultiDBHandler interface {
@@ -133,6 +171,10 @@ type MultiDBHandler interface {
 	AlterUser(ctx context.Context, username, password string, permission Permission) error
 	GrantSQLPrivileges(ctx context.Context, database, username string, privileges []SQLPrivilege) error
 	RevokeSQLPrivileges(ctx context.Context, database, username string, privileges []SQLPrivilege) error
+	GrantTablePrivileges(ctx context.Context, table *Table, username string, privileges []SQLPrivilege, columns []string) error
+	RevokeTablePrivileges(ctx context.Context, table *Table, username string, privileges []SQLPrivilege, columns []string) error
+	GrantSchemaPrivileges(ctx context.Context, schema, username string, privileges []SQLPrivilege) error
+	RevokeSchemaPrivileges(ctx context.Context, schema, username string, privileges []SQLPrivilege) error
 	DropUser(ctx context.Context, username string) error
 	ExecPreparedStmts(ctx context.Context, opts *TxOptions, stmts []SQLStmt, params map[string]interface{}) (ntx *SQLTx, committedTxs []*SQLTx, err error)
 }
@@ -146,6 +188,7 @@ type User interface {
 	Username() string
 	Permission() Permission
 	SQLPrivileges() []SQLPrivilege
+	TablePrivileges() []TablePrivilege
 }
 
 func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
@@ -170,11 +213,21 @@ func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
 		autocommit:                    opts.autocommit,
 		lazyIndexConstraintValidation: opts.lazyIndexConstraintValidation,
 		parseTxMetadata:               opts.parseTxMetadata,
+		txUserMetadataKey:             opts.txUserMetadataKey,
+		statementTimeout:              opts.statementTimeout,
+		queryMemoryLimit:              opts.queryMemoryLimit,
+		maxTriggerDepth:               opts.maxTriggerDepth,
 		multidbHandler:                opts.multidbHandler,
+		truncatedIndexes:              make(map[uint32]int),
 	}
 
 	copy(e.prefix, opts.prefix)
 
+	e.stmtCache, err = newStmtCache(opts.stmtCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
 	err = st.InitIndexing(&store.IndexSpec{
 		SourcePrefix:     append(e.prefix, []byte(catalogPrefix)...),
 		TargetPrefix:     append(e.prefix, []byte(catalogPrefix)...),
@@ -231,6 +284,10 @@ func (e *Engine) NewTx(ctx context.Context, opts *TxOptions) (*SQLTx, error) {
 
 	catalog := newCatalog(e.prefix)
 
+	if len(opts.SearchPath) > 0 {
+		catalog.searchPath = opts.SearchPath
+	}
+
 	err = catalog.load(ctx, tx)
 	if err != nil {
 		return nil, err
@@ -385,7 +442,7 @@ func indexEntryMapperFor(index, primaryIndex *Index) store.EntryMapper {
 		}
 
 		for i, col := range index.cols {
-			encKey, _, err := EncodeValueAsKey(valuesByColID[col.id], col.Type(), col.MaxLen())
+			encKey, _, err := EncodeValueAsKey(col.valueFrom(valuesByColID), col.Type(), col.MaxLen())
 			if err != nil {
 				return nil, err
 			}
@@ -405,9 +462,9 @@ func indexEntryMapperFor(index, primaryIndex *Index) store.EntryMapper {
 }
 
 func (e *Engine) Exec(ctx context.Context, tx *SQLTx, sql string, params map[string]interface{}) (ntx *SQLTx, committedTxs []*SQLTx, err error) {
-	stmts, err := ParseSQL(strings.NewReader(sql))
+	stmts, err := e.PrepareSQL(sql, params)
 	if err != nil {
-		return nil, nil, fmt.Errorf("%w: %v", ErrParsingError, err)
+		return nil, nil, err
 	}
 
 	return e.ExecPreparedStmts(ctx, tx, stmts, params)
@@ -493,13 +550,13 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 		}
 
 		if e.multidbHandler != nil {
-			if err := e.checkUserPermissions(ctx, stmt); err != nil {
+			if err := e.checkUserPermissions(ctx, currTx, stmt); err != nil {
 				currTx.Cancel()
 				return nil, committedTxs, stmts[execStmts:], err
 			}
 		}
 
-		ntx, err := stmt.execAt(ctx, currTx, nparams)
+		ntx, err := e.execStmt(ctx, currTx, stmt, nparams)
 		if err != nil {
 			currTx.Cancel()
 			return nil, committedTxs, stmts[execStmts:], err
@@ -541,7 +598,34 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 	return currTx, committedTxs, stmts[execStmts:], nil
 }
 
-func (e *Engine) checkUserPermissions(ctx context.Context, stmt SQLStmt) error {
+// execStmt executes a single statement, aborting it when the statement timeout expires
+func (e *Engine) execStmt(ctx context.Context, tx *SQLTx, stmt SQLStmt, params map[string]interface{}) (*SQLTx, error) {
+	tx.memBudget = newMemoryBudget(e.queryMemoryLimit)
+	tx.stmtCtx = ctx
+	tx.checkedRoutines = nil
+
+	timeout := tx.statementTimeout()
+
+	// the transaction started by BEGIN outlives the statement
+	_, isBeginStmt := stmt.(*BeginTransactionStmt)
+
+	if timeout <= 0 || isBeginStmt {
+		return stmt.execAt(ctx, tx, params)
+	}
+
+	stmtCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tx.stmtCtx = stmtCtx
+
+	ntx, err := stmt.execAt(stmtCtx, tx, params)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return ntx, ErrStatementTimeout
+	}
+	return ntx, err
+}
+
+func (e *Engine) checkUserPermissions(ctx context.Context, tx *SQLTx, stmt SQLStmt) error {
 	user, err := e.multidbHandler.GetLoggedUser(ctx)
 	if err != nil {
 		return err
@@ -552,9 +636,53 @@ func (e *Engine) checkUserPermissions(ctx context.Context, stmt SQLStmt) error {
 	}
 
 	requiredPrivileges := stmt.requiredPrivileges()
-	if !hasAllPrivileges(user.SQLPrivileges(), requiredPrivileges) {
+	if hasAllPrivileges(user.SQLPrivileges(), requiredPrivileges) {
+		return nil
+	}
+
+	// privileges not granted over the whole database may still
+	// be granted over each of the tables accessed by the statement
+	if len(user.TablePrivileges()) == 0 {
 		return fmt.Errorf("%w: statement requires %v privileges", ErrAccessDenied, requiredPrivileges)
 	}
+
+	for _, p := range requiredPrivileges {
+		if !IsTablePrivilege(p) && !hasAllPrivileges(user.SQLPrivileges(), []SQLPrivilege{p}) {
+			return fmt.Errorf("%w: statement requires %v privileges", ErrAccessDenied, requiredPrivileges)
+		}
+	}
+
+	return checkTablePrivileges(user, tableAccessesOf(tx, stmt, nil))
+}
+
+// checkRoutinePermissions verifies the user calling the routine is allowed to execute it, and to execute
+// each of the statements of its body. Routines are checked once within each statement calling them.
+func (e *Engine) checkRoutinePermissions(ctx context.Context, tx *SQLTx, r *Routine) error {
+	if _, checked := tx.checkedRoutines[r]; checked {
+		return nil
+	}
+
+	user, err := e.multidbHandler.GetLoggedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !hasAllPrivileges(user.SQLPrivileges(), []SQLPrivilege{SQLPrivilegeExecute}) {
+		return fmt.Errorf("%w: %s requires %s privilege", ErrAccessDenied, r.describe(), SQLPrivilegeExecute)
+	}
+
+	err = forEachRoutineStmt(r.stmts, func(stmt SQLStmt) error {
+		return e.checkUserPermissions(ctx, tx, stmt)
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, r.describe())
+	}
+
+	if tx.checkedRoutines == nil {
+		tx.checkedRoutines = make(map[*Routine]struct{})
+	}
+	tx.checkedRoutines[r] = struct{}{}
+
 	return nil
 }
 
@@ -586,9 +714,9 @@ func (e *Engine) queryAll(ctx context.Context, tx *SQLTx, sql string, params map
 }
 
 func (e *Engine) Query(ctx context.Context, tx *SQLTx, sql string, params map[string]interface{}) (RowReader, error) {
-	stmts, err := ParseSQL(strings.NewReader(sql))
+	stmts, err := e.PrepareSQL(sql, params)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrParsingError, err)
+		return nil, err
 	}
 	if len(stmts) != 1 {
 		return nil, ErrExpectingDQLStmt
@@ -621,34 +749,52 @@ func (e *Engine) QueryPreparedStmt(ctx context.Context, tx *SQLTx, stmt DataSour
 		}()
 	}
 
+	r, err := e.resolveQuery(ctx, qtx, stmt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var deadline time.Time
+	if timeout := qtx.statementTimeout(); timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	r = newTimeoutRowReader(r, deadline)
+
+	if tx == nil {
+		r.onClose(func() {
+			qtx.Cancel()
+		})
+	}
+
+	return r, nil
+}
+
+// resolveQuery checks the privileges required by the query and resolves the reader of its rows
+func (e *Engine) resolveQuery(ctx context.Context, tx *SQLTx, stmt DataSource, params map[string]interface{}) (RowReader, error) {
 	nparams, err := normalizeParams(params)
 	if err != nil {
 		return nil, err
 	}
 
 	if e.multidbHandler != nil {
-		if err := e.checkUserPermissions(ctx, stmt); err != nil {
+		err = e.checkUserPermissions(ctx, tx, stmt)
+		if err != nil {
 			return nil, err
 		}
 	}
 
-	_, err = stmt.execAt(ctx, qtx, nparams)
-	if err != nil {
-		return nil, err
-	}
+	tx.stmtCtx = ctx
+	tx.checkedRoutines = nil
 
-	r, err := stmt.Resolve(ctx, qtx, nparams, nil)
+	_, err = stmt.execAt(ctx, tx, nparams)
 	if err != nil {
 		return nil, err
 	}
 
-	if tx == nil {
-		r.onClose(func() {
-			qtx.Cancel()
-		})
-	}
+	tx.memBudget = newMemoryBudget(e.queryMemoryLimit)
 
-	return r, nil
+	return stmt.Resolve(ctx, tx, nparams, nil)
 }
 
 func (e *Engine) Catalog(ctx context.Context, tx *SQLTx) (catalog *Catalog, err error) {
@@ -666,9 +812,9 @@ func (e *Engine) Catalog(ctx context.Context, tx *SQLTx) (catalog *Catalog, err
 }
 
 func (e *Engine) InferParameters(ctx context.Context, tx *SQLTx, sql string) (params map[string]SQLValueType, err error) {
-	stmts, err := ParseSQL(strings.NewReader(sql))
+	stmts, err := e.PrepareSQL(sql, nil)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrParsingError, err)
+		return nil, err
 	}
 	return e.InferParametersPreparedStmts(ctx, tx, stmts)
 }
@@ -745,7 +891,17 @@ func (e *Engine) tableResolveFor(tableName string) TableResolver {
 	if e.tableResolvers == nil {
 		return nil
 	}
-	return e.tableResolvers[tableName]
+
+	if r, ok := e.tableResolvers[tableName]; ok {
+		return r
+	}
+
+	// resolvers emulating system catalogs can also be referenced through their schema
+	schema, table := splitTableName(tableName)
+	if schema == systemCatalogSchema {
+		return e.tableResolvers[table]
+	}
+	return nil
 }
 
 func (e *Engine) registerTableResolver(tableName string, r TableResolver) {
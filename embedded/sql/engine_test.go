@@ -17,6 +17,7 @@ limitations under the License.
 package sql
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -25,6 +26,7 @@ import (
 	"math"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -7793,9 +7795,10 @@ func TestMultiDBCatalogQueries(t *testing.T) {
 }
 
 type mockUser struct {
-	username      string
-	permission    Permission
-	sqlPrivileges []SQLPrivilege
+	username        string
+	permission      Permission
+	sqlPrivileges   []SQLPrivilege
+	tablePrivileges []TablePrivilege
 }
 
 func (u *mockUser) Username() string {
@@ -7810,6 +7813,10 @@ func (u *mockUser) SQLPrivileges() []SQLPrivilege {
 	return u.sqlPrivileges
 }
 
+func (u *mockUser) TablePrivileges() []TablePrivilege {
+	return u.tablePrivileges
+}
+
 type multidbHandlerMock struct {
 	dbs    []string
 	user   *mockUser
@@ -7832,6 +7839,52 @@ func (h *multidbHandlerMock) RevokeSQLPrivileges(ctx context.Context, database,
 	return ErrNoSupported
 }
 
+func (h *multidbHandlerMock) GrantTablePrivileges(ctx context.Context, table *Table, username string, privileges []SQLPrivilege, columns []string) error {
+	for _, p := range privileges {
+		h.user.tablePrivileges = append(h.user.tablePrivileges, TablePrivilege{Table: table.Name(), TableID: table.PrivilegeID(), Privilege: p, Columns: columns})
+	}
+	return nil
+}
+
+func (h *multidbHandlerMock) RevokeTablePrivileges(ctx context.Context, table *Table, username string, privileges []SQLPrivilege, columns []string) error {
+	tablePrivileges := make([]TablePrivilege, 0, len(h.user.tablePrivileges))
+
+	for _, tp := range h.user.tablePrivileges {
+		if tp.TableID != table.PrivilegeID() || !hasAllPrivileges(privileges, []SQLPrivilege{tp.Privilege}) {
+			tablePrivileges = append(tablePrivileges, tp)
+			continue
+		}
+
+		if len(columns) > 0 && len(tp.Columns) == 0 {
+			tp.ExcludedColumns = append(tp.ExcludedColumns, columns...)
+			tablePrivileges = append(tablePrivileges, tp)
+		}
+	}
+
+	h.user.tablePrivileges = tablePrivileges
+	return nil
+}
+
+func (h *multidbHandlerMock) GrantSchemaPrivileges(ctx context.Context, schema, username string, privileges []SQLPrivilege) error {
+	for _, p := range privileges {
+		h.user.tablePrivileges = append(h.user.tablePrivileges, TablePrivilege{Schema: schema, Privilege: p})
+	}
+	return nil
+}
+
+func (h *multidbHandlerMock) RevokeSchemaPrivileges(ctx context.Context, schema, username string, privileges []SQLPrivilege) error {
+	tablePrivileges := make([]TablePrivilege, 0, len(h.user.tablePrivileges))
+
+	for _, tp := range h.user.tablePrivileges {
+		if tp.Table != "" || tp.Schema != schema || !hasAllPrivileges(privileges, []SQLPrivilege{tp.Privilege}) {
+			tablePrivileges = append(tablePrivileges, tp)
+		}
+	}
+
+	h.user.tablePrivileges = tablePrivileges
+	return nil
+}
+
 func (h *multidbHandlerMock) UseDatabase(ctx context.Context, db string) error {
 	return nil
 }
@@ -9245,6 +9298,11 @@ func TestCheckConstraints(t *testing.T) {
 		)
 		require.ErrorIs(t, err, ErrNoSupported)
 	})
+
+	t.Run("drop table with checks", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "DROP TABLE table_with_checks", nil)
+		require.NoError(t, err)
+	})
 }
 
 func TestQueryTxMetadata(t *testing.T) {
@@ -9330,6 +9388,94 @@ func TestQueryTxMetadata(t *testing.T) {
 	require.ErrorIs(t, err, ErrInvalidTxMetadata)
 }
 
+func TestQueryTxPseudoColumns(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st,
+		DefaultOptions().
+			WithPrefix(sqlPrefix).
+			WithTxUserMetadataKey("usr").
+			WithParseTxMetadataFunc(func(b []byte) (map[string]interface{}, error) {
+				var md map[string]interface{}
+				err := json.Unmarshal(b, &md)
+				return md, err
+			}),
+	)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE mytbl (id INTEGER, title VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	var txIDs []uint64
+
+	for i, usr := range []string{"alice", "bob", ""} {
+		txOpts := DefaultTxOptions()
+
+		if usr != "" {
+			extra, err := json.Marshal(map[string]interface{}{"usr": usr})
+			require.NoError(t, err)
+
+			txOpts = txOpts.WithExtra(extra)
+		}
+
+		tx, err := engine.NewTx(context.Background(), txOpts)
+		require.NoError(t, err)
+
+		_, txs, err := engine.Exec(context.Background(), tx, "UPSERT INTO mytbl(id, title) VALUES (@id, @title)", map[string]interface{}{"id": i % 2, "title": usr})
+		require.NoError(t, err)
+
+		txIDs = append(txIDs, txs[0].txHeader.ID)
+	}
+
+	t.Run("pseudo-columns are selectable", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id, _tx_id, _tx_ts, _tx_user FROM mytbl ORDER BY id", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, int64(0), rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(txIDs[2]), rows[0].ValuesByPosition[1].RawValue())
+		require.True(t, rows[0].ValuesByPosition[3].IsNull())
+
+		require.Equal(t, int64(1), rows[1].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(txIDs[1]), rows[1].ValuesByPosition[1].RawValue())
+		require.Equal(t, "bob", rows[1].ValuesByPosition[3].RawValue())
+
+		hdr, err := st.ReadTxHeader(txIDs[1], false, false)
+		require.NoError(t, err)
+		require.Equal(t, time.Unix(hdr.Ts, 0).UTC(), rows[1].ValuesByPosition[2].RawValue())
+	})
+
+	t.Run("pseudo-columns are filterable", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id FROM mytbl WHERE _tx_user = 'bob'", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(1), rows[0].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT id FROM mytbl WHERE _tx_id > @tx AND _tx_ts <= NOW()", map[string]interface{}{"tx": txIDs[1]})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(0), rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("pseudo-columns are available on history", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT _rev, _tx_user FROM (HISTORY OF mytbl) WHERE id = 0 ORDER BY _tx_id", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, int64(1), rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, "alice", rows[0].ValuesByPosition[1].RawValue())
+		require.Equal(t, int64(2), rows[1].ValuesByPosition[0].RawValue())
+		require.True(t, rows[1].ValuesByPosition[1].IsNull())
+	})
+
+	t.Run("pseudo-columns are reserved", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE tbl2 (id INTEGER, _tx_user VARCHAR, PRIMARY KEY id)", nil)
+		require.ErrorIs(t, err, ErrReservedWord)
+	})
+}
+
 func TestGrantSQLPrivileges(t *testing.T) {
 	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
 	require.NoError(t, err)
@@ -9408,255 +9554,2838 @@ func TestGrantSQLPrivileges(t *testing.T) {
 	checkGrants("SHOW GRANTS FOR myuser")
 }
 
-func TestFunctions(t *testing.T) {
+func TestGrantTablePrivileges(t *testing.T) {
 	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
 	require.NoError(t, err)
 	defer closeStore(t, st)
 
-	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
-	require.NoError(t, err)
+	handler := &multidbHandlerMock{
+		dbs: []string{"db1"},
+		user: &mockUser{
+			username:      "myuser",
+			permission:    PermissionReadWrite,
+			sqlPrivileges: []SQLPrivilege{SQLPrivilegeCreate},
+		},
+	}
 
-	_, _, err = engine.Exec(
-		context.Background(),
-		nil,
-		"CREATE TABLE mytable(id INTEGER, PRIMARY KEY id)",
-		nil,
-	)
+	opts := DefaultOptions().
+		WithPrefix(sqlPrefix).
+		WithMultiDBHandler(handler)
+
+	engine, err := NewEngine(st, opts)
 	require.NoError(t, err)
 
+	handler.engine = engine
+
 	_, _, err = engine.Exec(
 		context.Background(),
 		nil,
-		"INSERT INTO mytable(id) VALUES (1)",
+		`CREATE TABLE customers(id INTEGER AUTO_INCREMENT, name VARCHAR, ssn VARCHAR, PRIMARY KEY id);
+		CREATE TABLE orders(id INTEGER AUTO_INCREMENT, customer_id INTEGER, PRIMARY KEY id);`,
 		nil,
 	)
 	require.NoError(t, err)
 
-	t.Run("timestamp functions", func(t *testing.T) {
-		_, err := engine.queryAll(context.Background(), nil, "SELECT NOW(1) FROM mytable", nil)
-		require.ErrorIs(t, err, ErrIllegalArguments)
-
-		rows, err := engine.queryAll(context.Background(), nil, "SELECT NOW() FROM mytable", nil)
-		require.NoError(t, err)
-		require.Len(t, rows, 1)
+	_, _, err = engine.Exec(context.Background(), nil, "SELECT id, name FROM customers", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
 
-		require.IsType(t, time.Time{}, rows[0].ValuesByPosition[0].RawValue())
+	t.Run("table privileges can not be granted inside a transaction", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, `
+			BEGIN TRANSACTION;
+				GRANT SELECT ON TABLE customers TO USER myuser;
+			COMMIT;
+		`, nil)
+		require.ErrorIs(t, err, ErrNonTransactionalStmt)
 	})
 
-	t.Run("uuid functions", func(t *testing.T) {
-		_, err := engine.queryAll(context.Background(), nil, "SELECT RANDOM_UUID(1) FROM mytable", nil)
-		require.ErrorIs(t, err, ErrIllegalArguments)
+	t.Run("privileges must refer to existing tables and columns", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT ON TABLE invoices TO USER myuser", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
 
-		rows, err := engine.queryAll(context.Background(), nil, "SELECT RANDOM_UUID() FROM mytable", nil)
-		require.NoError(t, err)
-		require.Len(t, rows, 1)
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT (id, email) ON TABLE customers TO USER myuser", nil)
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
 
-		require.IsType(t, uuid.UUID{}, rows[0].ValuesByPosition[0].RawValue())
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT CREATE ON TABLE customers TO USER myuser", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
 	})
 
-	t.Run("string functions", func(t *testing.T) {
-		t.Run("length", func(t *testing.T) {
-			_, err := engine.queryAll(context.Background(), nil, "SELECT LENGTH(NULL, 1) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
-
-			_, err = engine.queryAll(context.Background(), nil, "SELECT LENGTH(10) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT (id, name) ON TABLE customers TO USER myuser", nil)
+	require.NoError(t, err)
 
-			rows, err := engine.queryAll(context.Background(), nil, "SELECT LENGTH(NULL) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
-			require.True(t, rows[0].ValuesByPosition[0].IsNull())
-			require.Equal(t, IntegerType, rows[0].ValuesByPosition[0].Type())
+	t.Run("column privileges restrict the columns which can be read", func(t *testing.T) {
+		_, err := engine.queryAll(context.Background(), nil, "SELECT id, name FROM customers WHERE name = 'John' ORDER BY id", nil)
+		require.NoError(t, err)
 
-			rows, err = engine.queryAll(context.Background(), nil, "SELECT LENGTH('immudb'), LENGTH('') FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
+		_, err = engine.queryAll(context.Background(), nil, "SELECT c.name FROM customers AS c", nil)
+		require.NoError(t, err)
 
-			require.Equal(t, int64(6), rows[0].ValuesByPosition[0].RawValue().(int64))
-			require.Equal(t, int64(0), rows[0].ValuesByPosition[1].RawValue().(int64))
-		})
+		_, err = engine.queryAll(context.Background(), nil, "SELECT COUNT(*) FROM customers", nil)
+		require.NoError(t, err)
 
-		t.Run("substring", func(t *testing.T) {
-			_, err := engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 0, 6, true) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+		_, err = engine.queryAll(context.Background(), nil, "SELECT * FROM customers", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			_, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 0, 6) FROM mytable", nil)
-			require.ErrorContains(t, err, "parameter 'position' must be greater than zero")
+		_, err = engine.queryAll(context.Background(), nil, "SELECT ssn FROM customers", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			_, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 1, -1) FROM mytable", nil)
-			require.ErrorContains(t, err, "parameter 'length' cannot be negative")
+		_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM customers WHERE ssn = '123'", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			rows, err := engine.queryAll(context.Background(), nil, "SELECT SUBSTRING(NULL, 8, 0) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
-			require.True(t, rows[0].ValuesByPosition[0].IsNull())
-			require.Equal(t, VarcharType, rows[0].ValuesByPosition[0].Type())
+		_, err = engine.queryAll(context.Background(), nil, "SELECT c.id FROM customers AS c INNER JOIN orders AS o ON c.id = o.customer_id", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			rows, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 8, 0) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
-			require.Equal(t, "", rows[0].ValuesByPosition[0].RawValue().(string))
+		_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM CHANGES OF customers", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			rows, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 8, 6) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW customer_ssns AS SELECT id, ssn FROM customers", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
 
-			require.Equal(t, "immudb", rows[0].ValuesByPosition[0].RawValue().(string))
+	t.Run("write privileges can be granted on a table", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO orders(customer_id) VALUES (1)", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			rows, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 8, 100) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT INSERT ON TABLE orders TO USER myuser", nil)
+		require.NoError(t, err)
 
-			require.Equal(t, "immudb!", rows[0].ValuesByPosition[0].RawValue().(string))
-		})
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO orders(customer_id) VALUES (1)", nil)
+		require.NoError(t, err)
 
-		t.Run("trim", func(t *testing.T) {
-			_, err := engine.queryAll(context.Background(), nil, "SELECT TRIM(1) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM orders WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			_, err = engine.queryAll(context.Background(), nil, "SELECT TRIM(NULL, 1) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO customers(name) VALUES ('John')", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
 
-			rows, err := engine.queryAll(context.Background(), nil, "SELECT TRIM(NULL) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
-			require.True(t, rows[0].ValuesByPosition[0].IsNull())
-			require.Equal(t, VarcharType, rows[0].ValuesByPosition[0].Type())
+	t.Run("table privileges are listed as grants", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SHOW GRANTS FOR myuser", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
 
-			rows, err = engine.queryAll(context.Background(), nil, "SELECT TRIM('      \t\n\r        Hello, immudb!  ') FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
+		require.Equal(t, string(SQLPrivilegeCreate), rows[0].ValuesByPosition[1].RawValue())
+		require.Nil(t, rows[0].ValuesByPosition[3].RawValue())
+		require.Nil(t, rows[0].ValuesByPosition[4].RawValue())
 
-			require.Equal(t, "Hello, immudb!", rows[0].ValuesByPosition[0].RawValue().(string))
-		})
+		require.Equal(t, string(SQLPrivilegeSelect), rows[1].ValuesByPosition[1].RawValue())
+		require.Equal(t, "customers", rows[1].ValuesByPosition[3].RawValue())
+		require.Equal(t, "id,name", rows[1].ValuesByPosition[4].RawValue())
 
-		t.Run("concat", func(t *testing.T) {
-			_, err := engine.queryAll(context.Background(), nil, "SELECT CONCAT() FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+		require.Equal(t, string(SQLPrivilegeInsert), rows[2].ValuesByPosition[1].RawValue())
+		require.Equal(t, "orders", rows[2].ValuesByPosition[3].RawValue())
+		require.Nil(t, rows[2].ValuesByPosition[4].RawValue())
+	})
 
-			_, err = engine.queryAll(context.Background(), nil, "SELECT CONCAT('ciao', NULL, true) FROM mytable", nil)
-			require.ErrorContains(t, err, "'CONCAT' function doesn't accept arguments of type BOOL")
+	_, _, err = engine.Exec(context.Background(), nil, "REVOKE SELECT ON TABLE customers TO USER myuser", nil)
+	require.NoError(t, err)
 
-			rows, err := engine.queryAll(context.Background(), nil, "SELECT CONCAT('Hello', ', ', NULL, 'immudb', NULL, '!') FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id, name FROM customers", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
 
-			require.Equal(t, "Hello, immudb!", rows[0].ValuesByPosition[0].RawValue().(string))
-		})
+	t.Run("columns can be revoked from a privilege granted on the whole table", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT ON TABLE customers TO USER myuser", nil)
+		require.NoError(t, err)
 
-		t.Run("upper/lower", func(t *testing.T) {
-			_, err := engine.queryAll(context.Background(), nil, "SELECT UPPER(1) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+		_, _, err = engine.Exec(context.Background(), nil, "REVOKE SELECT (ssn) ON TABLE customers TO USER myuser", nil)
+		require.NoError(t, err)
 
-			_, err = engine.queryAll(context.Background(), nil, "SELECT LOWER(NULL, 1) FROM mytable", nil)
-			require.ErrorIs(t, err, ErrIllegalArguments)
+		_, err = engine.queryAll(context.Background(), nil, "SELECT id, name FROM customers", nil)
+		require.NoError(t, err)
 
-			rows, err := engine.queryAll(context.Background(), nil, "SELECT UPPER(NULL), LOWER(NULL) FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
-			require.True(t, rows[0].ValuesByPosition[0].IsNull())
-			require.True(t, rows[0].ValuesByPosition[1].IsNull())
+		_, err = engine.queryAll(context.Background(), nil, "SELECT ssn FROM customers", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			rows, err = engine.queryAll(context.Background(), nil, "SELECT UPPER('immudb'), LOWER('IMMUDB') FROM mytable", nil)
-			require.NoError(t, err)
-			require.Len(t, rows, 1)
+		_, err = engine.queryAll(context.Background(), nil, "SELECT * FROM customers", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-			require.Equal(t, "IMMUDB", rows[0].ValuesByPosition[0].RawValue().(string))
-			require.Equal(t, "immudb", rows[0].ValuesByPosition[1].RawValue().(string))
-		})
+		rows, err := engine.queryAll(context.Background(), nil, "SHOW GRANTS FOR myuser", nil)
+		require.NoError(t, err)
+		require.Equal(t, "EXCEPT ssn", rows[len(rows)-1].ValuesByPosition[4].RawValue())
 	})
 
-	t.Run("json functions", func(t *testing.T) {
-		_, err := engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF(true) FROM mytable", nil)
-		require.ErrorIs(t, err, ErrIllegalArguments)
+	t.Run("privileges follow renamed tables and are not inherited by new tables with the same name", func(t *testing.T) {
+		handler.user.sqlPrivileges = []SQLPrivilege{SQLPrivilegeCreate, SQLPrivilegeAlter, SQLPrivilegeDrop}
 
-		_, err = engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF('{}'::JSON, 1) FROM mytable", nil)
-		require.ErrorIs(t, err, ErrIllegalArguments)
+		_, _, err = engine.Exec(context.Background(), nil, "ALTER TABLE orders RENAME TO purchases", nil)
+		require.NoError(t, err)
 
-		rows, err := engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF(NULL) FROM mytable", nil)
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO purchases(customer_id) VALUES (2)", nil)
 		require.NoError(t, err)
-		require.Len(t, rows, 1)
-		require.Nil(t, rows[0].ValuesByPosition[0].RawValue())
 
-		rows, err = engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF('{}'::JSON) FROM mytable", nil)
+		rows, err := engine.queryAll(context.Background(), nil, "SHOW GRANTS FOR myuser", nil)
 		require.NoError(t, err)
-		require.Len(t, rows, 1)
-		require.Equal(t, "OBJECT", rows[0].ValuesByPosition[0].RawValue().(string))
+		require.Equal(t, "purchases", rows[3].ValuesByPosition[3].RawValue())
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			DROP TABLE purchases;
+			CREATE TABLE purchases(id INTEGER AUTO_INCREMENT, customer_id INTEGER, PRIMARY KEY id);
+		`, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO purchases(customer_id) VALUES (3)", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 	})
-}
 
-func TestTableResolver(t *testing.T) {
-	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
-	require.NoError(t, err)
-	defer closeStore(t, st)
+	t.Run("triggers require privileges on the table and on the tables changed by the trigger", func(t *testing.T) {
+		createTrigger := `
+			CREATE TRIGGER customers_copy AFTER INSERT ON customers FOR EACH ROW
+			EXECUTE INSERT INTO purchases(customer_id) VALUES (NEW.id)
+		`
 
-	r := &mockTableResolver{
-		name: "my_table",
-		cols: []ColDescriptor{
-			{Column: "varchar_col", Type: VarcharType},
-			{Column: "int_col", Type: IntegerType},
-			{Column: "bool_col", Type: BooleanType},
-		},
-		values: [][]ValueExp{{NewVarchar("test"), NewInteger(1), NewBool(true)}},
-	}
+		_, _, err = engine.Exec(context.Background(), nil, createTrigger, nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
 
-	engine, err := NewEngine(
-		st,
-		DefaultOptions().
-			WithPrefix(sqlPrefix).
-			WithTableResolvers(r),
+		_, _, err = engine.Exec(context.Background(), nil, "GRANT INSERT ON TABLE purchases TO USER myuser", nil)
+		require.NoError(t, err)
+
+		// ssn is still excluded from the SELECT grant on customers
+		_, _, err = engine.Exec(context.Background(), nil, createTrigger, nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			REVOKE SELECT ON TABLE customers TO USER myuser;
+			GRANT SELECT ON TABLE customers TO USER myuser;
+		`, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, createTrigger, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("privileges are kept when the table is truncated", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, `
+			CREATE TABLE staging(id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+			GRANT SELECT, INSERT, DELETE ON TABLE staging TO USER myuser;
+			INSERT INTO staging(amount) VALUES (10), (20);
+		`, nil)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, _, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE staging", nil)
+			require.NoError(t, err)
+
+			_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO staging(amount) VALUES (30)", nil)
+			require.NoError(t, err)
+
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT id, amount FROM staging", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Equal(t, int64(30), rows[0].ValuesByPosition[1].RawValue())
+		}
+
+		rows, err := engine.queryAll(context.Background(), nil, "SHOW GRANTS FOR myuser", nil)
+		require.NoError(t, err)
+		require.Equal(t, "staging", rows[len(rows)-1].ValuesByPosition[3].RawValue())
+
+		_, _, err = engine.Exec(context.Background(), nil, "REVOKE INSERT ON TABLE staging TO USER myuser", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO staging(amount) VALUES (40)", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT id, amount FROM staging", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("columns read by UPDATE and DELETE statements require the SELECT privilege", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, `
+			CREATE TABLE clients(id INTEGER AUTO_INCREMENT, name VARCHAR, ssn VARCHAR, PRIMARY KEY id);
+			GRANT SELECT (id, name) ON TABLE clients TO USER myuser;
+			GRANT UPDATE, DELETE ON TABLE clients TO USER myuser;
+		`, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE clients SET name = name WHERE ssn = '123-45'", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE clients SET name = ssn WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM clients WHERE ssn = '123-45'", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE clients SET name = 'John' WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE clients SET ssn = '123-45' WHERE name = 'John'", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM clients WHERE name = 'John'", nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestSchemas(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE sales.orders(id INTEGER, PRIMARY KEY id)", nil)
+	require.ErrorIs(t, err, ErrSchemaDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SCHEMA pg_catalog", nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SCHEMA public", nil)
+	require.ErrorIs(t, err, ErrSchemaAlreadyExists)
+
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		`CREATE SCHEMA sales;
+		CREATE SCHEMA IF NOT EXISTS sales;
+		CREATE TABLE orders(id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+		CREATE TABLE sales.orders(id INTEGER AUTO_INCREMENT, customer VARCHAR[64], PRIMARY KEY id);
+		CREATE INDEX ON sales.orders(customer);
+		INSERT INTO orders(amount) VALUES (10);
+		INSERT INTO sales.orders(customer) VALUES ('John'), ('Mary');`,
+		nil,
 	)
 	require.NoError(t, err)
 
-	assertQueryShouldProduceResults(
-		t,
-		engine,
-		"SELECT int_col, varchar_col, bool_col FROM my_table",
-		`SELECT * FROM (
-			VALUES
-				(1, 'test', true)
-		)`,
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SCHEMA sales", nil)
+	require.ErrorIs(t, err, ErrSchemaAlreadyExists)
+
+	t.Run("tables are resolved within their schema", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id, amount FROM orders", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT id, amount FROM public.orders", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT orders.customer FROM sales.orders WHERE customer = 'Mary'", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, "Mary", rows[0].ValuesByPosition[0].RawValue())
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM inventory.orders", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+
+	t.Run("unqualified names are resolved using the search path", func(t *testing.T) {
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithSearchPath([]string{"inventory", "sales", "public"}))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		rows, err := engine.queryAll(context.Background(), tx, "SELECT id, customer FROM orders", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+	})
+
+	t.Run("tables are created in the first existing schema of the search path", func(t *testing.T) {
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithSearchPath([]string{"sales"}))
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), tx, "CREATE TABLE invoices(id INTEGER, PRIMARY KEY id)", nil)
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+		require.True(t, catalog.ExistTable("sales.invoices"))
+		require.False(t, catalog.ExistTable("public.invoices"))
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, "DROP SCHEMA public", nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DROP SCHEMA sales", nil)
+	require.ErrorIs(t, err, ErrSchemaNotEmpty)
+
+	t.Run("schemas are persisted", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id FROM sales.orders", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT id FROM sales.invoices", nil)
+		require.NoError(t, err)
+		require.Empty(t, rows)
+	})
+
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		`DROP TABLE sales.orders;
+		DROP TABLE sales.invoices;
+		DROP SCHEMA sales;`,
+		nil,
 	)
+	require.NoError(t, err)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM sales.orders", nil)
+	require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DROP SCHEMA sales", nil)
+	require.ErrorIs(t, err, ErrSchemaDoesNotExist)
 }
 
-func assertQueryShouldProduceResults(t *testing.T, e *Engine, query, resultQuery string) {
-	queryReader, err := e.Query(context.Background(), nil, query, nil)
+func TestGrantSchemaPrivileges(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
 	require.NoError(t, err)
-	defer queryReader.Close()
+	defer closeStore(t, st)
 
-	resultReader, err := e.Query(context.Background(), nil, resultQuery, nil)
+	handler := &multidbHandlerMock{
+		dbs: []string{"db1"},
+		user: &mockUser{
+			username:      "myuser",
+			permission:    PermissionReadWrite,
+			sqlPrivileges: []SQLPrivilege{SQLPrivilegeCreate},
+		},
+	}
+
+	opts := DefaultOptions().
+		WithPrefix(sqlPrefix).
+		WithMultiDBHandler(handler)
+
+	engine, err := NewEngine(st, opts)
 	require.NoError(t, err)
-	defer resultReader.Close()
 
-	for {
-		actualRow, actualErr := queryReader.Read(context.Background())
-		expectedRow, expectedErr := resultReader.Read(context.Background())
-		require.Equal(t, expectedErr, actualErr)
+	handler.engine = engine
 
-		if errors.Is(actualErr, ErrNoMoreRows) {
-			break
-		}
-		require.Equal(t, expectedRow.ValuesByPosition, actualRow.ValuesByPosition)
-	}
-}
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		`CREATE SCHEMA sales;
+		CREATE TABLE sales.orders(id INTEGER AUTO_INCREMENT, customer VARCHAR, PRIMARY KEY id);
+		CREATE TABLE customers(id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id);`,
+		nil,
+	)
+	require.NoError(t, err)
 
-type mockTableResolver struct {
-	name   string
-	cols   []ColDescriptor
-	values [][]ValueExp
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT ON SCHEMA inventory TO USER myuser", nil)
+	require.ErrorIs(t, err, ErrSchemaDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT CREATE ON SCHEMA sales TO USER myuser", nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT, INSERT ON SCHEMA sales TO USER myuser", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO sales.orders(customer) VALUES ('John')", nil)
+	require.NoError(t, err)
+
+	rows, err := engine.queryAll(context.Background(), nil, "SELECT id, customer FROM sales.orders", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM customers", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM sales.orders WHERE id = 1", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	rows, err = engine.queryAll(context.Background(), nil, "SHOW GRANTS FOR myuser", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "sales", rows[1].ValuesByPosition[2].RawValue())
+	require.Nil(t, rows[1].ValuesByPosition[3].RawValue())
+
+	_, _, err = engine.Exec(context.Background(), nil, "REVOKE SELECT ON SCHEMA sales TO USER myuser", nil)
+	require.NoError(t, err)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM sales.orders", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
 }
 
-func (r *mockTableResolver) Table() string {
-	return r.name
+func TestStatementTimeout(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		`CREATE TABLE mytable(id INTEGER AUTO_INCREMENT, title VARCHAR, PRIMARY KEY id);
+		INSERT INTO mytable(title) VALUES ('title1'), ('title2'), ('title3');`,
+		nil,
+	)
+	require.NoError(t, err)
+
+	t.Run("the timeout can be set on a transaction", func(t *testing.T) {
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithStatementTimeout(time.Nanosecond))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		_, err = engine.queryAll(context.Background(), tx, "SELECT * FROM mytable ORDER BY title", nil)
+		require.ErrorIs(t, err, ErrStatementTimeout)
+
+		tx, err = engine.NewTx(context.Background(), DefaultTxOptions().WithStatementTimeout(time.Minute))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		rows, err := engine.queryAll(context.Background(), tx, "SELECT * FROM mytable ORDER BY title", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+	})
+
+	t.Run("the timeout of the engine applies to all statements", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithStatementTimeout(time.Nanosecond))
+		require.NoError(t, err)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT * FROM mytable", nil)
+		require.ErrorIs(t, err, ErrStatementTimeout)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM mytable WHERE title = 'title1'", nil)
+		require.ErrorIs(t, err, ErrStatementTimeout)
+	})
+
+	t.Run("cancellation is not reported as a timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		reader, err := engine.Query(ctx, nil, "SELECT * FROM mytable", nil)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		cancel()
+
+		_, err = reader.Read(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	_, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithStatementTimeout(-time.Second))
+	require.ErrorIs(t, err, store.ErrInvalidOptions)
 }
 
-func (r *mockTableResolver) Resolve(ctx context.Context, tx *SQLTx, alias string) (RowReader, error) {
-	return NewValuesRowReader(
-		tx,
+func TestQueryMemoryLimit(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	opts := DefaultOptions().
+		WithPrefix(sqlPrefix).
+		WithSortBufferSize(8).
+		WithQueryMemoryLimit(2048)
+
+	engine, err := NewEngine(st, opts)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		"CREATE TABLE mytable(id INTEGER AUTO_INCREMENT, title VARCHAR, content VARCHAR, PRIMARY KEY id)",
 		nil,
-		r.cols,
-		false,
-		r.name,
-		r.values,
 	)
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		_, _, err = engine.Exec(
+			context.Background(),
+			nil,
+			"INSERT INTO mytable(title, content) VALUES (@title, @content)",
+			map[string]interface{}{"title": fmt.Sprintf("title%d", i), "content": ""},
+		)
+		require.NoError(t, err)
+	}
+
+	t.Run("queries within the limit succeed", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id FROM mytable WHERE id <= 10 ORDER BY title DESC", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 10)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT COUNT(*) FROM mytable GROUP BY id", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 100)
+	})
+
+	t.Run("sorting is limited", func(t *testing.T) {
+		_, err := engine.queryAll(context.Background(), nil, "SELECT id FROM mytable ORDER BY title DESC", nil)
+		require.ErrorIs(t, err, ErrQueryMemoryLimitExceeded)
+	})
+
+	t.Run("distinct is limited", func(t *testing.T) {
+		_, err := engine.queryAll(context.Background(), nil, "SELECT DISTINCT title FROM mytable", nil)
+		require.ErrorIs(t, err, ErrQueryMemoryLimitExceeded)
+	})
+
+	t.Run("grouping is limited", func(t *testing.T) {
+		_, _, err = engine.Exec(
+			context.Background(),
+			nil,
+			"UPDATE mytable SET content = @content WHERE id = 50",
+			map[string]interface{}{"content": strings.Repeat("x", 3000)},
+		)
+		require.NoError(t, err)
+
+		_, err := engine.queryAll(context.Background(), nil, "SELECT COUNT(*) FROM mytable GROUP BY id", nil)
+		require.ErrorIs(t, err, ErrQueryMemoryLimitExceeded)
+	})
+
+	_, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithQueryMemoryLimit(-1))
+	require.ErrorIs(t, err, store.ErrInvalidOptions)
+}
+
+func TestStmtCache(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE items (id INTEGER, title VARCHAR[64], PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO items (id, title) VALUES (@id, @title)", map[string]interface{}{"id": i, "title": fmt.Sprintf("title%d", i)})
+		require.NoError(t, err)
+	}
+
+	t.Run("statements should be reused", func(t *testing.T) {
+		stmts1, err := engine.PrepareSQL("SELECT id FROM items WHERE id = @id", map[string]interface{}{"id": 1})
+		require.NoError(t, err)
+
+		stmts2, err := engine.PrepareSQL("SELECT id FROM items WHERE id = @id", map[string]interface{}{"id": 2})
+		require.NoError(t, err)
+		require.Same(t, stmts1[0], stmts2[0])
+
+		stmts3, err := engine.PrepareSQL("SELECT id FROM items WHERE id = @id", map[string]interface{}{"id": "2"})
+		require.NoError(t, err)
+		require.NotSame(t, stmts1[0], stmts3[0])
+
+		_, err = engine.PrepareSQL("SELECT id FROM", nil)
+		require.ErrorIs(t, err, ErrParsingError)
+	})
+
+	t.Run("cached statements should not retain parameters", func(t *testing.T) {
+		query := "SELECT id FROM items WHERE id = @id + 0 AND NOT (CAST(@id AS FLOAT) = 0.5) OR (id > @max AND id > @max - 1)"
+
+		var wg sync.WaitGroup
+
+		for i := 1; i <= 10; i++ {
+			wg.Add(1)
+
+			go func(id int) {
+				defer wg.Done()
+
+				rows, err := engine.queryAll(context.Background(), nil, query, map[string]interface{}{"id": id, "max": 100})
+				require.NoError(t, err)
+				require.Len(t, rows, 1)
+				require.Equal(t, int64(id), rows[0].ValuesByPosition[0].RawValue())
+			}(i)
+		}
+
+		wg.Wait()
+	})
+
+	t.Run("descriptions should be refreshed when the catalog changes", func(t *testing.T) {
+		_, params, cols, err := engine.DescribeSQL(context.Background(), nil, "SELECT * FROM items WHERE id > @id")
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"id": IntegerType}, params)
+		require.Len(t, cols, 2)
+
+		_, _, cols, err = engine.DescribeSQL(context.Background(), nil, "SELECT * FROM items WHERE id > @id")
+		require.NoError(t, err)
+		require.Len(t, cols, 2)
+
+		_, _, err = engine.Exec(context.Background(), nil, "ALTER TABLE items ADD COLUMN active BOOLEAN", nil)
+		require.NoError(t, err)
+
+		_, _, cols, err = engine.DescribeSQL(context.Background(), nil, "SELECT * FROM items WHERE id > @id")
+		require.NoError(t, err)
+		require.Len(t, cols, 3)
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithExplicitClose(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		_, _, err = engine.Exec(context.Background(), tx, "ALTER TABLE items DROP COLUMN active", nil)
+		require.NoError(t, err)
+
+		_, _, cols, err = engine.DescribeSQL(context.Background(), tx, "SELECT * FROM items WHERE id > @id")
+		require.NoError(t, err)
+		require.Len(t, cols, 2)
+	})
+
+	t.Run("cache can be disabled", func(t *testing.T) {
+		st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+		require.NoError(t, err)
+		defer closeStore(t, st)
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithStmtCacheSize(0))
+		require.NoError(t, err)
+		require.Nil(t, engine.stmtCache)
+
+		stmts1, err := engine.PrepareSQL("SELECT 1", nil)
+		require.NoError(t, err)
+
+		stmts2, err := engine.PrepareSQL("SELECT 1", nil)
+		require.NoError(t, err)
+		require.NotSame(t, stmts1[0], stmts2[0])
+
+		_, _, cols, err := engine.DescribeSQL(context.Background(), nil, "SELECT 1")
+		require.NoError(t, err)
+		require.Len(t, cols, 1)
+	})
+}
+
+func TestAnalyze(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE items (
+			id INTEGER AUTO_INCREMENT,
+			category VARCHAR[16],
+			status INTEGER,
+			price INTEGER,
+			note VARCHAR,
+			PRIMARY KEY id
+		);
+
+		CREATE INDEX ON items(category);
+		CREATE INDEX ON items(status);
+		CREATE INDEX ON items(price);
+	`, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 200; i++ {
+		_, _, err = engine.Exec(
+			context.Background(),
+			nil,
+			"INSERT INTO items (category, status, price, note) VALUES (@category, @status, @price, NULL)",
+			map[string]interface{}{"category": fmt.Sprintf("cat%d", i%50), "status": i % 2, "price": i},
+		)
+		require.NoError(t, err)
+	}
+
+	scanIndex := func(t *testing.T, query string) *Index {
+		r, err := engine.Query(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		return r.ScanSpecs().Index
+	}
+
+	t.Run("index selection should be rule-based without statistics", func(t *testing.T) {
+		index := scanIndex(t, "SELECT * FROM items WHERE status = 1 AND category = 'cat3'")
+		require.True(t, index.IsPrimary())
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, "ANALYZE items", nil)
+	require.NoError(t, err)
+
+	t.Run("statistics should be stored in the catalog", func(t *testing.T) {
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("items")
+		require.NoError(t, err)
+
+		stats := table.Stats()
+		require.NotNil(t, stats)
+		require.EqualValues(t, 200, stats.RowCount())
+
+		categoryCol, err := table.GetColumnByName("category")
+		require.NoError(t, err)
+
+		cs, ok := stats.ColumnStats(categoryCol.ID())
+		require.True(t, ok)
+		require.EqualValues(t, 50, cs.DistinctCount())
+		require.Zero(t, cs.NullCount())
+		require.Len(t, cs.HistogramBounds(), histogramBuckets+1)
+
+		noteCol, err := table.GetColumnByName("note")
+		require.NoError(t, err)
+
+		cs, ok = stats.ColumnStats(noteCol.ID())
+		require.True(t, ok)
+		require.Zero(t, cs.DistinctCount())
+		require.EqualValues(t, 200, cs.NullCount())
+		require.Empty(t, cs.HistogramBounds())
+	})
+
+	t.Run("the most selective index should be used", func(t *testing.T) {
+		index := scanIndex(t, "SELECT * FROM items WHERE status = 1 AND category = 'cat3'")
+		require.Equal(t, "items(category)", index.Name())
+
+		index = scanIndex(t, "SELECT * FROM items WHERE status = 1 AND price > 190")
+		require.Equal(t, "items(price)", index.Name())
+
+		index = scanIndex(t, "SELECT * FROM items WHERE id = 10 AND category = 'cat10'")
+		require.True(t, index.IsPrimary())
+	})
+
+	t.Run("index chosen by the user should be honoured", func(t *testing.T) {
+		index := scanIndex(t, "SELECT * FROM items USE INDEX ON (status) WHERE status = 1 AND category = 'cat3'")
+		require.Equal(t, "items(status)", index.Name())
+	})
+
+	t.Run("queries should return the same results regardless of the index", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT id FROM items WHERE status = 1 AND category = 'cat3' ORDER BY id", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var ids []int64
+		for {
+			row, err := r.Read(context.Background())
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			ids = append(ids, row.ValuesByPosition[0].RawValue().(int64))
+		}
+		require.Equal(t, []int64{4, 54, 104, 154}, ids)
+	})
+
+	t.Run("statistics should be loaded when reopening the engine", func(t *testing.T) {
+		reopened, err := NewEngine(engine.store, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		catalog, err := reopened.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("items")
+		require.NoError(t, err)
+		require.NotNil(t, table.Stats())
+		require.EqualValues(t, 200, table.Stats().RowCount())
+	})
+
+	t.Run("statistics of all the tables should be collected", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE t2 (id INTEGER, PRIMARY KEY id)", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "ANALYZE", nil)
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("t2")
+		require.NoError(t, err)
+		require.NotNil(t, table.Stats())
+		require.Zero(t, table.Stats().RowCount())
+	})
+
+	t.Run("statistics should be removed with the table", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "DROP TABLE items", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "ANALYZE items", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+}
+
+func TestEstimateDistinct(t *testing.T) {
+	require.Zero(t, estimateDistinct(0, nil, 100))
+	require.EqualValues(t, 2, estimateDistinct(3, map[string]int{"a": 2, "b": 1}, 3))
+
+	// all values were sampled once, so they are likely to be unique
+	occurrences := make(map[string]int)
+	for i := 0; i < 100; i++ {
+		occurrences[fmt.Sprint(i)] = 1
+	}
+	require.EqualValues(t, 1000, estimateDistinct(100, occurrences, 1000))
+
+	// values found many times are unlikely to have unseen duplicates
+	occurrences = map[string]int{"a": 50, "b": 50}
+	require.EqualValues(t, 2, estimateDistinct(100, occurrences, 1000))
+}
+
+func TestChangesOf(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE items (id INTEGER, name VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	_, txs1, err := engine.Exec(context.Background(), nil, "INSERT INTO items (id, name) VALUES (1, 'a'), (2, 'b'), (3, 'c')", nil)
+	require.NoError(t, err)
+
+	_, txs2, err := engine.Exec(context.Background(), nil, "UPDATE items SET name = 'b2' WHERE id = 2; DELETE FROM items WHERE id = 3", nil)
+	require.NoError(t, err)
+
+	_, txs3, err := engine.Exec(context.Background(), nil, "INSERT INTO items (id, name) VALUES (4, 'd'), (5, 'e')", nil)
+	require.NoError(t, err)
+
+	_, txs4, err := engine.Exec(context.Background(), nil, "UPDATE items SET name = 'b3' WHERE id = 2; DELETE FROM items WHERE id = 5", nil)
+	require.NoError(t, err)
+
+	tx1 := txs1[0].txHeader.ID
+	tx2 := txs2[0].txHeader.ID
+	tx3 := txs3[0].txHeader.ID
+	tx4 := txs4[0].txHeader.ID
+
+	type change struct {
+		op         string
+		rev        int64
+		id         interface{}
+		name       interface{}
+		beforeID   interface{}
+		beforeName interface{}
+	}
+
+	readChanges := func(t *testing.T, query string, params map[string]interface{}) []change {
+		r, err := engine.Query(context.Background(), nil, query, params)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var changes []change
+		for {
+			row, err := r.Read(context.Background())
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			changes = append(changes, change{
+				op:         row.ValuesByPosition[0].RawValue().(string),
+				rev:        row.ValuesByPosition[1].RawValue().(int64),
+				id:         row.ValuesByPosition[2].RawValue(),
+				name:       row.ValuesByPosition[3].RawValue(),
+				beforeID:   row.ValuesByPosition[4].RawValue(),
+				beforeName: row.ValuesByPosition[5].RawValue(),
+			})
+		}
+		return changes
+	}
+
+	query := "SELECT _op, _rev, id, name, _before_id, _before_name FROM CHANGES OF items SINCE TX @since UNTIL TX @until"
+
+	t.Run("changes within a single tx", func(t *testing.T) {
+		changes := readChanges(t, query, map[string]interface{}{"since": tx2, "until": tx2})
+		require.Equal(t, []change{
+			{op: "UPDATE", rev: 2, id: int64(2), name: "b2", beforeID: int64(2), beforeName: "b"},
+			{op: "DELETE", rev: 2, id: nil, name: nil, beforeID: int64(3), beforeName: "c"},
+		}, changes)
+	})
+
+	t.Run("net changes over many txs", func(t *testing.T) {
+		changes := readChanges(t, query, map[string]interface{}{"since": tx2, "until": tx4})
+		require.Equal(t, []change{
+			{op: "UPDATE", rev: 3, id: int64(2), name: "b3", beforeID: int64(2), beforeName: "b"},
+			{op: "DELETE", rev: 2, id: nil, name: nil, beforeID: int64(3), beforeName: "c"},
+			{op: "INSERT", rev: 1, id: int64(4), name: "d", beforeID: nil, beforeName: nil},
+		}, changes)
+	})
+
+	t.Run("changes since the first tx", func(t *testing.T) {
+		changes := readChanges(t, "SELECT _op, _rev, id, name, _before_id, _before_name FROM CHANGES OF items WHERE _op = 'INSERT'", nil)
+		require.Equal(t, []change{
+			{op: "INSERT", rev: 1, id: int64(1), name: "a", beforeID: nil, beforeName: nil},
+			{op: "INSERT", rev: 3, id: int64(2), name: "b3", beforeID: nil, beforeName: nil},
+			{op: "INSERT", rev: 1, id: int64(4), name: "d", beforeID: nil, beforeName: nil},
+		}, changes)
+
+		changes = readChanges(t, query, map[string]interface{}{"since": tx1, "until": tx1})
+		require.Len(t, changes, 3)
+	})
+
+	t.Run("changes after the last tx", func(t *testing.T) {
+		changes := readChanges(t, "SELECT _op, _rev, id, name, _before_id, _before_name FROM CHANGES OF items AFTER TX @since", map[string]interface{}{"since": tx4})
+		require.Empty(t, changes)
+	})
+
+	t.Run("changes should include tx metadata", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT * FROM CHANGES OF items SINCE TX @tx UNTIL TX @tx", map[string]interface{}{"tx": tx3})
+		require.NoError(t, err)
+		defer r.Close()
+
+		cols, err := r.Columns(context.Background())
+		require.NoError(t, err)
+		require.Len(t, cols, 10)
+		require.Equal(t, "_tx_metadata", cols[2].Column)
+		require.Equal(t, JSONType, cols[2].Type)
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.True(t, row.ValuesByPosition[2].IsNull())
+	})
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		_, err := engine.Query(context.Background(), nil, "SELECT * FROM CHANGES OF items1", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		r, err := engine.Query(context.Background(), nil, "SELECT * FROM CHANGES OF items SINCE TX 0", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestMaterializedViews(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions().WithMultiIndexing(true))
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE payments (
+			id INTEGER AUTO_INCREMENT,
+			account VARCHAR[32] NOT NULL,
+			day VARCHAR[10] NOT NULL,
+			amount INTEGER NOT NULL,
+			PRIMARY KEY id
+		)`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO payments (account, day, amount) VALUES
+			('alice', '2024-01-01', 10),
+			('alice', '2024-01-01', 20),
+			('bob', '2024-01-01', 5),
+			('bob', '2024-01-02', 7)`, nil)
+	require.NoError(t, err)
+
+	daily := "SELECT account, day, SUM(amount) AS total, COUNT(*) AS n FROM payments WHERE amount > 0 GROUP BY account, day"
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW daily_totals AS "+daily, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW daily_totals_inc WITH INCREMENTAL REFRESH AS "+daily+";", nil)
+	require.NoError(t, err)
+
+	catalog, err := engine.Catalog(context.Background(), nil)
+	require.NoError(t, err)
+
+	table, err := catalog.GetTableByName("daily_totals_inc")
+	require.NoError(t, err)
+	require.NotNil(t, table.MaterializedView())
+	require.True(t, table.MaterializedView().IsIncremental())
+	require.Equal(t, daily, table.MaterializedView().Query())
+	require.Equal(t, []string{"account", "day"}, []string{table.PrimaryIndex().Cols()[0].Name(), table.PrimaryIndex().Cols()[1].Name()})
+
+	viewRows := "SELECT account, day, total, n FROM %s ORDER BY account, day"
+
+	readRows := func(t *testing.T, engine *Engine, query string) [][]interface{} {
+		r, err := engine.Query(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var rows [][]interface{}
+		for {
+			row, err := r.Read(context.Background())
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			values := make([]interface{}, len(row.ValuesByPosition))
+			for i, v := range row.ValuesByPosition {
+				values[i] = v.RawValue()
+			}
+			rows = append(rows, values)
+		}
+		return rows
+	}
+
+	assertViewRows := func(t *testing.T, engine *Engine, view string) {
+		require.Equal(t, readRows(t, engine, daily), readRows(t, engine, fmt.Sprintf(viewRows, view)))
+	}
+
+	assertViewRows(t, engine, "daily_totals")
+	assertViewRows(t, engine, "daily_totals_inc")
+
+	for _, stmt := range []string{
+		"INSERT INTO payments (account, day, amount) VALUES ('carol', '2024-01-02', 1), ('alice', '2024-01-01', 3)",
+		"UPDATE payments SET day = '2024-01-03' WHERE account = 'bob' AND day = '2024-01-02'",
+		"DELETE FROM payments WHERE account = 'bob' AND day = '2024-01-01'",
+		"UPDATE payments SET amount = 0 WHERE account = 'carol'",
+	} {
+		_, _, err = engine.Exec(context.Background(), nil, stmt, nil)
+		require.NoError(t, err)
+	}
+
+	t.Run("views are not changed until refreshed", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT COUNT(*) FROM daily_totals_inc WHERE account = 'bob'", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(2), row.ValuesByPosition[0].RawValue())
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW daily_totals; REFRESH MATERIALIZED VIEW daily_totals_inc", nil)
+	require.NoError(t, err)
+
+	assertViewRows(t, engine, "daily_totals")
+	assertViewRows(t, engine, "daily_totals_inc")
+
+	t.Run("the rows of a view keep their history", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW daily_totals; REFRESH MATERIALIZED VIEW daily_totals_inc", nil)
+		require.NoError(t, err)
+
+		// refreshing an up to date view does not write any row
+		for _, view := range []string{"daily_totals", "daily_totals_inc"} {
+			rows := readRows(t, engine, "SELECT _rev, total FROM (HISTORY OF "+view+") WHERE account = 'alice'")
+			require.Equal(t, [][]interface{}{{int64(1), int64(30)}, {int64(2), int64(33)}}, rows)
+		}
+	})
+
+	t.Run("views can not be modified", func(t *testing.T) {
+		for _, stmt := range []string{
+			"INSERT INTO daily_totals (account, day, total, n) VALUES ('dave', '2024-01-01', 1, 1)",
+			"UPDATE daily_totals SET total = 0",
+			"DELETE FROM daily_totals",
+			"ALTER TABLE daily_totals ADD COLUMN note VARCHAR",
+			"DROP TABLE daily_totals",
+		} {
+			_, _, err := engine.Exec(context.Background(), nil, stmt, nil)
+			require.ErrorIs(t, err, ErrMaterializedViewIsReadOnly, stmt)
+		}
+
+		_, _, err = engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW payments", nil)
+		require.ErrorIs(t, err, ErrNotMaterializedView)
+	})
+
+	t.Run("view definitions are validated", func(t *testing.T) {
+		for _, stmt := range []string{
+			"CREATE MATERIALIZED VIEW v1 AS SELECT account, amount FROM payments",
+			"CREATE MATERIALIZED VIEW v1 AS SELECT COUNT(*) FROM payments GROUP BY account",
+			"CREATE MATERIALIZED VIEW v1 WITH INCREMENTAL REFRESH AS SELECT account, SUM(amount) AS total FROM payments GROUP BY account",
+			"CREATE MATERIALIZED VIEW v1 WITH INCREMENTAL REFRESH AS SELECT account, MAX(amount) AS m, COUNT(*) AS n FROM payments GROUP BY account",
+			"CREATE MATERIALIZED VIEW v1 WITH INCREMENTAL REFRESH AS SELECT id, account FROM payments",
+		} {
+			_, _, err := engine.Exec(context.Background(), nil, stmt, nil)
+			require.ErrorIs(t, err, ErrInvalidMaterializedView, stmt)
+		}
+
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW v1 AS SELECT id, account FROM payments", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW IF NOT EXISTS v1 AS SELECT id FROM payments", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, readRows(t, engine, "SELECT id, account FROM payments"), readRows(t, engine, "SELECT id, account FROM v1"))
+	})
+
+	t.Run("view definitions are loaded with the catalog", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "INSERT INTO payments (account, day, amount) VALUES ('alice', '2024-01-03', 100)", nil)
+		require.NoError(t, err)
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW daily_totals_inc", nil)
+		require.NoError(t, err)
+
+		assertViewRows(t, engine, "daily_totals_inc")
+	})
+
+	t.Run("views can be dropped", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "DROP MATERIALIZED VIEW daily_totals", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DROP MATERIALIZED VIEW payments", nil)
+		require.ErrorIs(t, err, ErrNotMaterializedView)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+		require.False(t, catalog.ExistTable("daily_totals"))
+	})
+}
+
+func TestTriggers(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE accounts (
+			name VARCHAR[32],
+			balance INTEGER NOT NULL,
+			PRIMARY KEY name
+		);
+
+		CREATE TABLE payments (
+			id INTEGER AUTO_INCREMENT,
+			account VARCHAR[32] NOT NULL,
+			amount INTEGER NOT NULL,
+			PRIMARY KEY id
+		);
+
+		CREATE TABLE audit (
+			id INTEGER AUTO_INCREMENT,
+			payment INTEGER NOT NULL,
+			old_amount INTEGER,
+			new_amount INTEGER,
+			PRIMARY KEY id
+		);
+
+		INSERT INTO accounts (name, balance) VALUES ('alice', 0), ('bob', 0);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TRIGGER payments_audit AFTER INSERT OR UPDATE ON payments FOR EACH ROW
+		EXECUTE INSERT INTO audit (payment, old_amount, new_amount) VALUES (NEW.id, OLD.amount, NEW.amount);
+
+		CREATE TRIGGER payments_audit_delete AFTER DELETE ON payments FOR EACH ROW
+		EXECUTE INSERT INTO audit (payment, old_amount) VALUES (OLD.id, OLD.amount);
+
+		CREATE TRIGGER payments_balance AFTER INSERT ON payments FOR EACH ROW
+		EXECUTE UPDATE accounts SET balance = balance + NEW.amount WHERE name = NEW.account;
+	`, nil)
+	require.NoError(t, err)
+
+	catalog, err := engine.Catalog(context.Background(), nil)
+	require.NoError(t, err)
+
+	table, err := catalog.GetTableByName("payments")
+	require.NoError(t, err)
+	require.Len(t, table.Triggers(), 3)
+	require.Equal(t, "payments_audit", table.Triggers()[0].Name())
+	require.Equal(t, TriggerOnInsert|TriggerOnUpdate, table.Triggers()[0].Events())
+	require.Equal(t, "INSERT INTO audit (payment, old_amount, new_amount) VALUES (NEW.id, OLD.amount, NEW.amount)", table.Triggers()[0].SQL())
+
+	readRows := func(t *testing.T, engine *Engine, query string) [][]interface{} {
+		r, err := engine.Query(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var rows [][]interface{}
+		for {
+			row, err := r.Read(context.Background())
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			values := make([]interface{}, len(row.ValuesByPosition))
+			for i, v := range row.ValuesByPosition {
+				values[i] = v.RawValue()
+			}
+			rows = append(rows, values)
+		}
+		return rows
+	}
+
+	t.Run("triggers are executed within the same tx", func(t *testing.T) {
+		_, txs, err := engine.Exec(context.Background(), nil, "INSERT INTO payments (account, amount) VALUES ('alice', 10), ('alice', 20), ('bob', 5)", nil)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, 3, txs[0].UpdatedRows())
+
+		require.Equal(t,
+			[][]interface{}{{"alice", int64(30)}, {"bob", int64(5)}},
+			readRows(t, engine, "SELECT name, balance FROM accounts ORDER BY name"),
+		)
+
+		require.Equal(t,
+			[][]interface{}{{int64(1), nil, int64(10)}, {int64(2), nil, int64(20)}, {int64(3), nil, int64(5)}},
+			readRows(t, engine, "SELECT payment, old_amount, new_amount FROM audit ORDER BY id"),
+		)
+	})
+
+	t.Run("triggers reference the old and new values of the row", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "UPDATE payments SET amount = amount + 1 WHERE account = 'alice'", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPSERT INTO payments (id, account, amount) VALUES (3, 'bob', 50)", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM payments WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{
+				{int64(1), int64(10), int64(11)},
+				{int64(2), int64(20), int64(21)},
+				{int64(3), int64(5), int64(50)},
+				{int64(1), int64(11), nil},
+			},
+			readRows(t, engine, "SELECT payment, old_amount, new_amount FROM audit WHERE id > 3 ORDER BY id"),
+		)
+
+		// balances are only maintained on insertion
+		require.Equal(t,
+			[][]interface{}{{"alice", int64(30)}, {"bob", int64(5)}},
+			readRows(t, engine, "SELECT name, balance FROM accounts ORDER BY name"),
+		)
+	})
+
+	t.Run("trigger failures rollback the statement", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TRIGGER payments_invalid AFTER UPDATE ON payments FOR EACH ROW EXECUTE INSERT INTO audit (payment) VALUES (NULL)", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE payments SET amount = 0", nil)
+		require.ErrorIs(t, err, ErrNotNullableColumnCannotBeNull)
+		require.ErrorContains(t, err, "trigger 'payments_invalid' on table 'payments'")
+
+		_, _, err = engine.Exec(context.Background(), nil, "DROP TRIGGER payments_invalid ON payments", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DROP TRIGGER payments_invalid ON payments", nil)
+		require.ErrorIs(t, err, ErrTriggerDoesNotExist)
+
+		require.Equal(t,
+			[][]interface{}{{int64(2), int64(21)}, {int64(3), int64(50)}},
+			readRows(t, engine, "SELECT id, amount FROM payments ORDER BY id"),
+		)
+	})
+
+	t.Run("triggers can not be duplicated", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TRIGGER payments_audit AFTER DELETE ON payments FOR EACH ROW EXECUTE DELETE FROM audit", nil)
+		require.ErrorIs(t, err, ErrTriggerAlreadyExists)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TRIGGER IF NOT EXISTS payments_audit AFTER DELETE ON payments FOR EACH ROW EXECUTE DELETE FROM audit", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TRIGGER payments_audit AFTER INSERT ON unknown_table FOR EACH ROW EXECUTE DELETE FROM audit", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+
+	t.Run("recursive triggers are limited", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, `
+			CREATE TABLE counter (n INTEGER, PRIMARY KEY n);
+
+			CREATE TRIGGER counter_next AFTER INSERT ON counter FOR EACH ROW
+			EXECUTE INSERT INTO counter (n) VALUES (NEW.n + 1);
+		`, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO counter (n) VALUES (1)", nil)
+		require.ErrorIs(t, err, ErrMaxTriggerDepthExceeded)
+
+		require.Empty(t, readRows(t, engine, "SELECT n FROM counter"))
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMaxTriggerDepth(32))
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO counter (n) VALUES (1)", nil)
+		require.ErrorIs(t, err, ErrMaxTriggerDepthExceeded)
+		require.ErrorContains(t, err, "trigger 'counter_next' on table 'counter'")
+
+		_, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMaxTriggerDepth(0))
+		require.ErrorIs(t, err, store.ErrInvalidOptions)
+	})
+
+	t.Run("triggers are loaded with the catalog", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments (account, amount) VALUES ('bob', 7)", nil)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{"alice", int64(30)}, {"bob", int64(12)}},
+			readRows(t, engine, "SELECT name, balance FROM accounts ORDER BY name"),
+		)
+	})
+
+	t.Run("triggers can not be created on materialized views", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW balances AS SELECT name, balance FROM accounts", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TRIGGER balances_audit AFTER UPDATE ON balances FOR EACH ROW EXECUTE DELETE FROM audit", nil)
+		require.ErrorIs(t, err, ErrMaterializedViewIsReadOnly)
+	})
+
+	t.Run("triggers are dropped with their table", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "DROP TABLE counter", nil)
+		require.NoError(t, err)
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			CREATE TABLE counter (n INTEGER, PRIMARY KEY n);
+			INSERT INTO counter (n) VALUES (1);
+		`, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{{int64(1)}}, readRows(t, engine, "SELECT n FROM counter"))
+	})
+}
+
+func TestCreateTableAsSelect(t *testing.T) {
+	engine, _ := setupCommonTestWithOptions(t, store.DefaultOptions().WithMultiIndexing(true))
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE payments (
+			id INTEGER AUTO_INCREMENT,
+			account VARCHAR[32] NOT NULL,
+			amount INTEGER NOT NULL,
+			PRIMARY KEY id
+		);
+
+		INSERT INTO payments (account, amount) VALUES ('alice', 10), ('alice', 20), ('bob', 5);
+	`, nil)
+	require.NoError(t, err)
+
+	readRows := func(t *testing.T, query string) [][]interface{} {
+		r, err := engine.Query(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var rows [][]interface{}
+		for {
+			row, err := r.Read(context.Background())
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			values := make([]interface{}, len(row.ValuesByPosition))
+			for i, v := range row.ValuesByPosition {
+				values[i] = v.RawValue()
+			}
+			rows = append(rows, values)
+		}
+		return rows
+	}
+
+	t.Run("the primary key is inferred from the grouping columns", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE totals AS SELECT account, SUM(amount) AS total, COUNT(*) AS n FROM payments GROUP BY account", nil)
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("totals")
+		require.NoError(t, err)
+		require.Nil(t, table.MaterializedView())
+		require.Len(t, table.PrimaryIndex().Cols(), 1)
+		require.Equal(t, "account", table.PrimaryIndex().Cols()[0].Name())
+		require.Equal(t, 32, table.PrimaryIndex().Cols()[0].MaxLen())
+
+		var types []SQLValueType
+		for _, col := range table.Cols() {
+			types = append(types, col.Type())
+		}
+		require.Equal(t, []SQLValueType{VarcharType, IntegerType, IntegerType}, types)
+
+		require.Equal(t, [][]interface{}{{"alice", int64(30), int64(2)}, {"bob", int64(5), int64(1)}}, readRows(t, "SELECT * FROM totals"))
+
+		// the table is a regular table
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE totals SET total = 0 WHERE account = 'bob'", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("the primary key is inferred from the queried table", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE large_payments AS SELECT id, account FROM payments WHERE amount > @amount", map[string]interface{}{"amount": 7})
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{{int64(1), "alice"}, {int64(2), "alice"}}, readRows(t, "SELECT * FROM large_payments"))
+	})
+
+	t.Run("the primary key can be specified", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE by_amount PRIMARY KEY (amount, account) AS SELECT account, amount FROM payments", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{{"bob", int64(5)}, {"alice", int64(10)}, {"alice", int64(20)}}, readRows(t, "SELECT * FROM by_amount"))
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE by_account PRIMARY KEY account AS SELECT account, amount FROM payments", nil)
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE by_account PRIMARY KEY name AS SELECT account, amount FROM payments", nil)
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+	})
+
+	t.Run("the table is created only if it does not exist", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE totals AS SELECT account, COUNT(*) AS n FROM payments GROUP BY account", nil)
+		require.ErrorIs(t, err, ErrTableAlreadyExists)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE IF NOT EXISTS totals AS SELECT account, COUNT(*) AS n FROM payments GROUP BY account", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{{"alice", int64(30), int64(2)}, {"bob", int64(0), int64(1)}}, readRows(t, "SELECT * FROM totals"))
+	})
+
+	t.Run("the primary key must be inferred or specified", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE accounts AS SELECT account FROM payments", nil)
+		require.ErrorIs(t, err, ErrInvalidTableQuery)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE accounts AS SELECT account, NULL AS note FROM payments GROUP BY account", nil)
+		require.ErrorIs(t, err, ErrInvalidTableQuery)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE accounts AS SELECT account FROM missing GROUP BY account", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+}
+
+func TestTruncateTable(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions().WithMultiIndexing(true))
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE payments (
+			id INTEGER AUTO_INCREMENT,
+			account VARCHAR[32] NOT NULL,
+			amount INTEGER NOT NULL,
+			CHECK amount >= 0,
+			PRIMARY KEY id
+		);
+
+		CREATE TABLE audit (id INTEGER AUTO_INCREMENT, account VARCHAR, PRIMARY KEY id);
+
+		CREATE UNIQUE INDEX ON payments (account, amount);
+
+		CREATE TRIGGER audit_payments AFTER INSERT ON payments FOR EACH ROW EXECUTE
+			INSERT INTO audit (account) VALUES (NEW.account);
+	`, nil)
+	require.NoError(t, err)
+
+	_, txs, err := engine.Exec(context.Background(), nil, "INSERT INTO payments (account, amount) VALUES ('alice', 10), ('alice', 20), ('bob', 5)", nil)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+
+	insertTxID := txs[0].TxHeader().ID
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE MATERIALIZED VIEW totals WITH INCREMENTAL REFRESH AS
+			SELECT account, SUM(amount) AS total, COUNT(*) AS n FROM payments GROUP BY account;
+
+		CREATE MATERIALIZED VIEW accounts AS SELECT id, account FROM payments;
+	`, nil)
+	require.NoError(t, err)
+
+	count := func(t *testing.T, table string) int64 {
+		r, err := engine.Query(context.Background(), nil, "SELECT COUNT(*) FROM "+table, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		return row.ValuesByPosition[0].RawValue().(int64)
+	}
+
+	catalog, err := engine.Catalog(context.Background(), nil)
+	require.NoError(t, err)
+
+	table, err := catalog.GetTableByName("payments")
+	require.NoError(t, err)
+
+	tableID := table.ID()
+
+	_, txs, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE payments", nil)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Zero(t, txs[0].UpdatedRows())
+
+	truncateTxID := txs[0].TxHeader().ID
+
+	require.Zero(t, count(t, "payments"))
+	require.Zero(t, count(t, "totals"))
+	require.Equal(t, int64(3), count(t, "accounts"))
+	require.Equal(t, int64(3), count(t, "audit"))
+
+	t.Run("the table keeps its definition", func(t *testing.T) {
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("payments")
+		require.NoError(t, err)
+		require.NotEqual(t, tableID, table.ID())
+		require.Len(t, table.Cols(), 3)
+		require.Len(t, table.GetIndexes(), 2)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments (account, amount) VALUES ('carol', 1), ('carol', 2)", nil)
+		require.NoError(t, err)
+
+		r, err := engine.Query(context.Background(), nil, "SELECT id FROM payments WHERE account = 'carol' ORDER BY id", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(1), row.ValuesByPosition[0].RawValue())
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments (account, amount) VALUES ('carol', 2)", nil)
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments (account, amount) VALUES ('carol', -1)", nil)
+		require.ErrorIs(t, err, ErrCheckConstraintViolation)
+
+		require.Equal(t, int64(5), count(t, "audit"))
+	})
+
+	t.Run("incremental views are refreshed from the truncation onwards", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW totals", nil)
+		require.NoError(t, err)
+
+		r, err := engine.Query(context.Background(), nil, "SELECT account, total, n FROM totals", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "carol", row.ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(3), row.ValuesByPosition[1].RawValue())
+		require.Equal(t, int64(2), row.ValuesByPosition[2].RawValue())
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrNoMoreRows)
+	})
+
+	t.Run("the truncated rows remain part of the history", func(t *testing.T) {
+		tx := store.NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err := st.ReadTx(insertTxID, false, tx)
+		require.NoError(t, err)
+
+		rowPrefix := MapKey(engine.prefix, RowPrefix, EncodeID(DatabaseID), EncodeID(tableID))
+
+		n := 0
+		for _, e := range tx.Entries() {
+			if bytes.HasPrefix(e.Key(), rowPrefix) {
+				n++
+			}
+		}
+		require.Equal(t, 3, n)
+	})
+
+	t.Run("the history of the table includes the truncated rows", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id, account, amount FROM (HISTORY OF payments) ORDER BY id, _tx_id", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 5)
+
+		expected := [][]interface{}{
+			{int64(1), "alice", int64(10)},
+			{int64(1), "carol", int64(1)},
+			{int64(2), "alice", int64(20)},
+			{int64(2), "carol", int64(2)},
+			{int64(3), "bob", int64(5)},
+		}
+
+		for i, row := range rows {
+			for j, v := range expected[i] {
+				require.Equal(t, v, row.ValuesByPosition[j].RawValue())
+			}
+		}
+	})
+
+	t.Run("the changes of the table include the rows deleted by the truncation", func(t *testing.T) {
+		params := map[string]interface{}{"since": insertTxID, "until": truncateTxID}
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT _op, _tx_id, _before_account FROM CHANGES OF payments AFTER TX @since UNTIL TX @until", params)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		for i, account := range []string{"alice", "alice", "bob"} {
+			require.Equal(t, deleteOp, rows[i].ValuesByPosition[0].RawValue())
+			require.Equal(t, int64(truncateTxID), rows[i].ValuesByPosition[1].RawValue())
+			require.Equal(t, account, rows[i].ValuesByPosition[2].RawValue())
+		}
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT _op, _before_id, account, _before_account FROM CHANGES OF payments AFTER TX @since", params)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		require.Equal(t, []interface{}{updateOp, int64(1), "carol", "alice"}, rawValues(rows[0]))
+		require.Equal(t, []interface{}{updateOp, int64(2), "carol", "alice"}, rawValues(rows[1]))
+		require.Equal(t, []interface{}{deleteOp, int64(3), nil, "bob"}, rawValues(rows[2]))
+
+		// rows inserted and truncated within the range are not changed
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT _op, id FROM CHANGES OF payments", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, []interface{}{insertOp, int64(1)}, rawValues(rows[0]))
+		require.Equal(t, []interface{}{insertOp, int64(2)}, rawValues(rows[1]))
+	})
+
+	t.Run("only existing tables can be truncated", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "TRUNCATE TABLE missing", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		_, _, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE totals", nil)
+		require.ErrorIs(t, err, ErrMaterializedViewIsReadOnly)
+	})
+}
+
+func TestTruncatedTableHistoryAfterReopening(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE items (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id);
+		INSERT INTO items (name) VALUES ('a'), ('b');
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE items", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE items", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO items (name) VALUES ('c')", nil)
+	require.NoError(t, err)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	rows, err := engine.queryAll(context.Background(), nil, "SELECT name FROM (HISTORY OF items) ORDER BY name", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	for i, name := range []string{"a", "b", "c"} {
+		require.Equal(t, name, rows[i].ValuesByPosition[0].RawValue())
+	}
+
+	rows, err = engine.queryAll(context.Background(), nil, "SELECT name FROM items", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DROP TABLE items", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE items (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	rows, err = engine.queryAll(context.Background(), nil, "SELECT name FROM (HISTORY OF items)", nil)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
+
+func TestTruncatedTableIndexesAreOpenedWhileRead(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE staging (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	requireClosed := func(ids []uint32) {
+		for _, id := range ids {
+			err := st.CloseIndexing(truncatedIndexPrefix(engine.prefix, id))
+			require.ErrorIs(t, err, store.ErrIndexNotFound)
+		}
+		require.Empty(t, engine.truncatedIndexes)
+	}
+
+	var truncatedIDs []uint32
+
+	for i := 0; i < 5; i++ {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO staging (name) VALUES ('a'), ('b')", nil)
+		require.NoError(t, err)
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		table, err := tx.catalog.GetTableByName("staging")
+		require.NoError(t, err)
+		truncatedIDs = append(truncatedIDs, table.id)
+
+		err = tx.Cancel()
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE staging", nil)
+		require.NoError(t, err)
+
+		requireClosed(truncatedIDs)
+	}
+
+	tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	rows, err := engine.queryAll(context.Background(), tx, "SELECT name FROM (HISTORY OF staging)", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 10)
+	require.Len(t, engine.truncatedIndexes, len(truncatedIDs))
+
+	rows, err = engine.queryAll(context.Background(), tx, "SELECT _op FROM CHANGES OF staging", nil)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	err = tx.Cancel()
+	require.NoError(t, err)
+
+	requireClosed(truncatedIDs)
+
+	indexPath := func(id uint32) string {
+		return filepath.Join(dir, "index_"+hex.EncodeToString(truncatedIndexPrefix(engine.prefix, id)))
+	}
+
+	for _, id := range truncatedIDs {
+		require.DirExists(t, indexPath(id))
+	}
+
+	_, _, err = engine.Exec(context.Background(), nil, "DROP TABLE staging", nil)
+	require.NoError(t, err)
+
+	requireClosed(truncatedIDs)
+
+	for _, id := range truncatedIDs {
+		require.NoDirExists(t, indexPath(id))
+	}
+}
+
+func rawValues(row *Row) []interface{} {
+	values := make([]interface{}, len(row.ValuesByPosition))
+	for i, v := range row.ValuesByPosition {
+		values[i] = v.RawValue()
+	}
+	return values
+}
+
+type testCursorRegistry map[string]*Cursor
+
+func (r testCursorRegistry) AddCursor(cursor *Cursor) error {
+	if _, exists := r[cursor.Name()]; exists {
+		return ErrCursorAlreadyExists
+	}
+	r[cursor.Name()] = cursor
+	return nil
+}
+
+func (r testCursorRegistry) GetCursor(name string) (*Cursor, error) {
+	cursor, exists := r[name]
+	if !exists {
+		return nil, ErrCursorDoesNotExist
+	}
+	return cursor, nil
+}
+
+func (r testCursorRegistry) CloseCursor(name string) error {
+	cursor, exists := r[name]
+	if !exists {
+		return ErrCursorDoesNotExist
+	}
+	delete(r, name)
+	return cursor.Close()
+}
+
+func TestCursors(t *testing.T) {
+	engine, _ := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE items (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO items (name) VALUES (@name)", map[string]interface{}{"name": fmt.Sprintf("item%d", i)})
+		require.NoError(t, err)
+	}
+
+	cursors := make(testCursorRegistry)
+
+	newTx := func(t *testing.T) *SQLTx {
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithCursors(cursors))
+		require.NoError(t, err)
+		t.Cleanup(func() { tx.Cancel() })
+		return tx
+	}
+
+	fetchIDs := func(t *testing.T, fetch string) []int64 {
+		rows, err := engine.queryAll(context.Background(), newTx(t), fetch, nil)
+		require.NoError(t, err)
+
+		ids := make([]int64, len(rows))
+		for i, row := range rows {
+			ids[i] = row.ValuesByPosition[0].RawValue().(int64)
+		}
+		return ids
+	}
+
+	t.Run("cursors require a registry", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "DECLARE c1 CURSOR FOR SELECT id FROM items", nil)
+		require.ErrorIs(t, err, ErrCursorsNotAvailable)
+
+		_, err = engine.queryAll(context.Background(), nil, "FETCH 1 FROM c1", nil)
+		require.ErrorIs(t, err, ErrCursorsNotAvailable)
+	})
+
+	t.Run("rows are fetched in batches from the same snapshot", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), newTx(t), "DECLARE c1 CURSOR FOR SELECT id, name FROM items WHERE id > @id ORDER BY id DESC", map[string]interface{}{"id": 2})
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), newTx(t), "DECLARE c1 CURSOR FOR SELECT id FROM items", nil)
+		require.ErrorIs(t, err, ErrCursorAlreadyExists)
+
+		require.Equal(t, []int64{10, 9, 8}, fetchIDs(t, "FETCH 3 FROM c1"))
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO items (name) VALUES ('item10'); DELETE FROM items WHERE id = 5", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{7}, fetchIDs(t, "FETCH NEXT FROM c1"))
+		require.Equal(t, []int64{6, 5, 4, 3}, fetchIDs(t, "FETCH ALL FROM c1"))
+		require.Empty(t, fetchIDs(t, "FETCH 3 FROM c1"))
+
+		r, err := engine.Query(context.Background(), newTx(t), "FETCH 3 FROM c1", nil)
+		require.NoError(t, err)
+
+		cols, err := r.Columns(context.Background())
+		require.NoError(t, err)
+		require.Len(t, cols, 2)
+		require.Equal(t, "name", cols[1].Column)
+		require.NoError(t, r.Close())
+
+		_, err = engine.queryAll(context.Background(), newTx(t), "FETCH 0 FROM c1", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), newTx(t), "CLOSE c1", nil)
+		require.NoError(t, err)
+
+		_, err = engine.queryAll(context.Background(), newTx(t), "FETCH 1 FROM c1", nil)
+		require.ErrorIs(t, err, ErrCursorDoesNotExist)
+
+		_, _, err = engine.Exec(context.Background(), newTx(t), "CLOSE c1", nil)
+		require.ErrorIs(t, err, ErrCursorDoesNotExist)
+	})
+
+	t.Run("cursors can be declared through the engine", func(t *testing.T) {
+		stmts, err := ParseSQLString("SELECT id FROM items ORDER BY id")
+		require.NoError(t, err)
+
+		cursor, err := engine.DeclareCursor(context.Background(), nil, "c2", stmts[0].(DataSource), nil)
+		require.NoError(t, err)
+		require.Equal(t, "c2", cursor.Name())
+		require.Len(t, cursor.Columns(), 1)
+
+		rows, err := cursor.Fetch(context.Background(), 8)
+		require.NoError(t, err)
+		require.Len(t, rows, 8)
+		require.False(t, cursor.Exhausted())
+
+		rows, err = cursor.Fetch(context.Background(), 8)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.True(t, cursor.Exhausted())
+
+		require.NoError(t, cursor.Close())
+		require.NoError(t, cursor.Close())
+
+		_, err = cursor.Fetch(context.Background(), 1)
+		require.ErrorIs(t, err, ErrCursorClosed)
+
+		stmts, err = ParseSQLString("SELECT id FROM unknown_table")
+		require.NoError(t, err)
+
+		_, err = engine.DeclareCursor(context.Background(), nil, "c3", stmts[0].(DataSource), nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+}
+
+func TestFunctions(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		"CREATE TABLE mytable(id INTEGER, PRIMARY KEY id)",
+		nil,
+	)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(
+		context.Background(),
+		nil,
+		"INSERT INTO mytable(id) VALUES (1)",
+		nil,
+	)
+	require.NoError(t, err)
+
+	t.Run("timestamp functions", func(t *testing.T) {
+		_, err := engine.queryAll(context.Background(), nil, "SELECT NOW(1) FROM mytable", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT NOW() FROM mytable", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		require.IsType(t, time.Time{}, rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("uuid functions", func(t *testing.T) {
+		_, err := engine.queryAll(context.Background(), nil, "SELECT RANDOM_UUID(1) FROM mytable", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT RANDOM_UUID() FROM mytable", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		require.IsType(t, uuid.UUID{}, rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("string functions", func(t *testing.T) {
+		t.Run("length", func(t *testing.T) {
+			_, err := engine.queryAll(context.Background(), nil, "SELECT LENGTH(NULL, 1) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			_, err = engine.queryAll(context.Background(), nil, "SELECT LENGTH(10) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT LENGTH(NULL) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.True(t, rows[0].ValuesByPosition[0].IsNull())
+			require.Equal(t, IntegerType, rows[0].ValuesByPosition[0].Type())
+
+			rows, err = engine.queryAll(context.Background(), nil, "SELECT LENGTH('immudb'), LENGTH('') FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			require.Equal(t, int64(6), rows[0].ValuesByPosition[0].RawValue().(int64))
+			require.Equal(t, int64(0), rows[0].ValuesByPosition[1].RawValue().(int64))
+		})
+
+		t.Run("substring", func(t *testing.T) {
+			_, err := engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 0, 6, true) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			_, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 0, 6) FROM mytable", nil)
+			require.ErrorContains(t, err, "parameter 'position' must be greater than zero")
+
+			_, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 1, -1) FROM mytable", nil)
+			require.ErrorContains(t, err, "parameter 'length' cannot be negative")
+
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT SUBSTRING(NULL, 8, 0) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.True(t, rows[0].ValuesByPosition[0].IsNull())
+			require.Equal(t, VarcharType, rows[0].ValuesByPosition[0].Type())
+
+			rows, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 8, 0) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Equal(t, "", rows[0].ValuesByPosition[0].RawValue().(string))
+
+			rows, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 8, 6) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			require.Equal(t, "immudb", rows[0].ValuesByPosition[0].RawValue().(string))
+
+			rows, err = engine.queryAll(context.Background(), nil, "SELECT SUBSTRING('Hello, immudb!', 8, 100) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			require.Equal(t, "immudb!", rows[0].ValuesByPosition[0].RawValue().(string))
+		})
+
+		t.Run("trim", func(t *testing.T) {
+			_, err := engine.queryAll(context.Background(), nil, "SELECT TRIM(1) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			_, err = engine.queryAll(context.Background(), nil, "SELECT TRIM(NULL, 1) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT TRIM(NULL) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.True(t, rows[0].ValuesByPosition[0].IsNull())
+			require.Equal(t, VarcharType, rows[0].ValuesByPosition[0].Type())
+
+			rows, err = engine.queryAll(context.Background(), nil, "SELECT TRIM('      \t\n\r        Hello, immudb!  ') FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			require.Equal(t, "Hello, immudb!", rows[0].ValuesByPosition[0].RawValue().(string))
+		})
+
+		t.Run("concat", func(t *testing.T) {
+			_, err := engine.queryAll(context.Background(), nil, "SELECT CONCAT() FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			_, err = engine.queryAll(context.Background(), nil, "SELECT CONCAT('ciao', NULL, true) FROM mytable", nil)
+			require.ErrorContains(t, err, "'CONCAT' function doesn't accept arguments of type BOOL")
+
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT CONCAT('Hello', ', ', NULL, 'immudb', NULL, '!') FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			require.Equal(t, "Hello, immudb!", rows[0].ValuesByPosition[0].RawValue().(string))
+		})
+
+		t.Run("upper/lower", func(t *testing.T) {
+			_, err := engine.queryAll(context.Background(), nil, "SELECT UPPER(1) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			_, err = engine.queryAll(context.Background(), nil, "SELECT LOWER(NULL, 1) FROM mytable", nil)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT UPPER(NULL), LOWER(NULL) FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.True(t, rows[0].ValuesByPosition[0].IsNull())
+			require.True(t, rows[0].ValuesByPosition[1].IsNull())
+
+			rows, err = engine.queryAll(context.Background(), nil, "SELECT UPPER('immudb'), LOWER('IMMUDB') FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			require.Equal(t, "IMMUDB", rows[0].ValuesByPosition[0].RawValue().(string))
+			require.Equal(t, "immudb", rows[0].ValuesByPosition[1].RawValue().(string))
+		})
+	})
+
+	t.Run("json functions", func(t *testing.T) {
+		_, err := engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF(true) FROM mytable", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF('{}'::JSON, 1) FROM mytable", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF(NULL) FROM mytable", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Nil(t, rows[0].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT JSON_TYPEOF('{}'::JSON) FROM mytable", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, "OBJECT", rows[0].ValuesByPosition[0].RawValue().(string))
+	})
+}
+
+func TestScalarFunctions(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE mytable(id INTEGER, title VARCHAR, price FLOAT, data BLOB, PRIMARY KEY id);
+		INSERT INTO mytable(id, title, price, data) VALUES (1, NULL, 2.5, x'696d6d756462');
+	`, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		exp      string
+		expected interface{}
+	}{
+		{exp: "COALESCE(title, 'untitled')", expected: "untitled"},
+		{exp: "COALESCE(NULL, NULL, id)", expected: int64(1)},
+		{exp: "NULLIF(id, 1)", expected: nil},
+		{exp: "NULLIF(id, 2)", expected: int64(1)},
+		{exp: "GREATEST(3, id, NULL, 7)", expected: int64(7)},
+		{exp: "LEAST('b', 'a', 'c')", expected: "a"},
+		{exp: "REPLACE('a-b-c', '-', '+')", expected: "a+b+c"},
+		{exp: "POSITION('db' IN 'immudb')", expected: int64(5)},
+		{exp: "POSITION('x', 'immudb')", expected: int64(0)},
+		{exp: "LPAD('7', 3, '0')", expected: "007"},
+		{exp: "RPAD('ab', 5, 'xy')", expected: "abxyx"},
+		{exp: "LPAD('immudb', 3)", expected: "imm"},
+		{exp: "SPLIT_PART('a,b,c', ',', 2)", expected: "b"},
+		{exp: "SPLIT_PART('a,b,c', ',', -1)", expected: "c"},
+		{exp: "SPLIT_PART('a,b,c', ',', 4)", expected: ""},
+		{exp: "REGEXP_REPLACE('foo bar foo', 'fo+', 'baz')", expected: "baz bar foo"},
+		{exp: "REGEXP_REPLACE('foo bar FOO', 'fo(o)', '<\\1>', 'gi')", expected: "<o> bar <O>"},
+		{exp: "REGEXP_MATCH('order 123-45', '(\\d+)-(\\d+)')", expected: []interface{}{"123", "45"}},
+		{exp: "REGEXP_MATCH('abc', 'x')", expected: nil},
+		{exp: "ABS(-3)", expected: int64(3)},
+		{exp: "ABS(-price)", expected: 2.5},
+		{exp: "ROUND(price)", expected: 3.0},
+		{exp: "ROUND(3.14159, 2)", expected: 3.14},
+		{exp: "ROUND(1250, -2)", expected: int64(1300)},
+		{exp: "ROUND(-1250, -2)", expected: int64(-1300)},
+		{exp: "ROUND(9007199254740993, -1)", expected: int64(9007199254740990)},
+		{exp: "ROUND(1250, -400)", expected: int64(0)},
+		{exp: "ROUND(1.55, 400)", expected: 1.55},
+		{exp: "ROUND(1.55, 300)", expected: 1.55},
+		{exp: "ROUND(1.55, -400)", expected: 0.0},
+		{exp: "CEIL(2.1)", expected: 3.0},
+		{exp: "FLOOR(-2.1)", expected: -3.0},
+		{exp: "POWER(2, 10)", expected: 1024.0},
+		{exp: "SQRT(16)", expected: 4.0},
+		{exp: "MOD(10, 3)", expected: int64(1)},
+		{exp: "MOD(-7.5, 2)", expected: -1.5},
+		{exp: "MD5(data)", expected: "a38c2ec50bb645f3a7d0f423630427c6"},
+		{exp: "MD5('immudb')", expected: "a38c2ec50bb645f3a7d0f423630427c6"},
+		{exp: "ENCODE(SHA256(data), 'hex')", expected: "0cb4d4757226f0d8eb98e5e7130d9e429fe25d041dd4f5765be327ad96d59182"},
+		{exp: "ENCODE(data, 'base64')", expected: "aW1tdWRi"},
+		{exp: "DECODE('696d6d756462', 'hex')", expected: []byte("immudb")},
+		{exp: "ENCODE(DECODE('aW1tdWRi', 'base64'), 'hex')", expected: "696d6d756462"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exp, func(t *testing.T) {
+			rows, err := engine.queryAll(context.Background(), nil, "SELECT "+tc.exp+" FROM mytable", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+
+			v := rows[0].ValuesByPosition[0]
+			if tc.expected == nil {
+				require.True(t, v.IsNull())
+				return
+			}
+			require.Equal(t, tc.expected, v.RawValue())
+		})
+	}
+
+	t.Run("padded strings are limited in length", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, fmt.Sprintf("SELECT LPAD('a', %d, 'x') FROM mytable", maxPadLength), nil)
+		require.NoError(t, err)
+		require.Len(t, rows[0].ValuesByPosition[0].RawValue().(string), maxPadLength)
+
+		_, err = engine.queryAll(context.Background(), nil, fmt.Sprintf("SELECT RPAD('a', %d, 'x') FROM mytable", maxPadLength+1), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT LPAD('a', 2000000000, 'x') FROM mytable", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT LPAD('a', 9000000000000000000) FROM mytable", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("type inference", func(t *testing.T) {
+		params, err := engine.InferParameters(context.Background(), nil, "SELECT id FROM mytable WHERE COALESCE(title, @t) = 'x' AND ABS(@n) > 1 AND LPAD(@s, @l) = title")
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"t": VarcharType, "n": IntegerType, "s": VarcharType, "l": IntegerType}, params)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id FROM mytable WHERE COALESCE(title, @t) = 'untitled'", map[string]interface{}{"t": "untitled"})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		for _, exp := range []string{
+			"COALESCE(id, 'a')",
+			"REPLACE(id, 'a', 'b')",
+			"ABS('x')",
+			"SQRT(-1)",
+			"MOD(1, 0)",
+			"SPLIT_PART('a', ',', 0)",
+			"REGEXP_REPLACE('a', '(', 'b')",
+			"REGEXP_MATCH('a', 'a', 'x')",
+			"MD5(id)",
+			"ENCODE(data, 'escape')",
+			"DECODE('zz', 'hex')",
+			"NULLIF(1)",
+			"ROUND(9223372036854775807, -1)",
+		} {
+			_, err := engine.queryAll(context.Background(), nil, "SELECT "+exp+" FROM mytable", nil)
+			require.Error(t, err, exp)
+		}
+	})
+}
+
+func TestTableResolver(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	r := &mockTableResolver{
+		name: "my_table",
+		cols: []ColDescriptor{
+			{Column: "varchar_col", Type: VarcharType},
+			{Column: "int_col", Type: IntegerType},
+			{Column: "bool_col", Type: BooleanType},
+		},
+		values: [][]ValueExp{{NewVarchar("test"), NewInteger(1), NewBool(true)}},
+	}
+
+	engine, err := NewEngine(
+		st,
+		DefaultOptions().
+			WithPrefix(sqlPrefix).
+			WithTableResolvers(r),
+	)
+	require.NoError(t, err)
+
+	assertQueryShouldProduceResults(
+		t,
+		engine,
+		"SELECT int_col, varchar_col, bool_col FROM my_table",
+		`SELECT * FROM (
+			VALUES
+				(1, 'test', true)
+		)`,
+	)
+}
+
+func assertQueryShouldProduceResults(t *testing.T, e *Engine, query, resultQuery string) {
+	queryReader, err := e.Query(context.Background(), nil, query, nil)
+	require.NoError(t, err)
+	defer queryReader.Close()
+
+	resultReader, err := e.Query(context.Background(), nil, resultQuery, nil)
+	require.NoError(t, err)
+	defer resultReader.Close()
+
+	for {
+		actualRow, actualErr := queryReader.Read(context.Background())
+		expectedRow, expectedErr := resultReader.Read(context.Background())
+		require.Equal(t, expectedErr, actualErr)
+
+		if errors.Is(actualErr, ErrNoMoreRows) {
+			break
+		}
+		require.Equal(t, expectedRow.ValuesByPosition, actualRow.ValuesByPosition)
+	}
+}
+
+type mockTableResolver struct {
+	name   string
+	cols   []ColDescriptor
+	values [][]ValueExp
+}
+
+func (r *mockTableResolver) Table() string {
+	return r.name
+}
+
+func (r *mockTableResolver) Resolve(ctx context.Context, tx *SQLTx, alias string) (RowReader, error) {
+	return NewValuesRowReader(
+		tx,
+		nil,
+		r.cols,
+		false,
+		r.name,
+		r.values,
+	)
+}
+
+func TestJSONOperatorsAndFunctions(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE docs (
+			id INTEGER AUTO_INCREMENT,
+			doc JSON,
+			PRIMARY KEY id
+		);
+
+		INSERT INTO docs(doc) VALUES
+			('{"name": "alice", "tags": ["a", "b"], "address": {"city": "rome"}, "age": 30}'),
+			('{"name": "bob", "tags": ["b"], "address": {"city": "paris"}}'),
+			(NULL);
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("text extraction", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `
+			SELECT doc->>'name', doc->'address'->>'city', doc#>'{tags,0}', doc#>>'{address,city}', doc->>'age'
+			FROM docs
+			ORDER BY id`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		require.Equal(t, "alice", rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, "rome", rows[0].ValuesByPosition[1].RawValue())
+		require.Equal(t, "a", rows[0].ValuesByPosition[2].RawValue())
+		require.Equal(t, "rome", rows[0].ValuesByPosition[3].RawValue())
+		require.Equal(t, "30", rows[0].ValuesByPosition[4].RawValue())
+
+		require.True(t, rows[1].ValuesByPosition[4].IsNull())
+		require.True(t, rows[2].ValuesByPosition[0].IsNull())
+	})
+
+	t.Run("containment and key existence", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `
+			SELECT id FROM docs WHERE doc @> '{"tags": ["b"]}' ORDER BY id`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		rows, err = engine.queryAll(context.Background(), nil, `
+			SELECT id FROM docs WHERE doc @> '{"address": {"city": "paris"}}'`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(2), rows[0].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, `SELECT id FROM docs WHERE doc ? 'age'`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(1), rows[0].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, `SELECT id FROM docs WHERE doc->'tags' ? @tag`, map[string]interface{}{"tag": "a"})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		_, err = engine.queryAll(context.Background(), nil, `SELECT id FROM docs WHERE id ? 'age'`, nil)
+		require.ErrorIs(t, err, ErrInvalidTypes)
+	})
+
+	t.Run("jsonb_set", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `
+			SELECT
+				jsonb_set(doc, '{address,city}', '"milan"'),
+				jsonb_set(doc, '{tags,-1}', '"z"'),
+				jsonb_set(doc, '{email}', '"a@b.c"', false)
+			FROM docs WHERE id = 1`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		require.Equal(t, "milan", rows[0].ValuesByPosition[0].RawValue().(map[string]interface{})["address"].(map[string]interface{})["city"])
+		require.Equal(t, []interface{}{"a", "z"}, rows[0].ValuesByPosition[1].RawValue().(map[string]interface{})["tags"])
+		require.NotContains(t, rows[0].ValuesByPosition[2].RawValue().(map[string]interface{}), "email")
+	})
+
+	t.Run("json_build_object", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `
+			SELECT json_build_object('id', id, 'name', doc->>'name', 'city', doc->'address'->'city') FROM docs WHERE id = 2`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, map[string]interface{}{"id": int64(2), "name": "bob", "city": "paris"}, rows[0].ValuesByPosition[0].RawValue())
+
+		_, err = engine.queryAll(context.Background(), nil, `SELECT json_build_object('id') FROM docs`, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("json_agg", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `SELECT json_agg(id) FROM docs`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, JSONType, rows[0].ValuesByPosition[0].Type())
+		require.Equal(t, []interface{}{int64(1), int64(2), int64(3)}, rows[0].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, `SELECT json_agg(id) FROM docs WHERE id > 10`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.True(t, rows[0].ValuesByPosition[0].IsNull())
+	})
+
+	t.Run("jsonb_array_elements", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `SELECT value FROM jsonb_array_elements('[1, "two", {"three": 3}]')`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, "two", rows[1].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, `
+			SELECT docs.id, tag.value
+			FROM docs
+			INNER JOIN jsonb_array_elements(docs.doc->'tags') AS tag ON true
+			ORDER BY docs.id`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, int64(1), rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, "b", rows[2].ValuesByPosition[1].RawValue())
+
+		_, err = engine.queryAll(context.Background(), nil, `SELECT value FROM jsonb_array_elements('{"a": 1}')`, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestJSONPathIndex(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE docs (
+			id INTEGER AUTO_INCREMENT,
+			doc JSON,
+			PRIMARY KEY id
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `CREATE INDEX ON docs(doc)`, nil)
+	require.ErrorIs(t, err, ErrCannotIndexJson)
+
+	_, _, err = engine.Exec(context.Background(), nil, `CREATE INDEX ON docs(id->>'name')`, nil)
+	require.ErrorIs(t, err, ErrInvalidTypes)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE INDEX ON docs(doc->>'kind');
+		CREATE UNIQUE INDEX ON docs((doc#>>'{user,email}'));
+	`, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		_, _, err = engine.Exec(context.Background(), nil, `INSERT INTO docs(doc) VALUES (@doc)`, map[string]interface{}{
+			"doc": fmt.Sprintf(`{"kind": "k%d", "user": {"email": "user%d@example.com"}}`, i%4, i),
+		})
+		require.NoError(t, err)
+	}
+
+	_, _, err = engine.Exec(context.Background(), nil, `INSERT INTO docs(doc) VALUES ('{"user": {"email": "user1@example.com"}}')`, nil)
+	require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+
+	assertUsesIndex := func(t *testing.T, engine *Engine, query, index string, expectedRows int) {
+		r, err := engine.Query(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		require.Equal(t, index, r.ScanSpecs().Index.Name())
+
+		rows, err := ReadAllRows(context.Background(), r)
+		require.NoError(t, err)
+		require.Len(t, rows, expectedRows)
+	}
+
+	assertUsesIndex(t, engine, `SELECT id FROM docs USE INDEX ON doc->>'kind' WHERE doc->>'kind' = 'k1'`, "docs(doc->>'kind')", 5)
+	assertUsesIndex(t, engine, `SELECT id FROM docs WHERE doc->>'kind' = 'k1' ORDER BY doc->>'kind'`, "docs(doc->>'kind')", 5)
+	assertUsesIndex(t, engine, `SELECT id FROM docs USE INDEX ON (doc->'user'->>'email') WHERE doc->'user'->>'email' = 'user7@example.com'`, "docs(doc->>'user->email')", 1)
+
+	_, _, err = engine.Exec(context.Background(), nil, `UPDATE docs SET doc = '{"kind": "k9"}' WHERE id = 1`, nil)
+	require.NoError(t, err)
+
+	assertUsesIndex(t, engine, `SELECT id FROM docs USE INDEX ON doc->>'kind' WHERE doc->>'kind' = 'k0'`, "docs(doc->>'kind')", 4)
+	assertUsesIndex(t, engine, `SELECT id FROM docs USE INDEX ON doc->>'kind' WHERE doc->>'kind' = 'k9'`, "docs(doc->>'kind')", 1)
+
+	_, _, err = engine.Exec(context.Background(), nil, `DELETE FROM docs WHERE doc->>'kind' = 'k9'`, nil)
+	require.NoError(t, err)
+
+	assertUsesIndex(t, engine, `SELECT id FROM docs USE INDEX ON doc->>'kind' WHERE doc->>'kind' = 'k9'`, "docs(doc->>'kind')", 0)
+
+	_, _, err = engine.Exec(context.Background(), nil, `ALTER TABLE docs DROP COLUMN doc`, nil)
+	require.ErrorIs(t, err, ErrCannotDropColumn)
+
+	t.Run("path indexes are loaded after reopening", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		assertUsesIndex(t, engine, `SELECT id FROM docs USE INDEX ON doc->>'kind' WHERE doc->>'kind' = 'k2'`, "docs(doc->>'kind')", 5)
+
+		_, _, err = engine.Exec(context.Background(), nil, `DROP INDEX ON docs(doc->>'kind')`, nil)
+		require.NoError(t, err)
+
+		_, err = engine.queryAll(context.Background(), nil, `SELECT id FROM docs USE INDEX ON doc->>'kind'`, nil)
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+	})
+}
+
+func TestRoutines(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE accounts (
+			id INTEGER,
+			balance INTEGER NOT NULL,
+			PRIMARY KEY id
+		);
+
+		CREATE TABLE postings (
+			id INTEGER AUTO_INCREMENT,
+			account INTEGER NOT NULL,
+			amount INTEGER NOT NULL,
+			PRIMARY KEY id
+		);
+
+		INSERT INTO accounts (id, balance) VALUES (1, 100), (2, 0);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE FUNCTION balance_of(@account INTEGER) RETURNS INTEGER AS $$
+			SELECT balance FROM accounts WHERE id = @account
+		$$;
+
+		CREATE FUNCTION factorial(@n INTEGER) RETURNS INTEGER AS $$
+			DECLARE @result INTEGER = 1;
+			WHILE @n > 1 LOOP
+				SET @result = @result * @n;
+				SET @n = @n - 1;
+			END LOOP;
+			RETURN @result
+		$$;
+
+		CREATE PROCEDURE transfer(@from INTEGER, @to INTEGER, @amount INTEGER) AS $$
+		BEGIN
+			IF @amount <= 0 THEN
+				RAISE 'amount must be positive';
+			END IF;
+
+			DECLARE @balance INTEGER;
+			SET @balance = (SELECT balance FROM accounts WHERE id = @from);
+
+			IF @balance IS NULL OR balance_of(@to) IS NULL THEN
+				RAISE 'unknown account';
+			ELSE
+				IF @balance < @amount THEN
+					RAISE 'insufficient funds';
+				END IF;
+			END IF;
+
+			UPDATE accounts SET balance = balance - @amount WHERE id = @from;
+			UPDATE accounts SET balance = balance + @amount WHERE id = @to;
+
+			INSERT INTO postings (account, amount) VALUES (@from, -@amount), (@to, @amount);
+		END
+		$$;
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("functions are called within expressions", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id, balance_of(id), factorial(5) FROM accounts ORDER BY id", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, []TypedValue{&Integer{val: 1}, &Integer{val: 100}, &Integer{val: 120}}, rows[0].ValuesByPosition)
+		require.Equal(t, []TypedValue{&Integer{val: 2}, &Integer{val: 0}, &Integer{val: 120}}, rows[1].ValuesByPosition)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT id FROM accounts WHERE balance_of(id) > @min", map[string]interface{}{"min": 50})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, &Integer{val: 1}, rows[0].ValuesByPosition[0])
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT balance_of(3)", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.True(t, rows[0].ValuesByPosition[0].IsNull())
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT factorial(1, 2)", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT factorial('a')", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("procedures are executed within the calling transaction", func(t *testing.T) {
+		_, txs, err := engine.Exec(context.Background(), nil, "CALL transfer(1, 2, 30)", nil)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL transfer(@from, @to, @amount)", map[string]interface{}{"from": 2, "to": 1, "amount": 10})
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT balance FROM accounts ORDER BY id", nil)
+		require.NoError(t, err)
+		require.Equal(t, []TypedValue{&Integer{val: 80}}, rows[0].ValuesByPosition)
+		require.Equal(t, []TypedValue{&Integer{val: 20}}, rows[1].ValuesByPosition)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT SUM(amount) FROM postings", nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("raised exceptions rollback the statement", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CALL transfer(2, 1, 1000)", nil)
+		require.ErrorIs(t, err, ErrRaisedException)
+		require.ErrorContains(t, err, "insufficient funds")
+		require.ErrorContains(t, err, "procedure 'transfer'")
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL transfer(1, 3, 10)", nil)
+		require.ErrorIs(t, err, ErrRaisedException)
+		require.ErrorContains(t, err, "unknown account")
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL transfer(1, 2, 0)", nil)
+		require.ErrorContains(t, err, "amount must be positive")
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL transfer(1, 2)", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL factorial(3)", nil)
+		require.ErrorIs(t, err, ErrRoutineDoesNotExist)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT COUNT(*) FROM postings", nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("invalid routines can not be created", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE FUNCTION f() RETURNS INTEGER AS $$ DELETE FROM postings $$", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE PROCEDURE p() AS $$ RETURN 1 $$", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE FUNCTION f(@a INTEGER, @a INTEGER) RETURNS INTEGER AS $$ RETURN @a $$", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE FUNCTION upper(@a VARCHAR) RETURNS VARCHAR AS $$ RETURN @a $$", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE FUNCTION f() RETURNS INTEGER AS $$ RETURN 1 +$$", nil)
+		require.Error(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE PROCEDURE transfer() AS $$ RETURN $$", nil)
+		require.ErrorIs(t, err, ErrRoutineAlreadyExists)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE OR REPLACE FUNCTION transfer() RETURNS INTEGER AS $$ RETURN 1 $$", nil)
+		require.ErrorIs(t, err, ErrRoutineAlreadyExists)
+	})
+
+	t.Run("undeclared variables can not be assigned", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, `
+			CREATE FUNCTION undeclared() RETURNS INTEGER AS $$ SET @x = 1; RETURN @x $$;
+			CREATE FUNCTION recursive(@n INTEGER) RETURNS INTEGER AS $$ RETURN recursive(@n + 1) $$;
+		`, nil)
+		require.NoError(t, err)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT undeclared()", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT recursive(1)", nil)
+		require.ErrorIs(t, err, ErrMaxRoutineDepthExceeded)
+	})
+
+	t.Run("replaced routines are versioned and loaded with the catalog", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, `
+			CREATE OR REPLACE FUNCTION factorial(@n INTEGER) RETURNS INTEGER AS $$
+				IF @n <= 1 THEN
+					RETURN 1;
+				END IF;
+				RETURN @n * factorial(@n - 1)
+			$$
+		`, nil)
+		require.NoError(t, err)
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT factorial(6)", nil)
+		require.NoError(t, err)
+		require.Equal(t, []TypedValue{&Integer{val: 720}}, rows[0].ValuesByPosition)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		routine, err := catalog.GetRoutineByName("factorial")
+		require.NoError(t, err)
+		require.Equal(t, FunctionRoutine, routine.Kind())
+		require.Equal(t, IntegerType, routine.ReturnType())
+		require.Equal(t, uint32(2), routine.Version())
+		require.True(t, strings.HasPrefix(routine.SQL(), "CREATE FUNCTION factorial(@n INTEGER) RETURNS INTEGER AS $$"))
+
+		// former definitions are kept in the history of the catalog entry
+		_, count, err := st.History(routineKey(engine.prefix, "factorial"), 0, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), count)
+
+		require.Len(t, catalog.Routines(), 5)
+		require.Equal(t, "balance_of", catalog.Routines()[0].Name())
+	})
+
+	t.Run("routines can be dropped", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "DROP PROCEDURE factorial", nil)
+		require.ErrorIs(t, err, ErrRoutineDoesNotExist)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DROP FUNCTION factorial; DROP PROCEDURE transfer", nil)
+		require.NoError(t, err)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT factorial(3)", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL transfer(1, 2, 10)", nil)
+		require.ErrorIs(t, err, ErrRoutineDoesNotExist)
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+		require.Len(t, catalog.Routines(), 3)
+	})
+}
+
+func TestRoutinePrivileges(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	handler := &multidbHandlerMock{
+		dbs: []string{"db1"},
+		user: &mockUser{
+			username:      "myuser",
+			permission:    PermissionReadWrite,
+			sqlPrivileges: []SQLPrivilege{SQLPrivilegeCreate, SQLPrivilegeSelect, SQLPrivilegeInsert},
+		},
+	}
+
+	opts := DefaultOptions().
+		WithPrefix(sqlPrefix).
+		WithMultiDBHandler(handler)
+
+	engine, err := NewEngine(st, opts)
+	require.NoError(t, err)
+
+	handler.engine = engine
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE accounts (id INTEGER, balance INTEGER, PRIMARY KEY id);
+		CREATE TABLE postings (id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+		INSERT INTO accounts (id, balance) VALUES (1, 100);
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("routines can not be created when the creator can not execute their body", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, `
+			CREATE PROCEDURE reset() AS $$ UPDATE accounts SET balance = 0 $$
+		`, nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			CREATE FUNCTION total() RETURNS INTEGER AS $$
+				DECLARE @total INTEGER;
+				SET @total = (SELECT SUM(balance) FROM accounts);
+				RETURN @total
+			$$;
+
+			CREATE PROCEDURE post(@amount INTEGER) AS $$
+				INSERT INTO postings (amount) VALUES (@amount)
+			$$;
+		`, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("routines can only be used with the execute privilege", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CALL post(10)", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT total()", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		handler.user.sqlPrivileges = append(handler.user.sqlPrivileges, SQLPrivilegeExecute)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CALL post(10)", nil)
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT total()", nil)
+		require.NoError(t, err)
+		require.Equal(t, []TypedValue{&Integer{val: 100}}, rows[0].ValuesByPosition)
+	})
+
+	t.Run("the body of routines is checked against the privileges of the caller", func(t *testing.T) {
+		handler.user.sqlPrivileges = []SQLPrivilege{SQLPrivilegeSelect, SQLPrivilegeExecute}
+
+		_, _, err := engine.Exec(context.Background(), nil, "CALL post(10)", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+		require.ErrorContains(t, err, "procedure 'post'")
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT total()", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		handler.user.sqlPrivileges = []SQLPrivilege{SQLPrivilegeInsert, SQLPrivilegeExecute}
+
+		_, err = engine.queryAll(context.Background(), nil, "SELECT total()", nil)
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+}
+
+func TestRoutinesHonorStatementContext(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithStatementTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE FUNCTION forever() RETURNS INTEGER AS $$
+			DECLARE @n INTEGER = 0;
+			WHILE TRUE LOOP
+				SET @n = @n + 1;
+			END LOOP;
+			RETURN @n
+		$$;
+
+		CREATE PROCEDURE spin() AS $$
+			DECLARE @n INTEGER = 0;
+			WHILE TRUE LOOP
+				SET @n = @n + 1;
+			END LOOP
+		$$;
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CALL spin()", nil)
+	require.ErrorIs(t, err, ErrStatementTimeout)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT forever()", nil)
+	require.ErrorIs(t, err, ErrStatementTimeout)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = engine.queryAll(ctx, nil, "SELECT forever()", nil)
+	require.ErrorIs(t, err, context.Canceled)
 }
@@ -40,6 +40,9 @@ type fileSorter struct {
 	sortBuf     []*Row
 	nextIdx     int
 
+	memBudget   *memoryBudget
+	bufferedMem int // memory held by the rows in the sort buffer
+
 	tempFile     *os.File
 	writer       *bufio.Writer
 	tempFileSize uint64
@@ -56,6 +59,14 @@ func (s *fileSorter) update(r *Row) error {
 		s.nextIdx = 0
 	}
 
+	size := rowMemSize(r)
+
+	err := s.memBudget.consume(size)
+	if err != nil {
+		return err
+	}
+	s.bufferedMem += size
+
 	s.sortBuf[s.nextIdx] = r
 	s.nextIdx++
 
@@ -91,6 +102,12 @@ func (s *fileSorter) finalize() (resultReader, error) {
 func (s *fileSorter) mergeAllChunks() (resultReader, error) {
 	currFile := s.tempFile
 
+	// merging requires a second temporary file of the same size
+	err := s.memBudget.consume(int(s.tempFileSize))
+	if err != nil {
+		return nil, err
+	}
+
 	outFile, err := s.tx.createTempFile()
 	if err != nil {
 		return nil, err
@@ -376,7 +393,12 @@ func (s *fileSorter) flushBuffer() error {
 		size:   chunkSize,
 	})
 	s.tempFileSize += chunkSize
-	return nil
+
+	// flushed rows are no longer held in memory but in the temporary file
+	s.memBudget.release(s.bufferedMem)
+	s.bufferedMem = 0
+
+	return s.memBudget.consume(int(chunkSize))
 }
 
 func (s *fileSorter) tempFileWriter() (*bufio.Writer, error) {
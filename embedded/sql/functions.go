@@ -17,9 +17,16 @@ limitations under the License.
 package sql
 
 import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"regexp"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -41,6 +48,32 @@ const (
 	IndexesFnCall            string = "INDEXES"
 	GrantsFnCall             string = "GRANTS"
 	JSONTypeOfFnCall         string = "JSON_TYPEOF"
+	JSONBSetFnCall           string = "JSONB_SET"
+	JSONBuildObjectFnCall    string = "JSON_BUILD_OBJECT"
+	JSONBArrayElementsFnCall string = "JSONB_ARRAY_ELEMENTS"
+	CoalesceFnCall           string = "COALESCE"
+	NullIfFnCall             string = "NULLIF"
+	GreatestFnCall           string = "GREATEST"
+	LeastFnCall              string = "LEAST"
+	ReplaceFnCall            string = "REPLACE"
+	PositionFnCall           string = "POSITION"
+	LPadFnCall               string = "LPAD"
+	RPadFnCall               string = "RPAD"
+	SplitPartFnCall          string = "SPLIT_PART"
+	RegexpReplaceFnCall      string = "REGEXP_REPLACE"
+	RegexpMatchFnCall        string = "REGEXP_MATCH"
+	AbsFnCall                string = "ABS"
+	RoundFnCall              string = "ROUND"
+	CeilFnCall               string = "CEIL"
+	CeilingFnCall            string = "CEILING"
+	FloorFnCall              string = "FLOOR"
+	PowerFnCall              string = "POWER"
+	SqrtFnCall               string = "SQRT"
+	ModFnCall                string = "MOD"
+	MD5FnCall                string = "MD5"
+	SHA256FnCall             string = "SHA256"
+	EncodeFnCall             string = "ENCODE"
+	DecodeFnCall             string = "DECODE"
 	PGGetUserByIDFnCall      string = "PG_GET_USERBYID"
 	PgTableIsVisibleFnCall   string = "PG_TABLE_IS_VISIBLE"
 	PgShobjDescriptionFnCall string = "SHOBJ_DESCRIPTION"
@@ -56,6 +89,31 @@ var builtinFunctions = map[string]Function{
 	NowFnCall:                &NowFn{},
 	UUIDFnCall:               &UUIDFn{},
 	JSONTypeOfFnCall:         &JsonTypeOfFn{},
+	JSONBSetFnCall:           &JsonbSetFn{},
+	JSONBuildObjectFnCall:    &JsonBuildObjectFn{},
+	CoalesceFnCall:           &CoalesceFn{},
+	NullIfFnCall:             &NullIfFn{},
+	GreatestFnCall:           &GreatestLeastFn{},
+	LeastFnCall:              &GreatestLeastFn{isLeast: true},
+	ReplaceFnCall:            &ReplaceFn{},
+	PositionFnCall:           &PositionFn{},
+	LPadFnCall:               &PadFn{},
+	RPadFnCall:               &PadFn{isRight: true},
+	SplitPartFnCall:          &SplitPartFn{},
+	RegexpReplaceFnCall:      &RegexpReplaceFn{},
+	RegexpMatchFnCall:        &RegexpMatchFn{},
+	AbsFnCall:                &AbsFn{},
+	RoundFnCall:              &RoundFn{},
+	CeilFnCall:               &CeilFloorFn{name: CeilFnCall},
+	CeilingFnCall:            &CeilFloorFn{name: CeilingFnCall},
+	FloorFnCall:              &CeilFloorFn{name: FloorFnCall},
+	PowerFnCall:              &PowerFn{},
+	SqrtFnCall:               &SqrtFn{},
+	ModFnCall:                &ModFn{},
+	MD5FnCall:                &MD5Fn{},
+	SHA256FnCall:             &SHA256Fn{},
+	EncodeFnCall:             &EncodeFn{},
+	DecodeFnCall:             &DecodeFn{},
 	PGGetUserByIDFnCall:      &pgGetUserByIDFunc{},
 	PgTableIsVisibleFnCall:   &pgTableIsVisible{},
 	PgShobjDescriptionFnCall: &pgShobjDescription{},
@@ -67,6 +125,12 @@ type Function interface {
 	Apply(tx *SQLTx, params []TypedValue) (TypedValue, error)
 }
 
+// argsTypedFunction is implemented by functions whose type depends on, or constrains, the types of their arguments
+type argsTypedFunction interface {
+	inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error)
+	requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error
+}
+
 // -------------------------------------
 // String Functions
 // -------------------------------------
@@ -319,6 +383,95 @@ func (f *JsonTypeOfFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error)
 	return NewVarchar(jsonVal.primitiveType()), nil
 }
 
+type JsonbSetFn struct{}
+
+func (f *JsonbSetFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return JSONType, nil
+}
+
+func (f *JsonbSetFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != JSONType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, JSONType, t)
+	}
+	return nil
+}
+
+// Apply replaces the value found at a path given as a text array e.g. '{a,0,b}'.
+// Unless the optional fourth argument is false, the last key of the path is created when missing.
+func (f *JsonbSetFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) < 3 || len(params) > 4 {
+		return nil, fmt.Errorf("%w: '%s' function expects 3 or 4 arguments but %d were provided", ErrIllegalArguments, JSONBSetFnCall, len(params))
+	}
+
+	for _, v := range params {
+		if v.IsNull() {
+			return NewNull(JSONType), nil
+		}
+	}
+
+	target, err := jsonArgument(params[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s' function expects a target of type JSON", ErrIllegalArguments, JSONBSetFnCall)
+	}
+
+	if params[1].Type() != VarcharType {
+		return nil, fmt.Errorf("%w: '%s' function expects a path of type %s", ErrIllegalArguments, JSONBSetFnCall, VarcharType)
+	}
+
+	newVal, err := jsonArgument(params[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s' function expects a new value of type JSON", ErrIllegalArguments, JSONBSetFnCall)
+	}
+
+	createMissing := true
+	if len(params) == 4 {
+		if params[3].Type() != BooleanType {
+			return nil, fmt.Errorf("%w: '%s' function expects a create_missing flag of type %s", ErrIllegalArguments, JSONBSetFnCall, BooleanType)
+		}
+		createMissing = params[3].RawValue().(bool)
+	}
+
+	path := parseJSONPath(params[1].RawValue().(string))
+
+	return NewJson(jsonSet(target.val, path, newVal.val, createMissing)), nil
+}
+
+type JsonBuildObjectFn struct{}
+
+func (f *JsonBuildObjectFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return JSONType, nil
+}
+
+func (f *JsonBuildObjectFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != JSONType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, JSONType, t)
+	}
+	return nil
+}
+
+// Apply builds a JSON object out of alternating keys and values
+func (f *JsonBuildObjectFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params)%2 != 0 {
+		return nil, fmt.Errorf("%w: '%s' function expects an even number of arguments but %d were provided", ErrIllegalArguments, JSONBuildObjectFnCall, len(params))
+	}
+
+	obj := make(map[string]interface{}, len(params)/2)
+
+	for i := 0; i < len(params); i += 2 {
+		key := params[i]
+		if key.IsNull() {
+			return nil, fmt.Errorf("%w: '%s' function does not accept null keys", ErrIllegalArguments, JSONBuildObjectFnCall)
+		}
+
+		if key.Type() == VarcharType {
+			obj[key.RawValue().(string)] = jsonValueOf(params[i+1])
+		} else {
+			obj[key.String()] = jsonValueOf(params[i+1])
+		}
+	}
+	return NewJson(obj), nil
+}
+
 // -------------------------------------
 // UUID Functions
 // -------------------------------------
@@ -427,3 +580,1336 @@ func (f *pgShobjDescription) Apply(tx *SQLTx, params []TypedValue) (TypedValue,
 	}
 	return NewVarchar(""), nil
 }
+
+// -------------------------------------
+// Conditional Functions
+// -------------------------------------
+
+type CoalesceFn struct{}
+
+func (f *CoalesceFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *CoalesceFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return nil
+}
+
+func (f *CoalesceFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return commonArgsType(CoalesceFnCall, args, cols, params, implicitTable)
+}
+
+func (f *CoalesceFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireArgsType(t, args, cols, params, implicitTable)
+}
+
+// Apply returns the first of its arguments that is not null
+func (f *CoalesceFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("%w: '%s' function expects at least one argument", ErrIllegalArguments, CoalesceFnCall)
+	}
+
+	err := requireSameTypes(CoalesceFnCall, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range params {
+		if !v.IsNull() {
+			return v, nil
+		}
+	}
+	return params[0], nil
+}
+
+type NullIfFn struct{}
+
+func (f *NullIfFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *NullIfFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return nil
+}
+
+func (f *NullIfFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	if len(args) != 2 {
+		return AnyType, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, NullIfFnCall, 2, len(args))
+	}
+
+	_, err := commonArgsType(NullIfFnCall, args, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return args[0].inferType(cols, params, implicitTable)
+}
+
+func (f *NullIfFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, NullIfFnCall, 2, len(args))
+	}
+	return args[0].requiresType(t, cols, params, implicitTable)
+}
+
+// Apply returns null if both arguments are equal, and the first one otherwise
+func (f *NullIfFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, NullIfFnCall, 2, len(params))
+	}
+
+	v1, v2 := params[0], params[1]
+	if v1.IsNull() || v2.IsNull() {
+		return v1, nil
+	}
+
+	cmp, err := v1.Compare(v2)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmp == 0 {
+		return NewNull(v1.Type()), nil
+	}
+	return v1, nil
+}
+
+type GreatestLeastFn struct {
+	isLeast bool
+}
+
+func (f *GreatestLeastFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *GreatestLeastFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return nil
+}
+
+func (f *GreatestLeastFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return commonArgsType(f.name(), args, cols, params, implicitTable)
+}
+
+func (f *GreatestLeastFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireArgsType(t, args, cols, params, implicitTable)
+}
+
+// Apply returns the greatest (or least) of its arguments, null values are ignored
+func (f *GreatestLeastFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("%w: '%s' function expects at least one argument", ErrIllegalArguments, f.name())
+	}
+
+	err := requireSameTypes(f.name(), params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := params[0]
+
+	for _, v := range params[1:] {
+		if v.IsNull() {
+			continue
+		}
+
+		if res.IsNull() {
+			res = v
+			continue
+		}
+
+		cmp, err := v.Compare(res)
+		if err != nil {
+			return nil, fmt.Errorf("%w: '%s' function can not compare values of type %s and %s", err, f.name(), v.Type(), res.Type())
+		}
+
+		if (f.isLeast && cmp < 0) || (!f.isLeast && cmp > 0) {
+			res = v
+		}
+	}
+	return res, nil
+}
+
+func (f *GreatestLeastFn) name() string {
+	if f.isLeast {
+		return LeastFnCall
+	}
+	return GreatestFnCall
+}
+
+// commonArgsType returns the type shared by the arguments, where integers are promoted to floats
+// when both are provided. AnyType is returned when no argument has a known type, otherwise
+// arguments of unknown type, such as parameters, are required to be of the returned type.
+func commonArgsType(fn string, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	common := AnyType
+
+	var untypedArgs []ValueExp
+
+	for _, arg := range args {
+		t, err := arg.inferType(cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+
+		if t == AnyType {
+			untypedArgs = append(untypedArgs, arg)
+		}
+
+		switch {
+		case t == AnyType || t == common:
+		case common == AnyType:
+			common = t
+		case IsNumericType(t) && IsNumericType(common):
+			common = Float64Type
+		default:
+			return AnyType, fmt.Errorf("%w: '%s' function arguments of types %s and %s can not be matched", ErrInvalidTypes, fn, common, t)
+		}
+	}
+
+	if common != AnyType {
+		err := requireArgsType(common, untypedArgs, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+	}
+	return common, nil
+}
+
+// requireSameTypes checks that values which are not null are all of the same type, or are all numbers
+func requireSameTypes(fn string, params []TypedValue) error {
+	t := AnyType
+
+	for _, v := range params {
+		if v.IsNull() || v.Type() == t || (IsNumericType(v.Type()) && IsNumericType(t)) {
+			continue
+		}
+
+		if t != AnyType {
+			return fmt.Errorf("%w: '%s' function arguments of types %s and %s can not be matched", ErrIllegalArguments, fn, t, v.Type())
+		}
+		t = v.Type()
+	}
+	return nil
+}
+
+func requireArgsType(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	for _, arg := range args {
+		err := arg.requiresType(t, cols, params, implicitTable)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireArgTypes checks the number of arguments and requires each of them to be of the type
+// found at the same position, AnyType allows arguments of any type
+func requireArgTypes(fn string, args []ValueExp, minArgs int, types []SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if len(args) < minArgs || len(args) > len(types) {
+		if minArgs == len(types) {
+			return fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, fn, minArgs, len(args))
+		}
+		return fmt.Errorf("%w: '%s' function expects %d to %d arguments but %d were provided", ErrIllegalArguments, fn, minArgs, len(types), len(args))
+	}
+
+	for i, arg := range args {
+		if types[i] == AnyType {
+			_, err := arg.inferType(cols, params, implicitTable)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := arg.requiresType(types[i], cols, params, implicitTable)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// -------------------------------------
+// Additional String Functions
+// -------------------------------------
+
+type ReplaceFn struct{}
+
+func (f *ReplaceFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *ReplaceFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *ReplaceFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(ReplaceFnCall, args, 3, []SQLValueType{VarcharType, VarcharType, VarcharType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *ReplaceFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+func (f *ReplaceFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	strs, isNull, err := varcharArgs(ReplaceFnCall, params, 3, 3)
+	if err != nil || isNull {
+		return NewNull(VarcharType), err
+	}
+
+	if strs[1] == "" {
+		return NewVarchar(strs[0]), nil
+	}
+	return NewVarchar(strings.ReplaceAll(strs[0], strs[1], strs[2])), nil
+}
+
+type PositionFn struct{}
+
+func (f *PositionFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return IntegerType, nil
+}
+
+func (f *PositionFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntegerType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
+	}
+	return nil
+}
+
+func (f *PositionFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(PositionFnCall, args, 2, []SQLValueType{VarcharType, VarcharType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *PositionFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply returns the position of the first occurrence of a substring, starting from one, or zero if not found.
+// It is invoked as POSITION(substring IN string) or POSITION(substring, string).
+func (f *PositionFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	strs, isNull, err := varcharArgs(PositionFnCall, params, 2, 2)
+	if err != nil || isNull {
+		return NewNull(IntegerType), err
+	}
+
+	idx := strings.Index(strs[1], strs[0])
+	if idx < 0 {
+		return NewInteger(0), nil
+	}
+	return NewInteger(int64(utf8.RuneCountInString(strs[1][:idx]) + 1)), nil
+}
+
+type PadFn struct {
+	isRight bool
+}
+
+func (f *PadFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *PadFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *PadFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(f.name(), args, 2, []SQLValueType{VarcharType, IntegerType, VarcharType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *PadFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// maxPadLength is the largest number of characters a padded string may have
+const maxPadLength = 1 << 20
+
+// Apply fills the string up to the given length with the fill text (a space by default).
+// As in postgres, strings longer than length are truncated.
+func (f *PadFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) < 2 || len(params) > 3 {
+		return nil, fmt.Errorf("%w: '%s' function expects 2 or 3 arguments but %d were provided", ErrIllegalArguments, f.name(), len(params))
+	}
+
+	for _, v := range params {
+		if v.IsNull() {
+			return NewNull(VarcharType), nil
+		}
+	}
+
+	if params[0].Type() != VarcharType || params[1].Type() != IntegerType || (len(params) == 3 && params[2].Type() != VarcharType) {
+		return nil, fmt.Errorf("%w: '%s' function expects arguments of type %s, %s and %s", ErrIllegalArguments, f.name(), VarcharType, IntegerType, VarcharType)
+	}
+
+	if params[1].RawValue().(int64) > maxPadLength {
+		return nil, fmt.Errorf("%w: '%s' function result is out of range", ErrIllegalArguments, f.name())
+	}
+
+	s := []rune(params[0].RawValue().(string))
+	length := int(params[1].RawValue().(int64))
+
+	fill := []rune(" ")
+	if len(params) == 3 {
+		fill = []rune(params[2].RawValue().(string))
+	}
+
+	if length <= 0 {
+		return NewVarchar(""), nil
+	}
+
+	if len(s) >= length || len(fill) == 0 {
+		if len(s) > length {
+			s = s[:length]
+		}
+		return NewVarchar(string(s)), nil
+	}
+
+	padding := make([]rune, length-len(s))
+	for i := range padding {
+		padding[i] = fill[i%len(fill)]
+	}
+
+	if f.isRight {
+		return NewVarchar(string(s) + string(padding)), nil
+	}
+	return NewVarchar(string(padding) + string(s)), nil
+}
+
+func (f *PadFn) name() string {
+	if f.isRight {
+		return RPadFnCall
+	}
+	return LPadFnCall
+}
+
+type SplitPartFn struct{}
+
+func (f *SplitPartFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *SplitPartFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *SplitPartFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(SplitPartFnCall, args, 3, []SQLValueType{VarcharType, VarcharType, IntegerType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *SplitPartFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply splits the string at the occurrences of the delimiter and returns the n-th field, starting from one.
+// Negative positions count fields from the end. An empty string is returned when the field does not exist.
+func (f *SplitPartFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 3 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, SplitPartFnCall, 3, len(params))
+	}
+
+	for _, v := range params {
+		if v.IsNull() {
+			return NewNull(VarcharType), nil
+		}
+	}
+
+	if params[0].Type() != VarcharType || params[1].Type() != VarcharType || params[2].Type() != IntegerType {
+		return nil, fmt.Errorf("%w: '%s' function expects arguments of type %s, %s and %s", ErrIllegalArguments, SplitPartFnCall, VarcharType, VarcharType, IntegerType)
+	}
+
+	s := params[0].RawValue().(string)
+	delim := params[1].RawValue().(string)
+	n := params[2].RawValue().(int64)
+
+	if n == 0 {
+		return nil, fmt.Errorf("%w: '%s' function field position must not be zero", ErrIllegalArguments, SplitPartFnCall)
+	}
+
+	fields := []string{s}
+	if delim != "" {
+		fields = strings.Split(s, delim)
+	}
+
+	if n < 0 {
+		n = int64(len(fields)) + n + 1
+	}
+
+	if n < 1 || n > int64(len(fields)) {
+		return NewVarchar(""), nil
+	}
+	return NewVarchar(fields[n-1]), nil
+}
+
+type RegexpReplaceFn struct{}
+
+func (f *RegexpReplaceFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *RegexpReplaceFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *RegexpReplaceFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(RegexpReplaceFnCall, args, 3, []SQLValueType{VarcharType, VarcharType, VarcharType, VarcharType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *RegexpReplaceFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply replaces the first match of the pattern, or all of them when the 'g' flag is given.
+// As in postgres, the replacement may refer to the captured groups as \1 to \9 and to the whole match as \&.
+func (f *RegexpReplaceFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	strs, isNull, err := varcharArgs(RegexpReplaceFnCall, params, 3, 4)
+	if err != nil || isNull {
+		return NewNull(VarcharType), err
+	}
+
+	var flags string
+	if len(strs) == 4 {
+		flags = strs[3]
+	}
+
+	re, global, err := compileRegexp(RegexpReplaceFnCall, strs[1], flags)
+	if err != nil {
+		return nil, err
+	}
+
+	repl := regexpReplacement(strs[2])
+
+	if global {
+		return NewVarchar(re.ReplaceAllString(strs[0], repl)), nil
+	}
+
+	loc := re.FindStringSubmatchIndex(strs[0])
+	if loc == nil {
+		return NewVarchar(strs[0]), nil
+	}
+
+	res := re.ExpandString(nil, repl, strs[0], loc)
+
+	return NewVarchar(strs[0][:loc[0]] + string(res) + strs[0][loc[1]:]), nil
+}
+
+type RegexpMatchFn struct{}
+
+func (f *RegexpMatchFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return JSONType, nil
+}
+
+func (f *RegexpMatchFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != JSONType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, JSONType, t)
+	}
+	return nil
+}
+
+func (f *RegexpMatchFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(RegexpMatchFnCall, args, 2, []SQLValueType{VarcharType, VarcharType, VarcharType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *RegexpMatchFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply returns the texts captured by the first match of the pattern, or the whole match when
+// the pattern has no groups. As there is no array type, the texts are returned as a JSON array.
+// Null is returned when the pattern does not match.
+func (f *RegexpMatchFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	strs, isNull, err := varcharArgs(RegexpMatchFnCall, params, 2, 3)
+	if err != nil || isNull {
+		return NewNull(JSONType), err
+	}
+
+	var flags string
+	if len(strs) == 3 {
+		flags = strs[2]
+	}
+
+	re, global, err := compileRegexp(RegexpMatchFnCall, strs[1], flags)
+	if err != nil {
+		return nil, err
+	}
+
+	if global {
+		return nil, fmt.Errorf("%w: '%s' function does not support the 'g' flag", ErrIllegalArguments, RegexpMatchFnCall)
+	}
+
+	loc := re.FindStringSubmatchIndex(strs[0])
+	if loc == nil {
+		return NewNull(JSONType), nil
+	}
+
+	if len(loc) == 2 {
+		return NewJson([]interface{}{strs[0][loc[0]:loc[1]]}), nil
+	}
+
+	groups := make([]interface{}, 0, len(loc)/2-1)
+	for i := 2; i < len(loc); i += 2 {
+		if loc[i] < 0 {
+			groups = append(groups, nil)
+			continue
+		}
+		groups = append(groups, strs[0][loc[i]:loc[i+1]])
+	}
+	return NewJson(groups), nil
+}
+
+// compileRegexp compiles a pattern applying the flags supported by regexp functions:
+// 'i' for case-insensitive matching and 'g' to replace all the matches
+func compileRegexp(fn, pattern, flags string) (re *regexp.Regexp, global bool, err error) {
+	for _, flag := range flags {
+		switch flag {
+		case 'g':
+			global = true
+		case 'i':
+			pattern = "(?i)" + pattern
+		default:
+			return nil, false, fmt.Errorf("%w: '%s' function does not support the '%c' flag", ErrIllegalArguments, fn, flag)
+		}
+	}
+
+	re, err = regexp.Compile(pattern)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: '%s' function got an invalid pattern: %s", ErrIllegalArguments, fn, err.Error())
+	}
+	return re, global, nil
+}
+
+// regexpReplacement translates a replacement using postgres references (\1, \&) into the go template syntax
+func regexpReplacement(repl string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(repl); i++ {
+		c := repl[i]
+
+		switch {
+		case c == '$':
+			sb.WriteString("$$")
+		case c == '\\' && i+1 < len(repl) && repl[i+1] >= '0' && repl[i+1] <= '9':
+			sb.WriteString("${" + string(repl[i+1]) + "}")
+			i++
+		case c == '\\' && i+1 < len(repl) && repl[i+1] == '&':
+			sb.WriteString("${0}")
+			i++
+		case c == '\\' && i+1 < len(repl) && repl[i+1] == '\\':
+			sb.WriteByte('\\')
+			i++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// varcharArgs validates the arguments of functions taking only texts, isNull is set if any of them is null
+func varcharArgs(fn string, params []TypedValue, minArgs, maxArgs int) (strs []string, isNull bool, err error) {
+	if len(params) < minArgs || len(params) > maxArgs {
+		if minArgs == maxArgs {
+			return nil, false, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, fn, minArgs, len(params))
+		}
+		return nil, false, fmt.Errorf("%w: '%s' function expects %d to %d arguments but %d were provided", ErrIllegalArguments, fn, minArgs, maxArgs, len(params))
+	}
+
+	strs = make([]string, len(params))
+
+	for i, v := range params {
+		if v.IsNull() {
+			isNull = true
+			continue
+		}
+
+		if v.Type() != VarcharType {
+			return nil, false, fmt.Errorf("%w: '%s' function expects arguments of type %s", ErrIllegalArguments, fn, VarcharType)
+		}
+		strs[i] = v.RawValue().(string)
+	}
+	return strs, isNull, nil
+}
+
+// -------------------------------------
+// Math Functions
+// -------------------------------------
+
+type AbsFn struct{}
+
+func (f *AbsFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *AbsFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericType(t)
+}
+
+func (f *AbsFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return numericArgsType(AbsFnCall, args, 1, 1, cols, params, implicitTable)
+}
+
+func (f *AbsFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericArgsType(t, AbsFnCall, args, 1, 1, cols, params, implicitTable)
+}
+
+func (f *AbsFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, AbsFnCall, 1, len(params))
+	}
+
+	switch v := params[0].(type) {
+	case *Integer:
+		if v.val == math.MinInt64 {
+			return nil, fmt.Errorf("%w: '%s' function result is out of range", ErrIllegalArguments, AbsFnCall)
+		}
+		if v.val < 0 {
+			return NewInteger(-v.val), nil
+		}
+		return v, nil
+	case *Float64:
+		return NewFloat64(math.Abs(v.val)), nil
+	}
+	return numericArgError(AbsFnCall, params[0])
+}
+
+type RoundFn struct{}
+
+func (f *RoundFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *RoundFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericType(t)
+}
+
+func (f *RoundFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	if len(args) == 2 {
+		err := args[1].requiresType(IntegerType, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+		args = args[:1]
+	}
+	return numericArgsType(RoundFnCall, args, 1, 1, cols, params, implicitTable)
+}
+
+func (f *RoundFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if len(args) == 2 {
+		err := args[1].requiresType(IntegerType, cols, params, implicitTable)
+		if err != nil {
+			return err
+		}
+		args = args[:1]
+	}
+	return requireNumericArgsType(t, RoundFnCall, args, 1, 1, cols, params, implicitTable)
+}
+
+// Apply rounds the value to the given number of decimal places (zero by default), halves are rounded away from zero
+func (f *RoundFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) < 1 || len(params) > 2 {
+		return nil, fmt.Errorf("%w: '%s' function expects 1 or 2 arguments but %d were provided", ErrIllegalArguments, RoundFnCall, len(params))
+	}
+
+	var places int64
+	if len(params) == 2 {
+		if params[1].IsNull() {
+			return NewNull(params[0].Type()), nil
+		}
+
+		p, ok := params[1].RawValue().(int64)
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s' function expects the number of decimal places to be of type %s", ErrIllegalArguments, RoundFnCall, IntegerType)
+		}
+		places = p
+	}
+
+	switch v := params[0].(type) {
+	case *Integer:
+		if places >= 0 {
+			return v, nil
+		}
+		return roundInteger(v.val, places)
+	case *Float64:
+		return roundFloat(v.val, places), nil
+	}
+	return numericArgError(RoundFnCall, params[0])
+}
+
+const (
+	// maxInt64Digits is the number of digits of the largest integer values
+	maxInt64Digits = 19
+	// maxFloat64Exp10 is the largest power of ten which can be represented as a float value
+	maxFloat64Exp10 = 308
+)
+
+func roundInteger(v int64, places int64) (TypedValue, error) {
+	// any integer value is closer to zero than to the lowest power of ten it can't hold
+	if -places >= maxInt64Digits {
+		return NewInteger(0), nil
+	}
+
+	scale := int64(1)
+	for i := int64(0); i < -places; i++ {
+		scale *= 10
+	}
+
+	q, r := v/scale, v%scale
+
+	if r >= 0 && r >= scale-r {
+		q++
+	} else if r < 0 && -r >= scale+r {
+		q--
+	}
+
+	if q > math.MaxInt64/scale || q < math.MinInt64/scale {
+		return nil, fmt.Errorf("%w: '%s' function result is out of range", ErrIllegalArguments, RoundFnCall)
+	}
+	return NewInteger(q * scale), nil
+}
+
+func roundFloat(v float64, places int64) TypedValue {
+	// beyond these many places, the scale either overflows or underflows
+	if places > maxFloat64Exp10 {
+		return NewFloat64(v)
+	}
+	if places < -maxFloat64Exp10 {
+		return NewFloat64(math.Copysign(0, v))
+	}
+
+	scale := math.Pow10(int(places))
+
+	scaled := v * scale
+	if math.IsInf(scaled, 0) {
+		// there are no more decimal places to round within the precision of the value
+		return NewFloat64(v)
+	}
+	return NewFloat64(math.Round(scaled) / scale)
+}
+
+type CeilFloorFn struct {
+	name string
+}
+
+func (f *CeilFloorFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *CeilFloorFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericType(t)
+}
+
+func (f *CeilFloorFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return numericArgsType(f.name, args, 1, 1, cols, params, implicitTable)
+}
+
+func (f *CeilFloorFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericArgsType(t, f.name, args, 1, 1, cols, params, implicitTable)
+}
+
+func (f *CeilFloorFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, f.name, 1, len(params))
+	}
+
+	switch v := params[0].(type) {
+	case *Integer:
+		return v, nil
+	case *Float64:
+		if f.name == FloorFnCall {
+			return NewFloat64(math.Floor(v.val)), nil
+		}
+		return NewFloat64(math.Ceil(v.val)), nil
+	}
+	return numericArgError(f.name, params[0])
+}
+
+type PowerFn struct{}
+
+func (f *PowerFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return Float64Type, nil
+}
+
+func (f *PowerFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != Float64Type {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, Float64Type, t)
+	}
+	return nil
+}
+
+func (f *PowerFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	_, err := numericArgsType(PowerFnCall, args, 2, 2, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return Float64Type, nil
+}
+
+func (f *PowerFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+func (f *PowerFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	vals, isNull, err := float64Args(PowerFnCall, params, 2)
+	if err != nil || isNull {
+		return NewNull(Float64Type), err
+	}
+
+	res := math.Pow(vals[0], vals[1])
+	if math.IsNaN(res) || math.IsInf(res, 0) {
+		return nil, fmt.Errorf("%w: '%s' function result is not a finite number", ErrIllegalArguments, PowerFnCall)
+	}
+	return NewFloat64(res), nil
+}
+
+type SqrtFn struct{}
+
+func (f *SqrtFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return Float64Type, nil
+}
+
+func (f *SqrtFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != Float64Type {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, Float64Type, t)
+	}
+	return nil
+}
+
+func (f *SqrtFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	_, err := numericArgsType(SqrtFnCall, args, 1, 1, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return Float64Type, nil
+}
+
+func (f *SqrtFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+func (f *SqrtFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	vals, isNull, err := float64Args(SqrtFnCall, params, 1)
+	if err != nil || isNull {
+		return NewNull(Float64Type), err
+	}
+
+	if vals[0] < 0 {
+		return nil, fmt.Errorf("%w: '%s' function can not be applied on negative numbers", ErrIllegalArguments, SqrtFnCall)
+	}
+	return NewFloat64(math.Sqrt(vals[0])), nil
+}
+
+type ModFn struct{}
+
+func (f *ModFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *ModFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericType(t)
+}
+
+func (f *ModFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return numericArgsType(ModFnCall, args, 2, 2, cols, params, implicitTable)
+}
+
+func (f *ModFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return requireNumericArgsType(t, ModFnCall, args, 2, 2, cols, params, implicitTable)
+}
+
+// Apply returns the remainder of the division, with the sign of the dividend
+func (f *ModFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, ModFnCall, 2, len(params))
+	}
+
+	a, isInt1 := params[0].(*Integer)
+	b, isInt2 := params[1].(*Integer)
+
+	if isInt1 && isInt2 {
+		if b.val == 0 {
+			return nil, ErrDivisionByZero
+		}
+		if b.val == -1 {
+			return NewInteger(0), nil
+		}
+		return NewInteger(a.val % b.val), nil
+	}
+
+	vals, isNull, err := float64Args(ModFnCall, params, 2)
+	if err != nil || isNull {
+		return NewNull(AnyType), err
+	}
+
+	if vals[1] == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return NewFloat64(math.Mod(vals[0], vals[1])), nil
+}
+
+func requireNumericType(t SQLValueType) error {
+	if !IsNumericType(t) {
+		return fmt.Errorf("%w: %v or %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, Float64Type, t)
+	}
+	return nil
+}
+
+// numericArgsType requires numeric arguments and returns the type of the result:
+// a float when any argument is a float, an integer if all of them are integers, AnyType otherwise
+func numericArgsType(fn string, args []ValueExp, minArgs, maxArgs int, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	if len(args) < minArgs || len(args) > maxArgs {
+		return AnyType, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, fn, minArgs, len(args))
+	}
+
+	t, err := commonArgsType(fn, args, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	if t != AnyType && !IsNumericType(t) {
+		return AnyType, fmt.Errorf("%w: '%s' function expects numeric arguments but %s was given", ErrInvalidTypes, fn, t)
+	}
+	return t, nil
+}
+
+func requireNumericArgsType(t SQLValueType, fn string, args []ValueExp, minArgs, maxArgs int, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	err := requireNumericType(t)
+	if err != nil {
+		return err
+	}
+
+	argsType, err := numericArgsType(fn, args, minArgs, maxArgs, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+
+	if argsType == AnyType {
+		return requireArgsType(t, args, cols, params, implicitTable)
+	}
+
+	if argsType != t {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, argsType, t)
+	}
+	return nil
+}
+
+// float64Args converts numeric arguments to floats, isNull is set if any of them is null
+func float64Args(fn string, params []TypedValue, nargs int) (vals []float64, isNull bool, err error) {
+	if len(params) != nargs {
+		return nil, false, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, fn, nargs, len(params))
+	}
+
+	vals = make([]float64, len(params))
+
+	for i, v := range params {
+		switch nv := v.(type) {
+		case *Integer:
+			vals[i] = float64(nv.val)
+		case *Float64:
+			vals[i] = nv.val
+		default:
+			if v.IsNull() {
+				isNull = true
+				continue
+			}
+			_, err := numericArgError(fn, v)
+			return nil, false, err
+		}
+	}
+	return vals, isNull, nil
+}
+
+func numericArgError(fn string, v TypedValue) (TypedValue, error) {
+	if v.IsNull() {
+		return v, nil
+	}
+	return nil, fmt.Errorf("%w: '%s' function expects numeric arguments but %s was given", ErrIllegalArguments, fn, v.Type())
+}
+
+// -------------------------------------
+// Hashing and Encoding Functions
+// -------------------------------------
+
+type MD5Fn struct{}
+
+func (f *MD5Fn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *MD5Fn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *MD5Fn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireBytesArg(MD5FnCall, args, 1, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *MD5Fn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply returns the MD5 digest of a blob or a text, hex encoded
+func (f *MD5Fn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	b, isNull, err := bytesArg(MD5FnCall, params, 1)
+	if err != nil || isNull {
+		return NewNull(VarcharType), err
+	}
+
+	digest := md5.Sum(b)
+	return NewVarchar(hex.EncodeToString(digest[:])), nil
+}
+
+type SHA256Fn struct{}
+
+func (f *SHA256Fn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return BLOBType, nil
+}
+
+func (f *SHA256Fn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BLOBType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BLOBType, t)
+	}
+	return nil
+}
+
+func (f *SHA256Fn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireBytesArg(SHA256FnCall, args, 1, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *SHA256Fn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply returns the SHA-256 digest of a blob or a text
+func (f *SHA256Fn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	b, isNull, err := bytesArg(SHA256FnCall, params, 1)
+	if err != nil || isNull {
+		return NewNull(BLOBType), err
+	}
+
+	digest := sha256.Sum256(b)
+	return NewBlob(digest[:]), nil
+}
+
+type EncodeFn struct{}
+
+func (f *EncodeFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *EncodeFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *EncodeFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireBytesArg(EncodeFnCall, args, 2, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	err = args[1].requiresType(VarcharType, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *EncodeFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply encodes a blob (or a text) as text using the given format: 'base64' or 'hex'
+func (f *EncodeFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	b, isNull, err := bytesArg(EncodeFnCall, params, 2)
+	if err != nil || isNull || params[1].IsNull() {
+		return NewNull(VarcharType), err
+	}
+
+	format, ok := params[1].RawValue().(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s' function expects a format of type %s", ErrIllegalArguments, EncodeFnCall, VarcharType)
+	}
+
+	switch strings.ToLower(format) {
+	case "base64":
+		return NewVarchar(base64.StdEncoding.EncodeToString(b)), nil
+	case "hex":
+		return NewVarchar(hex.EncodeToString(b)), nil
+	}
+	return nil, fmt.Errorf("%w: '%s' function does not support the format '%s'", ErrIllegalArguments, EncodeFnCall, format)
+}
+
+type DecodeFn struct{}
+
+func (f *DecodeFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return BLOBType, nil
+}
+
+func (f *DecodeFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BLOBType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BLOBType, t)
+	}
+	return nil
+}
+
+func (f *DecodeFn) inferTypeOf(args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := requireArgTypes(DecodeFnCall, args, 2, []SQLValueType{VarcharType, VarcharType}, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+	return f.InferType(cols, params, implicitTable)
+}
+
+func (f *DecodeFn) requiresTypeOf(t SQLValueType, args []ValueExp, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	_, err := f.inferTypeOf(args, cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+	return f.RequiresType(t, cols, params, implicitTable)
+}
+
+// Apply decodes a text encoded using the given format: 'base64' or 'hex'
+func (f *DecodeFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	strs, isNull, err := varcharArgs(DecodeFnCall, params, 2, 2)
+	if err != nil || isNull {
+		return NewNull(BLOBType), err
+	}
+
+	var b []byte
+
+	switch strings.ToLower(strs[1]) {
+	case "base64":
+		b, err = base64.StdEncoding.DecodeString(strs[0])
+	case "hex":
+		b, err = hex.DecodeString(strs[0])
+	default:
+		return nil, fmt.Errorf("%w: '%s' function does not support the format '%s'", ErrIllegalArguments, DecodeFnCall, strs[1])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s' function got an invalid %s value", ErrIllegalArguments, DecodeFnCall, strs[1])
+	}
+	return NewBlob(b), nil
+}
+
+// requireBytesArg requires the first argument to be a blob or a text
+func requireBytesArg(fn string, args []ValueExp, nargs int, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if len(args) != nargs {
+		return fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, fn, nargs, len(args))
+	}
+
+	t, err := args[0].inferType(cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
+
+	if t != AnyType && t != BLOBType && t != VarcharType {
+		return fmt.Errorf("%w: '%s' function expects an argument of type %s or %s but %s was given", ErrInvalidTypes, fn, BLOBType, VarcharType, t)
+	}
+	return nil
+}
+
+// bytesArg returns the content of the first argument, which must be a blob or a text
+func bytesArg(fn string, params []TypedValue, nargs int) (b []byte, isNull bool, err error) {
+	if len(params) != nargs {
+		return nil, false, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, fn, nargs, len(params))
+	}
+
+	switch v := params[0].(type) {
+	case *Blob:
+		return v.val, false, nil
+	case *Varchar:
+		return []byte(v.val), false, nil
+	}
+
+	if params[0].IsNull() {
+		return nil, true, nil
+	}
+	return nil, false, fmt.Errorf("%w: '%s' function expects an argument of type %s or %s", ErrIllegalArguments, fn, BLOBType, VarcharType)
+}
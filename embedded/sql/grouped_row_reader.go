@@ -34,6 +34,9 @@ type groupedRowReader struct {
 
 	currRow *Row
 	empty   bool
+
+	memBudget  *memoryBudget
+	currRowMem int // memory held by the row of the current group
 }
 
 func newGroupedRowReader(rowReader RowReader, allAggregations bool, selectors []*AggColSelector, groupBy []*ColSelector) (*groupedRowReader, error) {
@@ -49,6 +52,10 @@ func newGroupedRowReader(rowReader RowReader, allAggregations bool, selectors []
 		allAggregations: allAggregations,
 	}
 
+	if tx := rowReader.Tx(); tx != nil {
+		gr.memBudget = tx.memBudget
+	}
+
 	cols, err := gr.columns()
 	if err == nil {
 		gr.cols = cols
@@ -136,6 +143,9 @@ func (gr *groupedRowReader) colsBySelector(ctx context.Context) (map[string]ColD
 		}
 
 		des.Type = colDesc.Type
+		if aggFn == JSONAGG {
+			des.Type = JSONType
+		}
 		colDescriptors[encSel] = des
 	}
 	return colDescriptors, nil
@@ -195,8 +205,7 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 		gr.empty = false
 
 		if gr.currRow == nil {
-			gr.currRow = row
-			err = gr.initAggregations(gr.currRow)
+			err = gr.setCurrentRow(row)
 			if err != nil {
 				return nil, err
 			}
@@ -210,9 +219,8 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 
 		if !compatible {
 			r := gr.currRow
-			gr.currRow = row
 
-			err = gr.initAggregations(gr.currRow)
+			err = gr.setCurrentRow(row)
 			if err != nil {
 				return nil, err
 			}
@@ -227,6 +235,23 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 	}
 }
 
+// setCurrentRow starts a new group, only the row of the current group is held in memory
+func (gr *groupedRowReader) setCurrentRow(row *Row) error {
+	gr.memBudget.release(gr.currRowMem)
+	gr.currRowMem = 0
+
+	size := rowMemSize(row)
+
+	err := gr.memBudget.consume(size)
+	if err != nil {
+		return err
+	}
+	gr.currRowMem = size
+
+	gr.currRow = row
+	return gr.initAggregations(gr.currRow)
+}
+
 func updateRow(currRow, newRow *Row) error {
 	for _, v := range currRow.ValuesBySelector {
 		aggV, isAggregatedValue := v.(AggregatedValue)
@@ -295,6 +320,8 @@ func (gr *groupedRowReader) zeroRow(ctx context.Context) (*Row, error) {
 		var zero TypedValue
 		if aggFn == COUNT {
 			zero = zeroForType(IntegerType)
+		} else if aggFn == JSONAGG {
+			zero = &NullValue{t: JSONType}
 		} else {
 			zero = zeroForType(colsBySelector[encSel].Type)
 		}
@@ -374,6 +401,12 @@ func initAggValue(aggFn, table, col string) (TypedValue, error) {
 				sel: EncodeSelector("", table, col),
 			}
 		}
+	case JSONAGG:
+		{
+			v = &JSONAggValue{
+				sel: EncodeSelector("", table, col),
+			}
+		}
 	}
 	return v, nil
 }
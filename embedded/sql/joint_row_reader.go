@@ -231,8 +231,13 @@ func (jointr *jointRowReader) Read(ctx context.Context) (row *Row, err error) {
 		for i := len(jointr.rowReaders) - 1; i < len(jointr.joins); i++ {
 			jspec := jointr.joins[i]
 
+			ds := jspec.ds
+			if fnds, ok := ds.(*FnDataSourceStmt); ok {
+				ds = fnds.reduceSelectors(row, jointr.TableAlias())
+			}
+
 			jointq := &SelectStmt{
-				ds:      jspec.ds,
+				ds:      ds,
 				where:   jspec.cond.reduceSelectors(row, jointr.TableAlias()),
 				indexOn: jspec.indexOn,
 			}
@@ -18,6 +18,7 @@ package sql
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -167,6 +168,14 @@ func (v *JSON) String() string {
 	return string(data)
 }
 
+// text returns the value as text, strings are not quoted
+func (v *JSON) text() string {
+	if s, ok := v.val.(string); ok {
+		return s
+	}
+	return v.String()
+}
+
 func (v *JSON) lookup(fields []string) TypedValue {
 	currVal := v.val
 	for i, field := range fields {
@@ -194,9 +203,12 @@ func (v *JSON) lookup(fields []string) TypedValue {
 	return NewJson(currVal)
 }
 
+// JSONSelector selects the value found at a path within a JSON column (col->'a'->'b' or col#>'{a,b}').
+// When text is set, the value is returned as text (col->>'a' or col#>>'{a}').
 type JSONSelector struct {
 	*ColSelector
 	fields []string
+	text   bool
 }
 
 func (sel *JSONSelector) substitute(params map[string]interface{}) (ValueExp, error) {
@@ -209,20 +221,69 @@ func (v *JSONSelector) resolve(implicitTable string) (string, string, string) {
 }
 
 func (v *JSONSelector) String() string {
+	if v.text {
+		return jsonPathColName(v.ColSelector.col, v.fields)
+	}
 	return fmt.Sprintf("%s->'%s'", v.ColSelector.col, strings.Join(v.fields, "->"))
 }
 
+func (sel *JSONSelector) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	t, err := sel.ColSelector.inferType(cols, params, implicitTable)
+	if err != nil || !sel.text {
+		return t, err
+	}
+	return VarcharType, nil
+}
+
+func (sel *JSONSelector) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if !sel.text {
+		return sel.ColSelector.requiresType(t, cols, params, implicitTable)
+	}
+
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+
+	_, err := sel.ColSelector.inferType(cols, params, implicitTable)
+	return err
+}
+
 func (sel *JSONSelector) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
 	val, err := sel.ColSelector.reduce(tx, row, implicitTable)
 	if err != nil {
 		return nil, err
 	}
 
+	if val.IsNull() {
+		return sel.lookup(nil), nil
+	}
+
 	jsonVal, ok := val.(*JSON)
 	if !ok {
 		return val, fmt.Errorf("-> operator cannot be applied on column of type %s", val.Type())
 	}
-	return jsonVal.lookup(sel.fields), nil
+	return sel.lookup(jsonVal), nil
+}
+
+// lookup returns the value found at the path of the selector within the given JSON value
+func (sel *JSONSelector) lookup(v *JSON) TypedValue {
+	if v == nil {
+		if sel.text {
+			return NewNull(VarcharType)
+		}
+		return NewNull(AnyType)
+	}
+
+	val := v.lookup(sel.fields)
+	if !sel.text {
+		return val
+	}
+
+	jsonVal, ok := val.(*JSON)
+	if !ok || jsonVal.val == nil {
+		return NewNull(VarcharType)
+	}
+	return NewVarchar(jsonVal.text())
 }
 
 func (sel *JSONSelector) selectors() []Selector {
@@ -236,5 +297,375 @@ func (sel *JSONSelector) reduceSelectors(row *Row, implicitTable string) ValueEx
 	if !ok {
 		return sel
 	}
-	return jsonVal.lookup(sel.fields)
+	return sel.lookup(jsonVal)
+}
+
+func (sel *JSONSelector) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+// pathRanges narrows the ranges of the column of a JSON path index when the text at the
+// path of the selector is compared with a constant value
+func (sel *JSONSelector) pathRanges(table *Table, asTable string, params map[string]interface{}, op CmpOperator, exp ValueExp, rangesByColID map[uint32]*typedValueRange) error {
+	if !sel.text {
+		return nil
+	}
+
+	aggFn, t, _ := sel.ColSelector.resolve(table.name)
+	if aggFn != "" || t != asTable {
+		return nil
+	}
+
+	col, err := table.getIndexableColumnByName(jsonPathColName(sel.ColSelector.col, sel.fields))
+	if err != nil || col.source == nil {
+		return nil
+	}
+
+	val, err := exp.substitute(params)
+	if errors.Is(err, ErrMissingParameter) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rval, err := val.reduce(nil, nil, table.name)
+	if err != nil {
+		return err
+	}
+
+	if rval.IsNull() || rval.Type() != VarcharType || len(rval.RawValue().(string)) > col.MaxLen() {
+		return nil
+	}
+
+	return updateRangeFor(col.id, rval, op, rangesByColID)
+}
+
+// jsonPathColName is the name of the text found at the given path within a JSON column,
+// as used by the columns of JSON path indexes
+func jsonPathColName(col string, path []string) string {
+	return fmt.Sprintf("%s->>'%s'", col, strings.Join(path, "->"))
+}
+
+// parseJSONPath parses a path given as a text array e.g. '{a,b,0}'
+func parseJSONPath(s string) []string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+
+	if strings.TrimSpace(s) == "" {
+		return []string{}
+	}
+
+	fields := strings.Split(s, ",")
+	for i, f := range fields {
+		fields[i] = strings.Trim(strings.TrimSpace(f), `"`)
+	}
+	return fields
+}
+
+// jsonValueOf returns the representation of the given value within a JSON document
+func jsonValueOf(v TypedValue) interface{} {
+	if v.IsNull() {
+		return nil
+	}
+
+	switch v.Type() {
+	case JSONType:
+		return v.RawValue()
+	case IntegerType, Float64Type, BooleanType, VarcharType:
+		return v.RawValue()
+	}
+	return v.String()
+}
+
+// jsonArgument interprets the given value as a JSON value, texts are parsed as JSON documents
+func jsonArgument(v TypedValue) (*JSON, error) {
+	switch jv := v.(type) {
+	case *JSON:
+		return jv, nil
+	case *Varchar:
+		return NewJsonFromString(jv.val)
+	}
+	return nil, fmt.Errorf("%w: %s can not be interpreted as type %s", ErrInvalidTypes, v.Type(), JSONType)
+}
+
+func jsonNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func jsonEqual(a, b interface{}) bool {
+	if na, ok := jsonNumber(a); ok {
+		nb, ok := jsonNumber(b)
+		return ok && na == nb
+	}
+	return a == b
+}
+
+// jsonContains returns true when the value a contains the value b, as defined by the @> operator:
+// objects contain the objects whose entries they contain, arrays contain the arrays whose elements
+// they contain and scalar values contain only equal values
+func jsonContains(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range bv {
+			e, ok := av[k]
+			if !ok || !jsonContains(e, v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			// an array contains a primitive value when it is one of its elements
+			switch b.(type) {
+			case map[string]interface{}:
+				return false
+			}
+			bv = []interface{}{b}
+		}
+
+		for _, v := range bv {
+			found := false
+			for _, e := range av {
+				if jsonContains(e, v) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch b.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return jsonEqual(a, b)
+}
+
+// jsonHasKey returns true when the key is a key of an object, a string element of an array or the value of a string
+func jsonHasKey(v interface{}, key string) bool {
+	switch jv := v.(type) {
+	case map[string]interface{}:
+		_, ok := jv[key]
+		return ok
+	case []interface{}:
+		for _, e := range jv {
+			if s, ok := e.(string); ok && s == key {
+				return true
+			}
+		}
+		return false
+	case string:
+		return jv == key
+	}
+	return false
+}
+
+// jsonSet returns a copy of the value v with the value at path replaced by newVal.
+// When createMissing is set, the last field of the path is added if it does not exist.
+// The value is returned unchanged if any other field of the path does not exist.
+func jsonSet(v interface{}, path []string, newVal interface{}, createMissing bool) interface{} {
+	if len(path) == 0 {
+		return newVal
+	}
+
+	switch jv := v.(type) {
+	case map[string]interface{}:
+		curr, exists := jv[path[0]]
+		if !exists && (len(path) > 1 || !createMissing) {
+			return v
+		}
+
+		obj := make(map[string]interface{}, len(jv)+1)
+		for k, e := range jv {
+			obj[k] = e
+		}
+		obj[path[0]] = jsonSet(curr, path[1:], newVal, createMissing)
+
+		return obj
+	case []interface{}:
+		idx, err := strconv.Atoi(path[0])
+		if err != nil {
+			return v
+		}
+
+		if idx < 0 {
+			idx += len(jv)
+		}
+
+		arr := make([]interface{}, len(jv), len(jv)+1)
+		copy(arr, jv)
+
+		if idx >= 0 && idx < len(jv) {
+			arr[idx] = jsonSet(jv[idx], path[1:], newVal, createMissing)
+			return arr
+		}
+
+		if len(path) > 1 || !createMissing {
+			return v
+		}
+
+		if idx < 0 {
+			return append([]interface{}{newVal}, arr...)
+		}
+		return append(arr, newVal)
+	}
+	return v
+}
+
+type JSONOperator = int
+
+const (
+	JSONContains JSONOperator = iota
+	JSONHasKey
+)
+
+func JSONOperatorToString(op JSONOperator) string {
+	switch op {
+	case JSONContains:
+		return "@>"
+	case JSONHasKey:
+		return "?"
+	}
+	return ""
+}
+
+// JSONBoolExp evaluates the containment (@>) and key existence (?) operators over JSON values
+type JSONBoolExp struct {
+	op          JSONOperator
+	left, right ValueExp
+}
+
+func NewJSONBoolExp(op JSONOperator, left, right ValueExp) *JSONBoolExp {
+	return &JSONBoolExp{
+		op:    op,
+		left:  left,
+		right: right,
+	}
+}
+
+func (bexp *JSONBoolExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	err := bexp.left.requiresType(JSONType, cols, params, implicitTable)
+	if err != nil {
+		return AnyType, fmt.Errorf("error in '%s' operator: %w", JSONOperatorToString(bexp.op), err)
+	}
+
+	t, err := bexp.right.inferType(cols, params, implicitTable)
+	if err != nil {
+		return AnyType, fmt.Errorf("error in '%s' operator: %w", JSONOperatorToString(bexp.op), err)
+	}
+
+	if t == AnyType {
+		t = VarcharType
+
+		err = bexp.right.requiresType(t, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, fmt.Errorf("error in '%s' operator: %w", JSONOperatorToString(bexp.op), err)
+		}
+	}
+
+	if t != VarcharType && (bexp.op == JSONHasKey || t != JSONType) {
+		return AnyType, fmt.Errorf("error in '%s' operator: %w", JSONOperatorToString(bexp.op), ErrInvalidTypes)
+	}
+
+	return BooleanType, nil
+}
+
+func (bexp *JSONBoolExp) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BooleanType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
+	}
+
+	_, err := bexp.inferType(cols, params, implicitTable)
+	return err
+}
+
+func (bexp *JSONBoolExp) substitute(params map[string]interface{}) (ValueExp, error) {
+	left, err := bexp.left.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := bexp.right.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONBoolExp{op: bexp.op, left: left, right: right}, nil
+}
+
+func (bexp *JSONBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	left, err := bexp.left.reduce(tx, row, implicitTable)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := bexp.right.reduce(tx, row, implicitTable)
+	if err != nil {
+		return nil, err
+	}
+
+	if left.IsNull() || right.IsNull() {
+		return &Bool{val: false}, nil
+	}
+
+	jsonVal, ok := left.(*JSON)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s' operator cannot be applied on values of type %s", ErrInvalidTypes, JSONOperatorToString(bexp.op), left.Type())
+	}
+
+	if bexp.op == JSONHasKey {
+		key, ok := right.RawValue().(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s' operator expects a key of type %s", ErrInvalidTypes, JSONOperatorToString(bexp.op), VarcharType)
+		}
+		return &Bool{val: jsonHasKey(jsonVal.val, key)}, nil
+	}
+
+	rval, err := jsonArgument(right)
+	if err != nil {
+		return nil, err
+	}
+	return &Bool{val: jsonContains(jsonVal.val, rval.val)}, nil
+}
+
+func (bexp *JSONBoolExp) selectors() []Selector {
+	return append(bexp.left.selectors(), bexp.right.selectors()...)
+}
+
+func (bexp *JSONBoolExp) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return &JSONBoolExp{
+		op:    bexp.op,
+		left:  bexp.left.reduceSelectors(row, implicitTable),
+		right: bexp.right.reduceSelectors(row, implicitTable),
+	}
+}
+
+func (bexp *JSONBoolExp) isConstant() bool {
+	return false
+}
+
+func (bexp *JSONBoolExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (bexp *JSONBoolExp) String() string {
+	return fmt.Sprintf("(%s %s %s)", bexp.left.String(), JSONOperatorToString(bexp.op), bexp.right.String())
 }
@@ -0,0 +1,1006 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+const (
+	viewIncrementalFlag = 1 << iota
+)
+
+// MaterializedView holds the definition of a table whose rows are the result of a query.
+// The rows are stored as regular table rows, thus they can be verified and queried with history,
+// but they are only written when the view is refreshed.
+type MaterializedView struct {
+	query       string
+	selectStmt  *SelectStmt
+	incremental bool
+
+	// id of the last transaction taken into account when the view was refreshed
+	refreshedAt uint64
+}
+
+// Query returns the text of the query defining the view
+func (v *MaterializedView) Query() string {
+	return v.query
+}
+
+// IsIncremental returns true if the view is refreshed by applying the changes
+// committed since its last refresh instead of evaluating the query again
+func (v *MaterializedView) IsIncremental() bool {
+	return v.incremental
+}
+
+// RefreshedAt returns the id of the last transaction taken into account by the view
+func (v *MaterializedView) RefreshedAt() uint64 {
+	return v.refreshedAt
+}
+
+// MaterializedView returns the definition of the view whose rows are held by the table,
+// nil is returned for regular tables
+func (t *Table) MaterializedView() *MaterializedView {
+	return t.view
+}
+
+// requireRegularTable returns an error if the table holds the rows of a materialized view,
+// which can only be modified by refreshing the view
+func requireRegularTable(table *Table) error {
+	if table.view != nil {
+		return fmt.Errorf("%w: '%s'", ErrMaterializedViewIsReadOnly, table.name)
+	}
+	return nil
+}
+
+// CreateMaterializedViewStmt represents a statement to create a table holding the result of a query
+type CreateMaterializedViewStmt struct {
+	view        string
+	ifNotExists bool
+	incremental bool
+	query       string
+	selectStmt  *SelectStmt
+}
+
+func (stmt *CreateMaterializedViewStmt) setCapturedText(text string) {
+	stmt.query = text
+}
+
+func (stmt *CreateMaterializedViewStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateMaterializedViewStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate, SQLPrivilegeSelect}
+}
+
+func (stmt *CreateMaterializedViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateMaterializedViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	viewName, err := tx.catalog.qualifyNewTableName(stmt.view)
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.ifNotExists && tx.catalog.ExistTable(viewName) {
+		return tx, nil
+	}
+
+	if stmt.query == "" || stmt.selectStmt == nil {
+		return nil, fmt.Errorf("%w: query not specified", ErrInvalidMaterializedView)
+	}
+
+	var iv *incrementalView
+
+	if stmt.incremental {
+		iv, err = newIncrementalView(tx, stmt.selectStmt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rowReader, err := stmt.selectStmt.Resolve(ctx, tx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rowReader.Close()
+
+	cols, err := rowReader.Columns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	colsSpec, pkColNames, err := viewColsSpec(tx, stmt.selectStmt, cols)
+	if err != nil {
+		return nil, err
+	}
+
+	createTableStmt := &CreateTableStmt{
+		table:      stmt.view,
+		colsSpec:   colsSpec,
+		pkColNames: pkColNames,
+	}
+
+	_, err = createTableStmt.execAt(ctx, tx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := tx.catalog.GetTableByName(viewName)
+	if err != nil {
+		return nil, err
+	}
+
+	table.view = &MaterializedView{
+		query:       stmt.query,
+		selectStmt:  stmt.selectStmt,
+		incremental: stmt.incremental,
+	}
+
+	// the table has just been created, so its rows are written without looking for existing ones
+	if stmt.incremental {
+		table.view.refreshedAt, err = iv.refresh(ctx, tx, table, 0, true)
+	} else {
+		table.view.refreshedAt = tx.engine.store.LastCommittedTxID()
+		err = tx.refreshViewRows(ctx, table, rowReader, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistView(tx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// viewColsSpec returns the specification of the columns of the table holding the rows of the view.
+// The primary key is made of the grouping columns or, if the query is not grouped,
+// of the primary key columns of the queried table.
+func viewColsSpec(tx *SQLTx, stmt *SelectStmt, cols []ColDescriptor) ([]*ColSpec, []string, error) {
+	pkPositions, err := inferredPrimaryKeyPositions(tx, stmt, cols, ErrInvalidMaterializedView)
+	if err != nil {
+		return nil, nil, err
+	}
+	return queryColsSpec(tx, stmt, cols, pkPositions, ErrInvalidMaterializedView)
+}
+
+// queryColsSpec returns the specification of the columns of a table holding the rows returned by the query,
+// the columns at the given positions being the primary key of the table.
+// Errors are reported wrapping invalidErr.
+func queryColsSpec(tx *SQLTx, stmt *SelectStmt, cols []ColDescriptor, pkPositions []int, invalidErr error) ([]*ColSpec, []string, error) {
+	colsSpec := make([]*ColSpec, len(cols))
+
+	for i, col := range cols {
+		if col.Type == AnyType {
+			return nil, nil, fmt.Errorf("%w: the type of column '%s' can not be inferred", invalidErr, col.Column)
+		}
+
+		colsSpec[i] = &ColSpec{
+			colName: col.Column,
+			colType: col.Type,
+		}
+	}
+
+	pkColNames := make([]string, len(pkPositions))
+
+	for i, pos := range pkPositions {
+		spec := colsSpec[pos]
+
+		spec.notNull = true
+
+		if variableSizedType(spec.colType) {
+			spec.maxLen = MaxKeyLen / len(pkPositions)
+
+			if srcCol := sourceColumn(tx, stmt, pos, cols); srcCol != nil && srcCol.MaxLen() > 0 {
+				spec.maxLen = srcCol.MaxLen()
+			}
+		}
+
+		pkColNames[i] = spec.colName
+	}
+
+	return colsSpec, pkColNames, nil
+}
+
+// inferredPrimaryKeyPositions returns the positions of the columns identifying the rows returned by the query:
+// the grouping columns or, if the query is not grouped, the primary key columns of the queried table.
+func inferredPrimaryKeyPositions(tx *SQLTx, stmt *SelectStmt, cols []ColDescriptor, invalidErr error) ([]int, error) {
+	var positions []int
+
+	if len(stmt.groupBy) > 0 {
+		for _, sel := range stmt.groupBy {
+			pos := targetPosition(stmt, sel)
+			if pos < 0 {
+				return nil, fmt.Errorf("%w: grouping column '%s' must be selected", invalidErr, sel.col)
+			}
+			positions = append(positions, pos)
+		}
+		return positions, nil
+	}
+
+	ref, isTableRef := stmt.ds.(*tableRef)
+	if !isTableRef || len(stmt.joins) > 0 {
+		return nil, fmt.Errorf("%w: the query must either be grouped or select the primary key of a single table", invalidErr)
+	}
+
+	table, err := ref.referencedTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkCol := range table.primaryIndex.cols {
+		pos := -1
+
+		if len(stmt.targets) == 0 {
+			for i, col := range cols {
+				if col.Column == pkCol.colName {
+					pos = i
+					break
+				}
+			}
+		} else {
+			pos = targetPosition(stmt, &ColSelector{table: ref.Alias(), col: pkCol.colName})
+		}
+
+		if pos < 0 {
+			return nil, fmt.Errorf("%w: primary key column '%s' must be selected", invalidErr, pkCol.colName)
+		}
+		positions = append(positions, pos)
+	}
+
+	return positions, nil
+}
+
+// targetPosition returns the position of the target selecting the column, or -1 if it's not selected
+func targetPosition(stmt *SelectStmt, sel *ColSelector) int {
+	for i, t := range stmt.targets {
+		colSel, ok := t.Exp.(*ColSelector)
+		if !ok || colSel.col != sel.col {
+			continue
+		}
+
+		if colSel.table == "" || sel.table == "" || colSel.table == sel.table {
+			return i
+		}
+	}
+	return -1
+}
+
+// sourceColumn returns the column of a queried table selected at the given position, if any
+func sourceColumn(tx *SQLTx, stmt *SelectStmt, pos int, cols []ColDescriptor) *Column {
+	refs := make([]*tableRef, 0, 1+len(stmt.joins))
+
+	if ref, ok := stmt.ds.(*tableRef); ok {
+		refs = append(refs, ref)
+	}
+
+	for _, join := range stmt.joins {
+		if ref, ok := join.ds.(*tableRef); ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	alias, colName := "", cols[pos].Column
+
+	if len(stmt.targets) > 0 {
+		sel, ok := stmt.targets[pos].Exp.(*ColSelector)
+		if !ok {
+			return nil
+		}
+		alias, colName = sel.table, sel.col
+	}
+
+	for _, ref := range refs {
+		if alias != "" && alias != ref.Alias() {
+			continue
+		}
+
+		if alias == "" && len(refs) > 1 {
+			return nil
+		}
+
+		table, err := ref.referencedTable(tx)
+		if err != nil {
+			return nil
+		}
+
+		col, err := table.GetColumnByName(colName)
+		if err != nil {
+			return nil
+		}
+		return col
+	}
+
+	return nil
+}
+
+// refreshViewRows writes the rows read from the view query into the table of the view,
+// updating the rows whose values changed and deleting those no longer returned by the query
+func (tx *SQLTx) refreshViewRows(ctx context.Context, table *Table, rowReader RowReader, isNew bool) error {
+	cols, err := rowReader.Columns(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(cols) != len(table.cols) {
+		return fmt.Errorf("%w: the columns of the query no longer match the columns of view '%s'", ErrInvalidMaterializedView, table.name)
+	}
+
+	for i, col := range cols {
+		if col.Type != table.cols[i].colType {
+			return fmt.Errorf("%w: the columns of the query no longer match the columns of view '%s'", ErrInvalidMaterializedView, table.name)
+		}
+	}
+
+	pks, rows, err := tx.readRowsByPK(ctx, table, rowReader)
+	if errors.Is(err, store.ErrKeyAlreadyExists) {
+		return fmt.Errorf("%w: the query returned duplicated rows for the primary key of view '%s'", ErrInvalidMaterializedView, table.name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isNew {
+		deleted, err := tx.diffViewRows(ctx, table, rows)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range deleted {
+			pkEncVals, err := encodedKey(table.primaryIndex, row)
+			if err != nil {
+				return err
+			}
+
+			err = tx.deleteIndexEntries(pkEncVals, row, table)
+			if err != nil {
+				return err
+			}
+
+			tx.updatedRows++
+		}
+	}
+
+	for _, pk := range pks {
+		valuesByColID, changed := rows[pk]
+		if !changed {
+			continue
+		}
+
+		err := tx.doUpsert(ctx, []byte(pk), valuesByColID, table, !isNew)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readRowsByPK reads the rows to be written into the table, which must have the same columns as the reader.
+// The encoded primary keys are returned in reading order along with the values of each row,
+// store.ErrKeyAlreadyExists is returned if the primary key of two rows is the same.
+func (tx *SQLTx) readRowsByPK(ctx context.Context, table *Table, rowReader RowReader) ([]string, map[string]map[uint32]TypedValue, error) {
+	var pks []string
+	rows := make(map[string]map[uint32]TypedValue)
+
+	for {
+		row, err := rowReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		valuesByColID := make(map[uint32]TypedValue, len(table.cols))
+		for i, col := range table.cols {
+			valuesByColID[col.id] = row.ValuesByPosition[i]
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, exists := rows[string(pkEncVals)]; exists {
+			return nil, nil, store.ErrKeyAlreadyExists
+		}
+
+		err = tx.memBudget.consume(rowMemOverhead + len(pkEncVals))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pks = append(pks, string(pkEncVals))
+		rows[string(pkEncVals)] = valuesByColID
+	}
+
+	return pks, rows, nil
+}
+
+// diffViewRows compares the stored rows of the view with the refreshed ones.
+// Refreshed rows equal to the stored ones are removed from the map and
+// the stored rows not included in the refreshed ones are returned.
+func (tx *SQLTx) diffViewRows(ctx context.Context, table *Table, rows map[string]map[uint32]TypedValue) ([]map[uint32]TypedValue, error) {
+	rowReader, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if err != nil {
+		return nil, err
+	}
+	defer rowReader.Close()
+
+	var deleted []map[uint32]TypedValue
+
+	for {
+		row, err := rowReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		currValuesByColID := make(map[uint32]TypedValue, len(table.cols))
+		for _, col := range table.cols {
+			currValuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, currValuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
+		valuesByColID, ok := rows[string(pkEncVals)]
+		if !ok {
+			deleted = append(deleted, currValuesByColID)
+			continue
+		}
+
+		currValue, err := tx.encodeRowValue(currValuesByColID, table)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := tx.encodeRowValue(valuesByColID, table)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(currValue, value) {
+			delete(rows, string(pkEncVals))
+		}
+	}
+
+	return deleted, nil
+}
+
+// incrementalView maintains the rows of a view grouping the rows of a single table,
+// by applying the changes made to the rows of the table since the last refresh.
+// Only the grouping columns, COUNT and SUM aggregations can be selected,
+// and COUNT(*) is required to detect when a group becomes empty.
+type incrementalView struct {
+	source   *Table
+	alias    string
+	where    ValueExp
+	groupBy  []*ColSelector
+	targets  []TargetEntry
+	countPos int
+}
+
+func newIncrementalView(tx *SQLTx, stmt *SelectStmt) (*incrementalView, error) {
+	ref, isTableRef := stmt.ds.(*tableRef)
+	if !isTableRef || ref.history || ref.period.start != nil || ref.period.end != nil || len(stmt.joins) > 0 {
+		return nil, fmt.Errorf("%w: a single table must be queried", ErrUnsupportedIncrementalRefresh)
+	}
+
+	if len(stmt.groupBy) == 0 {
+		return nil, fmt.Errorf("%w: the query must be grouped", ErrUnsupportedIncrementalRefresh)
+	}
+
+	if stmt.distinct || stmt.having != nil || stmt.limit != nil || stmt.offset != nil {
+		return nil, fmt.Errorf("%w: DISTINCT, HAVING, LIMIT and OFFSET clauses are not supported", ErrUnsupportedIncrementalRefresh)
+	}
+
+	source, err := ref.referencedTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := &incrementalView{
+		source:   source,
+		alias:    ref.Alias(),
+		groupBy:  stmt.groupBy,
+		targets:  stmt.targets,
+		countPos: -1,
+	}
+
+	for i, t := range stmt.targets {
+		switch e := t.Exp.(type) {
+		case *ColSelector:
+			if groupPosition(stmt.groupBy, e) < 0 {
+				return nil, fmt.Errorf("%w: column '%s' %v", ErrInvalidMaterializedView, e.col, ErrColumnMustAppearInGroupByOrAggregation)
+			}
+		case *AggColSelector:
+			if e.aggFn == COUNT && e.col == "*" {
+				iv.countPos = i
+				continue
+			}
+
+			if e.aggFn != COUNT && e.aggFn != SUM {
+				return nil, fmt.Errorf("%w: %s can not be incrementally maintained", ErrUnsupportedIncrementalRefresh, e.aggFn)
+			}
+
+			col, err := source.GetColumnByName(e.col)
+			if err != nil {
+				return nil, err
+			}
+
+			if e.aggFn == SUM && col.IsNullable() {
+				return nil, fmt.Errorf("%w: SUM(%s) requires a NOT NULL column", ErrUnsupportedIncrementalRefresh, e.col)
+			}
+		default:
+			return nil, fmt.Errorf("%w: only grouping columns, COUNT and SUM can be selected", ErrUnsupportedIncrementalRefresh)
+		}
+	}
+
+	if iv.countPos < 0 {
+		return nil, fmt.Errorf("%w: COUNT(*) must be selected", ErrUnsupportedIncrementalRefresh)
+	}
+
+	if stmt.where != nil {
+		iv.where, err = stmt.where.substitute(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return iv, nil
+}
+
+func groupPosition(groupBy []*ColSelector, sel *ColSelector) int {
+	for i, g := range groupBy {
+		if g.col == sel.col && (g.table == "" || sel.table == "" || g.table == sel.table) {
+			return i
+		}
+	}
+	return -1
+}
+
+// groupDelta accumulates the changes to the aggregations of a group of the view
+type groupDelta struct {
+	valuesByColID map[uint32]TypedValue
+	deltas        []TypedValue
+}
+
+// refresh applies the changes committed after the transaction fromTxID to the rows of the view,
+// returning the id of the last transaction taken into account
+func (iv *incrementalView) refresh(ctx context.Context, tx *SQLTx, table *Table, fromTxID uint64, isNew bool) (uint64, error) {
+	lastTxID := tx.engine.store.LastCommittedTxID()
+	if fromTxID >= lastTxID {
+		return fromTxID, nil
+	}
+
+	changesReader := newChangesRowReader(tx, nil, iv.source, period{}, iv.alias)
+	changesReader.txRange = &txRange{initialTxID: fromTxID + 1, finalTxID: lastTxID}
+
+	defer changesReader.Close()
+
+	var pks []string
+	groups := make(map[string]*groupDelta)
+
+	nCols := len(iv.source.cols)
+
+	for {
+		row, err := changesReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		op := row.ValuesByPosition[0].RawValue()
+
+		if op != insertOp {
+			before := iv.sourceRow(row.ValuesByPosition[changesExtraCols+nCols : changesExtraCols+2*nCols])
+
+			pks, err = iv.accumulate(tx, table, groups, pks, before, SUBSOP)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if op != deleteOp {
+			after := iv.sourceRow(row.ValuesByPosition[changesExtraCols : changesExtraCols+nCols])
+
+			pks, err = iv.accumulate(tx, table, groups, pks, after, ADDOP)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for _, pk := range pks {
+		err := iv.applyDelta(ctx, tx, table, []byte(pk), groups[pk], isNew)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return lastTxID, nil
+}
+
+func (iv *incrementalView) sourceRow(values []TypedValue) *Row {
+	row := &Row{
+		ValuesByPosition: values,
+		ValuesBySelector: make(map[string]TypedValue, len(values)),
+	}
+
+	for i, col := range iv.source.cols {
+		row.ValuesBySelector[EncodeSelector("", iv.alias, col.colName)] = values[i]
+	}
+	return row
+}
+
+// accumulate adds (or subtracts) the contribution of a row of the source table to its group
+func (iv *incrementalView) accumulate(tx *SQLTx, table *Table, groups map[string]*groupDelta, pks []string, row *Row, op NumOperator) ([]string, error) {
+	if iv.where != nil {
+		cond, err := iv.where.reduce(tx, row, iv.alias)
+		if err != nil {
+			return nil, err
+		}
+
+		if cond.IsNull() || cond.RawValue() != true {
+			return pks, nil
+		}
+	}
+
+	groupValues := make(map[uint32]TypedValue, len(iv.groupBy))
+
+	for i, t := range iv.targets {
+		sel, isColSel := t.Exp.(*ColSelector)
+		if !isColSel {
+			continue
+		}
+
+		val, err := sel.reduce(tx, row, iv.alias)
+		if err != nil {
+			return nil, err
+		}
+		groupValues[table.cols[i].id] = val
+	}
+
+	pkEncVals, err := encodedKey(table.primaryIndex, groupValues)
+	if err != nil {
+		return nil, err
+	}
+
+	group, exists := groups[string(pkEncVals)]
+	if !exists {
+		err := tx.memBudget.consume(rowMemOverhead + len(pkEncVals))
+		if err != nil {
+			return nil, err
+		}
+
+		group = &groupDelta{
+			valuesByColID: groupValues,
+			deltas:        make([]TypedValue, len(iv.targets)),
+		}
+
+		for i, t := range iv.targets {
+			if _, isAgg := t.Exp.(*AggColSelector); isAgg {
+				group.deltas[i] = zeroForType(table.cols[i].colType)
+			}
+		}
+
+		groups[string(pkEncVals)] = group
+		pks = append(pks, string(pkEncVals))
+	}
+
+	for i, t := range iv.targets {
+		sel, isAgg := t.Exp.(*AggColSelector)
+		if !isAgg {
+			continue
+		}
+
+		var val TypedValue = &Integer{val: 1}
+
+		if sel.col != "*" {
+			v, err := (&ColSelector{table: sel.table, col: sel.col}).reduce(tx, row, iv.alias)
+			if err != nil {
+				return nil, err
+			}
+
+			if v.IsNull() {
+				continue
+			}
+
+			if sel.aggFn == SUM {
+				val = v
+			}
+		}
+
+		group.deltas[i], err = applyNumOperator(op, group.deltas[i], val)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pks, nil
+}
+
+// applyDelta updates the row of the group, which is deleted once it no longer counts any row
+func (iv *incrementalView) applyDelta(ctx context.Context, tx *SQLTx, table *Table, pkEncVals []byte, group *groupDelta, isNew bool) error {
+	var currValuesByColID map[uint32]TypedValue
+
+	if !isNew {
+		row, err := tx.fetchPKRow(ctx, table, group.valuesByColID)
+		if err != nil && !errors.Is(err, ErrNoMoreRows) {
+			return err
+		}
+
+		if err == nil {
+			currValuesByColID = make(map[uint32]TypedValue, len(table.cols))
+
+			for _, col := range table.cols {
+				currValuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+			}
+		}
+	}
+
+	valuesByColID := make(map[uint32]TypedValue, len(table.cols))
+	changed := false
+
+	for i, col := range table.cols {
+		if group.deltas[i] == nil {
+			valuesByColID[col.id] = group.valuesByColID[col.id]
+			continue
+		}
+
+		curr := zeroForType(col.colType)
+		if v, ok := currValuesByColID[col.id]; ok && !v.IsNull() {
+			curr = v
+		}
+
+		val, err := applyNumOperator(ADDOP, curr, group.deltas[i])
+		if err != nil {
+			return err
+		}
+
+		if cmp, err := val.Compare(curr); err != nil || cmp != 0 {
+			changed = true
+		}
+
+		valuesByColID[col.id] = val
+	}
+
+	count := valuesByColID[table.cols[iv.countPos].id].RawValue().(int64)
+
+	if count <= 0 {
+		if currValuesByColID == nil {
+			return nil
+		}
+
+		tx.updatedRows++
+
+		return tx.deleteIndexEntries(pkEncVals, currValuesByColID, table)
+	}
+
+	if currValuesByColID != nil && !changed {
+		return nil
+	}
+
+	return tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !isNew)
+}
+
+// RefreshMaterializedViewStmt represents a statement to update the rows of a materialized view
+type RefreshMaterializedViewStmt struct {
+	view string
+}
+
+func (stmt *RefreshMaterializedViewStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *RefreshMaterializedViewStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeAlter, SQLPrivilegeSelect}
+}
+
+func (stmt *RefreshMaterializedViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *RefreshMaterializedViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := lookupMaterializedView(tx, stmt.view)
+	if err != nil {
+		return nil, err
+	}
+
+	view := table.view
+
+	if view.incremental {
+		iv, err := newIncrementalView(tx, view.selectStmt)
+		if err != nil {
+			return nil, err
+		}
+
+		view.refreshedAt, err = iv.refresh(ctx, tx, table, view.refreshedAt, false)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rowReader, err := view.selectStmt.Resolve(ctx, tx, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer rowReader.Close()
+
+		view.refreshedAt = tx.engine.store.LastCommittedTxID()
+
+		err = tx.refreshViewRows(ctx, table, rowReader, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = persistView(tx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// DropMaterializedViewStmt represents a statement to delete a materialized view
+type DropMaterializedViewStmt struct {
+	view string
+}
+
+func (stmt *DropMaterializedViewStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropMaterializedViewStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropMaterializedViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropMaterializedViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := lookupMaterializedView(tx, stmt.view)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dropTable(ctx, tx, table, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func lookupMaterializedView(tx *SQLTx, name string) (*Table, error) {
+	table, err := tx.catalog.GetTableByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.view == nil {
+		return nil, fmt.Errorf("%w: '%s'", ErrNotMaterializedView, table.name)
+	}
+	return table, nil
+}
+
+func persistView(tx *SQLTx, table *Table) error {
+	key := MapKey(tx.sqlPrefix(), catalogViewPrefix, EncodeID(DatabaseID), EncodeID(table.id))
+
+	// {flags}{refreshedAt}{query}
+	value := make([]byte, 1+8+len(table.view.query))
+
+	if table.view.incremental {
+		value[0] |= viewIncrementalFlag
+	}
+
+	binary.BigEndian.PutUint64(value[1:], table.view.refreshedAt)
+	copy(value[9:], table.view.query)
+
+	return tx.set(key, nil, value)
+}
+
+func decodeView(value []byte) (*MaterializedView, error) {
+	if len(value) < 9 {
+		return nil, ErrCorruptedData
+	}
+
+	view := &MaterializedView{
+		incremental: value[0]&viewIncrementalFlag != 0,
+		refreshedAt: binary.BigEndian.Uint64(value[1:]),
+		query:       string(value[9:]),
+	}
+
+	stmts, err := ParseSQLString(view.query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) != 1 {
+		return nil, ErrCorruptedData
+	}
+
+	selectStmt, ok := stmts[0].(*SelectStmt)
+	if !ok {
+		return nil, ErrCorruptedData
+	}
+
+	view.selectStmt = selectStmt
+
+	return view, nil
+}
+
+func (catlg *Catalog) loadViews(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogViewPrefix, EncodeID(DatabaseID))
+
+	return catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		encID, err := trimPrefix(catlg.enginePrefix, key, []byte(catalogViewPrefix))
+		if err != nil {
+			return err
+		}
+
+		if len(encID) != EncIDLen*2 || binary.BigEndian.Uint32(encID) != DatabaseID {
+			return ErrCorruptedData
+		}
+
+		tableID := binary.BigEndian.Uint32(encID[EncIDLen:])
+
+		table, exists := catlg.tablesByID[tableID]
+		if !exists {
+			return nil
+		}
+
+		view, err := decodeView(value)
+		if err != nil {
+			return fmt.Errorf("%w: invalid definition of materialized view '%s'", err, table.name)
+		}
+		table.view = view
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sql
+
+import "fmt"
+
+const (
+	rowMemOverhead   = 64 // row struct and maps
+	valueMemOverhead = 32 // value wrapper and selector entry
+)
+
+// memoryBudget keeps track of the memory and temporary disk space held by a single statement
+type memoryBudget struct {
+	limit int64
+	used  int64
+}
+
+// newMemoryBudget returns nil when there is no limit, all the operations on a nil budget are no-ops
+func newMemoryBudget(limit int64) *memoryBudget {
+	if limit <= 0 {
+		return nil
+	}
+	return &memoryBudget{limit: limit}
+}
+
+func (b *memoryBudget) consume(n int) error {
+	if b == nil {
+		return nil
+	}
+
+	b.used += int64(n)
+
+	if b.used > b.limit {
+		return fmt.Errorf("%w: statement exceeded the limit of %d bytes", ErrQueryMemoryLimitExceeded, b.limit)
+	}
+	return nil
+}
+
+func (b *memoryBudget) release(n int) {
+	if b == nil {
+		return
+	}
+	b.used -= int64(n)
+}
+
+// rowMemSize estimates the amount of memory held by a row
+func rowMemSize(r *Row) int {
+	size := rowMemOverhead
+
+	for _, v := range r.ValuesByPosition {
+		size += valueMemOverhead
+
+		switch rv := v.RawValue().(type) {
+		case string:
+			size += len(rv)
+		case []byte:
+			size += len(rv)
+		default:
+			size += 8
+		}
+	}
+	return size
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// ---- Statement cache ---------------------------------------
+
+	metricsStmtCacheEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_sql_stmt_cache_events",
+		Help: "Immudb SQL statement cache event counters",
+	}, []string{"event"})
+
+	metricsStmtCacheEvicted = metricsStmtCacheEvents.WithLabelValues("evicted")
+	metricsStmtCacheHit     = metricsStmtCacheEvents.WithLabelValues("hit")
+	metricsStmtCacheMiss    = metricsStmtCacheEvents.WithLabelValues("miss")
+)
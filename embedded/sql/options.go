@@ -18,13 +18,16 @@ package sql
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
 )
 
 const (
-	defaultDistinctLimit  = 1 << 20 // ~ 1mi rows
-	defaultSortBufferSize = 1024
+	defaultDistinctLimit   = 1 << 20 // ~ 1mi rows
+	defaultSortBufferSize  = 1024
+	defaultStmtCacheSize   = 1000
+	defaultMaxTriggerDepth = 16
 )
 
 type Options struct {
@@ -34,6 +37,11 @@ type Options struct {
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
 	parseTxMetadata               func([]byte) (map[string]interface{}, error)
+	txUserMetadataKey             string
+	statementTimeout              time.Duration
+	queryMemoryLimit              int64
+	stmtCacheSize                 int
+	maxTriggerDepth               int
 
 	multidbHandler MultiDBHandler
 	tableResolvers []TableResolver
@@ -41,8 +49,10 @@ type Options struct {
 
 func DefaultOptions() *Options {
 	return &Options{
-		sortBufferSize: defaultSortBufferSize,
-		distinctLimit:  defaultDistinctLimit,
+		sortBufferSize:  defaultSortBufferSize,
+		distinctLimit:   defaultDistinctLimit,
+		stmtCacheSize:   defaultStmtCacheSize,
+		maxTriggerDepth: defaultMaxTriggerDepth,
 	}
 }
 
@@ -59,6 +69,22 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid SortBufferSize value", store.ErrInvalidOptions)
 	}
 
+	if opts.statementTimeout < 0 {
+		return fmt.Errorf("%w: invalid StatementTimeout value", store.ErrInvalidOptions)
+	}
+
+	if opts.queryMemoryLimit < 0 {
+		return fmt.Errorf("%w: invalid QueryMemoryLimit value", store.ErrInvalidOptions)
+	}
+
+	if opts.stmtCacheSize < 0 {
+		return fmt.Errorf("%w: invalid StmtCacheSize value", store.ErrInvalidOptions)
+	}
+
+	if opts.maxTriggerDepth <= 0 {
+		return fmt.Errorf("%w: invalid MaxTriggerDepth value", store.ErrInvalidOptions)
+	}
+
 	return nil
 }
 
@@ -95,11 +121,49 @@ func (opts *Options) WithSortBufferSize(size int) *Options {
 	return opts
 }
 
+// WithStatementTimeout sets the maximum amount of time a statement may run before being aborted.
+// A value of zero, the default, disables the timeout. It may be overridden on each transaction.
+func (opts *Options) WithStatementTimeout(timeout time.Duration) *Options {
+	opts.statementTimeout = timeout
+	return opts
+}
+
+// WithQueryMemoryLimit sets the maximum number of bytes a single statement may hold in memory
+// or spill to temporary files when sorting rows, grouping them or discarding duplicates.
+// A value of zero, the default, disables the limit.
+func (opts *Options) WithQueryMemoryLimit(limit int64) *Options {
+	opts.queryMemoryLimit = limit
+	return opts
+}
+
+// WithStmtCacheSize sets the maximum number of parsed statements kept in memory,
+// so that repeated SQL texts are not parsed again. Statements are still planned
+// on every execution. The default value is 1000.
+// A value of zero disables the cache.
+func (opts *Options) WithStmtCacheSize(size int) *Options {
+	opts.stmtCacheSize = size
+	return opts
+}
+
+// WithMaxTriggerDepth sets the maximum nesting of triggers, which is reached when
+// the statement executed by a trigger fires further triggers. The default value is 16.
+func (opts *Options) WithMaxTriggerDepth(depth int) *Options {
+	opts.maxTriggerDepth = depth
+	return opts
+}
+
 func (opts *Options) WithParseTxMetadataFunc(parseFunc func([]byte) (map[string]interface{}, error)) *Options {
 	opts.parseTxMetadata = parseFunc
 	return opts
 }
 
+// WithTxUserMetadataKey sets the key of the parsed tx metadata holding the name of the user
+// who committed the tx, which is exposed by the _tx_user column.
+func (opts *Options) WithTxUserMetadataKey(key string) *Options {
+	opts.txUserMetadataKey = key
+	return opts
+}
+
 func (opts *Options) WithTableResolvers(resolvers ...TableResolver) *Options {
 	opts.tableResolvers = append(opts.tableResolvers, resolvers...)
 	return opts
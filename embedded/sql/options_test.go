@@ -18,6 +18,7 @@ package sql
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -48,5 +49,29 @@ func TestOptions(t *testing.T) {
 	opts.WithSortBufferSize(defaultSortBufferSize)
 	require.Equal(t, opts.sortBufferSize, defaultSortBufferSize)
 
+	opts.WithStatementTimeout(-time.Second)
+	require.Error(t, opts.Validate())
+
+	opts.WithStatementTimeout(time.Second)
+	require.Equal(t, time.Second, opts.statementTimeout)
+
+	opts.WithQueryMemoryLimit(-1)
+	require.Error(t, opts.Validate())
+
+	opts.WithQueryMemoryLimit(1 << 20)
+	require.Equal(t, int64(1<<20), opts.queryMemoryLimit)
+
+	opts.WithStmtCacheSize(-1)
+	require.Error(t, opts.Validate())
+
+	opts.WithStmtCacheSize(0)
+	require.Zero(t, opts.stmtCacheSize)
+
+	opts.WithMaxTriggerDepth(0)
+	require.Error(t, opts.Validate())
+
+	opts.WithMaxTriggerDepth(defaultMaxTriggerDepth)
+	require.Equal(t, defaultMaxTriggerDepth, opts.maxTriggerDepth)
+
 	require.NoError(t, opts.Validate())
 }
@@ -98,6 +98,32 @@ var reservedWords = map[string]int{
 	"SHOW":           SHOW,
 	"DATABASES":      DATABASES,
 	"TABLES":         TABLES,
+	"SCHEMA":         SCHEMA,
+	"ANALYZE":        ANALYZE,
+	"CHANGES":        CHANGES,
+	"MATERIALIZED":   MATERIALIZED,
+	"VIEW":           VIEW,
+	"REFRESH":        REFRESH,
+	"INCREMENTAL":    INCREMENTAL,
+	"TRUNCATE":       TRUNCATE,
+	"TRIGGER":        TRIGGER,
+	"EACH":           EACH,
+	"ROW":            ROW,
+	"EXECUTE":        EXECUTE,
+	"DECLARE":        DECLARE,
+	"CURSOR":         CURSOR,
+	"FETCH":          FETCH,
+	"NEXT":           NEXT,
+	"CLOSE":          CLOSE,
+	"FUNCTION":       FUNCTION,
+	"PROCEDURE":      PROCEDURE,
+	"RETURNS":        RETURNS,
+	"RETURN":         RETURN,
+	"CALL":           CALL,
+	"REPLACE":        REPLACE,
+	"RAISE":          RAISE,
+	"WHILE":          WHILE,
+	"LOOP":           LOOP,
 	"USERS":          USERS,
 	"USER":           USER,
 	"WITH":           WITH,
@@ -137,11 +163,12 @@ var types = map[string]SQLValueType{
 }
 
 var aggregateFns = map[string]AggregateFn{
-	"COUNT": COUNT,
-	"SUM":   SUM,
-	"MAX":   MAX,
-	"MIN":   MIN,
-	"AVG":   AVG,
+	"COUNT":    COUNT,
+	"SUM":      SUM,
+	"MAX":      MAX,
+	"MIN":      MIN,
+	"AVG":      AVG,
+	"JSON_AGG": JSONAGG,
 }
 
 var boolValues = map[string]bool{
@@ -177,6 +204,27 @@ type lexer struct {
 	namedParamsType positionalParamType
 	paramsCount     int
 	result          []SQLStmt
+
+	// startToken is returned before any other token, selecting the kind of text being parsed
+	startToken int
+
+	// the text of the queries defining materialized views and of the statements executed by triggers
+	// is kept, so that their definitions can be persisted and parsed again
+	lastTokens    [2]int
+	captureStart  int
+	capturedTexts []string
+}
+
+// textCapturingStmt is implemented by statements keeping the text of one of their sub-statements
+type textCapturingStmt interface {
+	setCapturedText(text string)
+}
+
+// capturedTextStarts holds the token after which the text is captured,
+// for each couple of tokens starting a statement whose text is captured
+var capturedTextStarts = map[[2]int]int{
+	{CREATE, MATERIALIZED}: AS,
+	{CREATE, TRIGGER}:      EXECUTE,
 }
 
 type aheadByteReader struct {
@@ -184,6 +232,7 @@ type aheadByteReader struct {
 	nextErr   error
 	r         io.ByteReader
 	readCount int
+	capture   *bytes.Buffer
 }
 
 func newAheadByteReader(r io.ByteReader) *aheadByteReader {
@@ -201,6 +250,10 @@ func (ar *aheadByteReader) ReadByte() (byte, error) {
 
 	ar.readCount++
 
+	if ar.capture != nil && ar.nextErr == nil {
+		ar.capture.WriteByte(ar.nextChar)
+	}
+
 	return ar.nextChar, ar.nextErr
 }
 
@@ -221,6 +274,21 @@ func ParseSQL(r io.ByteReader) ([]SQLStmt, error) {
 
 	yyParse(lexer)
 
+	if lexer.err == nil {
+		lexer.setCapturedTexts()
+	}
+
+	return lexer.result, lexer.err
+}
+
+// parseRoutineBody parses the body of a user-defined function or procedure,
+// made of procedural and SQL statements
+func parseRoutineBody(body string) ([]SQLStmt, error) {
+	lexer := newLexer(strings.NewReader(body))
+	lexer.startToken = ROUTINE_BODY
+
+	yyParse(lexer)
+
 	return lexer.result, lexer.err
 }
 
@@ -244,6 +312,56 @@ func newLexer(r io.ByteReader) *lexer {
 }
 
 func (l *lexer) Lex(lval *yySymType) int {
+	if l.startToken != 0 {
+		tkn := l.startToken
+		l.startToken = 0
+		return tkn
+	}
+
+	tkn := l.lex(lval)
+	l.captureText(tkn)
+	return tkn
+}
+
+// captureText records the text of statements such as CREATE MATERIALIZED VIEW or CREATE TRIGGER,
+// from the token following their name up to the end of the statement
+func (l *lexer) captureText(tkn int) {
+	switch {
+	case l.r.capture != nil && (tkn == STMT_SEPARATOR || tkn == 0 || tkn == ERROR):
+		text := l.r.capture.String()
+		if tkn == STMT_SEPARATOR {
+			text = text[:len(text)-1]
+		}
+
+		l.capturedTexts = append(l.capturedTexts, strings.TrimSpace(text))
+		l.r.capture = nil
+	case tkn == STMT_SEPARATOR || tkn == 0:
+		l.captureStart = 0
+	case l.captureStart != 0 && tkn == l.captureStart:
+		l.captureStart = 0
+		l.r.capture = &bytes.Buffer{}
+	case l.captureStart == 0 && l.r.capture == nil:
+		l.captureStart = capturedTextStarts[l.lastTokens]
+	}
+
+	l.lastTokens = [2]int{l.lastTokens[1], tkn}
+}
+
+func (l *lexer) setCapturedTexts() {
+	i := 0
+
+	for _, stmt := range l.result {
+		capturingStmt, ok := stmt.(textCapturingStmt)
+		if !ok || i == len(l.capturedTexts) {
+			continue
+		}
+
+		capturingStmt.setCapturedText(l.capturedTexts[i])
+		i++
+	}
+}
+
+func (l *lexer) lex(lval *yySymType) int {
 	var ch byte
 	var err error
 
@@ -301,9 +419,34 @@ func (l *lexer) Lex(lval *yySymType) int {
 
 	if ch == '-' && l.r.nextChar == '>' {
 		l.r.ReadByte()
+
+		if l.r.nextChar == '>' {
+			l.r.ReadByte()
+			return TEXT_ARROW
+		}
 		return ARROW
 	}
 
+	if ch == '#' && l.r.nextChar == '>' {
+		l.r.ReadByte()
+
+		if l.r.nextChar == '>' {
+			l.r.ReadByte()
+			return TEXT_PATH_ARROW
+		}
+		return PATH_ARROW
+	}
+
+	if ch == '@' && l.r.nextChar == '>' {
+		l.r.ReadByte()
+		return CONTAINS_OP
+	}
+
+	// a question mark following an operand is the key existence operator, otherwise a parameter
+	if ch == '?' && endsOperand(l.lastTokens[1]) {
+		return HAS_KEY_OP
+	}
+
 	if isBLOBPrefix(ch) && isQuote(l.r.nextChar) {
 		l.r.ReadByte() // consume starting quote
 
@@ -503,6 +646,20 @@ func (l *lexer) Lex(lval *yySymType) int {
 		return NPARAM
 	}
 
+	// dollar-quoted strings, such as the bodies of functions and procedures, are read verbatim
+	if ch == '$' && l.r.nextChar == '$' {
+		l.r.ReadByte()
+
+		tail, err := l.readDollarQuotedString()
+		if err != nil {
+			lval.err = err
+			return ERROR
+		}
+
+		lval.str = tail
+		return VARCHAR
+	}
+
 	if ch == '$' {
 		if l.namedParamsType == UnnamedParamType {
 			lval.err = ErrEitherNamedOrUnnamedParams
@@ -573,6 +730,14 @@ func (l *lexer) Lex(lval *yySymType) int {
 	return int(ch)
 }
 
+func endsOperand(tkn int) bool {
+	switch tkn {
+	case IDENTIFIER, VARCHAR, INTEGER, FLOAT, BOOLEAN, BLOB, NPARAM, PPARAM, NULL, TYPE, ')':
+		return true
+	}
+	return false
+}
+
 func (l *lexer) Error(err string) {
 	l.err = fmt.Errorf("%s at position %d", err, l.r.ReadCount())
 }
@@ -612,6 +777,29 @@ func (l *lexer) readString() (string, error) {
 	return b.String(), nil
 }
 
+func (l *lexer) readDollarQuotedString() (string, error) {
+	var b bytes.Buffer
+
+	for {
+		ch, err := l.r.ReadByte()
+		if err == io.EOF {
+			return "", fmt.Errorf("unterminated dollar-quoted string")
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if ch == '$' && l.r.nextChar == '$' {
+			l.r.ReadByte() // consume closing dollar sign
+			break
+		}
+
+		b.WriteByte(ch)
+	}
+
+	return b.String(), nil
+}
+
 func (l *lexer) readComparison() (string, error) {
 	return l.readWhile(func(ch byte) bool {
 		return isComparison(ch)
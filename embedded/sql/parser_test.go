@@ -63,6 +63,50 @@ func TestCreateDatabaseStmt(t *testing.T) {
 	}
 }
 
+func TestSchemaStmt(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input:          "CREATE SCHEMA sales",
+			expectedOutput: []SQLStmt{&CreateSchemaStmt{schema: "sales"}},
+		},
+		{
+			input:          "CREATE SCHEMA IF NOT EXISTS sales",
+			expectedOutput: []SQLStmt{&CreateSchemaStmt{schema: "sales", ifNotExists: true}},
+		},
+		{
+			input:          "DROP SCHEMA sales",
+			expectedOutput: []SQLStmt{&DropSchemaStmt{schema: "sales"}},
+		},
+		{
+			input: "SELECT id FROM sales.orders",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					targets: []TargetEntry{{Exp: &ColSelector{col: "id"}}},
+					ds:      &tableRef{table: "sales.orders"},
+				},
+			},
+		},
+		{
+			input:          "CREATE SCHEMA sales.orders",
+			expectedOutput: nil,
+			expectedError:  errors.New("syntax error: unexpected DOT at position 20"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
 func TestUseDatabaseStmt(t *testing.T) {
 	testCases := []struct {
 		input          string
@@ -286,7 +330,7 @@ func TestCreateTableStmt(t *testing.T) {
 		{
 			input:          "CREATE TABLE table1",
 			expectedOutput: []SQLStmt{&CreateTableStmt{table: "table1"}},
-			expectedError:  errors.New("syntax error: unexpected $end, expecting '(' at position 20"),
+			expectedError:  errors.New("syntax error: unexpected $end, expecting PRIMARY or AS or '(' at position 20"),
 		},
 		{
 			input:          "CREATE TABLE table1()",
@@ -340,6 +384,387 @@ func TestCreateTableStmt(t *testing.T) {
 				}},
 			expectedError: nil,
 		},
+		{
+			input:          "ANALYZE",
+			expectedOutput: []SQLStmt{&AnalyzeStmt{}},
+			expectedError:  nil,
+		},
+		{
+			input:          "ANALYZE table1",
+			expectedOutput: []SQLStmt{&AnalyzeStmt{table: "table1"}},
+			expectedError:  nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestMaterializedViewStmts(t *testing.T) {
+	query := "SELECT account, SUM(amount) AS total, COUNT(*) FROM payments WHERE amount > 0 GROUP BY account"
+
+	selectStmt := &SelectStmt{
+		targets: []TargetEntry{
+			{Exp: &ColSelector{col: "account"}},
+			{Exp: &AggColSelector{aggFn: "SUM", col: "amount"}, As: "total"},
+			{Exp: &AggColSelector{aggFn: "COUNT", col: "*"}},
+		},
+		ds: &tableRef{table: "payments"},
+		where: &CmpBoolExp{
+			op:    GT,
+			left:  &ColSelector{col: "amount"},
+			right: &Integer{val: 0},
+		},
+		groupBy: []*ColSelector{{col: "account"}},
+	}
+
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "CREATE MATERIALIZED VIEW totals AS " + query,
+			expectedOutput: []SQLStmt{
+				&CreateMaterializedViewStmt{view: "totals", query: query, selectStmt: selectStmt},
+			},
+		},
+		{
+			input: "CREATE MATERIALIZED VIEW IF NOT EXISTS totals WITH INCREMENTAL REFRESH AS\n\t" + query + " ;\nREFRESH MATERIALIZED VIEW totals",
+			expectedOutput: []SQLStmt{
+				&CreateMaterializedViewStmt{view: "totals", ifNotExists: true, incremental: true, query: query, selectStmt: selectStmt},
+				&RefreshMaterializedViewStmt{view: "totals"},
+			},
+		},
+		{
+			input: "CREATE MATERIALIZED VIEW v1 AS SELECT id AS \"key\" FROM t1; CREATE MATERIALIZED VIEW v2 AS SELECT id FROM t2 WHERE name = 'a;b'",
+			expectedOutput: []SQLStmt{
+				&CreateMaterializedViewStmt{
+					view:       "v1",
+					query:      `SELECT id AS "key" FROM t1`,
+					selectStmt: &SelectStmt{targets: []TargetEntry{{Exp: &ColSelector{col: "id"}, As: "key"}}, ds: &tableRef{table: "t1"}},
+				},
+				&CreateMaterializedViewStmt{
+					view:  "v2",
+					query: "SELECT id FROM t2 WHERE name = 'a;b'",
+					selectStmt: &SelectStmt{
+						targets: []TargetEntry{{Exp: &ColSelector{col: "id"}}},
+						ds:      &tableRef{table: "t2"},
+						where:   &CmpBoolExp{op: EQ, left: &ColSelector{col: "name"}, right: &Varchar{val: "a;b"}},
+					},
+				},
+			},
+		},
+		{
+			input:          "DROP MATERIALIZED VIEW totals",
+			expectedOutput: []SQLStmt{&DropMaterializedViewStmt{view: "totals"}},
+		},
+		{
+			input:         "CREATE MATERIALIZED VIEW totals AS INSERT INTO t1 (id) VALUES (1)",
+			expectedError: errors.New("syntax error: unexpected INSERT, expecting SELECT at position 41"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestTriggerStmts(t *testing.T) {
+	body := "INSERT INTO audit (account, amount) VALUES (NEW.account, NEW.amount - OLD.amount)"
+
+	bodyStmt := &UpsertIntoStmt{
+		isInsert: true,
+		tableRef: &tableRef{table: "audit"},
+		cols:     []string{"account", "amount"},
+		ds: &valuesDataSource{
+			rows: []*RowSpec{{
+				Values: []ValueExp{
+					&ColSelector{table: "new", col: "account"},
+					&NumExp{op: SUBSOP, left: &ColSelector{table: "new", col: "amount"}, right: &ColSelector{table: "old", col: "amount"}},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "CREATE TRIGGER audit_payments AFTER UPDATE ON payments FOR EACH ROW EXECUTE " + body,
+			expectedOutput: []SQLStmt{
+				&CreateTriggerStmt{trigger: "audit_payments", events: TriggerOnUpdate, table: "payments", sql: body, stmt: bodyStmt},
+			},
+		},
+		{
+			input: "CREATE TRIGGER IF NOT EXISTS audit_payments AFTER INSERT OR UPDATE OR DELETE ON payments FOR EACH ROW EXECUTE\n\t" + body + " ;\nDROP TRIGGER audit_payments ON payments",
+			expectedOutput: []SQLStmt{
+				&CreateTriggerStmt{trigger: "audit_payments", ifNotExists: true, events: TriggerOnInsert | TriggerOnUpdate | TriggerOnDelete, table: "payments", sql: body, stmt: bodyStmt},
+				&DropTriggerStmt{trigger: "audit_payments", table: "payments"},
+			},
+		},
+		{
+			input: "CREATE TRIGGER purge AFTER DELETE ON accounts FOR EACH ROW EXECUTE DELETE FROM payments WHERE account = OLD.name",
+			expectedOutput: []SQLStmt{
+				&CreateTriggerStmt{
+					trigger: "purge",
+					events:  TriggerOnDelete,
+					table:   "accounts",
+					sql:     "DELETE FROM payments WHERE account = OLD.name",
+					stmt: &DeleteFromStmt{
+						tableRef: &tableRef{table: "payments"},
+						where:    &CmpBoolExp{op: EQ, left: &ColSelector{col: "account"}, right: &ColSelector{table: "old", col: "name"}},
+					},
+				},
+			},
+		},
+		{
+			input:         "CREATE TRIGGER t1 AFTER INSERT ON payments FOR EACH ROW EXECUTE SELECT * FROM audit",
+			expectedError: errors.New("syntax error: unexpected SELECT, expecting INSERT or UPSERT or DELETE or UPDATE at position 70"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestRoutineStmts(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "CREATE FUNCTION balance_of(@account INTEGER) RETURNS INTEGER AS $$ SELECT balance FROM accounts WHERE id = @account $$",
+			expectedOutput: []SQLStmt{
+				&CreateRoutineStmt{
+					kind:       FunctionRoutine,
+					name:       "balance_of",
+					params:     []*routineParam{{name: "account", paramType: IntegerType}},
+					returnType: IntegerType,
+					body:       " SELECT balance FROM accounts WHERE id = @account ",
+				},
+			},
+		},
+		{
+			input: "CREATE OR REPLACE PROCEDURE transfer(@from INTEGER, @to INTEGER) AS 'CALL log(''transfer'')'; CALL transfer(1, @to); DROP PROCEDURE transfer; DROP FUNCTION balance_of",
+			expectedOutput: []SQLStmt{
+				&CreateRoutineStmt{
+					kind:      ProcedureRoutine,
+					name:      "transfer",
+					orReplace: true,
+					params:    []*routineParam{{name: "from", paramType: IntegerType}, {name: "to", paramType: IntegerType}},
+					body:      "CALL log('transfer')",
+				},
+				&CallStmt{procedure: "transfer", args: []ValueExp{&Integer{val: 1}, &Param{id: "to"}}},
+				&DropRoutineStmt{kind: ProcedureRoutine, name: "transfer"},
+				&DropRoutineStmt{kind: FunctionRoutine, name: "balance_of"},
+			},
+		},
+		{
+			input:         "CREATE FUNCTION f() AS $$ RETURN 1 $$",
+			expectedError: errors.New("syntax error: unexpected AS, expecting RETURNS at position 22"),
+		},
+		{
+			input:         "CREATE FUNCTION f() RETURNS INTEGER AS $$ RETURN 1",
+			expectedError: errors.New("syntax error: unexpected ERROR, expecting VARCHAR at position 51"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestRoutineBody(t *testing.T) {
+	body := `
+		DECLARE @balance INTEGER = 0;
+		SET @balance = (SELECT balance FROM accounts);
+		IF @balance < @amount THEN
+			RAISE 'insufficient funds';
+		ELSE
+			BEGIN
+				UPDATE accounts SET balance = balance - @amount;
+			END;
+		END IF;
+		WHILE @amount > 0 LOOP
+			SET @amount = @amount - 1;
+		END LOOP;
+		RETURN
+	`
+
+	stmts, err := parseRoutineBody(body)
+	require.NoError(t, err)
+	require.Equal(t, []SQLStmt{
+		&DeclareVarStmt{variable: "balance", varType: IntegerType, op: EQ, exp: &Integer{val: 0}},
+		&SetVarStmt{
+			variable: "balance",
+			op:       EQ,
+			query: &SelectStmt{
+				targets: []TargetEntry{{Exp: &ColSelector{col: "balance"}}},
+				ds:      &tableRef{table: "accounts"},
+			},
+		},
+		&IfStmt{
+			cond: &CmpBoolExp{op: LT, left: &Param{id: "balance"}, right: &Param{id: "amount"}},
+			then: []SQLStmt{&RaiseStmt{exp: &Varchar{val: "insufficient funds"}}},
+			els: []SQLStmt{
+				&BlockStmt{stmts: []SQLStmt{
+					&UpdateStmt{
+						tableRef: &tableRef{table: "accounts"},
+						updates:  []*colUpdate{{col: "balance", op: EQ, val: &NumExp{op: SUBSOP, left: &ColSelector{col: "balance"}, right: &Param{id: "amount"}}}},
+					},
+				}},
+			},
+		},
+		&WhileStmt{
+			cond: &CmpBoolExp{op: GT, left: &Param{id: "amount"}, right: &Integer{val: 0}},
+			body: []SQLStmt{
+				&SetVarStmt{variable: "amount", op: EQ, exp: &NumExp{op: SUBSOP, left: &Param{id: "amount"}, right: &Integer{val: 1}}},
+			},
+		},
+		&ReturnStmt{},
+	}, stmts)
+
+	_, err = parseRoutineBody("CREATE TABLE t (id INTEGER, PRIMARY KEY id)")
+	require.Error(t, err)
+
+	_, err = ParseSQLString("RETURN 1")
+	require.Error(t, err)
+}
+
+func TestCreateTableAsSelectStmt(t *testing.T) {
+	query := &SelectStmt{
+		targets: []TargetEntry{{Exp: &ColSelector{col: "account"}}, {Exp: &AggColSelector{aggFn: "SUM", col: "amount"}, As: "total"}},
+		ds:      &tableRef{table: "payments"},
+		groupBy: []*ColSelector{{col: "account"}},
+	}
+
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "CREATE TABLE totals AS SELECT account, SUM(amount) AS total FROM payments GROUP BY account",
+			expectedOutput: []SQLStmt{
+				&CreateTableAsSelectStmt{table: "totals", selectStmt: query},
+			},
+		},
+		{
+			input: "CREATE TABLE IF NOT EXISTS totals PRIMARY KEY (account) AS SELECT account, SUM(amount) AS total FROM payments GROUP BY account",
+			expectedOutput: []SQLStmt{
+				&CreateTableAsSelectStmt{table: "totals", ifNotExists: true, pkColNames: []string{"account"}, selectStmt: query},
+			},
+		},
+		{
+			input: "CREATE TABLE copy PRIMARY KEY id AS SELECT * FROM payments",
+			expectedOutput: []SQLStmt{
+				&CreateTableAsSelectStmt{
+					table:      "copy",
+					pkColNames: []string{"id"},
+					selectStmt: &SelectStmt{ds: &tableRef{table: "payments"}},
+				},
+			},
+		},
+		{
+			input:         "CREATE TABLE copy AS DELETE FROM payments",
+			expectedError: errors.New("syntax error: unexpected DELETE, expecting SELECT at position 27"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestTruncateTableStmt(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input:          "TRUNCATE TABLE staging",
+			expectedOutput: []SQLStmt{&TruncateTableStmt{table: "staging"}},
+		},
+		{
+			input:          "TRUNCATE public.staging",
+			expectedOutput: []SQLStmt{&TruncateTableStmt{table: "public.staging"}},
+		},
+		{
+			input:         "TRUNCATE TABLE",
+			expectedError: errors.New("syntax error: unexpected $end, expecting IDENTIFIER at position 15"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseSQLString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestCursorStmts(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "DECLARE c1 CURSOR FOR SELECT id FROM table1 WHERE id > @id; FETCH 100 FROM c1; FETCH NEXT IN c1; FETCH FROM c1; FETCH ALL FROM c1; CLOSE c1",
+			expectedOutput: []SQLStmt{
+				&DeclareCursorStmt{
+					cursor: "c1",
+					query: &SelectStmt{
+						targets: []TargetEntry{{Exp: &ColSelector{col: "id"}}},
+						ds:      &tableRef{table: "table1"},
+						where:   &CmpBoolExp{op: GT, left: &ColSelector{col: "id"}, right: &Param{id: "id"}},
+					},
+				},
+				&FetchCursorStmt{cursor: "c1", count: 100},
+				&FetchCursorStmt{cursor: "c1", count: 1},
+				&FetchCursorStmt{cursor: "c1", count: 1},
+				&FetchCursorStmt{cursor: "c1", all: true},
+				&CloseCursorStmt{cursor: "c1"},
+			},
+		},
+		{
+			input:         "DECLARE c1 CURSOR FOR DELETE FROM table1",
+			expectedError: errors.New("syntax error: unexpected DELETE, expecting SELECT or SHOW at position 28"),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -397,6 +822,25 @@ func TestCreateIndexStmt(t *testing.T) {
 				}},
 			expectedError: nil,
 		},
+		{
+			input: "CREATE INDEX ON table1(id, data->'user'->>'email', (data#>>'{a,0}'))",
+			expectedOutput: []SQLStmt{
+				&CreateIndexStmt{
+					table: "table1",
+					cols:  []string{"id", "data", "data"},
+					paths: [][]string{nil, {"user", "email"}, {"a", "0"}},
+				}},
+			expectedError: nil,
+		},
+		{
+			input: "DROP INDEX ON table1(data->>'kind')",
+			expectedOutput: []SQLStmt{
+				&DropIndexStmt{
+					table: "table1",
+					cols:  []string{"data->>'kind'"},
+				}},
+			expectedError: nil,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -940,6 +1384,25 @@ func TestSelectStmt(t *testing.T) {
 				}},
 			expectedError: nil,
 		},
+		{
+			input: "SELECT _op, id FROM CHANGES OF table1 SINCE TX 10 UNTIL TX @tx AS c",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					targets: []TargetEntry{
+						{Exp: &ColSelector{col: "_op"}},
+						{Exp: &ColSelector{col: "id"}},
+					},
+					ds: &changesDataSource{
+						table: "table1",
+						period: period{
+							start: &openPeriod{inclusive: true, instant: periodInstant{instantType: txInstant, exp: &Integer{val: 10}}},
+							end:   &openPeriod{inclusive: true, instant: periodInstant{instantType: txInstant, exp: &Param{id: "tx"}}},
+						},
+						as: "c",
+					},
+				}},
+			expectedError: nil,
+		},
 		{
 			input: "SELECT id, title FROM table1 AS t1",
 			expectedOutput: []SQLStmt{
@@ -1273,6 +1736,42 @@ func TestSelectStmt(t *testing.T) {
 				}},
 			expectedError: nil,
 		},
+		{
+			input: "SELECT data->>'name', data#>'{tags,0}' FROM table1 WHERE data @> '{\"a\": 1}' AND data ? 'b'",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					targets: []TargetEntry{
+						{
+							Exp: &JSONSelector{
+								ColSelector: &ColSelector{col: "data"},
+								fields:      []string{"name"},
+								text:        true,
+							},
+						},
+						{
+							Exp: &JSONSelector{
+								ColSelector: &ColSelector{col: "data"},
+								fields:      []string{"tags", "0"},
+							},
+						},
+					},
+					ds: &tableRef{table: "table1"},
+					where: &BinBoolExp{
+						op: And,
+						left: &JSONBoolExp{
+							op:    JSONContains,
+							left:  &ColSelector{col: "data"},
+							right: &Varchar{val: `{"a": 1}`},
+						},
+						right: &JSONBoolExp{
+							op:    JSONHasKey,
+							left:  &ColSelector{col: "data"},
+							right: &Varchar{val: "b"},
+						},
+					},
+				}},
+			expectedError: nil,
+		},
 		{
 			input: "SELECT 1, (balance * balance) + 1, amount % 2, data::JSON FROM table1",
 			expectedOutput: []SQLStmt{
@@ -2063,6 +2562,74 @@ func TestGrantRevokeStmt(t *testing.T) {
 				privileges: allPrivileges,
 			},
 		},
+		{
+			text: "GRANT SELECT, INSERT ON TABLE customers TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table: "customers",
+				user:  "immudb",
+				privileges: []SQLPrivilege{
+					SQLPrivilegeInsert,
+					SQLPrivilegeSelect,
+				},
+				isGrant: true,
+			},
+		},
+		{
+			text: "GRANT SELECT (id, name) ON TABLE customers TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table:      "customers",
+				user:       "immudb",
+				privileges: []SQLPrivilege{SQLPrivilegeSelect},
+				columns:    []string{"id", "name"},
+				isGrant:    true,
+			},
+		},
+		{
+			text: "REVOKE ALL PRIVILEGES ON TABLE customers TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table:      "customers",
+				user:       "immudb",
+				privileges: allPrivileges,
+			},
+		},
+		{
+			text: "REVOKE SELECT (name) ON TABLE customers TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table:      "customers",
+				user:       "immudb",
+				privileges: []SQLPrivilege{SQLPrivilegeSelect},
+				columns:    []string{"name"},
+			},
+		},
+		{
+			text: "GRANT SELECT ON TABLE sales.customers TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table:      "sales.customers",
+				user:       "immudb",
+				privileges: []SQLPrivilege{SQLPrivilegeSelect},
+				isGrant:    true,
+			},
+		},
+		{
+			text: "GRANT SELECT, INSERT ON SCHEMA sales TO USER immudb",
+			expectedStmt: &AlterSchemaPrivilegesStmt{
+				schema: "sales",
+				user:   "immudb",
+				privileges: []SQLPrivilege{
+					SQLPrivilegeInsert,
+					SQLPrivilegeSelect,
+				},
+				isGrant: true,
+			},
+		},
+		{
+			text: "REVOKE ALL PRIVILEGES ON SCHEMA sales TO USER immudb",
+			expectedStmt: &AlterSchemaPrivilegesStmt{
+				schema:     "sales",
+				user:       "immudb",
+				privileges: allPrivileges,
+			},
+		},
 	}
 
 	for i, tc := range cases {
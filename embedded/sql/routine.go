@@ -0,0 +1,949 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// RoutineKind is the kind of a user-defined routine
+type RoutineKind int
+
+const (
+	// FunctionRoutine is a routine returning a value, which can be called within expressions
+	FunctionRoutine RoutineKind = iota + 1
+	// ProcedureRoutine is a routine executed by the CALL statement
+	ProcedureRoutine
+)
+
+func (k RoutineKind) String() string {
+	if k == FunctionRoutine {
+		return "FUNCTION"
+	}
+	return "PROCEDURE"
+}
+
+// maxRoutineDepth limits the nesting of routines calling other routines
+const maxRoutineDepth = 32
+
+type routineParam struct {
+	name      string
+	paramType SQLValueType
+}
+
+// Routine represents a user-defined function or procedure.
+// Its body is made of SQL statements and procedural statements which read and assign
+// the parameters and variables of the routine, referenced as named parameters (e.g. @amount).
+type Routine struct {
+	name       string
+	kind       RoutineKind
+	params     []*routineParam
+	returnType SQLValueType
+	body       string
+	stmts      []SQLStmt
+	version    uint32
+}
+
+func (r *Routine) Name() string {
+	return r.name
+}
+
+func (r *Routine) Kind() RoutineKind {
+	return r.kind
+}
+
+// ReturnType returns the type of the values returned by a function
+func (r *Routine) ReturnType() SQLValueType {
+	return r.returnType
+}
+
+// Body returns the text of the statements executed by the routine
+func (r *Routine) Body() string {
+	return r.body
+}
+
+// Version returns the number of times the routine has been defined, starting from one,
+// which increases each time it is replaced
+func (r *Routine) Version() uint32 {
+	return r.version
+}
+
+// SQL returns the statement defining the routine
+func (r *Routine) SQL() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("CREATE %s %s(", r.kind, r.name))
+
+	for i, p := range r.params {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("@%s %s", p.name, p.paramType))
+	}
+	sb.WriteString(")")
+
+	if r.kind == FunctionRoutine {
+		sb.WriteString(fmt.Sprintf(" RETURNS %s", r.returnType))
+	}
+
+	sb.WriteString(" AS ")
+	sb.WriteString(quoteRoutineBody(r.body))
+
+	return sb.String()
+}
+
+func quoteRoutineBody(body string) string {
+	if !strings.Contains(body, "$$") {
+		return "$$" + body + "$$"
+	}
+	return "'" + strings.ReplaceAll(body, "'", "''") + "'"
+}
+
+func (r *Routine) describe() string {
+	return fmt.Sprintf("%s '%s'", strings.ToLower(r.kind.String()), r.name)
+}
+
+func newRoutine(kind RoutineKind, name string, params []*routineParam, returnType SQLValueType, body string) (*Routine, error) {
+	routine := &Routine{
+		name:       name,
+		kind:       kind,
+		params:     params,
+		returnType: returnType,
+		body:       body,
+	}
+
+	paramNames := make(map[string]struct{}, len(params))
+
+	for _, p := range params {
+		if _, exists := paramNames[p.name]; exists {
+			return nil, fmt.Errorf("%w: duplicated parameter @%s in %s", ErrIllegalArguments, p.name, routine.describe())
+		}
+		paramNames[p.name] = struct{}{}
+	}
+
+	stmts, err := parseRoutineBody(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid body of %s", err, routine.describe())
+	}
+
+	err = forEachRoutineStmt(stmts, func(stmt SQLStmt) error {
+		if kind == FunctionRoutine && !stmt.readOnly() {
+			return fmt.Errorf("%w: %s can not modify data, a procedure should be used instead", ErrIllegalArguments, routine.describe())
+		}
+
+		ret, isReturn := stmt.(*ReturnStmt)
+		if kind == ProcedureRoutine && isReturn && (ret.exp != nil || ret.query != nil) {
+			return fmt.Errorf("%w: %s can not return a value", ErrIllegalArguments, routine.describe())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	routine.stmts = stmts
+
+	return routine, nil
+}
+
+// forEachRoutineStmt calls fn for each statement of a routine body, including the nested ones
+func forEachRoutineStmt(stmts []SQLStmt, fn func(stmt SQLStmt) error) error {
+	for _, stmt := range stmts {
+		if err := fn(stmt); err != nil {
+			return err
+		}
+
+		var nested [][]SQLStmt
+
+		switch s := stmt.(type) {
+		case *IfStmt:
+			nested = [][]SQLStmt{s.then, s.els}
+		case *WhileStmt:
+			nested = [][]SQLStmt{s.body}
+		case *BlockStmt:
+			nested = [][]SQLStmt{s.stmts}
+		}
+
+		for _, n := range nested {
+			if err := forEachRoutineStmt(n, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// routineStmtsPrivileges returns the privileges required by the statements of a routine body
+func routineStmtsPrivileges(stmts []SQLStmt) []SQLPrivilege {
+	var privileges []SQLPrivilege
+
+	forEachRoutineStmt(stmts, func(stmt SQLStmt) error {
+		for _, p := range stmt.requiredPrivileges() {
+			if !hasAllPrivileges(privileges, []SQLPrivilege{p}) {
+				privileges = append(privileges, p)
+			}
+		}
+		return nil
+	})
+
+	return privileges
+}
+
+// routineReturn stops the execution of a routine, carrying the value it returns
+type routineReturn struct {
+	val TypedValue
+}
+
+func (r *routineReturn) Error() string {
+	return "RETURN outside of a function or procedure"
+}
+
+// invoke executes the routine within the transaction. Parameters are bound to the arguments,
+// converted to the types of the parameters, and are available as variables to the statements of the body.
+func (r *Routine) invoke(ctx context.Context, tx *SQLTx, args []TypedValue) (TypedValue, error) {
+	if len(args) != len(r.params) {
+		return nil, fmt.Errorf("%w: %s expects %d arguments but %d were provided", ErrIllegalArguments, r.describe(), len(r.params), len(args))
+	}
+
+	if tx.routineDepth >= maxRoutineDepth {
+		return nil, fmt.Errorf("%w: %s", ErrMaxRoutineDepthExceeded, r.describe())
+	}
+
+	vars := make(map[string]interface{}, len(r.params))
+
+	for i, p := range r.params {
+		v, err := convertRoutineValue(args[i], p.paramType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parameter @%s of %s", err, p.name, r.describe())
+		}
+		vars[p.name] = v
+	}
+
+	if tx.engine.multidbHandler != nil {
+		err := tx.engine.checkRoutinePermissions(ctx, tx, r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx.routineDepth++
+	err := execRoutineStmts(ctx, tx, r.stmts, vars)
+	tx.routineDepth--
+
+	var ret *routineReturn
+
+	if errors.As(err, &ret) {
+		if r.kind == ProcedureRoutine {
+			return nil, nil
+		}
+
+		v, err := convertRoutineValue(ret.val, r.returnType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: value returned by %s", err, r.describe())
+		}
+		return v, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, r.describe())
+	}
+
+	if r.kind == FunctionRoutine {
+		return &NullValue{t: r.returnType}, nil
+	}
+	return nil, nil
+}
+
+func execRoutineStmts(ctx context.Context, tx *SQLTx, stmts []SQLStmt, vars map[string]interface{}) error {
+	for _, stmt := range stmts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		_, err := stmt.execAt(ctx, tx, vars)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertRoutineValue converts the value to the type of a parameter, a variable or the result of a routine,
+// following the same rules as CAST
+func convertRoutineValue(v TypedValue, t SQLValueType) (TypedValue, error) {
+	if v == nil || v.IsNull() {
+		return &NullValue{t: t}, nil
+	}
+
+	if v.Type() == t {
+		return v, nil
+	}
+
+	conv, err := getConverter(v.Type(), t)
+	if err != nil {
+		return nil, err
+	}
+	return conv(v)
+}
+
+func evalRoutineExp(tx *SQLTx, exp ValueExp, vars map[string]interface{}) (TypedValue, error) {
+	e, err := exp.substitute(vars)
+	if err != nil {
+		return nil, err
+	}
+	return e.reduce(tx, nil, "")
+}
+
+// evalRoutineQuery returns the first column of the first row returned by the query, or NULL when there is none
+func evalRoutineQuery(ctx context.Context, tx *SQLTx, query DataSource, vars map[string]interface{}) (TypedValue, error) {
+	rowReader, err := query.Resolve(ctx, tx, vars, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rowReader.Close()
+
+	row, err := rowReader.Read(ctx)
+	if errors.Is(err, ErrNoMoreRows) {
+		return &NullValue{t: AnyType}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(row.ValuesByPosition) == 0 {
+		return &NullValue{t: AnyType}, nil
+	}
+	return aggregationResultOf(row.ValuesByPosition[0]), nil
+}
+
+// aggregationResultOf returns the plain value computed by an aggregation, so it can be assigned to variables
+// and used within expressions, which can not reduce aggregated values
+func aggregationResultOf(v TypedValue) TypedValue {
+	switch agg := v.(type) {
+	case *CountValue:
+		return &Integer{val: agg.c}
+	case *SumValue:
+		return agg.val
+	case *MinValue:
+		return agg.val
+	case *MaxValue:
+		return agg.val
+	case *AVGValue:
+		if agg.s.IsNull() {
+			return &NullValue{t: agg.Type()}
+		}
+		return agg.calculate()
+	case *JSONAggValue:
+		return agg.asJSON()
+	}
+	return v
+}
+
+func evalRoutineCond(tx *SQLTx, cond ValueExp, vars map[string]interface{}) (bool, error) {
+	v, err := evalRoutineExp(tx, cond, vars)
+	if err != nil {
+		return false, err
+	}
+
+	if v.IsNull() {
+		return false, nil
+	}
+
+	b, isBool := v.RawValue().(bool)
+	if !isBool {
+		return false, fmt.Errorf("%w: %s can not be interpreted as type %s", ErrInvalidCondition, v.Type(), BooleanType)
+	}
+	return b, nil
+}
+
+// routineFunction makes a user-defined function callable within expressions, as builtin functions are
+type routineFunction struct {
+	routine *Routine
+}
+
+func (f *routineFunction) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != f.routine.returnType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, f.routine.returnType, t)
+	}
+	return nil
+}
+
+func (f *routineFunction) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return f.routine.returnType, nil
+}
+
+func (f *routineFunction) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if tx.stmtCtx == nil {
+		return nil, fmt.Errorf("%w: %s called outside of a statement", ErrUnexpected, f.routine.describe())
+	}
+	return f.routine.invoke(tx.stmtCtx, tx, params)
+}
+
+// Routines returns the user-defined functions and procedures, sorted by name
+func (catlg *Catalog) Routines() []*Routine {
+	routines := make([]*Routine, 0, len(catlg.routinesByName))
+	for _, r := range catlg.routinesByName {
+		routines = append(routines, r)
+	}
+
+	sort.Slice(routines, func(i, j int) bool {
+		return routines[i].name < routines[j].name
+	})
+
+	return routines
+}
+
+func (catlg *Catalog) GetRoutineByName(name string) (*Routine, error) {
+	routine, exists := catlg.routinesByName[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrRoutineDoesNotExist, name)
+	}
+	return routine, nil
+}
+
+func (catlg *Catalog) getRoutine(kind RoutineKind, name string) (*Routine, error) {
+	routine, exists := catlg.routinesByName[name]
+	if !exists || routine.kind != kind {
+		return nil, fmt.Errorf("%w (%s %s)", ErrRoutineDoesNotExist, strings.ToLower(kind.String()), name)
+	}
+	return routine, nil
+}
+
+// CreateRoutineStmt represents a statement to create or replace a user-defined function or procedure
+type CreateRoutineStmt struct {
+	kind       RoutineKind
+	name       string
+	orReplace  bool
+	params     []*routineParam
+	returnType SQLValueType
+	body       string
+
+	// statements of the body, parsed when the required privileges are checked
+	stmts []SQLStmt
+}
+
+func (stmt *CreateRoutineStmt) readOnly() bool {
+	return false
+}
+
+// requiredPrivileges includes the privileges required by the statements of the body,
+// so routines can not be used to perform operations not granted to their creator
+func (stmt *CreateRoutineStmt) requiredPrivileges() []SQLPrivilege {
+	return append([]SQLPrivilege{SQLPrivilegeCreate}, routineStmtsPrivileges(stmt.bodyStmts())...)
+}
+
+// bodyStmts returns the statements of the body, nil is returned if it's not valid,
+// which is reported when executing the statement
+func (stmt *CreateRoutineStmt) bodyStmts() []SQLStmt {
+	if stmt.stmts == nil {
+		stmt.stmts, _ = parseRoutineBody(stmt.body)
+	}
+	return stmt.stmts
+}
+
+func (stmt *CreateRoutineStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateRoutineStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if _, isBuiltin := builtinFunctions[strings.ToUpper(stmt.name)]; isBuiltin {
+		return nil, fmt.Errorf("%w: '%s' is a builtin function", ErrIllegalArguments, stmt.name)
+	}
+
+	routine, err := newRoutine(stmt.kind, stmt.name, stmt.params, stmt.returnType, stmt.body)
+	if err != nil {
+		return nil, err
+	}
+
+	routine.version = 1
+
+	current, exists := tx.catalog.routinesByName[stmt.name]
+	if exists && (!stmt.orReplace || current.kind != stmt.kind) {
+		return nil, fmt.Errorf("%w (%s)", ErrRoutineAlreadyExists, current.describe())
+	}
+	if exists {
+		routine.version = current.version + 1
+	}
+
+	// each definition of the routine is a new revision of the same catalog entry,
+	// so that former definitions are kept in its history
+	err = persistRoutine(tx, routine)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.catalog.routinesByName[routine.name] = routine
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// DropRoutineStmt represents a statement to delete a user-defined function or procedure
+type DropRoutineStmt struct {
+	kind RoutineKind
+	name string
+}
+
+func (stmt *DropRoutineStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropRoutineStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropRoutineStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropRoutineStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	routine, err := tx.catalog.getRoutine(stmt.kind, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.delete(ctx, routineKey(tx.sqlPrefix(), routine.name))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(tx.catalog.routinesByName, routine.name)
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// CallStmt represents a statement executing a procedure.
+// The statements of the procedure are executed within the transaction of the CALL statement,
+// provided the user calling it holds the privileges they require.
+type CallStmt struct {
+	procedure string
+	args      []ValueExp
+}
+
+func (stmt *CallStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CallStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeExecute}
+}
+
+func (stmt *CallStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	routine, err := tx.catalog.getRoutine(ProcedureRoutine, stmt.procedure)
+	if err != nil {
+		return err
+	}
+
+	if len(stmt.args) != len(routine.params) {
+		return fmt.Errorf("%w: %s expects %d arguments but %d were provided", ErrIllegalArguments, routine.describe(), len(routine.params), len(stmt.args))
+	}
+
+	for i, arg := range stmt.args {
+		err := arg.requiresType(routine.params[i].paramType, map[string]ColDescriptor{}, params, "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (stmt *CallStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	routine, err := tx.catalog.getRoutine(ProcedureRoutine, stmt.procedure)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]TypedValue, len(stmt.args))
+
+	for i, arg := range stmt.args {
+		args[i], err = evalRoutineExp(tx, arg, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = routine.invoke(ctx, tx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// DeclareVarStmt represents the declaration of a variable within the body of a routine,
+// which is NULL unless an initial value is given
+type DeclareVarStmt struct {
+	variable string
+	varType  SQLValueType
+	op       CmpOperator
+	exp      ValueExp
+}
+
+func (stmt *DeclareVarStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *DeclareVarStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *DeclareVarStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DeclareVarStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.exp != nil && stmt.op != EQ {
+		return nil, ErrIllegalArguments
+	}
+
+	if _, declared := params[stmt.variable]; declared {
+		return nil, fmt.Errorf("%w: variable @%s is already declared", ErrIllegalArguments, stmt.variable)
+	}
+
+	var val TypedValue = &NullValue{t: stmt.varType}
+
+	if stmt.exp != nil {
+		v, err := evalRoutineExp(tx, stmt.exp, params)
+		if err != nil {
+			return nil, err
+		}
+
+		val, err = convertRoutineValue(v, stmt.varType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: variable @%s", err, stmt.variable)
+		}
+	}
+
+	params[stmt.variable] = val
+
+	return tx, nil
+}
+
+// SetVarStmt represents the assignment of a parameter or variable within the body of a routine,
+// either to the value of an expression or to the first column of the first row returned by a query
+type SetVarStmt struct {
+	variable string
+	op       CmpOperator
+	exp      ValueExp
+	query    DataSource
+}
+
+func (stmt *SetVarStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *SetVarStmt) requiredPrivileges() []SQLPrivilege {
+	if stmt.query != nil {
+		return stmt.query.requiredPrivileges()
+	}
+	return nil
+}
+
+func (stmt *SetVarStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *SetVarStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.op != EQ {
+		return nil, ErrIllegalArguments
+	}
+
+	current, declared := params[stmt.variable].(TypedValue)
+	if !declared {
+		return nil, fmt.Errorf("%w: variable @%s is not declared", ErrIllegalArguments, stmt.variable)
+	}
+
+	var v TypedValue
+	var err error
+
+	if stmt.query != nil {
+		v, err = evalRoutineQuery(ctx, tx, stmt.query, params)
+	} else {
+		v, err = evalRoutineExp(tx, stmt.exp, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := convertRoutineValue(v, current.Type())
+	if err != nil {
+		return nil, fmt.Errorf("%w: variable @%s", err, stmt.variable)
+	}
+
+	params[stmt.variable] = val
+
+	return tx, nil
+}
+
+// IfStmt represents a conditional execution within the body of a routine
+type IfStmt struct {
+	cond ValueExp
+	then []SQLStmt
+	els  []SQLStmt
+}
+
+func (stmt *IfStmt) readOnly() bool {
+	return allReadOnly(stmt.then) && allReadOnly(stmt.els)
+}
+
+func (stmt *IfStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *IfStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *IfStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	holds, err := evalRoutineCond(tx, stmt.cond, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if holds {
+		err = execRoutineStmts(ctx, tx, stmt.then, params)
+	} else {
+		err = execRoutineStmts(ctx, tx, stmt.els, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// WhileStmt represents a loop within the body of a routine, repeated while its condition holds
+type WhileStmt struct {
+	cond ValueExp
+	body []SQLStmt
+}
+
+func (stmt *WhileStmt) readOnly() bool {
+	return allReadOnly(stmt.body)
+}
+
+func (stmt *WhileStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *WhileStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *WhileStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	for {
+		// the body may be empty or made only of procedural statements
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		holds, err := evalRoutineCond(tx, stmt.cond, params)
+		if err != nil {
+			return nil, err
+		}
+
+		if !holds {
+			return tx, nil
+		}
+
+		err = execRoutineStmts(ctx, tx, stmt.body, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// BlockStmt represents a sequence of statements delimited by BEGIN and END within the body of a routine
+type BlockStmt struct {
+	stmts []SQLStmt
+}
+
+func (stmt *BlockStmt) readOnly() bool {
+	return allReadOnly(stmt.stmts)
+}
+
+func (stmt *BlockStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *BlockStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *BlockStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	err := execRoutineStmts(ctx, tx, stmt.stmts, params)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func allReadOnly(stmts []SQLStmt) bool {
+	for _, stmt := range stmts {
+		if !stmt.readOnly() {
+			return false
+		}
+	}
+	return true
+}
+
+// RaiseStmt represents an error raised within the body of a routine,
+// aborting the routine and the statement which executed it
+type RaiseStmt struct {
+	exp ValueExp
+}
+
+func (stmt *RaiseStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *RaiseStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *RaiseStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *RaiseStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	v, err := evalRoutineExp(tx, stmt.exp, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.IsNull() {
+		return nil, ErrRaisedException
+	}
+	return nil, fmt.Errorf("%w: %v", ErrRaisedException, v.RawValue())
+}
+
+// ReturnStmt represents the end of the execution of a routine. Functions return the value of an expression,
+// or the first column of the first row returned by a query, which is the result of functions whose body is a query.
+type ReturnStmt struct {
+	exp   ValueExp
+	query DataSource
+}
+
+func (stmt *ReturnStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *ReturnStmt) requiredPrivileges() []SQLPrivilege {
+	if stmt.query != nil {
+		return stmt.query.requiredPrivileges()
+	}
+	return nil
+}
+
+func (stmt *ReturnStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *ReturnStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	var v TypedValue
+	var err error
+
+	switch {
+	case stmt.query != nil:
+		v, err = evalRoutineQuery(ctx, tx, stmt.query, params)
+	case stmt.exp != nil:
+		v, err = evalRoutineExp(tx, stmt.exp, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, &routineReturn{val: v}
+}
+
+func routineKey(sqlPrefix []byte, name string) []byte {
+	return MapKey(
+		sqlPrefix,
+		catalogRoutinePrefix,
+		EncodeID(DatabaseID),
+		[]byte(name),
+	)
+}
+
+func persistRoutine(tx *SQLTx, routine *Routine) error {
+	// {version}{sql}
+	sql := routine.SQL()
+
+	value := make([]byte, 4+len(sql))
+	binary.BigEndian.PutUint32(value, routine.version)
+	copy(value[4:], sql)
+
+	return tx.set(routineKey(tx.sqlPrefix(), routine.name), nil, value)
+}
+
+func (catlg *Catalog) loadRoutines(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogRoutinePrefix, EncodeID(DatabaseID))
+
+	return catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		enc, err := trimPrefix(catlg.enginePrefix, key, []byte(catalogRoutinePrefix))
+		if err != nil {
+			return err
+		}
+
+		if len(enc) <= EncIDLen || binary.BigEndian.Uint32(enc) != DatabaseID || len(value) < 4 {
+			return ErrCorruptedData
+		}
+
+		name := string(enc[EncIDLen:])
+
+		stmts, err := ParseSQLString(string(value[4:]))
+		if err != nil {
+			return fmt.Errorf("%w: invalid routine '%s'", err, name)
+		}
+
+		if len(stmts) != 1 {
+			return fmt.Errorf("%w: invalid routine '%s'", ErrCorruptedData, name)
+		}
+
+		stmt, ok := stmts[0].(*CreateRoutineStmt)
+		if !ok || stmt.name != name {
+			return fmt.Errorf("%w: invalid routine '%s'", ErrCorruptedData, name)
+		}
+
+		routine, err := newRoutine(stmt.kind, stmt.name, stmt.params, stmt.returnType, stmt.body)
+		if err != nil {
+			return err
+		}
+
+		routine.version = binary.BigEndian.Uint32(value)
+
+		catlg.routinesByName[name] = routine
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
@@ -23,7 +23,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
+	"github.com/codenotary/immudb/embedded/multierr"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -42,13 +44,16 @@ type RowReader interface {
 }
 
 type ScanSpecs struct {
-	Index             *Index
-	rangesByColID     map[uint32]*typedValueRange
-	IncludeHistory    bool
-	IncludeTxMetadata bool
-	DescOrder         bool
-	groupBySortExps   []*OrdExp
-	orderBySortExps   []*OrdExp
+	Index              *Index
+	rangesByColID      map[uint32]*typedValueRange
+	IncludeHistory     bool
+	IncludeTxMetadata  bool
+	IncludeTxID        bool
+	IncludeTxTimestamp bool
+	IncludeTxUser      bool
+	DescOrder          bool
+	groupBySortExps    []*OrdExp
+	orderBySortExps    []*OrdExp
 }
 
 func (s *ScanSpecs) extraCols() int {
@@ -60,6 +65,18 @@ func (s *ScanSpecs) extraCols() int {
 	if s.IncludeTxMetadata {
 		n++
 	}
+
+	if s.IncludeTxID {
+		n++
+	}
+
+	if s.IncludeTxTimestamp {
+		n++
+	}
+
+	if s.IncludeTxUser {
+		n++
+	}
 	return n
 }
 
@@ -141,8 +158,14 @@ type rawRowReader struct {
 
 	params map[string]interface{}
 
-	reader          store.KeyReader
+	// a single reader is used unless reading the history of a truncated table
+	readers         []store.KeyReader
+	currReader      int
 	onCloseCallback func()
+
+	// timestamp of the last tx read, rows are often committed within the same tx
+	lastTxID uint64
+	lastTxTs TypedValue
 }
 
 type txRange struct {
@@ -185,20 +208,36 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 		return nil, ErrIllegalArguments
 	}
 
-	rSpec, err := keyReaderSpecFrom(tx.engine.prefix, table, scanSpecs)
-	if err != nil {
-		return nil, err
-	}
+	var readers []store.KeyReader
 
-	var r store.KeyReader
+	// rows are kept under the ids used by the table before being truncated,
+	// they are read first when including the history of the table
+	if scanSpecs.IncludeHistory {
+		for _, id := range table.truncatedIDs {
+			err := tx.useTruncatedIndex(table, id)
+			if err != nil {
+				closeKeyReaders(readers)
+				return nil, err
+			}
+
+			r, err := newTableKeyReader(tx, table.truncatedTable(id), scanSpecs)
+			if err != nil {
+				closeKeyReaders(readers)
+				return nil, err
+			}
+			readers = append(readers, r)
+		}
+	}
 
 	if table.name == "pg_type" {
-		r = &emptyKeyReader{}
+		readers = append(readers, &emptyKeyReader{})
 	} else {
-		r, err = tx.newKeyReader(*rSpec)
+		r, err := newTableKeyReader(tx, table, scanSpecs)
 		if err != nil {
+			closeKeyReaders(readers)
 			return nil, err
 		}
+		readers = append(readers, r)
 	}
 
 	if tableAlias == "" {
@@ -223,11 +262,26 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 		off++
 	}
 
-	if scanSpecs.IncludeTxMetadata {
+	txCols := []struct {
+		included bool
+		col      string
+		colType  SQLValueType
+	}{
+		{scanSpecs.IncludeTxMetadata, txMetadataCol, JSONType},
+		{scanSpecs.IncludeTxID, txIDCol, IntegerType},
+		{scanSpecs.IncludeTxTimestamp, txTimestampCol, TimestampType},
+		{scanSpecs.IncludeTxUser, txUserCol, VarcharType},
+	}
+
+	for _, c := range txCols {
+		if !c.included {
+			continue
+		}
+
 		colDescriptor := ColDescriptor{
 			Table:  tableAlias,
-			Column: txMetadataCol,
-			Type:   JSONType,
+			Column: c.col,
+			Type:   c.colType,
 		}
 
 		colsByPos[off] = colDescriptor
@@ -255,10 +309,18 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 		colsBySel:  colsBySel,
 		scanSpecs:  scanSpecs,
 		params:     params,
-		reader:     r,
+		readers:    readers,
 	}, nil
 }
 
+func newTableKeyReader(tx *SQLTx, table *Table, scanSpecs *ScanSpecs) (store.KeyReader, error) {
+	rSpec, err := keyReaderSpecFrom(tx.engine.prefix, table, scanSpecs)
+	if err != nil {
+		return nil, err
+	}
+	return tx.newKeyReader(*rSpec)
+}
+
 func keyReaderSpecFrom(sqlPrefix []byte, table *Table, scanSpecs *ScanSpecs) (spec *store.KeyReaderSpec, err error) {
 	prefix := MapKey(sqlPrefix, MappedPrefix, EncodeID(table.id), EncodeID(scanSpecs.Index.id))
 
@@ -343,6 +405,10 @@ func (r *rawRowReader) TableAlias() string {
 }
 
 func (r *rawRowReader) OrderBy() []ColDescriptor {
+	if len(r.readers) > 1 {
+		return nil
+	}
+
 	cols := make([]ColDescriptor, len(r.scanSpecs.Index.cols))
 
 	for i, col := range r.scanSpecs.Index.cols {
@@ -447,13 +513,22 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 		return nil, err
 	}
 
-	if r.txRange == nil {
-		_, vref, err = r.reader.Read(ctx) //mkey
-	} else {
-		_, vref, err = r.reader.ReadBetween(ctx, r.txRange.initialTxID, r.txRange.finalTxID) //mkey
-	}
-	if err != nil {
-		return nil, err
+	for {
+		reader := r.readers[r.currReader]
+
+		if r.txRange == nil {
+			_, vref, err = reader.Read(ctx) //mkey
+		} else {
+			_, vref, err = reader.ReadBetween(ctx, r.txRange.initialTxID, r.txRange.finalTxID) //mkey
+		}
+		if errors.Is(err, store.ErrNoMoreEntries) && r.currReader+1 < len(r.readers) {
+			r.currReader++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
 	}
 
 	v, err := vref.Resolve()
@@ -475,6 +550,18 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 			if err != nil {
 				return nil, err
 			}
+		case txIDCol:
+			val = &Integer{val: int64(vref.Tx())}
+		case txTimestampCol:
+			val, err = r.txTimestamp(vref.Tx())
+			if err != nil {
+				return nil, err
+			}
+		case txUserCol:
+			val, err = r.tx.engine.txUserValue(vref.TxMetadata())
+			if err != nil {
+				return nil, err
+			}
 		default:
 			val = &NullValue{t: col.Type}
 		}
@@ -483,11 +570,25 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 		valuesBySelector[col.Selector()] = val
 	}
 
-	if len(v) < EncLenLen {
-		return nil, ErrCorruptedData
+	extraCols := r.scanSpecs.extraCols()
+
+	err = decodeRowValue(r.table, v, func(pos int, col *Column, val TypedValue) {
+		valuesByPosition[pos+extraCols] = val
+		valuesBySelector[EncodeSelector("", r.tableAlias, col.colName)] = val
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	extraCols := r.scanSpecs.extraCols()
+	return &Row{ValuesByPosition: valuesByPosition, ValuesBySelector: valuesBySelector}, nil
+}
+
+// decodeRowValue decodes the values stored in a row entry, fn is called with the position of
+// each column in the table. Values of dropped columns are skipped.
+func decodeRowValue(table *Table, v []byte, fn func(pos int, col *Column, val TypedValue)) error {
+	if len(v) < EncLenLen {
+		return ErrCorruptedData
+	}
 
 	voff := 0
 
@@ -496,68 +597,115 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 
 	for i, pos := 0, 0; i < cols; i++ {
 		if len(v) < EncIDLen {
-			return nil, ErrCorruptedData
+			return ErrCorruptedData
 		}
 
 		colID := binary.BigEndian.Uint32(v[voff:])
 		voff += EncIDLen
 
-		col, err := r.table.GetColumnByID(colID)
-		if errors.Is(err, ErrColumnDoesNotExist) && colID <= r.table.maxColID {
+		col, err := table.GetColumnByID(colID)
+		if errors.Is(err, ErrColumnDoesNotExist) && colID <= table.maxColID {
 			// Dropped column, skip it
 			vlen, n, err := DecodeValueLength(v[voff:])
 			if err != nil {
-				return nil, err
+				return err
 			}
 			voff += n + vlen
 
 			continue
 		}
 		if err != nil {
-			return nil, ErrCorruptedData
+			return ErrCorruptedData
 		}
 
 		val, n, err := DecodeValue(v[voff:], col.colType)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		voff += n
 
 		// make sure value is inserted in the correct position
-		for pos < len(r.table.cols) && r.table.cols[pos].id < colID {
+		for pos < len(table.cols) && table.cols[pos].id < colID {
 			pos++
 		}
 
-		if pos == len(r.table.cols) || r.table.cols[pos].id != colID {
-			return nil, ErrCorruptedData
+		if pos == len(table.cols) || table.cols[pos].id != colID {
+			return ErrCorruptedData
 		}
 
-		valuesByPosition[pos+extraCols] = val
+		fn(pos, col, val)
 
 		pos++
-
-		valuesBySelector[EncodeSelector("", r.tableAlias, col.colName)] = val
 	}
 
 	if len(v)-voff > 0 {
-		return nil, ErrCorruptedData
+		return ErrCorruptedData
 	}
 
-	return &Row{ValuesByPosition: valuesByPosition, ValuesBySelector: valuesBySelector}, nil
+	return nil
 }
 
 func (r *rawRowReader) parseTxMetadata(txmd *store.TxMetadata) (TypedValue, error) {
+	return r.tx.engine.txMetadataValue(txmd)
+}
+
+func (r *rawRowReader) txTimestamp(txID uint64) (TypedValue, error) {
+	if r.lastTxID == txID {
+		return r.lastTxTs, nil
+	}
+
+	ts, err := r.tx.engine.txTimestampValue(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lastTxID = txID
+	r.lastTxTs = ts
+
+	return ts, nil
+}
+
+func (e *Engine) txTimestampValue(txID uint64) (TypedValue, error) {
+	hdr, err := e.store.ReadTxHeader(txID, true, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Timestamp{val: time.Unix(hdr.Ts, 0).UTC()}, nil
+}
+
+// txUserValue returns the name of the user who committed the tx,
+// as recorded in the tx metadata under the configured key
+func (e *Engine) txUserValue(txmd *store.TxMetadata) (TypedValue, error) {
+	if txmd == nil || txmd.Extra() == nil || e.txUserMetadataKey == "" {
+		return &NullValue{t: VarcharType}, nil
+	}
+
+	md, err := e.txMetadataValue(txmd)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, _ := md.RawValue().(map[string]interface{})
+
+	usr, ok := fields[e.txUserMetadataKey].(string)
+	if !ok {
+		return &NullValue{t: VarcharType}, nil
+	}
+	return &Varchar{val: usr}, nil
+}
+
+func (e *Engine) txMetadataValue(txmd *store.TxMetadata) (TypedValue, error) {
 	if txmd == nil {
 		return &NullValue{t: JSONType}, nil
 	}
 
 	if extra := txmd.Extra(); extra != nil {
-		if r.tx.engine.parseTxMetadata == nil {
+		if e.parseTxMetadata == nil {
 			return nil, fmt.Errorf("unable to parse tx metadata")
 		}
 
-		md, err := r.tx.engine.parseTxMetadata(extra)
+		md, err := e.parseTxMetadata(extra)
 		if err != nil {
 			return nil, fmt.Errorf("%w: %s", ErrInvalidTxMetadata, err)
 		}
@@ -571,7 +719,20 @@ func (r *rawRowReader) Close() error {
 		defer r.onCloseCallback()
 	}
 
-	return r.reader.Close()
+	if len(r.readers) == 1 {
+		return r.readers[0].Close()
+	}
+	return closeKeyReaders(r.readers)
+}
+
+func closeKeyReaders(readers []store.KeyReader) error {
+	merr := multierr.NewMultiErr()
+
+	for _, reader := range readers {
+		merr.Append(reader.Close())
+	}
+
+	return merr.Reduce()
 }
 
 func ReadAllRows(ctx context.Context, reader RowReader) ([]*Row, error) {
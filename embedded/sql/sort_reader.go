@@ -80,6 +80,7 @@ func newSortRowReader(rowReader RowReader, ordExps []*OrdExp) (*sortRowReader, e
 			tx:               tx,
 			sortBufSize:      tx.engine.sortBufferSize,
 			sortBuf:          make([]*Row, tx.engine.sortBufferSize),
+			memBudget:        tx.memBudget,
 		},
 	}
 
@@ -47,6 +47,8 @@ func setResult(l yyLexer, stmts []SQLStmt) {
     sel Selector
     targets []TargetEntry
     jsonFields []string
+    indexCols []*indexCol
+    indexCol *indexCol
     distinct bool
     ds DataSource
     tableRef *tableRef
@@ -74,6 +76,10 @@ func setResult(l yyLexer, stmts []SQLStmt) {
     whenThenClauses []whenThenClause
     tableElem TableElem
     tableElems []TableElem
+    triggerEvents TriggerEvent
+    routineParams []*routineParam
+    routineParam *routineParam
+    routineDef *CreateRoutineStmt
 }
 
 %token CREATE DROP USE DATABASE USER WITH PASSWORD READ READWRITE ADMIN SNAPSHOT HISTORY SINCE AFTER BEFORE UNTIL TX OF TIMESTAMP
@@ -83,7 +89,12 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %token SELECT DISTINCT FROM JOIN HAVING WHERE GROUP BY LIMIT OFFSET ORDER ASC DESC AS UNION ALL CASE WHEN THEN ELSE END
 %token NOT LIKE IF EXISTS IN IS
 %token AUTO_INCREMENT NULL CAST SCAST
-%token SHOW DATABASES TABLES USERS
+%token SHOW DATABASES TABLES USERS SCHEMA
+%token ANALYZE CHANGES
+%token MATERIALIZED VIEW REFRESH INCREMENTAL TRUNCATE
+%token TRIGGER EACH ROW EXECUTE
+%token DECLARE CURSOR FETCH NEXT CLOSE
+%token FUNCTION PROCEDURE RETURNS RETURN CALL REPLACE RAISE WHILE LOOP ROUTINE_BODY
 %token <id> NPARAM
 %token <pparam> PPARAM
 %token <joinType> JOINTYPE
@@ -101,6 +112,7 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %token <err> ERROR
 %token <dot> DOT
 %token <arrow> ARROW
+%token TEXT_ARROW PATH_ARROW TEXT_PATH_ARROW CONTAINS_OP HAS_KEY_OP
 
 %left  ','
 %right AS
@@ -113,6 +125,7 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %right NOT
 
 %left CMPOP
+%left CONTAINS_OP HAS_KEY_OP
 %left '+' '-'
 %left '*' '/' '%'
 %left  '.'
@@ -120,7 +133,11 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %left IS
 
 %type <stmts> sql sqlstmts
-%type <stmt> sqlstmt ddlstmt dmlstmt dqlstmt select_stmt
+%type <stmt> sqlstmt ddlstmt dmlstmt dqlstmt select_stmt cursorstmt callstmt routine_stmt
+%type <stmts> routine_stmts
+%type <routineParams> opt_routine_params routine_params
+%type <routineParam> routine_param
+%type <routineDef> routine_def
 %type <colSpec> colSpec
 %type <ids> ids one_or_more_ids opt_ids
 %type <cols> cols
@@ -129,7 +146,10 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %type <values> values opt_values
 %type <value> val fnCall
 %type <sel> selector
-%type <jsonFields> jsonFields
+%type <jsonFields> jsonFields json_text_path
+%type <id> json_field
+%type <indexCols> index_cols
+%type <indexCol> index_col
 %type <col> col
 %type <distinct> opt_distinct opt_all
 %type <ds> ds values_or_query
@@ -149,12 +169,12 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %type <cols> opt_groupby
 %type <exp> opt_limit opt_offset case_when_exp
 %type <targets> opt_targets targets
-%type <integer> opt_max_len
-%type <id> opt_as
+%type <integer> opt_max_len opt_fetch_count
+%type <id> opt_as qualifiedName
 %type <ordexps> ordexps opt_orderby
 %type <opt_ord> opt_ord
 %type <ids> opt_indexon
-%type <boolean> opt_if_not_exists opt_auto_increment opt_not_null opt_not opt_primary_key
+%type <boolean> opt_if_not_exists opt_auto_increment opt_not_null opt_not opt_primary_key opt_incremental
 %type <update> update
 %type <updates> updates
 %type <onConflict> opt_on_conflict
@@ -162,6 +182,7 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %type <sqlPrivilege> sqlPrivilege
 %type <sqlPrivileges> sqlPrivileges
 %type <whenThenClauses> when_then_clauses
+%type <triggerEvents> trigger_events trigger_event
 
 %start sql
 
@@ -172,6 +193,12 @@ sql: sqlstmts
         $$ = $1
         setResult(yylex, $1)
     }
+|
+    ROUTINE_BODY routine_stmts
+    {
+        $$ = $2
+        setResult(yylex, $2)
+    }
 
 sqlstmts:
     sqlstmt opt_separator
@@ -186,7 +213,127 @@ sqlstmts:
 
 opt_separator: {} | STMT_SEPARATOR
 
-sqlstmt: ddlstmt | dmlstmt | dqlstmt
+sqlstmt: ddlstmt | dmlstmt | dqlstmt | cursorstmt | callstmt
+
+callstmt:
+    CALL IDENTIFIER '(' opt_values ')'
+    {
+        $$ = &CallStmt{procedure: $2, args: $4}
+    }
+
+routine_stmts:
+    routine_stmt opt_separator
+    {
+        $$ = []SQLStmt{$1}
+    }
+|
+    routine_stmt STMT_SEPARATOR routine_stmts
+    {
+        $$ = append([]SQLStmt{$1}, $3...)
+    }
+
+routine_stmt:
+    DECLARE NPARAM TYPE
+    {
+        $$ = &DeclareVarStmt{variable: $2, varType: $3}
+    }
+|
+    DECLARE NPARAM TYPE CMPOP exp
+    {
+        $$ = &DeclareVarStmt{variable: $2, varType: $3, op: $4, exp: $5}
+    }
+|
+    SET NPARAM CMPOP exp
+    {
+        $$ = &SetVarStmt{variable: $2, op: $3, exp: $4}
+    }
+|
+    SET NPARAM CMPOP '(' dqlstmt ')'
+    {
+        $$ = &SetVarStmt{variable: $2, op: $3, query: $5.(DataSource)}
+    }
+|
+    IF exp THEN routine_stmts END IF
+    {
+        $$ = &IfStmt{cond: $2, then: $4}
+    }
+|
+    IF exp THEN routine_stmts ELSE routine_stmts END IF
+    {
+        $$ = &IfStmt{cond: $2, then: $4, els: $6}
+    }
+|
+    WHILE exp LOOP routine_stmts END LOOP
+    {
+        $$ = &WhileStmt{cond: $2, body: $4}
+    }
+|
+    BEGIN routine_stmts END
+    {
+        $$ = &BlockStmt{stmts: $2}
+    }
+|
+    RAISE exp
+    {
+        $$ = &RaiseStmt{exp: $2}
+    }
+|
+    RETURN
+    {
+        $$ = &ReturnStmt{}
+    }
+|
+    RETURN exp
+    {
+        $$ = &ReturnStmt{exp: $2}
+    }
+|
+    dqlstmt
+    {
+        $$ = &ReturnStmt{query: $1.(DataSource)}
+    }
+|
+    dmlstmt
+|
+    callstmt
+
+cursorstmt:
+    DECLARE IDENTIFIER CURSOR FOR dqlstmt
+    {
+        $$ = &DeclareCursorStmt{cursor: $2, query: $5.(DataSource)}
+    }
+|
+    FETCH opt_fetch_count fetch_from IDENTIFIER
+    {
+        $$ = &FetchCursorStmt{cursor: $4, count: $2}
+    }
+|
+    FETCH ALL fetch_from IDENTIFIER
+    {
+        $$ = &FetchCursorStmt{cursor: $4, all: true}
+    }
+|
+    CLOSE IDENTIFIER
+    {
+        $$ = &CloseCursorStmt{cursor: $2}
+    }
+
+opt_fetch_count:
+    {
+        $$ = 1
+    }
+|
+    NEXT
+    {
+        $$ = 1
+    }
+|
+    INTEGER
+    {
+        $$ = $1
+    }
+
+fetch_from: FROM | IN
 
 ddlstmt:
     BEGIN TRANSACTION
@@ -229,7 +376,17 @@ ddlstmt:
         $$ = &UseSnapshotStmt{period: $3}
     }
 |
-    CREATE TABLE opt_if_not_exists IDENTIFIER '(' tableElems ')'
+    CREATE SCHEMA opt_if_not_exists IDENTIFIER
+    {
+        $$ = &CreateSchemaStmt{ifNotExists: $3, schema: $4}
+    }
+|
+    DROP SCHEMA IDENTIFIER
+    {
+        $$ = &DropSchemaStmt{schema: $3}
+    }
+|
+    CREATE TABLE opt_if_not_exists qualifiedName '(' tableElems ')'
     {
         colsSpecs := make([]*ColSpec, 0, 5)
         var checks []CheckConstraint
@@ -259,24 +416,95 @@ ddlstmt:
         }
     }
 |
-    DROP TABLE IDENTIFIER
+    CREATE TABLE opt_if_not_exists qualifiedName AS select_stmt
+    {
+        $$ = &CreateTableAsSelectStmt{ifNotExists: $3, table: $4, selectStmt: $6.(*SelectStmt)}
+    }
+|
+    CREATE TABLE opt_if_not_exists qualifiedName PRIMARY KEY one_or_more_ids AS select_stmt
+    {
+        $$ = &CreateTableAsSelectStmt{ifNotExists: $3, table: $4, pkColNames: $7, selectStmt: $9.(*SelectStmt)}
+    }
+|
+    DROP TABLE qualifiedName
     {
         $$ = &DropTableStmt{table: $3}
     }
 |
-    CREATE INDEX opt_if_not_exists ON IDENTIFIER '(' ids ')'
+    TRUNCATE opt_table qualifiedName
+    {
+        $$ = &TruncateTableStmt{table: $3}
+    }
+|
+    CREATE MATERIALIZED VIEW opt_if_not_exists qualifiedName opt_incremental AS select_stmt
     {
-        $$ = &CreateIndexStmt{ifNotExists: $3, table: $5, cols: $7}
+        $$ = &CreateMaterializedViewStmt{ifNotExists: $4, view: $5, incremental: $6, selectStmt: $8.(*SelectStmt)}
     }
 |
-    CREATE UNIQUE INDEX opt_if_not_exists ON IDENTIFIER '(' ids ')'
+    REFRESH MATERIALIZED VIEW qualifiedName
     {
-        $$ = &CreateIndexStmt{unique: true, ifNotExists: $4, table: $6, cols: $8}
+        $$ = &RefreshMaterializedViewStmt{view: $4}
     }
 |
-    DROP INDEX ON IDENTIFIER '(' ids ')'
+    DROP MATERIALIZED VIEW qualifiedName
     {
-        $$ = &DropIndexStmt{table: $4, cols: $6}
+        $$ = &DropMaterializedViewStmt{view: $4}
+    }
+|
+    CREATE TRIGGER opt_if_not_exists IDENTIFIER AFTER trigger_events ON qualifiedName FOR EACH ROW EXECUTE dmlstmt
+    {
+        $$ = &CreateTriggerStmt{ifNotExists: $3, trigger: $4, events: $6, table: $8, stmt: $13}
+    }
+|
+    DROP TRIGGER IDENTIFIER ON qualifiedName
+    {
+        $$ = &DropTriggerStmt{trigger: $3, table: $5}
+    }
+|
+    CREATE routine_def
+    {
+        $$ = $2
+    }
+|
+    CREATE OR REPLACE routine_def
+    {
+        $4.orReplace = true
+        $$ = $4
+    }
+|
+    DROP FUNCTION IDENTIFIER
+    {
+        $$ = &DropRoutineStmt{kind: FunctionRoutine, name: $3}
+    }
+|
+    DROP PROCEDURE IDENTIFIER
+    {
+        $$ = &DropRoutineStmt{kind: ProcedureRoutine, name: $3}
+    }
+|
+    ANALYZE
+    {
+        $$ = &AnalyzeStmt{}
+    }
+|
+    ANALYZE qualifiedName
+    {
+        $$ = &AnalyzeStmt{table: $2}
+    }
+|
+    CREATE INDEX opt_if_not_exists ON qualifiedName '(' index_cols ')'
+    {
+        $$ = newCreateIndexStmt(false, $3, $5, $7)
+    }
+|
+    CREATE UNIQUE INDEX opt_if_not_exists ON qualifiedName '(' index_cols ')'
+    {
+        $$ = newCreateIndexStmt(true, $4, $6, $8)
+    }
+|
+    DROP INDEX ON qualifiedName '(' index_cols ')'
+    {
+        $$ = &DropIndexStmt{table: $4, cols: indexColNames($6)}
     }
 |
     DROP INDEX IDENTIFIER DOT IDENTIFIER
@@ -284,27 +512,27 @@ ddlstmt:
         $$ = &DropIndexStmt{table: $3, cols: []string{$5}}
     }
 |
-    ALTER TABLE IDENTIFIER ADD COLUMN colSpec
+    ALTER TABLE qualifiedName ADD COLUMN colSpec
     {
         $$ = &AddColumnStmt{table: $3, colSpec: $6}
     }
 |
-    ALTER TABLE IDENTIFIER RENAME TO IDENTIFIER
+    ALTER TABLE qualifiedName RENAME TO IDENTIFIER
     {
         $$ = &RenameTableStmt{oldName: $3, newName: $6}
     }
 |
-    ALTER TABLE IDENTIFIER RENAME COLUMN IDENTIFIER TO IDENTIFIER
+    ALTER TABLE qualifiedName RENAME COLUMN IDENTIFIER TO IDENTIFIER
     {
         $$ = &RenameColumnStmt{table: $3, oldName: $6, newName: $8}
     }
 |
-    ALTER TABLE IDENTIFIER DROP COLUMN IDENTIFIER
+    ALTER TABLE qualifiedName DROP COLUMN IDENTIFIER
     {
         $$ = &DropColumnStmt{table: $3, colName: $6}
     }
 |
-    ALTER TABLE IDENTIFIER DROP CONSTRAINT IDENTIFIER
+    ALTER TABLE qualifiedName DROP CONSTRAINT IDENTIFIER
     {
         $$ = &DropConstraintStmt{table: $3, constraintName: $6}
     }
@@ -333,6 +561,36 @@ ddlstmt:
     {
         $$ = &AlterPrivilegesStmt{database: $5, user: $8, privileges: $2}
     }
+|
+    GRANT sqlPrivileges ON TABLE qualifiedName TO USER IDENTIFIER
+    {
+        $$ = &AlterTablePrivilegesStmt{table: $5, user: $8, privileges: $2, isGrant: true}
+    }
+|
+    GRANT SELECT '(' ids ')' ON TABLE qualifiedName TO USER IDENTIFIER
+    {
+        $$ = &AlterTablePrivilegesStmt{table: $8, user: $11, privileges: []SQLPrivilege{SQLPrivilegeSelect}, columns: $4, isGrant: true}
+    }
+|
+    REVOKE sqlPrivileges ON TABLE qualifiedName TO USER IDENTIFIER
+    {
+        $$ = &AlterTablePrivilegesStmt{table: $5, user: $8, privileges: $2}
+    }
+|
+    REVOKE SELECT '(' ids ')' ON TABLE qualifiedName TO USER IDENTIFIER
+    {
+        $$ = &AlterTablePrivilegesStmt{table: $8, user: $11, privileges: []SQLPrivilege{SQLPrivilegeSelect}, columns: $4}
+    }
+|
+    GRANT sqlPrivileges ON SCHEMA IDENTIFIER TO USER IDENTIFIER
+    {
+        $$ = &AlterSchemaPrivilegesStmt{schema: $5, user: $8, privileges: $2, isGrant: true}
+    }
+|
+    REVOKE sqlPrivileges ON SCHEMA IDENTIFIER TO USER IDENTIFIER
+    {
+        $$ = &AlterSchemaPrivilegesStmt{schema: $5, user: $8, privileges: $2}
+    }
 
 sqlPrivileges:
     ALL PRIVILEGES
@@ -385,6 +643,11 @@ sqlPrivilege:
     {
         $$ = SQLPrivilegeAlter
     }
+|
+    EXECUTE
+    {
+        $$ = SQLPrivilegeExecute
+    }
 
 permission:
     {
@@ -416,6 +679,89 @@ opt_if_not_exists:
         $$ = true
     }
 
+trigger_events:
+    trigger_event
+    {
+        $$ = $1
+    }
+|
+    trigger_events OR trigger_event
+    {
+        $$ = $1 | $3
+    }
+
+trigger_event:
+    INSERT
+    {
+        $$ = TriggerOnInsert
+    }
+|
+    UPDATE
+    {
+        $$ = TriggerOnUpdate
+    }
+|
+    DELETE
+    {
+        $$ = TriggerOnDelete
+    }
+
+routine_def:
+    FUNCTION IDENTIFIER '(' opt_routine_params ')' RETURNS TYPE AS VARCHAR
+    {
+        $$ = &CreateRoutineStmt{kind: FunctionRoutine, name: $2, params: $4, returnType: $7, body: $9}
+    }
+|
+    PROCEDURE IDENTIFIER '(' opt_routine_params ')' AS VARCHAR
+    {
+        $$ = &CreateRoutineStmt{kind: ProcedureRoutine, name: $2, params: $4, body: $7}
+    }
+
+opt_routine_params:
+    {
+        $$ = nil
+    }
+|
+    routine_params
+    {
+        $$ = $1
+    }
+
+routine_params:
+    routine_param
+    {
+        $$ = []*routineParam{$1}
+    }
+|
+    routine_params ',' routine_param
+    {
+        $$ = append($1, $3)
+    }
+
+routine_param:
+    NPARAM TYPE
+    {
+        $$ = &routineParam{name: $1, paramType: $2}
+    }
+
+opt_incremental:
+    {
+        $$ = false
+    }
+|
+    WITH INCREMENTAL REFRESH
+    {
+        $$ = true
+    }
+
+opt_table:
+    {
+    }
+|
+    TABLE
+    {
+    }
+
 one_or_more_ids:
     IDENTIFIER
     {
@@ -613,6 +959,22 @@ fnCall:
     {
         $$ = &FnCall{fn: $1, params: $3}
     }
+|
+    IDENTIFIER '(' boundexp IN selector ')'
+    {
+        // e.g. POSITION(substring IN string)
+        $$ = &FnCall{fn: $1, params: []ValueExp{$3, $5}}
+    }
+|
+    IDENTIFIER '(' boundexp IN val ')'
+    {
+        $$ = &FnCall{fn: $1, params: []ValueExp{$3, $5}}
+    }
+|
+    REPLACE '(' opt_values ')'
+    {
+        $$ = &FnCall{fn: ReplaceFnCall, params: $3}
+    }
 
 tableElems:
     tableElem
@@ -728,7 +1090,7 @@ dqlstmt:
         }
     }
 |
-    SHOW TABLE IDENTIFIER
+    SHOW TABLE qualifiedName
     {
         $$ = &SelectStmt{
             ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: $3}}}},
@@ -835,6 +1197,16 @@ selector:
     {
         $$ = &JSONSelector{ColSelector: $1, fields: $2}
     }
+|
+    col json_text_path
+    {
+        $$ = &JSONSelector{ColSelector: $1, fields: $2, text: true}
+    }
+|
+    col PATH_ARROW VARCHAR
+    {
+        $$ = &JSONSelector{ColSelector: $1, fields: parseJSONPath($3)}
+    }
 |
     AGGREGATE_FUNC '(' '*' ')'
     {
@@ -847,16 +1219,70 @@ selector:
     }
 
 jsonFields:
-    ARROW VARCHAR
+    ARROW json_field
     {
         $$ = []string{$2}
     }
 |
-    jsonFields ARROW VARCHAR
+    jsonFields ARROW json_field
     {
         $$ = append($$, $3)
     }
 
+json_text_path:
+    TEXT_ARROW json_field
+    {
+        $$ = []string{$2}
+    }
+|
+    jsonFields TEXT_ARROW json_field
+    {
+        $$ = append($1, $3)
+    }
+|
+    TEXT_PATH_ARROW VARCHAR
+    {
+        $$ = parseJSONPath($2)
+    }
+
+json_field:
+    VARCHAR
+    {
+        $$ = $1
+    }
+|
+    INTEGER
+    {
+        $$ = fmt.Sprintf("%d", $1)
+    }
+
+index_cols:
+    index_col
+    {
+        $$ = []*indexCol{$1}
+    }
+|
+    index_cols ',' index_col
+    {
+        $$ = append($1, $3)
+    }
+
+index_col:
+    IDENTIFIER
+    {
+        $$ = &indexCol{col: $1}
+    }
+|
+    IDENTIFIER json_text_path
+    {
+        $$ = &indexCol{col: $1, path: $2}
+    }
+|
+    '(' IDENTIFIER json_text_path ')'
+    {
+        $$ = &indexCol{col: $2, path: $3}
+    }
+
 col:
     IDENTIFIER
     {
@@ -897,7 +1323,7 @@ ds:
         $$ = &FnDataSourceStmt{fnCall:  &FnCall{fn: "tables"}, as: $4}
     }
 |
-    TABLE '(' IDENTIFIER ')'
+    TABLE '(' qualifiedName ')'
     {
         $$ = &FnDataSourceStmt{fnCall:  &FnCall{fn: "table", params: []ValueExp{&Varchar{val: $3}}}}
     }
@@ -912,17 +1338,38 @@ ds:
         $$ = &FnDataSourceStmt{fnCall: $1.(*FnCall), as: $2}
     }
 |
-    '(' HISTORY OF IDENTIFIER ')' opt_as
+    '(' HISTORY OF qualifiedName ')' opt_as
     {
         $$ = &tableRef{table: $4, history: true, as: $6}
     }
+|
+    CHANGES OF qualifiedName opt_period opt_as
+    {
+        $$ = &changesDataSource{table: $3, period: $4, as: $5}
+    }
 
 tableRef:
-    IDENTIFIER
+    qualifiedName
     {
         $$ = &tableRef{table: $1}
     }
 
+qualifiedName:
+    IDENTIFIER
+    {
+        $$ = $1
+    }
+|
+    IDENTIFIER DOT IDENTIFIER
+    {
+        $$ = $1 + "." + $3
+    }
+|
+    IDENTIFIER DOT TABLES
+    {
+        $$ = $1 + ".tables"
+    }
+
 opt_period:
     opt_period_start opt_period_end
     {
@@ -1072,9 +1519,19 @@ opt_indexon:
         $$ = nil
     }
 |
-    USE INDEX ON one_or_more_ids
+    USE INDEX ON IDENTIFIER
     {
-        $$ = $4
+        $$ = []string{$4}
+    }
+|
+    USE INDEX ON IDENTIFIER json_text_path
+    {
+        $$ = []string{jsonPathColName($4, $5)}
+    }
+|
+    USE INDEX ON '(' index_cols ')'
+    {
+        $$ = indexColNames($5)
     }
 
 ordexps:
@@ -1182,14 +1639,24 @@ exp:
         $$ = &ExistsBoolExp{q: ($3).(DataSource)}
     }
 |
-    boundexp opt_not IN '(' dqlstmt ')'
+    boundexp IN '(' dqlstmt ')'
+    {
+        $$ = &InSubQueryExp{val: $1, q: $4.(*SelectStmt)}
+    }
+|
+    boundexp NOT IN '(' dqlstmt ')'
     {
-        $$ = &InSubQueryExp{val: $1, notIn: $2, q: $5.(*SelectStmt)}
+        $$ = &InSubQueryExp{val: $1, notIn: true, q: $5.(*SelectStmt)}
     }
 |
-    boundexp opt_not IN '(' values ')'
+    boundexp IN '(' values ')'
     {
-        $$ = &InListExp{val: $1, notIn: $2, values: $5}
+        $$ = &InListExp{val: $1, values: $4}
+    }
+|
+    boundexp NOT IN '(' values ')'
+    {
+        $$ = &InListExp{val: $1, notIn: true, values: $5}
     }
 |
     case_when_exp
@@ -1302,6 +1769,16 @@ binExp:
     {
         $$ = &CmpBoolExp{left: $1, op: $2, right: $3}
     }
+|
+    exp CONTAINS_OP exp
+    {
+        $$ = &JSONBoolExp{left: $1, op: JSONContains, right: $3}
+    }
+|
+    exp HAS_KEY_OP exp
+    {
+        $$ = &JSONBoolExp{left: $1, op: JSONHasKey, right: $3}
+    }
 |
     exp IS NULL
     {
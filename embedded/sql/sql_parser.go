@@ -33,6 +33,8 @@ type yySymType struct {
 	sel             Selector
 	targets         []TargetEntry
 	jsonFields      []string
+	indexCols       []*indexCol
+	indexCol        *indexCol
 	distinct        bool
 	ds              DataSource
 	tableRef        *tableRef
@@ -60,6 +62,10 @@ type yySymType struct {
 	whenThenClauses []whenThenClause
 	tableElem       TableElem
 	tableElems      []TableElem
+	triggerEvents   TriggerEvent
+	routineParams   []*routineParam
+	routineParam    *routineParam
+	routineDef      *CreateRoutineStmt
 }
 
 const CREATE = 57346
@@ -149,25 +155,57 @@ const SHOW = 57429
 const DATABASES = 57430
 const TABLES = 57431
 const USERS = 57432
-const NPARAM = 57433
-const PPARAM = 57434
-const JOINTYPE = 57435
-const AND = 57436
-const OR = 57437
-const CMPOP = 57438
-const NOT_MATCHES_OP = 57439
-const IDENTIFIER = 57440
-const TYPE = 57441
-const INTEGER = 57442
-const FLOAT = 57443
-const VARCHAR = 57444
-const BOOLEAN = 57445
-const BLOB = 57446
-const AGGREGATE_FUNC = 57447
-const ERROR = 57448
-const DOT = 57449
-const ARROW = 57450
-const STMT_SEPARATOR = 57451
+const SCHEMA = 57433
+const ANALYZE = 57434
+const CHANGES = 57435
+const MATERIALIZED = 57436
+const VIEW = 57437
+const REFRESH = 57438
+const INCREMENTAL = 57439
+const TRUNCATE = 57440
+const TRIGGER = 57441
+const EACH = 57442
+const ROW = 57443
+const EXECUTE = 57444
+const DECLARE = 57445
+const CURSOR = 57446
+const FETCH = 57447
+const NEXT = 57448
+const CLOSE = 57449
+const FUNCTION = 57450
+const PROCEDURE = 57451
+const RETURNS = 57452
+const RETURN = 57453
+const CALL = 57454
+const REPLACE = 57455
+const RAISE = 57456
+const WHILE = 57457
+const LOOP = 57458
+const ROUTINE_BODY = 57459
+const NPARAM = 57460
+const PPARAM = 57461
+const JOINTYPE = 57462
+const AND = 57463
+const OR = 57464
+const CMPOP = 57465
+const NOT_MATCHES_OP = 57466
+const IDENTIFIER = 57467
+const TYPE = 57468
+const INTEGER = 57469
+const FLOAT = 57470
+const VARCHAR = 57471
+const BOOLEAN = 57472
+const BLOB = 57473
+const AGGREGATE_FUNC = 57474
+const ERROR = 57475
+const DOT = 57476
+const ARROW = 57477
+const TEXT_ARROW = 57478
+const PATH_ARROW = 57479
+const TEXT_PATH_ARROW = 57480
+const CONTAINS_OP = 57481
+const HAS_KEY_OP = 57482
+const STMT_SEPARATOR = 57483
 
 var yyToknames = [...]string{
 	"$end",
@@ -260,6 +298,33 @@ var yyToknames = [...]string{
 	"DATABASES",
 	"TABLES",
 	"USERS",
+	"SCHEMA",
+	"ANALYZE",
+	"CHANGES",
+	"MATERIALIZED",
+	"VIEW",
+	"REFRESH",
+	"INCREMENTAL",
+	"TRUNCATE",
+	"TRIGGER",
+	"EACH",
+	"ROW",
+	"EXECUTE",
+	"DECLARE",
+	"CURSOR",
+	"FETCH",
+	"NEXT",
+	"CLOSE",
+	"FUNCTION",
+	"PROCEDURE",
+	"RETURNS",
+	"RETURN",
+	"CALL",
+	"REPLACE",
+	"RAISE",
+	"WHILE",
+	"LOOP",
+	"ROUTINE_BODY",
 	"NPARAM",
 	"PPARAM",
 	"JOINTYPE",
@@ -278,6 +343,11 @@ var yyToknames = [...]string{
 	"ERROR",
 	"DOT",
 	"ARROW",
+	"TEXT_ARROW",
+	"PATH_ARROW",
+	"TEXT_PATH_ARROW",
+	"CONTAINS_OP",
+	"HAS_KEY_OP",
 	"','",
 	"'+'",
 	"'-'",
@@ -302,300 +372,446 @@ var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 97,
-	78, 199,
-	81, 199,
-	-2, 180,
-	-1, 277,
-	59, 152,
-	-2, 147,
-	-1, 331,
-	59, 152,
-	-2, 149,
+	-1, 116,
+	78, 290,
+	-2, 269,
+	-1, 338,
+	78, 290,
+	-2, 269,
+	-1, 476,
+	59, 239,
+	-2, 234,
+	-1, 551,
+	59, 239,
+	-2, 236,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 593
+const yyLast = 1054
 
 var yyAct = [...]int16{
-	131, 435, 324, 107, 271, 153, 203, 336, 200, 209,
-	244, 115, 353, 330, 335, 249, 245, 319, 6, 310,
-	147, 144, 71, 250, 22, 408, 358, 269, 357, 269,
-	301, 130, 374, 409, 269, 269, 404, 303, 395, 384,
-	106, 375, 129, 360, 314, 99, 302, 269, 101, 402,
-	401, 387, 118, 114, 214, 21, 270, 383, 381, 116,
-	117, 354, 96, 343, 341, 340, 119, 338, 109, 110,
-	111, 112, 113, 108, 300, 298, 297, 291, 268, 100,
-	355, 337, 180, 106, 309, 105, 290, 179, 99, 285,
-	284, 101, 179, 283, 162, 118, 114, 282, 256, 189,
-	169, 170, 116, 117, 162, 182, 172, 174, 149, 119,
-	162, 109, 110, 111, 112, 113, 108, 132, 161, 212,
-	213, 215, 100, 178, 157, 156, 158, 177, 105, 217,
-	171, 188, 154, 155, 157, 156, 158, 143, 154, 155,
-	157, 156, 158, 142, 434, 24, 205, 428, 211, 145,
-	374, 242, 202, 218, 301, 219, 220, 221, 222, 223,
-	224, 225, 226, 216, 162, 240, 269, 232, 206, 152,
-	186, 187, 83, 238, 436, 437, 159, 160, 161, 243,
-	246, 241, 176, 180, 134, 296, 265, 258, 239, 162,
-	234, 393, 154, 155, 157, 156, 158, 207, 259, 392,
-	235, 159, 160, 161, 350, 164, 76, 305, 233, 276,
-	242, 255, 252, 274, 254, 260, 277, 154, 155, 157,
-	156, 158, 286, 201, 287, 32, 168, 164, 275, 162,
-	280, 289, 33, 278, 163, 167, 378, 364, 295, 363,
-	162, 159, 160, 161, 362, 407, 166, 333, 342, 322,
-	307, 148, 159, 160, 161, 306, 163, 154, 155, 157,
-	156, 158, 264, 263, 262, 308, 261, 253, 154, 155,
-	157, 156, 158, 326, 257, 247, 229, 253, 77, 328,
-	198, 197, 190, 183, 334, 316, 150, 321, 323, 321,
-	133, 246, 162, 122, 347, 348, 120, 91, 54, 80,
-	79, 78, 351, 75, 159, 70, 161, 345, 22, 344,
-	69, 208, 288, 406, 39, 162, 31, 320, 361, 352,
-	154, 155, 157, 156, 158, 22, 368, 391, 181, 230,
-	49, 370, 231, 385, 390, 367, 65, 58, 246, 21,
-	369, 162, 121, 281, 377, 372, 379, 380, 376, 382,
-	386, 371, 60, 159, 160, 161, 21, 349, 90, 228,
-	394, 346, 237, 55, 388, 162, 227, 420, 325, 154,
-	155, 157, 156, 158, 272, 427, 279, 159, 160, 161,
-	413, 398, 400, 399, 22, 216, 403, 145, 293, 412,
-	294, 64, 373, 154, 155, 157, 156, 158, 140, 151,
-	52, 62, 56, 57, 59, 418, 414, 410, 415, 396,
-	88, 51, 50, 421, 25, 21, 82, 423, 106, 66,
-	67, 359, 92, 99, 426, 429, 101, 425, 432, 430,
-	118, 114, 433, 304, 417, 438, 191, 116, 117, 315,
-	439, 194, 195, 267, 119, 137, 109, 110, 111, 112,
-	113, 108, 192, 193, 106, 266, 424, 100, 94, 99,
-	124, 210, 101, 105, 299, 366, 118, 114, 135, 136,
-	327, 184, 123, 116, 117, 84, 81, 162, 43, 47,
-	119, 53, 109, 110, 111, 112, 113, 108, 273, 159,
-	160, 161, 68, 100, 10, 12, 11, 2, 339, 105,
-	38, 48, 138, 36, 318, 154, 155, 157, 156, 158,
-	73, 74, 85, 86, 87, 37, 196, 13, 34, 44,
-	35, 185, 63, 46, 45, 125, 14, 15, 128, 127,
-	42, 7, 317, 8, 9, 16, 17, 26, 30, 18,
-	19, 141, 311, 312, 313, 40, 22, 139, 204, 23,
-	236, 41, 365, 27, 29, 28, 146, 416, 165, 389,
-	405, 419, 431, 356, 95, 93, 102, 397, 98, 292,
-	97, 411, 173, 248, 251, 332, 331, 21, 329, 126,
-	72, 89, 61, 175, 103, 104, 422, 199, 20, 5,
-	4, 3, 1,
+	294, 686, 126, 544, 469, 556, 43, 6, 380, 297,
+	134, 389, 448, 26, 227, 550, 158, 95, 555, 437,
+	292, 449, 282, 509, 540, 518, 425, 353, 122, 42,
+	7, 123, 293, 272, 285, 33, 74, 426, 115, 140,
+	254, 142, 143, 328, 116, 351, 78, 649, 577, 345,
+	576, 526, 526, 6, 372, 610, 526, 650, 137, 133,
+	656, 631, 388, 629, 611, 585, 53, 32, 89, 526,
+	504, 567, 388, 644, 643, 141, 7, 372, 525, 503,
+	633, 492, 163, 125, 617, 344, 468, 139, 118, 171,
+	450, 120, 135, 136, 174, 137, 133, 604, 27, 138,
+	372, 128, 129, 130, 131, 132, 127, 372, 510, 376,
+	199, 214, 572, 571, 451, 112, 371, 188, 570, 220,
+	221, 605, 557, 318, 139, 223, 225, 566, 563, 135,
+	136, 214, 511, 517, 394, 561, 138, 560, 128, 129,
+	130, 131, 132, 127, 558, 491, 488, 467, 200, 173,
+	209, 210, 211, 447, 119, 444, 422, 420, 418, 417,
+	124, 256, 256, 410, 234, 343, 242, 299, 212, 213,
+	387, 204, 205, 207, 206, 208, 484, 145, 46, 322,
+	214, 235, 258, 483, 482, 214, 260, 481, 212, 213,
+	262, 204, 205, 207, 206, 208, 234, 441, 283, 392,
+	393, 395, 257, 301, 397, 304, 305, 306, 307, 308,
+	309, 310, 311, 312, 313, 279, 287, 317, 245, 209,
+	210, 211, 409, 298, 139, 360, 274, 147, 148, 318,
+	150, 281, 280, 152, 336, 334, 398, 212, 213, 303,
+	204, 205, 207, 206, 208, 204, 205, 207, 206, 208,
+	250, 249, 321, 256, 256, 337, 358, 340, 236, 233,
+	391, 346, 232, 222, 348, 195, 687, 688, 181, 177,
+	335, 214, 685, 341, 331, 678, 610, 359, 384, 338,
+	372, 214, 445, 388, 382, 296, 235, 179, 369, 333,
+	325, 326, 261, 356, 357, 374, 355, 399, 652, 229,
+	230, 401, 231, 223, 173, 588, 396, 383, 568, 229,
+	230, 228, 231, 457, 378, 379, 214, 406, 626, 386,
+	209, 210, 211, 443, 330, 414, 329, 243, 528, 332,
+	327, 247, 402, 207, 206, 208, 625, 104, 212, 213,
+	587, 204, 205, 207, 206, 208, 48, 57, 407, 299,
+	218, 408, 506, 499, 421, 209, 210, 211, 432, 219,
+	168, 446, 50, 56, 55, 431, 428, 442, 430, 415,
+	416, 320, 105, 212, 213, 264, 204, 205, 207, 206,
+	208, 201, 335, 666, 665, 75, 474, 218, 61, 475,
+	598, 217, 472, 106, 597, 62, 219, 216, 476, 596,
+	452, 594, 593, 592, 591, 298, 485, 477, 273, 487,
+	255, 263, 529, 496, 497, 508, 286, 462, 456, 473,
+	455, 479, 454, 453, 429, 400, 375, 373, 214, 370,
+	49, 507, 368, 51, 216, 553, 361, 339, 52, 493,
+	490, 291, 494, 290, 288, 244, 241, 58, 59, 239,
+	500, 175, 170, 501, 516, 226, 167, 166, 429, 169,
+	165, 54, 125, 162, 502, 527, 157, 118, 156, 211,
+	120, 546, 155, 523, 137, 133, 154, 548, 108, 107,
+	102, 385, 300, 531, 514, 212, 213, 554, 204, 205,
+	207, 206, 208, 543, 202, 354, 114, 542, 542, 569,
+	113, 562, 151, 139, 564, 522, 60, 191, 135, 136,
+	58, 59, 190, 675, 664, 138, 651, 128, 129, 130,
+	131, 132, 127, 513, 582, 172, 581, 164, 149, 574,
+	586, 573, 583, 119, 73, 584, 624, 648, 405, 124,
+	647, 319, 276, 623, 590, 580, 603, 541, 589, 269,
+	214, 125, 606, 595, 32, 32, 118, 599, 277, 120,
+	602, 192, 342, 137, 133, 270, 498, 608, 613, 621,
+	615, 616, 607, 618, 214, 390, 620, 614, 612, 627,
+	515, 619, 315, 489, 193, 27, 27, 146, 316, 314,
+	404, 403, 139, 628, 240, 565, 495, 135, 136, 423,
+	412, 94, 413, 324, 138, 238, 128, 129, 130, 131,
+	132, 127, 194, 209, 210, 211, 642, 187, 638, 637,
+	396, 641, 119, 197, 96, 632, 278, 99, 124, 645,
+	579, 212, 213, 271, 204, 205, 207, 206, 208, 524,
+	669, 512, 101, 125, 545, 470, 480, 640, 118, 677,
+	660, 120, 659, 419, 661, 137, 133, 283, 658, 670,
+	609, 295, 672, 93, 110, 32, 214, 182, 183, 184,
+	667, 655, 636, 676, 679, 185, 92, 91, 683, 478,
+	681, 684, 680, 47, 139, 178, 689, 32, 2, 135,
+	136, 690, 97, 98, 100, 630, 138, 289, 128, 129,
+	130, 131, 132, 127, 125, 209, 210, 211, 214, 118,
+	189, 578, 120, 674, 119, 505, 137, 133, 27, 45,
+	124, 362, 433, 212, 213, 663, 204, 205, 207, 206,
+	208, 203, 22, 23, 635, 144, 24, 25, 634, 214,
+	365, 366, 237, 44, 9, 139, 530, 209, 210, 211,
+	135, 136, 214, 267, 111, 363, 364, 138, 465, 128,
+	129, 130, 131, 132, 127, 212, 213, 214, 204, 205,
+	207, 206, 208, 464, 463, 119, 265, 266, 209, 210,
+	211, 302, 438, 460, 459, 458, 440, 439, 673, 601,
+	9, 209, 210, 211, 547, 466, 212, 213, 461, 204,
+	205, 207, 206, 208, 349, 259, 209, 246, 211, 212,
+	213, 180, 204, 205, 207, 206, 208, 13, 15, 14,
+	176, 471, 153, 77, 212, 213, 539, 204, 205, 207,
+	206, 208, 535, 72, 347, 559, 70, 486, 76, 367,
+	19, 13, 15, 14, 253, 252, 160, 161, 350, 20,
+	21, 64, 435, 69, 10, 268, 11, 12, 22, 23,
+	248, 654, 24, 25, 19, 519, 520, 521, 653, 32,
+	538, 537, 536, 20, 21, 534, 533, 532, 10, 381,
+	11, 12, 22, 23, 71, 436, 24, 25, 323, 81,
+	600, 284, 434, 32, 662, 215, 622, 646, 668, 682,
+	27, 103, 575, 198, 196, 18, 121, 639, 117, 17,
+	411, 16, 657, 224, 424, 427, 28, 552, 29, 63,
+	30, 551, 65, 549, 27, 31, 251, 66, 159, 18,
+	3, 82, 86, 17, 186, 16, 67, 68, 109, 671,
+	28, 377, 29, 39, 30, 82, 86, 22, 23, 31,
+	352, 24, 25, 36, 87, 82, 86, 34, 32, 8,
+	5, 4, 1, 0, 0, 0, 0, 0, 87, 0,
+	0, 0, 83, 0, 0, 0, 85, 84, 87, 0,
+	0, 37, 0, 275, 0, 0, 83, 0, 0, 27,
+	85, 84, 0, 0, 0, 0, 83, 90, 80, 0,
+	85, 84, 0, 0, 0, 35, 0, 79, 0, 0,
+	0, 0, 80, 41, 31, 0, 40, 38, 0, 0,
+	0, 0, 80, 0, 0, 0, 0, 0, 0, 88,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88,
 }
 
 var yyPact = [...]int16{
-	490, -1000, -1000, 29, -1000, -1000, -1000, 372, -1000, -1000,
-	530, 218, 495, 492, 474, 474, 365, 364, 342, 200,
-	293, 314, 344, -1000, 490, -1000, 257, 257, 257, 467,
-	212, -1000, 207, 494, 205, 180, 203, 202, 201, 450,
-	376, 63, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 449,
-	200, 200, 200, 359, -1000, 287, -1000, -1000, 199, -1000,
-	383, 346, -1000, -1000, 198, 265, 195, 446, 257, 516,
-	-1000, -1000, 510, 11, 11, -1000, 192, 77, -1000, 440,
-	493, 540, -1000, 474, 534, 26, 20, 326, 153, 252,
-	-1000, -1000, 188, 341, -1000, 60, 158, 149, -1000, 382,
-	382, 13, -1000, -1000, -1000, 382, 382, 74, 10, -1000,
-	-1000, -1000, -1000, -1000, 6, -1000, -1000, -1000, -1000, -25,
-	-1000, 248, -12, 185, 445, 511, -1000, 11, 11, -1000,
-	382, 147, -1000, -18, 184, 405, 422, 410, 506, 183,
-	-1000, 182, 125, 125, 542, 382, 88, -1000, 215, -1000,
-	-1000, 31, 382, -1000, 382, 382, 382, 382, 382, 382,
-	382, 382, 282, -1000, 178, 251, 382, 109, -1000, 22,
-	12, 252, 82, 289, 147, 65, 86, 53, 382, 382,
-	177, -1000, 179, -19, 176, 85, -1000, -1000, 147, 125,
-	-1000, 169, 168, 166, 165, 164, 84, 425, 413, -40,
-	57, -1000, -62, 310, 463, 147, 542, 153, 382, 542,
-	494, 328, -20, -24, -27, -28, 136, -30, 158, 12,
-	12, 233, 233, 233, 22, 210, 28, -1000, 228, -1000,
-	382, -31, 22, -1000, -41, -1000, 315, 382, 83, -1000,
-	-42, -43, 76, 395, -44, 45, 147, -1000, -72, -1000,
-	-1000, -1000, 399, 108, 382, 152, 125, -33, 531, -74,
-	-1000, -1000, 409, -1000, -1000, 531, 524, 496, 269, 151,
-	269, 303, 382, 444, 310, -1000, 147, 154, 136, -36,
-	-51, 477, -53, -54, 150, -55, -1000, -1000, -1000, 22,
-	-32, -1000, 285, 382, 382, 283, -1000, -1000, -1000, 105,
-	-1000, 382, -1000, 179, -37, -91, 147, 386, -75, 125,
-	-1000, -1000, -1000, -1000, -1000, 146, -1000, 141, 139, 439,
-	-36, -1000, -1000, -1000, -1000, 382, 147, -37, 303, 326,
-	-1000, 154, 333, -1000, -1000, -77, -1000, 382, 136, 138,
-	136, 136, -60, 136, -61, -79, -1000, 259, 147, 382,
-	-67, 147, -1000, -1000, -1000, 125, 250, 99, 91, 382,
-	-1000, -80, -1000, -1000, -1000, -1000, 357, 41, 147, -1000,
-	-1000, 319, -1000, 31, -36, -1000, -68, -1000, -69, -1000,
-	-1000, -1000, -1000, -1000, -1000, 382, 147, -1000, -82, 230,
-	-1000, 161, -95, -85, 147, -1000, 354, 329, 317, 542,
-	-1000, -1000, 136, 147, -1000, 401, -1000, -1000, -1000, -1000,
-	351, 301, 382, 112, 430, -1000, -1000, 393, -1000, 310,
-	312, 147, 38, -1000, 382, -1000, 303, 382, 112, 147,
-	-1000, 35, 107, -1000, 382, -1000, -1000, -1000, 107, -1000,
+	813, -1000, -1000, 902, 30, -1000, -1000, -1000, -1000, -1000,
+	641, -1000, -1000, 339, 381, 828, 810, 440, 260, 815,
+	951, 941, 630, 629, 605, 260, 554, 604, 355, 266,
+	354, 353, 607, -1000, -33, 382, 378, 571, 571, 902,
+	571, 571, -1000, -1000, -1000, -1000, 837, -1000, 508, 508,
+	508, 433, 508, -1000, 389, 508, 797, 351, 347, 343,
+	-1000, 341, 830, 338, 260, 432, 335, 332, 331, 334,
+	327, 260, -1000, 430, -1000, 170, 260, 326, 794, 120,
+	645, 146, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 785,
+	119, 260, 260, 260, 624, -1000, 546, -1000, -1000, 260,
+	-1000, 671, 408, 503, 503, -1000, -1000, -1000, 116, 479,
+	-1000, -1000, 902, 255, 371, 657, 310, -1000, 571, 571,
+	114, -1000, -1000, -1000, 571, 571, 174, 113, -1000, -1000,
+	-1000, -1000, -1000, 110, -1000, -1000, -1000, -1000, 47, 109,
+	626, 529, 670, 670, -1000, 324, 517, 321, 260, 508,
+	320, 402, 781, 508, 851, 102, 101, -1000, -1000, 826,
+	390, 390, -1000, -1000, 260, 779, -1000, -1000, 260, 158,
+	-1000, -1000, 260, 286, 748, 846, 542, 283, -1000, 927,
+	535, 283, 83, 82, 596, 291, 498, -1000, -1000, 319,
+	658, 318, -1000, -1000, 316, 571, 603, -1000, 144, 98,
+	-1000, 359, 632, 902, 571, 571, 571, 571, 571, 571,
+	571, 571, 571, 571, 505, 510, 571, 80, 460, 245,
+	346, 189, 498, 29, 530, 670, 155, -1000, 201, 197,
+	197, 200, 145, 571, 571, 312, 571, 902, -1000, -1000,
+	482, -1000, 16, 260, 817, -1000, 260, 778, 838, 377,
+	377, -1000, 390, 390, -1000, 571, 670, -1000, -1000, 260,
+	76, 311, -1000, -1000, -1000, 690, 725, 709, 829, 307,
+	260, 304, -34, -1000, -1000, -1000, 302, 260, 301, -41,
+	283, 283, 873, 571, 137, -1000, 358, -1000, -1000, 498,
+	-1000, -1000, 20, 142, 670, 111, 571, -1000, -1000, 300,
+	571, 670, 11, 515, 189, 189, 468, 468, 468, 346,
+	685, 49, 103, 103, -1000, 454, 571, 346, 11, 73,
+	-1000, 13, -1000, 527, 571, 197, 197, -1000, -1000, -1000,
+	-1000, -1000, -1000, 9, 8, 152, 584, 7, 273, -1000,
+	6, 523, -1000, 333, 609, 688, 843, 737, 48, 260,
+	194, 5, 141, -1000, 235, 3, -1000, -1000, 670, -1000,
+	-35, -1000, 299, 298, 297, 295, 293, 184, 755, 754,
+	753, 772, 292, 744, 743, 728, 769, -3, 139, -64,
+	581, 796, 670, 873, 291, 571, -1000, -1000, 571, 873,
+	830, 631, 38, 35, 34, 27, 280, 816, 15, 98,
+	-1000, 670, -4, 504, 902, -1000, 346, -5, -69, 11,
+	-1000, 520, 571, 571, 492, -1000, -1000, -1000, -1000, 227,
+	-1000, -26, -1000, 348, -71, -1000, -1000, -1000, 681, 226,
+	571, 290, -1000, -17, 572, 426, 458, -1000, -1000, -1000,
+	-1000, -35, -16, 854, 395, 377, -1000, 570, -72, -1000,
+	164, 287, -1000, -1000, 716, -1000, -1000, 854, 869, 868,
+	867, 809, -1000, 864, 863, 862, 803, 499, 499, 579,
+	571, 768, 581, -1000, 670, 670, 315, 280, -27, -6,
+	814, -13, -15, 260, -22, -1000, 260, -1000, -1000, -1000,
+	519, -1000, -1000, -23, -79, -1000, 234, 670, 571, -32,
+	-37, -38, -1000, -1000, 333, -17, -101, 670, 676, 561,
+	-1000, 283, 609, 428, 260, 737, -85, -35, -1000, -1000,
+	-1000, -1000, 214, -1000, 176, -1000, -35, -1000, 155, 164,
+	279, -1000, 278, 277, 276, 260, 274, 269, 265, 260,
+	763, -27, -1000, -1000, -1000, 571, 670, -28, 579, 596,
+	-1000, 315, 601, -1000, -1000, -86, -1000, 571, 280, 260,
+	280, 280, -66, 280, 830, 497, -1000, -1000, 571, 670,
+	-1000, -1000, -1000, -1000, -1000, 459, 209, 191, 571, 609,
+	-87, -1000, -1000, 656, -1000, -1000, -89, 556, -1000, -1000,
+	-70, -1000, -1000, -1000, -1000, 708, -1000, -1000, -1000, 704,
+	-1000, 620, 135, 670, 164, -35, -1000, 585, -1000, 111,
+	-27, -1000, -76, -1000, -77, -1000, -1000, -1000, -1000, 280,
+	-1000, 670, 457, -1000, 453, -105, -93, 670, -1000, -1000,
+	416, -1000, 169, -1000, 860, 853, 618, -1000, -90, 598,
+	589, 873, -1000, -1000, 280, -1000, 692, -1000, -1000, -1000,
+	-1000, 413, -1000, 259, 258, 616, -1000, 574, 571, 257,
+	762, -1000, -1000, 679, 411, -1000, -1000, -1000, 581, 586,
+	670, 134, -1000, 571, -1000, 687, 579, 571, 257, 670,
+	-1000, -1000, 131, 199, -1000, 571, -1000, -1000, -1000, 199,
+	-1000,
 }
 
 var yyPgo = [...]int16{
-	0, 592, 497, 591, 590, 589, 18, 588, 23, 8,
-	12, 587, 586, 14, 7, 16, 10, 585, 11, 584,
-	583, 3, 582, 581, 9, 17, 461, 22, 580, 579,
-	42, 578, 13, 576, 575, 574, 15, 573, 0, 572,
-	21, 571, 570, 569, 568, 567, 4, 2, 566, 565,
-	564, 563, 5, 562, 561, 1, 6, 391, 560, 559,
-	558, 557, 20, 556, 552, 19, 551, 314, 550, 549,
+	0, 962, 688, 961, 960, 6, 29, 13, 959, 743,
+	957, 35, 45, 950, 27, 66, 37, 33, 23, 941,
+	939, 18, 5, 32, 20, 31, 10, 28, 328, 14,
+	43, 12, 21, 2, 938, 934, 11, 24, 575, 16,
+	928, 926, 40, 923, 15, 921, 917, 915, 26, 914,
+	0, 913, 22, 912, 44, 910, 908, 907, 4, 3,
+	906, 904, 903, 902, 901, 9, 17, 899, 898, 1,
+	8, 177, 897, 896, 895, 894, 892, 34, 891, 890,
+	25, 889, 46, 888, 885, 19, 719, 507, 884,
 }
 
 var yyR1 = [...]int8{
-	0, 1, 2, 2, 69, 69, 3, 3, 3, 4,
+	0, 1, 1, 2, 2, 86, 86, 3, 3, 3,
+	3, 3, 9, 11, 11, 10, 10, 10, 10, 10,
+	10, 10, 10, 10, 10, 10, 10, 10, 10, 8,
+	8, 8, 8, 64, 64, 64, 87, 87, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 67, 67, 67, 66, 66, 66, 66,
-	66, 66, 66, 65, 65, 65, 65, 57, 57, 10,
-	10, 5, 5, 5, 5, 25, 25, 64, 64, 63,
-	63, 62, 11, 11, 13, 13, 14, 9, 9, 12,
-	12, 16, 16, 15, 15, 17, 17, 17, 17, 17,
-	17, 17, 17, 17, 17, 18, 37, 37, 36, 36,
-	36, 8, 61, 61, 51, 51, 51, 58, 58, 59,
-	59, 59, 6, 6, 6, 6, 6, 6, 6, 6,
-	7, 7, 23, 23, 22, 22, 49, 49, 50, 50,
-	19, 19, 19, 19, 20, 20, 21, 21, 24, 24,
-	24, 24, 24, 24, 24, 24, 24, 26, 27, 28,
-	28, 28, 29, 29, 29, 30, 30, 31, 31, 32,
-	32, 33, 34, 34, 40, 40, 45, 45, 41, 41,
-	46, 46, 47, 47, 54, 54, 56, 56, 53, 53,
-	55, 55, 55, 52, 52, 52, 35, 35, 39, 39,
-	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-	48, 68, 68, 43, 43, 42, 42, 42, 42, 60,
-	60, 44, 44, 44, 44, 44, 44, 44, 44, 44,
-	44,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 82, 82, 82, 81, 81, 81,
+	81, 81, 81, 81, 81, 80, 80, 80, 80, 71,
+	71, 84, 84, 85, 85, 85, 15, 15, 12, 12,
+	13, 13, 14, 76, 76, 88, 88, 18, 18, 5,
+	5, 5, 5, 37, 37, 79, 79, 78, 78, 77,
+	19, 19, 21, 21, 22, 17, 17, 20, 20, 24,
+	24, 23, 23, 25, 25, 25, 25, 25, 25, 25,
+	25, 25, 25, 26, 26, 26, 26, 49, 49, 48,
+	48, 48, 16, 75, 75, 63, 63, 63, 72, 72,
+	73, 73, 73, 6, 6, 6, 6, 6, 6, 6,
+	6, 7, 7, 35, 35, 34, 34, 61, 61, 62,
+	62, 27, 27, 27, 27, 27, 27, 28, 28, 29,
+	29, 29, 30, 30, 31, 31, 32, 32, 32, 33,
+	33, 36, 36, 36, 36, 36, 36, 36, 36, 36,
+	36, 38, 66, 66, 66, 39, 40, 40, 40, 41,
+	41, 41, 42, 42, 43, 43, 44, 44, 45, 46,
+	46, 52, 52, 57, 57, 53, 53, 58, 58, 59,
+	59, 68, 68, 70, 70, 70, 70, 67, 67, 69,
+	69, 69, 65, 65, 65, 47, 47, 51, 51, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 60, 83, 83, 55, 55, 54, 54, 54, 54,
+	74, 74, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56,
 }
 
 var yyR2 = [...]int8{
-	0, 1, 2, 3, 0, 1, 1, 1, 1, 2,
-	1, 1, 1, 4, 2, 3, 3, 7, 3, 8,
-	9, 7, 5, 6, 6, 8, 6, 6, 7, 7,
-	3, 8, 8, 2, 1, 3, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 0, 3, 1,
-	3, 8, 7, 7, 8, 2, 1, 0, 4, 1,
-	3, 3, 0, 1, 1, 3, 3, 1, 3, 1,
-	3, 0, 1, 1, 3, 1, 1, 1, 1, 1,
-	6, 1, 1, 1, 1, 4, 1, 3, 1, 1,
-	3, 6, 0, 2, 0, 3, 3, 0, 1, 0,
-	1, 2, 1, 4, 2, 2, 3, 2, 2, 4,
-	13, 3, 0, 1, 0, 1, 1, 1, 2, 4,
-	1, 2, 4, 4, 2, 3, 1, 3, 3, 4,
-	4, 4, 4, 4, 4, 2, 6, 1, 2, 0,
-	2, 2, 0, 2, 2, 2, 1, 0, 1, 1,
-	2, 6, 0, 1, 0, 2, 0, 3, 0, 2,
-	0, 2, 0, 2, 0, 3, 0, 4, 2, 4,
-	0, 1, 1, 0, 1, 2, 2, 4, 0, 1,
-	1, 1, 2, 2, 4, 3, 4, 6, 6, 1,
-	5, 4, 5, 0, 2, 1, 1, 3, 3, 0,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4,
+	0, 1, 2, 2, 3, 0, 1, 1, 1, 1,
+	1, 1, 5, 2, 3, 3, 5, 4, 6, 6,
+	8, 6, 3, 2, 1, 2, 1, 1, 1, 5,
+	4, 4, 2, 0, 1, 1, 1, 1, 2, 1,
+	1, 1, 4, 2, 3, 3, 4, 3, 7, 6,
+	9, 3, 3, 8, 4, 4, 13, 5, 2, 4,
+	3, 3, 1, 2, 8, 9, 7, 5, 6, 6,
+	8, 6, 6, 7, 7, 3, 8, 8, 8, 11,
+	8, 11, 8, 8, 2, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	3, 1, 3, 1, 1, 1, 9, 7, 0, 1,
+	1, 3, 2, 0, 3, 0, 1, 1, 3, 8,
+	7, 7, 8, 2, 1, 0, 4, 1, 3, 3,
+	0, 1, 1, 3, 3, 1, 3, 1, 3, 0,
+	1, 1, 3, 1, 1, 1, 1, 1, 6, 1,
+	1, 1, 1, 4, 6, 6, 4, 1, 3, 1,
+	1, 3, 6, 0, 2, 0, 3, 3, 0, 1,
+	0, 1, 2, 1, 4, 2, 2, 3, 2, 2,
+	4, 13, 3, 0, 1, 0, 1, 1, 1, 2,
+	4, 1, 2, 2, 3, 4, 4, 2, 3, 2,
+	3, 2, 1, 1, 1, 3, 1, 2, 4, 1,
+	3, 3, 4, 4, 4, 4, 4, 4, 2, 6,
+	5, 1, 1, 3, 3, 2, 0, 2, 2, 0,
+	2, 2, 2, 1, 0, 1, 1, 2, 6, 0,
+	1, 0, 2, 0, 3, 0, 2, 0, 2, 0,
+	2, 0, 3, 0, 4, 5, 6, 2, 4, 0,
+	1, 1, 0, 1, 2, 2, 4, 0, 1, 1,
+	1, 2, 2, 4, 3, 4, 5, 6, 5, 6,
+	1, 5, 4, 5, 0, 2, 1, 1, 3, 3,
+	0, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 4,
 }
 
 var yyChk = [...]int16{
-	-1000, -1, -2, -3, -4, -5, -6, 41, 43, 44,
-	4, 6, 5, 27, 36, 37, 45, 46, 49, 50,
-	-7, 87, 56, -69, 116, 42, 7, 23, 25, 24,
-	8, 98, 7, 14, 23, 25, 8, 23, 8, -67,
-	71, -66, 56, 4, 45, 50, 49, 5, 27, -67,
-	47, 47, 58, -26, 98, 70, 88, 89, 23, 90,
-	38, -22, 57, -2, -57, 79, -57, -57, 25, 98,
-	98, -27, -28, 16, 17, 98, 26, 98, 98, 98,
-	98, 26, 40, 109, 26, -26, -26, -26, 51, -23,
-	71, 98, 39, -49, 112, -50, -38, -42, -44, 77,
-	111, 80, -48, -19, -17, 117, 72, -21, 105, 100,
-	101, 102, 103, 104, 85, -18, 91, 92, 84, 98,
-	98, 77, 98, 26, -57, 9, -29, 19, 18, -30,
-	20, -38, -30, 98, 107, 28, 29, 5, 9, 7,
-	-67, 7, 117, 117, -40, 61, -63, -62, 98, -6,
-	98, 58, 109, -52, 110, 111, 113, 112, 114, 94,
-	95, 96, 82, 98, 69, -60, 97, 86, 77, -38,
-	-38, 117, -38, -39, -38, -20, 108, 117, 117, 117,
-	107, 80, 117, 98, 26, 10, -30, -30, -38, 117,
-	98, 31, 30, 31, 31, 32, 10, 98, 98, -11,
-	-9, 98, -9, -56, 6, -38, -40, 109, 96, -24,
-	-26, 117, 88, 89, 23, 90, -18, 98, -38, -38,
-	-38, -38, -38, -38, -38, -38, -38, 84, 77, 98,
-	78, 81, -38, 99, -6, 118, -68, 73, 108, 102,
-	112, -21, 98, -38, -16, -15, -38, 98, -37, -36,
-	-8, -35, 33, 98, 35, 32, 117, 98, 102, -9,
-	-8, 98, 98, 98, 98, 102, 30, 30, 118, 109,
-	118, -46, 64, 25, -56, -62, -38, -56, -27, 48,
-	-6, 15, 117, 117, 117, 117, -52, -52, 84, -38,
-	117, 118, -43, 73, 75, -38, 102, 118, 118, 69,
-	118, 109, 118, 109, 34, 99, -38, 98, -9, 117,
-	-65, 11, 12, 13, 118, 30, -65, 8, 8, -25,
-	48, -6, 98, -25, -47, 65, -38, 26, -46, -31,
-	-32, -33, -34, 93, -52, -13, -14, 117, 118, 21,
-	118, 118, 98, 118, -6, -15, 76, -38, -38, 74,
-	99, -38, -36, -10, 98, 117, -51, 119, 117, 35,
-	118, -9, 98, 98, 98, -64, 26, -13, -38, -10,
-	-47, -40, -32, 59, 109, 118, -16, -52, 98, -52,
-	-52, 118, -52, 118, 118, 74, -38, 118, -9, -59,
-	84, 77, 100, 100, -38, 118, 52, -45, 62, -24,
-	-14, 118, 118, -38, 118, -58, 83, 84, 120, 118,
-	53, -41, 60, 63, -56, -52, -61, 33, 54, -54,
-	66, -38, -12, -21, 26, 34, -46, 63, 109, -38,
-	-47, -53, -38, -21, 109, -55, 67, 68, -38, -55,
+	-1000, -1, -2, 117, -3, -4, -5, -6, -8, -9,
+	41, 43, 44, 4, 6, 5, 98, 96, 92, 27,
+	36, 37, 45, 46, 49, 50, -7, 87, 103, 105,
+	107, 112, 56, -11, -10, 103, 51, 79, 115, 41,
+	114, 111, -6, -5, -9, -86, 148, 42, 7, 91,
+	23, 94, 99, -15, 122, 25, 24, 8, 108, 109,
+	125, 7, 14, 91, 23, 94, 99, 108, 109, 25,
+	8, -88, 23, 94, -66, 125, 23, 8, -82, 56,
+	71, -81, 4, 45, 50, 49, 5, 27, 102, -82,
+	56, 47, 47, 58, -38, -66, 70, 88, 89, 23,
+	90, 38, 125, -64, 71, 106, 127, 125, 125, -34,
+	57, -86, 148, 118, 118, -50, -54, -56, 77, 143,
+	80, -60, -27, -25, 149, 72, -33, 132, 127, 128,
+	129, 130, 131, 85, -26, 118, 119, 84, 125, 113,
+	-50, -11, -50, -50, -2, -71, 79, -71, -71, 95,
+	-71, 113, -71, 25, 125, 125, 125, 125, -39, -40,
+	16, 17, 125, -66, 95, 125, 125, 125, 26, 125,
+	125, -66, 95, 134, -66, 125, 26, 149, 40, 141,
+	26, 149, -38, -38, -38, 51, -35, 71, -66, 39,
+	104, -87, 58, 81, -87, 149, -61, 144, -62, -50,
+	-11, 126, 123, 74, 142, 143, 145, 144, 146, 121,
+	122, 123, 139, 140, 82, -74, 124, 81, 77, 86,
+	-50, -50, 149, -50, -51, -50, -28, -29, 137, 135,
+	136, 138, 149, 149, 149, 134, 149, 116, 76, 125,
+	77, 125, -66, -71, 125, -15, 26, -71, 9, 149,
+	149, -41, 19, 18, -42, 20, -50, -42, -66, 26,
+	-66, 134, -66, 125, 89, 28, 29, 5, 9, 7,
+	23, 91, -17, 125, -82, 56, 7, 23, 91, -17,
+	149, 149, -52, 61, -78, -77, 125, -6, 125, 39,
+	125, 125, -24, -23, -50, 58, 141, -65, 125, 69,
+	123, -50, 149, -11, -50, -50, -50, -50, -50, -50,
+	-50, -50, -50, -50, 84, 77, 78, -50, 149, 81,
+	126, -6, 150, -83, 73, 135, 136, 129, -30, 129,
+	127, -30, 129, 144, -33, 125, -50, -24, -54, 125,
+	-24, -11, 80, 149, 69, 33, -66, 17, -66, 26,
+	10, -12, -13, -14, 118, -12, -42, -42, -50, -66,
+	149, 125, 31, 30, 31, 31, 32, 10, 125, -66,
+	125, 150, 141, 125, -66, 125, 150, -19, -17, -17,
+	-70, 6, -50, -52, 141, 123, -6, 150, 141, -36,
+	-38, 149, 88, 89, 23, 90, -26, 93, 125, -50,
+	125, -50, -6, 76, 75, 84, -50, -6, -23, 149,
+	150, -55, 73, 75, -50, -30, -30, 150, 150, 69,
+	150, 81, 150, 76, -49, -48, -16, -47, 33, 125,
+	35, 32, -7, 34, -76, 9, -84, -85, 45, 50,
+	49, 149, -66, 129, 150, 141, 126, 150, -31, -32,
+	125, 149, -16, 125, 125, 125, 125, 129, 30, 30,
+	30, 26, 125, 30, 30, 30, 26, 150, 150, -58,
+	64, 25, -70, -77, -50, -50, -70, -39, 48, -6,
+	15, 149, 149, 149, 149, -65, 21, -65, 150, 79,
+	-11, 150, 150, -6, -23, 76, -50, -50, 74, 126,
+	-27, -25, 116, 150, 141, 34, 126, -50, 125, -18,
+	125, 149, 69, 97, 26, 122, -31, 149, -80, 11,
+	12, 13, 110, -14, 69, 150, 141, -29, -28, 125,
+	30, -80, 8, 8, 8, 23, 8, 8, 8, 23,
+	-37, 48, -6, -37, -59, 65, -50, 26, -58, -43,
+	-44, -45, -46, 120, -65, -21, -22, 149, 150, 21,
+	150, 150, -66, 150, -66, 76, 150, 150, 74, -50,
+	150, 150, 150, -48, -18, -63, 151, 149, 35, 69,
+	-17, -7, 96, -66, -85, 150, -31, 126, 129, -32,
+	-29, 125, 125, 125, 125, -66, 125, 125, 125, -66,
+	-79, 26, -21, -50, 125, 149, -59, -52, -44, 59,
+	141, 150, -24, -65, -66, -65, -65, 150, -65, -39,
+	79, -50, -73, 84, 77, 127, 127, -50, -7, 150,
+	39, 150, 69, 150, 30, 30, 52, -29, -31, -57,
+	62, -36, -22, 150, 150, -65, -72, 83, 84, 152,
+	150, 100, 129, 8, 8, 53, 150, -53, 60, 63,
+	-70, -65, -75, 33, 101, 125, 125, 54, -68, 66,
+	-50, -20, -33, 26, 34, 102, -58, 63, 141, -50,
+	-5, -59, -67, -50, -33, 141, -69, 67, 68, -50,
+	-69,
 }
 
 var yyDef = [...]int16{
-	0, -2, 1, 4, 6, 7, 8, 10, 11, 12,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	102, 0, 114, 2, 5, 9, 47, 47, 47, 0,
-	0, 14, 0, 139, 0, 0, 0, 0, 0, 0,
-	0, 34, 36, 37, 38, 39, 40, 41, 42, 0,
-	0, 0, 0, 0, 137, 112, 104, 105, 0, 107,
-	108, 0, 115, 3, 0, 0, 0, 0, 47, 0,
-	15, 16, 142, 0, 0, 18, 0, 0, 30, 0,
-	0, 0, 33, 0, 0, 0, 0, 154, 0, 0,
-	113, 106, 0, 111, 116, 117, 173, -2, 181, 0,
-	0, 0, 189, 195, 196, 0, 178, 120, 0, 75,
-	76, 77, 78, 79, 0, 81, 82, 83, 84, 126,
-	13, 0, 0, 0, 0, 0, 138, 0, 0, 140,
-	0, 146, 141, 0, 0, 0, 0, 0, 0, 0,
-	35, 0, 62, 0, 166, 0, 154, 59, 0, 103,
-	109, 0, 0, 118, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 174, 0, 0, 0, 0, 200, 182,
-	183, 0, 0, 0, 179, 121, 0, 0, 0, 71,
-	0, 48, 0, 0, 0, 0, 143, 144, 145, 0,
-	22, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	63, 67, 0, 160, 0, 155, 166, 0, 0, 166,
-	139, 0, 0, 0, 0, 0, 173, 137, 173, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 0, 175,
-	0, 0, 185, 198, 0, 197, 193, 0, 0, 124,
-	0, 0, 126, 0, 0, 72, 73, 127, 0, 86,
-	88, 89, 0, 0, 0, 0, 0, 0, 43, 0,
-	23, 24, 0, 26, 27, 43, 0, 0, 0, 0,
-	0, 162, 0, 0, 160, 60, 61, -2, 173, 0,
-	0, 0, 0, 0, 0, 0, 135, 119, 210, 184,
-	0, 186, 0, 0, 0, 0, 125, 122, 123, 0,
-	85, 0, 17, 0, 0, 94, 176, 0, 0, 0,
-	28, 44, 45, 46, 21, 0, 29, 0, 0, 57,
-	0, 56, 68, 52, 53, 0, 161, 0, 162, 154,
-	148, -2, 0, 153, 128, 0, 64, 71, 173, 0,
-	173, 173, 0, 173, 0, 0, 190, 0, 194, 0,
-	0, 74, 87, 90, 49, 0, 99, 0, 0, 0,
-	19, 0, 25, 31, 32, 51, 0, 55, 163, 167,
-	54, 156, 150, 0, 0, 129, 0, 130, 0, 131,
-	132, 133, 134, 187, 188, 0, 191, 80, 0, 97,
-	100, 0, 0, 0, 177, 20, 0, 158, 0, 166,
-	65, 66, 173, 192, 50, 92, 98, 101, 95, 96,
-	0, 164, 0, 0, 0, 136, 91, 0, 58, 160,
-	0, 159, 157, 69, 0, 93, 162, 0, 0, 151,
-	110, 165, 170, 70, 0, 168, 171, 172, 170, 169,
+	0, -2, 1, 0, 5, 7, 8, 9, 10, 11,
+	39, 40, 41, 0, 0, 0, 115, 0, 62, 0,
+	0, 0, 0, 0, 0, 0, 173, 0, 0, 33,
+	0, 0, 185, 2, 5, 0, 0, 0, 0, 0,
+	0, 24, 26, 27, 28, 3, 6, 38, 99, 99,
+	99, 0, 99, 58, 0, 99, 0, 0, 0, 0,
+	43, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 116, 0, 63, 222, 0, 0, 0, 87,
+	0, 85, 88, 89, 90, 91, 92, 93, 94, 0,
+	87, 0, 0, 0, 0, 221, 183, 175, 176, 0,
+	178, 179, 0, 0, 0, 34, 35, 32, 0, 0,
+	186, 13, 6, 0, 0, 0, -2, 270, 0, 0,
+	0, 280, 286, 287, 0, 267, 191, 0, 143, 144,
+	145, 146, 147, 0, 149, 150, 151, 152, 209, 0,
+	0, 0, 23, 25, 4, 0, 0, 0, 0, 99,
+	0, 0, 0, 99, 0, 0, 0, 44, 45, 229,
+	0, 0, 47, 51, 0, 0, 60, 61, 0, 0,
+	75, 52, 0, 0, 0, 0, 0, 0, 84, 0,
+	0, 0, 0, 0, 241, 0, 0, 184, 177, 0,
+	0, 0, 36, 37, 0, 139, 182, 187, 188, 262,
+	14, 15, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	271, 272, 0, 0, 0, 268, 192, 193, 0, 0,
+	0, 0, 0, 0, 139, 0, 139, 0, 22, 42,
+	0, 46, 0, 0, 0, 59, 0, 0, 0, 108,
+	108, 225, 0, 0, 227, 0, 233, 228, 55, 0,
+	0, 0, 54, 223, 224, 0, 0, 0, 0, 0,
+	0, 0, 0, 135, 86, 87, 0, 0, 0, 0,
+	130, 0, 253, 0, 241, 127, 0, 174, 180, 0,
+	30, 31, 0, 140, 141, 0, 0, 189, 263, 0,
+	0, 17, 0, 0, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 302, 0, 0, 274, 0, 0,
+	289, 0, 288, 284, 0, 0, 0, 194, 197, 202,
+	203, 199, 201, 0, 0, 209, 0, 0, -2, 210,
+	0, 0, 100, 0, 0, 0, 113, 0, 0, 0,
+	0, 0, 109, 110, 0, 0, 230, 231, 232, 57,
+	0, 67, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	247, 0, 242, 253, 0, 0, 29, 12, 0, 253,
+	226, 0, 0, 0, 0, 0, 262, 0, 222, 262,
+	264, 16, 0, 0, 0, 303, 273, 0, 0, 0,
+	275, 0, 0, 0, 0, 198, 200, 195, 196, 0,
+	153, 0, 156, 0, 0, 157, 159, 160, 0, 0,
+	0, 0, 49, 0, 0, 0, 0, 101, 103, 104,
+	105, 0, 0, 95, 0, 0, 112, 0, 0, 204,
+	206, 0, 68, 69, 0, 71, 72, 95, 0, 0,
+	0, 0, 136, 0, 0, 0, 0, 0, 0, 249,
+	0, 0, 247, 128, 129, 142, -2, 262, 0, 0,
+	0, 0, 0, 0, 0, 218, 0, 190, 18, 19,
+	0, 276, 278, 0, 0, 281, 0, 285, 0, 0,
+	0, 0, 21, 48, 0, 0, 165, 265, 0, 0,
+	117, 0, 0, 0, 0, 0, 0, 0, 73, 96,
+	97, 98, 0, 111, 0, 66, 0, 207, 0, 0,
+	0, 74, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 124, 120, 121, 0, 248, 0, 249, 241,
+	235, -2, 0, 240, 211, 0, 132, 139, 262, 0,
+	262, 262, 0, 262, 226, 0, 277, 279, 0, 282,
+	148, 154, 155, 158, 161, 170, 0, 0, 0, 0,
+	0, 53, 114, 0, 102, 64, 0, 0, 107, 205,
+	0, 70, 76, 78, 82, 0, 77, 80, 83, 0,
+	119, 0, 123, 250, 254, 0, 122, 243, 237, 0,
+	0, 212, 0, 213, 0, 214, 215, 216, 217, 262,
+	20, 283, 168, 171, 0, 0, 0, 266, 50, 118,
+	0, 65, 0, 208, 0, 0, 0, 255, 0, 245,
+	0, 253, 133, 134, 262, 220, 163, 169, 172, 166,
+	167, 0, 106, 0, 0, 0, 256, 251, 0, 0,
+	0, 219, 162, 0, 0, 79, 81, 126, 247, 0,
+	246, 244, 137, 0, 164, 0, 249, 0, 0, 238,
+	56, 181, 252, 259, 138, 0, 257, 260, 261, 259,
+	258,
 }
 
-var yyTok1 = [...]int8{
+var yyTok1 = [...]uint8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 114, 3, 3,
-	117, 118, 112, 110, 109, 111, 115, 113, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 146, 3, 3,
+	149, 150, 144, 142, 141, 143, 147, 145, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 119, 3, 120,
+	3, 151, 3, 152,
 }
 
-var yyTok2 = [...]int8{
+var yyTok2 = [...]uint8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -606,7 +822,10 @@ var yyTok2 = [...]int8{
 	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
 	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
 	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 116,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 148,
 }
 
 var yyTok3 = [...]int8{
@@ -957,513 +1176,839 @@ yydefault:
 	case 2:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmts = []SQLStmt{yyDollar[1].stmt}
+			yyVAL.stmts = yyDollar[2].stmts
+			setResult(yylex, yyDollar[2].stmts)
 		}
 	case 3:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		{
-			yyVAL.stmts = append([]SQLStmt{yyDollar[1].stmt}, yyDollar[3].stmts...)
-		}
-	case 4:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		{
-		}
-	case 9:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &BeginTransactionStmt{}
+			yyVAL.stmts = []SQLStmt{yyDollar[1].stmt}
 		}
-	case 10:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 4:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &BeginTransactionStmt{}
+			yyVAL.stmts = append([]SQLStmt{yyDollar[1].stmt}, yyDollar[3].stmts...)
 		}
-	case 11:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 5:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.stmt = &CommitStmt{}
 		}
 	case 12:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &RollbackStmt{}
+			yyVAL.stmt = &CallStmt{procedure: yyDollar[2].id, args: yyDollar[4].values}
 		}
 	case 13:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: yyDollar[3].boolean, DB: yyDollar[4].id}
+			yyVAL.stmts = []SQLStmt{yyDollar[1].stmt}
 		}
 	case 14:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[2].id}
+			yyVAL.stmts = append([]SQLStmt{yyDollar[1].stmt}, yyDollar[3].stmts...)
 		}
 	case 15:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[3].id}
+			yyVAL.stmt = &DeclareVarStmt{variable: yyDollar[2].id, varType: yyDollar[3].sqlType}
 		}
 	case 16:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &UseSnapshotStmt{period: yyDollar[3].period}
+			yyVAL.stmt = &DeclareVarStmt{variable: yyDollar[2].id, varType: yyDollar[3].sqlType, op: yyDollar[4].cmpOp, exp: yyDollar[5].exp}
 		}
 	case 17:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			colsSpecs := make([]*ColSpec, 0, 5)
-			var checks []CheckConstraint
-
-			var pk PrimaryKeyConstraint
-
-			for _, e := range yyDollar[6].tableElems {
-				switch c := e.(type) {
-				case *ColSpec:
-					colsSpecs = append(colsSpecs, c)
-				case PrimaryKeyConstraint:
-					pk = c
-				case CheckConstraint:
-					if checks == nil {
-						checks = make([]CheckConstraint, 0, 5)
-					}
-					checks = append(checks, c)
-				}
-			}
-
-			yyVAL.stmt = &CreateTableStmt{
-				ifNotExists: yyDollar[3].boolean,
-				table:       yyDollar[4].id,
-				colsSpec:    colsSpecs,
-				pkColNames:  pk,
-				checks:      checks,
-			}
+			yyVAL.stmt = &SetVarStmt{variable: yyDollar[2].id, op: yyDollar[3].cmpOp, exp: yyDollar[4].exp}
 		}
 	case 18:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].id}
+			yyVAL.stmt = &SetVarStmt{variable: yyDollar[2].id, op: yyDollar[3].cmpOp, query: yyDollar[5].stmt.(DataSource)}
 		}
 	case 19:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].id, cols: yyDollar[7].ids}
+			yyVAL.stmt = &IfStmt{cond: yyDollar[2].exp, then: yyDollar[4].stmts}
 		}
 	case 20:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		{
-			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].id, cols: yyDollar[8].ids}
+			yyVAL.stmt = &IfStmt{cond: yyDollar[2].exp, then: yyDollar[4].stmts, els: yyDollar[6].stmts}
 		}
 	case 21:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &DropIndexStmt{table: yyDollar[4].id, cols: yyDollar[6].ids}
+			yyVAL.stmt = &WhileStmt{cond: yyDollar[2].exp, body: yyDollar[4].stmts}
 		}
 	case 22:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &DropIndexStmt{table: yyDollar[3].id, cols: []string{yyDollar[5].id}}
+			yyVAL.stmt = &BlockStmt{stmts: yyDollar[2].stmts}
 		}
 	case 23:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].id, colSpec: yyDollar[6].colSpec}
+			yyVAL.stmt = &RaiseStmt{exp: yyDollar[2].exp}
 		}
 	case 24:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.stmt = &RenameTableStmt{oldName: yyDollar[3].id, newName: yyDollar[6].id}
+			yyVAL.stmt = &ReturnStmt{}
 		}
 	case 25:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].id, oldName: yyDollar[6].id, newName: yyDollar[8].id}
+			yyVAL.stmt = &ReturnStmt{exp: yyDollar[2].exp}
 		}
 	case 26:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		{
-			yyVAL.stmt = &DropColumnStmt{table: yyDollar[3].id, colName: yyDollar[6].id}
-		}
-	case 27:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		{
-			yyVAL.stmt = &DropConstraintStmt{table: yyDollar[3].id, constraintName: yyDollar[6].id}
-		}
-	case 28:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.stmt = &CreateUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+			yyVAL.stmt = &ReturnStmt{query: yyDollar[1].stmt.(DataSource)}
 		}
 	case 29:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &AlterUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+			yyVAL.stmt = &DeclareCursorStmt{cursor: yyDollar[2].id, query: yyDollar[5].stmt.(DataSource)}
 		}
 	case 30:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &DropUserStmt{username: yyDollar[3].id}
+			yyVAL.stmt = &FetchCursorStmt{cursor: yyDollar[4].id, count: yyDollar[2].integer}
 		}
 	case 31:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+			yyVAL.stmt = &FetchCursorStmt{cursor: yyDollar[4].id, all: true}
 		}
 	case 32:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
+			yyVAL.stmt = &CloseCursorStmt{cursor: yyDollar[2].id}
 		}
 	case 33:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.sqlPrivileges = allPrivileges
+			yyVAL.integer = 1
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlPrivileges = []SQLPrivilege{yyDollar[1].sqlPrivilege}
+			yyVAL.integer = 1
 		}
 	case 35:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		{
-			yyVAL.sqlPrivileges = append(yyDollar[3].sqlPrivileges, yyDollar[1].sqlPrivilege)
-		}
-	case 36:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		{
-			yyVAL.sqlPrivilege = SQLPrivilegeSelect
-		}
-	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeCreate
+			yyVAL.integer = yyDollar[1].integer
 		}
 	case 38:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeInsert
+			yyVAL.stmt = &BeginTransactionStmt{}
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeUpdate
+			yyVAL.stmt = &BeginTransactionStmt{}
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeDelete
+			yyVAL.stmt = &CommitStmt{}
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeDrop
+			yyVAL.stmt = &RollbackStmt{}
 		}
 	case 42:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeAlter
+			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: yyDollar[3].boolean, DB: yyDollar[4].id}
 		}
 	case 43:
-		yyDollar = yyS[yypt-0 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.permission = PermissionReadWrite
+			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[2].id}
 		}
 	case 44:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.permission = PermissionReadOnly
+			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[3].id}
 		}
 	case 45:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.permission = PermissionReadWrite
+			yyVAL.stmt = &UseSnapshotStmt{period: yyDollar[3].period}
 		}
 	case 46:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.permission = PermissionAdmin
+			yyVAL.stmt = &CreateSchemaStmt{ifNotExists: yyDollar[3].boolean, schema: yyDollar[4].id}
 		}
 	case 47:
-		yyDollar = yyS[yypt-0 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.boolean = false
+			yyVAL.stmt = &DropSchemaStmt{schema: yyDollar[3].id}
 		}
 	case 48:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			yyVAL.boolean = true
+			colsSpecs := make([]*ColSpec, 0, 5)
+			var checks []CheckConstraint
+
+			var pk PrimaryKeyConstraint
+
+			for _, e := range yyDollar[6].tableElems {
+				switch c := e.(type) {
+				case *ColSpec:
+					colsSpecs = append(colsSpecs, c)
+				case PrimaryKeyConstraint:
+					pk = c
+				case CheckConstraint:
+					if checks == nil {
+						checks = make([]CheckConstraint, 0, 5)
+					}
+					checks = append(checks, c)
+				}
+			}
+
+			yyVAL.stmt = &CreateTableStmt{
+				ifNotExists: yyDollar[3].boolean,
+				table:       yyDollar[4].id,
+				colsSpec:    colsSpecs,
+				pkColNames:  pk,
+				checks:      checks,
+			}
 		}
 	case 49:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.ids = []string{yyDollar[1].id}
+			yyVAL.stmt = &CreateTableAsSelectStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[4].id, selectStmt: yyDollar[6].stmt.(*SelectStmt)}
 		}
 	case 50:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		{
-			yyVAL.ids = yyDollar[2].ids
+			yyVAL.stmt = &CreateTableAsSelectStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[4].id, pkColNames: yyDollar[7].ids, selectStmt: yyDollar[9].stmt.(*SelectStmt)}
 		}
 	case 51:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, ds: yyDollar[7].ds, onConflict: yyDollar[8].onConflict}
+			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].id}
 		}
 	case 52:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, ds: yyDollar[7].ds}
+			yyVAL.stmt = &TruncateTableStmt{table: yyDollar[3].id}
 		}
 	case 53:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		{
-			yyVAL.stmt = &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].ids, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
+			yyVAL.stmt = &CreateMaterializedViewStmt{ifNotExists: yyDollar[4].boolean, view: yyDollar[5].id, incremental: yyDollar[6].boolean, selectStmt: yyDollar[8].stmt.(*SelectStmt)}
 		}
 	case 54:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].ids, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
+			yyVAL.stmt = &RefreshMaterializedViewStmt{view: yyDollar[4].id}
 		}
 	case 55:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.ds = &valuesDataSource{rows: yyDollar[2].rows}
+			yyVAL.stmt = &DropMaterializedViewStmt{view: yyDollar[4].id}
 		}
 	case 56:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-13 : yypt+1]
 		{
-			yyVAL.ds = yyDollar[1].stmt.(DataSource)
+			yyVAL.stmt = &CreateTriggerStmt{ifNotExists: yyDollar[3].boolean, trigger: yyDollar[4].id, events: yyDollar[6].triggerEvents, table: yyDollar[8].id, stmt: yyDollar[13].stmt}
 		}
 	case 57:
-		yyDollar = yyS[yypt-0 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.onConflict = nil
+			yyVAL.stmt = &DropTriggerStmt{trigger: yyDollar[3].id, table: yyDollar[5].id}
 		}
 	case 58:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.onConflict = &OnConflictDo{}
+			yyVAL.stmt = yyDollar[2].routineDef
 		}
 	case 59:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.updates = []*colUpdate{yyDollar[1].update}
+			yyDollar[4].routineDef.orReplace = true
+			yyVAL.stmt = yyDollar[4].routineDef
 		}
 	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.updates = append(yyDollar[1].updates, yyDollar[3].update)
+			yyVAL.stmt = &DropRoutineStmt{kind: FunctionRoutine, name: yyDollar[3].id}
 		}
 	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.update = &colUpdate{col: yyDollar[1].id, op: yyDollar[2].cmpOp, val: yyDollar[3].exp}
+			yyVAL.stmt = &DropRoutineStmt{kind: ProcedureRoutine, name: yyDollar[3].id}
 		}
 	case 62:
-		yyDollar = yyS[yypt-0 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.ids = nil
+			yyVAL.stmt = &AnalyzeStmt{}
 		}
 	case 63:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.ids = yyDollar[1].ids
+			yyVAL.stmt = &AnalyzeStmt{table: yyDollar[2].id}
 		}
 	case 64:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		{
-			yyVAL.rows = []*RowSpec{yyDollar[1].row}
+			yyVAL.stmt = newCreateIndexStmt(false, yyDollar[3].boolean, yyDollar[5].id, yyDollar[7].indexCols)
 		}
 	case 65:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		{
-			yyVAL.rows = append(yyDollar[1].rows, yyDollar[3].row)
+			yyVAL.stmt = newCreateIndexStmt(true, yyDollar[4].boolean, yyDollar[6].id, yyDollar[8].indexCols)
 		}
 	case 66:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			yyVAL.row = &RowSpec{Values: yyDollar[2].values}
+			yyVAL.stmt = &DropIndexStmt{table: yyDollar[4].id, cols: indexColNames(yyDollar[6].indexCols)}
 		}
 	case 67:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.ids = []string{yyDollar[1].id}
+			yyVAL.stmt = &DropIndexStmt{table: yyDollar[3].id, cols: []string{yyDollar[5].id}}
 		}
 	case 68:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.ids = append(yyDollar[1].ids, yyDollar[3].id)
+			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].id, colSpec: yyDollar[6].colSpec}
 		}
 	case 69:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.cols = []*ColSelector{yyDollar[1].col}
+			yyVAL.stmt = &RenameTableStmt{oldName: yyDollar[3].id, newName: yyDollar[6].id}
 		}
 	case 70:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		{
-			yyVAL.cols = append(yyDollar[1].cols, yyDollar[3].col)
+			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].id, oldName: yyDollar[6].id, newName: yyDollar[8].id}
 		}
 	case 71:
-		yyDollar = yyS[yypt-0 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.values = nil
+			yyVAL.stmt = &DropColumnStmt{table: yyDollar[3].id, colName: yyDollar[6].id}
 		}
 	case 72:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &DropConstraintStmt{table: yyDollar[3].id, constraintName: yyDollar[6].id}
+		}
+	case 73:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &CreateUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+		}
+	case 74:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &AlterUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+		}
+	case 75:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &DropUserStmt{username: yyDollar[3].id}
+		}
+	case 76:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+		}
+	case 77:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
+		}
+	case 78:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterTablePrivilegesStmt{table: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+		}
+	case 79:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.stmt = &AlterTablePrivilegesStmt{table: yyDollar[8].id, user: yyDollar[11].id, privileges: []SQLPrivilege{SQLPrivilegeSelect}, columns: yyDollar[4].ids, isGrant: true}
+		}
+	case 80:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterTablePrivilegesStmt{table: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
+		}
+	case 81:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.stmt = &AlterTablePrivilegesStmt{table: yyDollar[8].id, user: yyDollar[11].id, privileges: []SQLPrivilege{SQLPrivilegeSelect}, columns: yyDollar[4].ids}
+		}
+	case 82:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterSchemaPrivilegesStmt{schema: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+		}
+	case 83:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterSchemaPrivilegesStmt{schema: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
+		}
+	case 84:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sqlPrivileges = allPrivileges
+		}
+	case 85:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivileges = []SQLPrivilege{yyDollar[1].sqlPrivilege}
+		}
+	case 86:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.sqlPrivileges = append(yyDollar[3].sqlPrivileges, yyDollar[1].sqlPrivilege)
+		}
+	case 87:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeSelect
+		}
+	case 88:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeCreate
+		}
+	case 89:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeInsert
+		}
+	case 90:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeUpdate
+		}
+	case 91:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeDelete
+		}
+	case 92:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeDrop
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeAlter
+		}
+	case 94:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeExecute
+		}
+	case 95:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.permission = PermissionReadWrite
+		}
+	case 96:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.permission = PermissionReadOnly
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.permission = PermissionReadWrite
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.permission = PermissionAdmin
+		}
+	case 99:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.boolean = false
+		}
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.boolean = true
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.triggerEvents = yyDollar[1].triggerEvents
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.triggerEvents = yyDollar[1].triggerEvents | yyDollar[3].triggerEvents
+		}
+	case 103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.triggerEvents = TriggerOnInsert
+		}
+	case 104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.triggerEvents = TriggerOnUpdate
+		}
+	case 105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.triggerEvents = TriggerOnDelete
+		}
+	case 106:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyVAL.routineDef = &CreateRoutineStmt{kind: FunctionRoutine, name: yyDollar[2].id, params: yyDollar[4].routineParams, returnType: yyDollar[7].sqlType, body: yyDollar[9].str}
+		}
+	case 107:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.routineDef = &CreateRoutineStmt{kind: ProcedureRoutine, name: yyDollar[2].id, params: yyDollar[4].routineParams, body: yyDollar[7].str}
+		}
+	case 108:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.routineParams = nil
+		}
+	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.routineParams = yyDollar[1].routineParams
+		}
+	case 110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.routineParams = []*routineParam{yyDollar[1].routineParam}
+		}
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.routineParams = append(yyDollar[1].routineParams, yyDollar[3].routineParam)
+		}
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.routineParam = &routineParam{name: yyDollar[1].id, paramType: yyDollar[2].sqlType}
+		}
+	case 113:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.boolean = false
+		}
+	case 114:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.boolean = true
+		}
+	case 115:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+		}
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.ids = []string{yyDollar[1].id}
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.ids = yyDollar[2].ids
+		}
+	case 119:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, ds: yyDollar[7].ds, onConflict: yyDollar[8].onConflict}
+		}
+	case 120:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, ds: yyDollar[7].ds}
+		}
+	case 121:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].ids, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
+		}
+	case 122:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].ids, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
+		}
+	case 123:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.ds = &valuesDataSource{rows: yyDollar[2].rows}
+		}
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.ds = yyDollar[1].stmt.(DataSource)
+		}
+	case 125:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.onConflict = nil
+		}
+	case 126:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.onConflict = &OnConflictDo{}
+		}
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.updates = []*colUpdate{yyDollar[1].update}
+		}
+	case 128:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.updates = append(yyDollar[1].updates, yyDollar[3].update)
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.update = &colUpdate{col: yyDollar[1].id, op: yyDollar[2].cmpOp, val: yyDollar[3].exp}
+		}
+	case 130:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.ids = nil
+		}
+	case 131:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.ids = yyDollar[1].ids
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.rows = []*RowSpec{yyDollar[1].row}
+		}
+	case 133:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.rows = append(yyDollar[1].rows, yyDollar[3].row)
+		}
+	case 134:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.row = &RowSpec{Values: yyDollar[2].values}
+		}
+	case 135:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.ids = []string{yyDollar[1].id}
+		}
+	case 136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.ids = append(yyDollar[1].ids, yyDollar[3].id)
+		}
+	case 137:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.cols = []*ColSelector{yyDollar[1].col}
+		}
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.cols = append(yyDollar[1].cols, yyDollar[3].col)
+		}
+	case 139:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.values = nil
+		}
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = yyDollar[1].values
 		}
-	case 73:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = []ValueExp{yyDollar[1].exp}
 		}
-	case 74:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].exp)
 		}
-	case 75:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Integer{val: int64(yyDollar[1].integer)}
 		}
-	case 76:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Float64{val: float64(yyDollar[1].float)}
 		}
-	case 77:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Varchar{val: yyDollar[1].str}
 		}
-	case 78:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Bool{val: yyDollar[1].boolean}
 		}
-	case 79:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Blob{val: yyDollar[1].blob}
 		}
-	case 80:
+	case 148:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.value = &Cast{val: yyDollar[3].exp, t: yyDollar[5].sqlType}
 		}
-	case 81:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = yyDollar[1].value
 		}
-	case 82:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: yyDollar[1].id}
 		}
-	case 83:
+	case 151:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.value = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
+		}
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.value = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
+			yyVAL.value = &NullValue{t: AnyType}
+		}
+	case 153:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
+		}
+	case 154:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			// e.g. POSITION(substring IN string)
+			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: []ValueExp{yyDollar[3].exp, yyDollar[5].sel}}
 		}
-	case 84:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 155:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.value = &NullValue{t: AnyType}
+			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: []ValueExp{yyDollar[3].exp, yyDollar[5].value}}
 		}
-	case 85:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
+			yyVAL.value = &FnCall{fn: ReplaceFnCall, params: yyDollar[3].values}
 		}
-	case 86:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableElems = []TableElem{yyDollar[1].tableElem}
 		}
-	case 87:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.tableElems = append(yyDollar[1].tableElems, yyDollar[3].tableElem)
 		}
-	case 88:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableElem = yyDollar[1].colSpec
 		}
-	case 89:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableElem = yyDollar[1].check
 		}
-	case 90:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.tableElem = PrimaryKeyConstraint(yyDollar[3].ids)
 		}
-	case 91:
+	case 162:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.colSpec = &ColSpec{colName: yyDollar[1].id, colType: yyDollar[2].sqlType, maxLen: int(yyDollar[3].integer), notNull: yyDollar[4].boolean || yyDollar[6].boolean, autoIncrement: yyDollar[5].boolean, primaryKey: yyDollar[6].boolean}
 		}
-	case 92:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 93:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 94:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.integer = 0
 		}
-	case 95:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.integer = yyDollar[2].integer
 		}
-	case 96:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.integer = yyDollar[2].integer
 		}
-	case 97:
+	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 98:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 99:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 100:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 101:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 102:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 103:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &UnionStmt{
@@ -1472,49 +2017,49 @@ yydefault:
 				right:    yyDollar[4].stmt.(DataSource),
 			}
 		}
-	case 104:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "databases"}},
 			}
 		}
-	case 105:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "tables"}},
 			}
 		}
-	case 106:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: yyDollar[3].id}}}},
 			}
 		}
-	case 107:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "users"}},
 			}
 		}
-	case 108:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "grants"}},
 			}
 		}
-	case 109:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "grants", params: []ValueExp{&Varchar{val: yyDollar[4].id}}}},
 			}
 		}
-	case 110:
+	case 181:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -1531,7 +2076,7 @@ yydefault:
 				offset:   yyDollar[13].exp,
 			}
 		}
-	case 111:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -1540,365 +2085,455 @@ yydefault:
 				ds:       &valuesDataSource{rows: []*RowSpec{{}}},
 			}
 		}
-	case 112:
+	case 183:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 113:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 114:
+	case 185:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 115:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 116:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.targets = nil
 		}
-	case 117:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.targets = yyDollar[1].targets
 		}
-	case 118:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.targets = []TargetEntry{{Exp: yyDollar[1].exp, As: yyDollar[2].id}}
 		}
-	case 119:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.targets = append(yyDollar[1].targets, TargetEntry{Exp: yyDollar[3].exp, As: yyDollar[4].id})
 		}
-	case 120:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sel = yyDollar[1].col
 		}
-	case 121:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: yyDollar[2].jsonFields}
 		}
-	case 122:
+	case 193:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: yyDollar[2].jsonFields, text: true}
+		}
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: parseJSONPath(yyDollar[3].str)}
+		}
+	case 195:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, col: "*"}
 		}
-	case 123:
+	case 196:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col}
 		}
-	case 124:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.jsonFields = []string{yyDollar[2].str}
+			yyVAL.jsonFields = []string{yyDollar[2].id}
 		}
-	case 125:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.jsonFields = append(yyVAL.jsonFields, yyDollar[3].str)
+			yyVAL.jsonFields = append(yyVAL.jsonFields, yyDollar[3].id)
 		}
-	case 126:
+	case 199:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.jsonFields = []string{yyDollar[2].id}
+		}
+	case 200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.jsonFields = append(yyDollar[1].jsonFields, yyDollar[3].id)
+		}
+	case 201:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.jsonFields = parseJSONPath(yyDollar[2].str)
+		}
+	case 202:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.id = yyDollar[1].str
+		}
+	case 203:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.id = fmt.Sprintf("%d", yyDollar[1].integer)
+		}
+	case 204:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.indexCols = []*indexCol{yyDollar[1].indexCol}
+		}
+	case 205:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.indexCols = append(yyDollar[1].indexCols, yyDollar[3].indexCol)
+		}
+	case 206:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.indexCol = &indexCol{col: yyDollar[1].id}
+		}
+	case 207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.indexCol = &indexCol{col: yyDollar[1].id, path: yyDollar[2].jsonFields}
+		}
+	case 208:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.indexCol = &indexCol{col: yyDollar[2].id, path: yyDollar[3].jsonFields}
+		}
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{col: yyDollar[1].id}
 		}
-	case 127:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{table: yyDollar[1].id, col: yyDollar[3].id}
 		}
-	case 128:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyDollar[1].tableRef.period = yyDollar[2].period
 			yyDollar[1].tableRef.as = yyDollar[3].id
 			yyVAL.ds = yyDollar[1].tableRef
 		}
-	case 129:
+	case 212:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &valuesDataSource{inferTypes: true, rows: yyDollar[3].rows}
 		}
-	case 130:
+	case 213:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyDollar[2].stmt.(*SelectStmt).as = yyDollar[4].id
 			yyVAL.ds = yyDollar[2].stmt.(DataSource)
 		}
-	case 131:
+	case 214:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "databases"}, as: yyDollar[4].id}
 		}
-	case 132:
+	case 215:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "tables"}, as: yyDollar[4].id}
 		}
-	case 133:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: yyDollar[3].id}}}}
 		}
-	case 134:
+	case 217:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "users"}, as: yyDollar[4].id}
 		}
-	case 135:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: yyDollar[1].value.(*FnCall), as: yyDollar[2].id}
 		}
-	case 136:
+	case 219:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.ds = &tableRef{table: yyDollar[4].id, history: true, as: yyDollar[6].id}
 		}
-	case 137:
+	case 220:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.ds = &changesDataSource{table: yyDollar[3].id, period: yyDollar[4].period, as: yyDollar[5].id}
+		}
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableRef = &tableRef{table: yyDollar[1].id}
 		}
-	case 138:
+	case 222:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.id = yyDollar[1].id
+		}
+	case 223:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.id = yyDollar[1].id + "." + yyDollar[3].id
+		}
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.id = yyDollar[1].id + ".tables"
+		}
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.period = period{start: yyDollar[1].openPeriod, end: yyDollar[2].openPeriod}
 		}
-	case 139:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 140:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 141:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 142:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 143:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 144:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 145:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: txInstant, exp: yyDollar[2].exp}
 		}
-	case 146:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: timeInstant, exp: yyDollar[1].exp}
 		}
-	case 147:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joins = nil
 		}
-	case 148:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = yyDollar[1].joins
 		}
-	case 149:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = []*JoinSpec{yyDollar[1].join}
 		}
-	case 150:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.joins = append([]*JoinSpec{yyDollar[1].join}, yyDollar[2].joins...)
 		}
-	case 151:
+	case 238:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[3].ds, indexOn: yyDollar[4].ids, cond: yyDollar[6].exp}
 		}
-	case 152:
+	case 239:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joinType = InnerJoin
 		}
-	case 153:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joinType = yyDollar[1].joinType
 		}
-	case 154:
+	case 241:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 155:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 156:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.cols = nil
 		}
-	case 157:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = yyDollar[3].cols
 		}
-	case 158:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 159:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 160:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 161:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 162:
+	case 249:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 163:
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 164:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ordexps = nil
 		}
-	case 165:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ordexps = yyDollar[3].ordexps
 		}
-	case 166:
+	case 253:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 167:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.ids = yyDollar[4].ids
+			yyVAL.ids = []string{yyDollar[4].id}
 		}
-	case 168:
+	case 255:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.ids = []string{jsonPathColName(yyDollar[4].id, yyDollar[5].jsonFields)}
+		}
+	case 256:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.ids = indexColNames(yyDollar[5].indexCols)
+		}
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ordexps = []*OrdExp{{exp: yyDollar[1].exp, descOrder: yyDollar[2].opt_ord}}
 		}
-	case 169:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ordexps = append(yyDollar[1].ordexps, &OrdExp{exp: yyDollar[3].exp, descOrder: yyDollar[4].opt_ord})
 		}
-	case 170:
+	case 259:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 171:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 172:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = true
 		}
-	case 173:
+	case 262:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.id = ""
 		}
-	case 174:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.id = yyDollar[1].id
 		}
-	case 175:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.id = yyDollar[2].id
 		}
-	case 176:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.check = CheckConstraint{exp: yyDollar[2].exp}
 		}
-	case 177:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.check = CheckConstraint{name: yyDollar[2].id, exp: yyDollar[4].exp}
 		}
-	case 178:
+	case 267:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 179:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 180:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 181:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].binExp
 		}
-	case 182:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NotBoolExp{exp: yyDollar[2].exp}
 		}
-	case 183:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			i, isInt := yyDollar[2].exp.(*Integer)
@@ -1909,37 +2544,47 @@ yydefault:
 				yyVAL.exp = &NumExp{left: &Integer{val: 0}, op: SUBSOP, right: yyDollar[2].exp}
 			}
 		}
-	case 184:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: yyDollar[2].boolean, pattern: yyDollar[4].exp}
 		}
-	case 185:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: true, pattern: yyDollar[3].exp}
 		}
-	case 186:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &ExistsBoolExp{q: (yyDollar[3].stmt).(DataSource)}
 		}
-	case 187:
+	case 276:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, q: yyDollar[4].stmt.(*SelectStmt)}
+		}
+	case 277:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, q: yyDollar[5].stmt.(*SelectStmt)}
+			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, notIn: true, q: yyDollar[5].stmt.(*SelectStmt)}
 		}
-	case 188:
+	case 278:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.exp = &InListExp{val: yyDollar[1].exp, values: yyDollar[4].values}
+		}
+	case 279:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.exp = &InListExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, values: yyDollar[5].values}
+			yyVAL.exp = &InListExp{val: yyDollar[1].exp, notIn: true, values: yyDollar[5].values}
 		}
-	case 189:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 190:
+	case 281:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.exp = &CaseWhenExp{
@@ -1948,102 +2593,112 @@ yydefault:
 				elseExp:  yyDollar[4].exp,
 			}
 		}
-	case 191:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.whenThenClauses = []whenThenClause{{when: yyDollar[2].exp, then: yyDollar[4].exp}}
 		}
-	case 192:
+	case 283:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.whenThenClauses = append(yyDollar[1].whenThenClauses, whenThenClause{when: yyDollar[3].exp, then: yyDollar[5].exp})
 		}
-	case 193:
+	case 284:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 194:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 195:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].sel
 		}
-	case 196:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].value
 		}
-	case 197:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 198:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &Cast{val: yyDollar[1].exp, t: yyDollar[3].sqlType}
 		}
-	case 199:
+	case 290:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 200:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 201:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: ADDOP, right: yyDollar[3].exp}
 		}
-	case 202:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: SUBSOP, right: yyDollar[3].exp}
 		}
-	case 203:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: DIVOP, right: yyDollar[3].exp}
 		}
-	case 204:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: MULTOP, right: yyDollar[3].exp}
 		}
-	case 205:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: MODOP, right: yyDollar[3].exp}
 		}
-	case 206:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &BinBoolExp{left: yyDollar[1].exp, op: And, right: yyDollar[3].exp}
 		}
-	case 207:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &BinBoolExp{left: yyDollar[1].exp, op: Or, right: yyDollar[3].exp}
 		}
-	case 208:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, right: yyDollar[3].exp}
 		}
-	case 209:
+	case 300:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.binExp = &JSONBoolExp{left: yyDollar[1].exp, op: JSONContains, right: yyDollar[3].exp}
+		}
+	case 301:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.binExp = &JSONBoolExp{left: yyDollar[1].exp, op: JSONHasKey, right: yyDollar[3].exp}
+		}
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: EQ, right: &NullValue{t: AnyType}}
 		}
-	case 210:
+	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: NE, right: &NullValue{t: AnyType}}
@@ -40,12 +40,25 @@ type SQLTx struct {
 	mutatedCatalog bool // set when a DDL stmt was executed within the current tx
 
 	updatedRows      int
+	triggerDepth     int              // nesting of the triggers being executed
+	routineDepth     int              // nesting of the functions and procedures being executed
 	lastInsertedPKs  map[string]int64 // last inserted PK by table name
 	firstInsertedPKs map[string]int64 // first inserted PK by table name
 
 	txHeader *store.TxHeader // header is set once tx is committed
 
+	memBudget *memoryBudget // memory budget of the statement being executed
+
+	// context of the statement being executed, expressions are evaluated without a context
+	// so it bounds the execution of the user-defined functions called by them
+	stmtCtx context.Context
+
+	// routines the user was checked to be allowed to execute within the current statement
+	checkedRoutines map[*Routine]struct{}
+
 	onCommittedCallbacks []onCommittedCallback
+
+	truncatedIndexes map[uint32]struct{} // truncated table ids whose primary index is read within the tx
 }
 
 type onCommittedCallback = func(sqlTx *SQLTx) error
@@ -96,6 +109,13 @@ func (sqlTx *SQLTx) distinctLimit() int {
 	return sqlTx.engine.distinctLimit
 }
 
+func (sqlTx *SQLTx) statementTimeout() time.Duration {
+	if sqlTx.opts.StatementTimeout > 0 {
+		return sqlTx.opts.StatementTimeout
+	}
+	return sqlTx.engine.statementTimeout
+}
+
 func (sqlTx *SQLTx) newKeyReader(rSpec store.KeyReaderSpec) (store.KeyReader, error) {
 	return sqlTx.tx.NewKeyReader(rSpec)
 }
@@ -118,12 +138,14 @@ func (sqlTx *SQLTx) getWithPrefix(ctx context.Context, prefix, neq []byte) (key
 
 func (sqlTx *SQLTx) Cancel() error {
 	defer sqlTx.removeTempFiles()
+	defer sqlTx.releaseTruncatedIndexes()
 
 	return sqlTx.tx.Cancel()
 }
 
 func (sqlTx *SQLTx) Commit(ctx context.Context) error {
 	defer sqlTx.removeTempFiles()
+	defer sqlTx.releaseTruncatedIndexes()
 
 	err := sqlTx.tx.RequireMVCCOnFollowingTxs(sqlTx.mutatedCatalog)
 	if err != nil {
@@ -30,6 +30,9 @@ type TxOptions struct {
 	ExplicitClose           bool
 	UnsafeMVCC              bool
 	Extra                   []byte
+	SearchPath              []string
+	StatementTimeout        time.Duration
+	Cursors                 CursorRegistry
 }
 
 func DefaultTxOptions() *TxOptions {
@@ -77,6 +80,26 @@ func (opts *TxOptions) WithUnsafeMVCC(unsafeMVCC bool) *TxOptions {
 	return opts
 }
 
+// WithSearchPath sets the schemas in which unqualified table names are looked up, in order.
+func (opts *TxOptions) WithSearchPath(searchPath []string) *TxOptions {
+	opts.SearchPath = searchPath
+	return opts
+}
+
+// WithStatementTimeout overrides the statement timeout of the engine for the statements executed within the transaction.
+// A value of zero means the timeout of the engine is used.
+func (opts *TxOptions) WithStatementTimeout(timeout time.Duration) *TxOptions {
+	opts.StatementTimeout = timeout
+	return opts
+}
+
+// WithCursors sets the registry of the cursors declared within the session the transaction belongs to.
+// Cursor statements fail when no registry is set.
+func (opts *TxOptions) WithCursors(cursors CursorRegistry) *TxOptions {
+	opts.Cursors = cursors
+	return opts
+}
+
 func (opts *TxOptions) WithExtra(data []byte) *TxOptions {
 	opts.Extra = data
 	return opts
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -34,12 +35,18 @@ import (
 )
 
 const (
-	catalogPrefix          = "CTL."
-	catalogTablePrefix     = "CTL.TABLE."     // (key=CTL.TABLE.{1}{tableID}, value={tableNAME})
-	catalogColumnPrefix    = "CTL.COLUMN."    // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
-	catalogIndexPrefix     = "CTL.INDEX."     // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
-	catalogCheckPrefix     = "CTL.CHECK."     // (key=CTL.CHECK.{1}{tableID}{checkID}, value={nameLen}{name}{expText})
-	catalogPrivilegePrefix = "CTL.PRIVILEGE." // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
+	catalogPrefix           = "CTL."
+	catalogSchemaPrefix     = "CTL.SCHEMA."     // (key=CTL.SCHEMA.{1}{schemaID}, value={schemaNAME})
+	catalogTablePrefix      = "CTL.TABLE."      // (key=CTL.TABLE.{1}{tableID}, value={tableNAME})
+	catalogColumnPrefix     = "CTL.COLUMN."     // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
+	catalogIndexPrefix      = "CTL.INDEX."      // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
+	catalogCheckPrefix      = "CTL.CHECK."      // (key=CTL.CHECK.{1}{tableID}{checkID}, value={nameLen}{name}{expText})
+	catalogPrivilegePrefix  = "CTL.PRIVILEGE."  // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
+	catalogStatsPrefix      = "CTL.STATS."      // (key=CTL.STATS.{1}{tableID}, value={rowCount}{analyzedAt}{colCount}({colID}{nullCount}{distinctCount}{boundCount}{bound}*)*)
+	catalogViewPrefix       = "CTL.VIEW."       // (key=CTL.VIEW.{1}{tableID}, value={flags}{refreshedAt}{query})
+	catalogTriggerPrefix    = "CTL.TRIGGER."    // (key=CTL.TRIGGER.{1}{tableID}{triggerNAME}, value={events}{sql})
+	catalogRoutinePrefix    = "CTL.ROUTINE."    // (key=CTL.ROUTINE.{1}{routineNAME}, value={version}{sql})
+	catalogTruncationPrefix = "CTL.TRUNCATION." // (key=CTL.TRUNCATION.{1}{tableID}, value={tableID}*)
 
 	RowPrefix    = "R." // (key=R.{1}{tableID}{0}({null}({pkVal}{padding}{pkValLen})?)+, value={count (colID valLen val)+})
 	MappedPrefix = "M." // (key=M.{tableID}{indexID}({null}({val}{padding}{valLen})?)*({pkVal}{padding}{pkValLen})+, value={count (colID valLen val)+})
@@ -56,13 +63,19 @@ const (
 )
 
 const (
-	revCol        = "_rev"
-	txMetadataCol = "_tx_metadata"
+	revCol         = "_rev"
+	txMetadataCol  = "_tx_metadata"
+	txIDCol        = "_tx_id"
+	txTimestampCol = "_tx_ts"
+	txUserCol      = "_tx_user"
 )
 
 var reservedColumns = map[string]struct{}{
-	revCol:        {},
-	txMetadataCol: {},
+	revCol:         {},
+	txMetadataCol:  {},
+	txIDCol:        {},
+	txTimestampCol: {},
+	txUserCol:      {},
 }
 
 func isReservedCol(col string) bool {
@@ -118,11 +131,12 @@ func PermissionFromCode(code uint32) Permission {
 type AggregateFn = string
 
 const (
-	COUNT AggregateFn = "COUNT"
-	SUM   AggregateFn = "SUM"
-	MAX   AggregateFn = "MAX"
-	MIN   AggregateFn = "MIN"
-	AVG   AggregateFn = "AVG"
+	COUNT   AggregateFn = "COUNT"
+	SUM     AggregateFn = "SUM"
+	MAX     AggregateFn = "MAX"
+	MIN     AggregateFn = "MIN"
+	AVG     AggregateFn = "AVG"
+	JSONAGG AggregateFn = "JSON_AGG"
 )
 
 type CmpOperator = int
@@ -456,6 +470,94 @@ func (stmt *DropUserStmt) execAt(ctx context.Context, tx *SQLTx, params map[stri
 	return nil, tx.engine.multidbHandler.DropUser(ctx, stmt.username)
 }
 
+// CreateSchemaStmt represents a statement to create a schema, a namespace for tables within the database.
+type CreateSchemaStmt struct {
+	schema      string
+	ifNotExists bool
+}
+
+func NewCreateSchemaStmt(schema string, ifNotExists bool) *CreateSchemaStmt {
+	return &CreateSchemaStmt{schema: schema, ifNotExists: ifNotExists}
+}
+
+func (stmt *CreateSchemaStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateSchemaStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreateSchemaStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateSchemaStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.ifNotExists && tx.catalog.ExistSchema(stmt.schema) {
+		return tx, nil
+	}
+
+	schema, err := tx.catalog.newSchema(stmt.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	mappedKey := MapKey(tx.sqlPrefix(), catalogSchemaPrefix, EncodeID(DatabaseID), EncodeID(schema.id))
+
+	err = tx.set(mappedKey, nil, []byte(schema.name))
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// DropSchemaStmt represents a statement to delete a schema. Only schemas without tables can be deleted.
+type DropSchemaStmt struct {
+	schema string
+}
+
+func NewDropSchemaStmt(schema string) *DropSchemaStmt {
+	return &DropSchemaStmt{schema: schema}
+}
+
+func (stmt *DropSchemaStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropSchemaStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropSchemaStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropSchemaStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	schema, err := tx.catalog.GetSchemaByName(stmt.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.catalog.deleteSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	mappedKey := MapKey(tx.sqlPrefix(), catalogSchemaPrefix, EncodeID(DatabaseID), EncodeID(schema.id))
+
+	err = tx.delete(ctx, mappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
 type TableElem interface{}
 
 type CreateTableStmt struct {
@@ -502,7 +604,12 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
-	if stmt.ifNotExists && tx.catalog.ExistTable(stmt.table) {
+	tableName, err := tx.catalog.qualifyNewTableName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.ifNotExists && tx.catalog.ExistTable(tableName) {
 		return tx, nil
 	}
 
@@ -511,9 +618,11 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		colSpecs[uint32(i)+1] = cs
 	}
 
-	row := zeroRow(stmt.table, stmt.colsSpec)
+	_, unqualifiedName := splitTableName(tableName)
+
+	row := zeroRow(unqualifiedName, stmt.colsSpec)
 	for _, check := range stmt.checks {
-		value, err := check.exp.reduce(tx, row, stmt.table)
+		value, err := check.exp.reduce(tx, row, unqualifiedName)
 		if err != nil {
 			return nil, err
 		}
@@ -526,7 +635,7 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 	nextUnnamedCheck := 0
 	checks := make(map[string]CheckConstraint)
 	for id, check := range stmt.checks {
-		name := fmt.Sprintf("%s_check%d", stmt.table, nextUnnamedCheck+1)
+		name := fmt.Sprintf("%s_check%d", unqualifiedName, nextUnnamedCheck+1)
 		if check.name != "" {
 			name = check.name
 		} else {
@@ -537,12 +646,12 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		checks[name] = check
 	}
 
-	table, err := tx.catalog.newTable(stmt.table, colSpecs, checks, uint32(len(colSpecs)))
+	table, err := tx.catalog.newTable(tableName, colSpecs, checks, uint32(len(colSpecs)))
 	if err != nil {
 		return nil, err
 	}
 
-	createIndexStmt := &CreateIndexStmt{unique: true, table: table.name, cols: stmt.primaryKeyCols()}
+	createIndexStmt := &CreateIndexStmt{unique: true, table: table.Name(), cols: stmt.primaryKeyCols()}
 	_, err = createIndexStmt.execAt(ctx, tx, params)
 	if err != nil {
 		return nil, err
@@ -569,7 +678,7 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 
 	mappedKey := MapKey(tx.sqlPrefix(), catalogTablePrefix, EncodeID(DatabaseID), EncodeID(table.id))
 
-	err = tx.set(mappedKey, nil, []byte(table.name))
+	err = tx.set(mappedKey, nil, []byte(table.Name()))
 	if err != nil {
 		return nil, err
 	}
@@ -613,6 +722,124 @@ func (stmt *CreateTableStmt) primaryKeyCols() []string {
 	return nil
 }
 
+// CreateTableAsSelectStmt represents a statement creating a table populated with the rows returned by a query.
+// The types of the columns are inferred from the query. Unless specified, the primary key is made of the
+// grouping columns or, if the query is not grouped, of the primary key columns of the queried table.
+type CreateTableAsSelectStmt struct {
+	table       string
+	ifNotExists bool
+	pkColNames  PrimaryKeyConstraint
+	selectStmt  *SelectStmt
+}
+
+func (stmt *CreateTableAsSelectStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateTableAsSelectStmt) requiredPrivileges() []SQLPrivilege {
+	return append([]SQLPrivilege{SQLPrivilegeCreate}, stmt.selectStmt.requiredPrivileges()...)
+}
+
+func (stmt *CreateTableAsSelectStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return stmt.selectStmt.inferParameters(ctx, tx, params)
+}
+
+func (stmt *CreateTableAsSelectStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	tableName, err := tx.catalog.qualifyNewTableName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.ifNotExists && tx.catalog.ExistTable(tableName) {
+		return tx, nil
+	}
+
+	rowReader, err := stmt.selectStmt.Resolve(ctx, tx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rowReader.Close()
+
+	cols, err := rowReader.Columns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pkPositions, err := stmt.primaryKeyPositions(tx, cols)
+	if err != nil {
+		return nil, err
+	}
+
+	colsSpec, pkColNames, err := queryColsSpec(tx, stmt.selectStmt, cols, pkPositions, ErrInvalidTableQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	createTableStmt := &CreateTableStmt{
+		table:      stmt.table,
+		colsSpec:   colsSpec,
+		pkColNames: pkColNames,
+	}
+
+	_, err = createTableStmt.execAt(ctx, tx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := tx.catalog.GetTableByName(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the table has just been created, so duplicated rows are detected while reading them
+	pks, rows, err := tx.readRowsByPK(ctx, table, rowReader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pk := range pks {
+		valuesByColID := rows[pk]
+
+		for _, col := range table.cols {
+			if col.notNull && valuesByColID[col.id].IsNull() {
+				return nil, fmt.Errorf("%w (%s)", ErrNotNullableColumnCannotBeNull, col.colName)
+			}
+		}
+
+		err := tx.doUpsert(ctx, []byte(pk), valuesByColID, table, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+func (stmt *CreateTableAsSelectStmt) primaryKeyPositions(tx *SQLTx, cols []ColDescriptor) ([]int, error) {
+	if len(stmt.pkColNames) == 0 {
+		return inferredPrimaryKeyPositions(tx, stmt.selectStmt, cols, ErrInvalidTableQuery)
+	}
+
+	positions := make([]int, len(stmt.pkColNames))
+
+	for i, colName := range stmt.pkColNames {
+		positions[i] = -1
+
+		for pos, col := range cols {
+			if col.Column == colName {
+				positions[i] = pos
+				break
+			}
+		}
+
+		if positions[i] < 0 {
+			return nil, fmt.Errorf("%w (%s)", ErrColumnDoesNotExist, colName)
+		}
+	}
+
+	return positions, nil
+}
+
 func persistColumn(tx *SQLTx, col *Column) error {
 	//{auto_incremental | nullable}{maxLen}{colNAME})
 	v := make([]byte, 1+4+len(col.colName))
@@ -691,12 +918,57 @@ type CreateIndexStmt struct {
 	ifNotExists bool
 	table       string
 	cols        []string
+	// paths holds, for each indexed column, the JSON path whose text is indexed (if any)
+	paths [][]string
 }
 
 func NewCreateIndexStmt(table string, cols []string, isUnique bool) *CreateIndexStmt {
 	return &CreateIndexStmt{unique: isUnique, table: table, cols: cols}
 }
 
+// indexCol is a column, or a JSON path within a column, as specified in an index definition
+type indexCol struct {
+	col  string
+	path []string
+}
+
+func (c *indexCol) name() string {
+	if c.path == nil {
+		return c.col
+	}
+	return jsonPathColName(c.col, c.path)
+}
+
+func indexColNames(cols []*indexCol) []string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.name()
+	}
+	return names
+}
+
+func newCreateIndexStmt(unique, ifNotExists bool, table string, cols []*indexCol) *CreateIndexStmt {
+	stmt := &CreateIndexStmt{
+		unique:      unique,
+		ifNotExists: ifNotExists,
+		table:       table,
+		cols:        make([]string, len(cols)),
+	}
+
+	for i, c := range cols {
+		stmt.cols[i] = c.col
+
+		if c.path != nil {
+			if stmt.paths == nil {
+				stmt.paths = make([][]string, len(cols))
+			}
+			stmt.paths[i] = c.path
+		}
+	}
+
+	return stmt
+}
+
 func (stmt *CreateIndexStmt) readOnly() bool {
 	return false
 }
@@ -733,6 +1005,13 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 			return nil, err
 		}
 
+		if i < len(stmt.paths) && stmt.paths[i] != nil {
+			col, err = table.jsonPathColumn(col, stmt.paths[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if col.Type() == JSONType {
 			return nil, ErrCannotIndexJson
 		}
@@ -772,30 +1051,50 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
+	err = persistIndex(tx, index)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// jsonPathColSpec flags the columns of an index holding the texts found at a JSON path
+const jsonPathColSpec = byte(0x02)
+
+func persistIndex(tx *SQLTx, index *Index) error {
 	// v={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
+	// JSON path columns are persisted as {sourceColID}{0x02}{pathLen}{path}
 	// TODO: currently only ASC order is supported
-	colSpecLen := EncIDLen + 1
-
-	encodedValues := make([]byte, 1+len(index.cols)*colSpecLen)
+	encodedValues := make([]byte, 1, 1+len(index.cols)*(EncIDLen+1))
 
 	if index.IsUnique() {
 		encodedValues[0] = 1
 	}
 
-	for i, col := range index.cols {
-		copy(encodedValues[1+i*colSpecLen:], EncodeID(col.id))
-	}
+	for _, col := range index.cols {
+		if col.source == nil {
+			encodedValues = append(encodedValues, EncodeID(col.id)...)
+			encodedValues = append(encodedValues, 0)
+			continue
+		}
 
-	mappedKey := MapKey(tx.sqlPrefix(), catalogIndexPrefix, EncodeID(DatabaseID), EncodeID(table.id), EncodeID(index.id))
+		path, err := json.Marshal(col.path)
+		if err != nil {
+			return err
+		}
 
-	err = tx.set(mappedKey, nil, encodedValues)
-	if err != nil {
-		return nil, err
+		encodedValues = append(encodedValues, EncodeID(col.source.id)...)
+		encodedValues = append(encodedValues, jsonPathColSpec)
+		encodedValues = append(encodedValues, EncodeID(uint32(len(path)))...)
+		encodedValues = append(encodedValues, path...)
 	}
 
-	tx.mutatedCatalog = true
+	mappedKey := MapKey(tx.sqlPrefix(), catalogIndexPrefix, EncodeID(DatabaseID), EncodeID(index.table.id), EncodeID(index.id))
 
-	return tx, nil
+	return tx.set(mappedKey, nil, encodedValues)
 }
 
 type AddColumnStmt struct {
@@ -825,6 +1124,10 @@ func (stmt *AddColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, err
 	}
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	col, err := table.newColumn(stmt.colSpec)
 	if err != nil {
 		return nil, err
@@ -870,7 +1173,7 @@ func (stmt *RenameTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		EncodeID(DatabaseID),
 		EncodeID(table.id),
 	)
-	err = tx.set(mappedKey, nil, []byte(stmt.newName))
+	err = tx.set(mappedKey, nil, []byte(table.Name()))
 	if err != nil {
 		return nil, err
 	}
@@ -908,6 +1211,10 @@ func (stmt *RenameColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[
 		return nil, err
 	}
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	col, err := table.renameColumn(stmt.oldName, stmt.newName)
 	if err != nil {
 		return nil, err
@@ -950,6 +1257,10 @@ func (stmt *DropColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		return nil, err
 	}
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	col, err := table.GetColumnByName(stmt.colName)
 	if err != nil {
 		return nil, err
@@ -1029,6 +1340,10 @@ func (stmt *DropConstraintStmt) execAt(ctx context.Context, tx *SQLTx, params ma
 		return nil, err
 	}
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	id, err := table.deleteCheck(stmt.constraintName)
 	if err != nil {
 		return nil, err
@@ -1163,6 +1478,10 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		return nil, err
 	}
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	selPosByColID, err := stmt.validate(table)
 	if err != nil {
 		return nil, err
@@ -1212,10 +1531,10 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 					pkCol := table.primaryIndex.cols[0]
 					valuesByColID[pkCol.id] = &Integer{val: table.maxPK}
 
-					if _, ok := tx.firstInsertedPKs[table.name]; !ok {
-						tx.firstInsertedPKs[table.name] = table.maxPK
+					if _, ok := tx.firstInsertedPKs[table.Name()]; !ok {
+						tx.firstInsertedPKs[table.Name()] = table.maxPK
 					}
-					tx.lastInsertedPKs[table.name] = table.maxPK
+					tx.lastInsertedPKs[table.Name()] = table.maxPK
 				}
 
 				continue
@@ -1251,10 +1570,10 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 				pkMustExist = nl <= table.maxPK
 
-				if _, ok := tx.firstInsertedPKs[table.name]; !ok {
-					tx.firstInsertedPKs[table.name] = nl
+				if _, ok := tx.firstInsertedPKs[table.Name()]; !ok {
+					tx.firstInsertedPKs[table.Name()] = nl
 				}
-				tx.lastInsertedPKs[table.name] = nl
+				tx.lastInsertedPKs[table.Name()] = nl
 			}
 
 			valuesByColID[colID] = rval
@@ -1313,14 +1632,44 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			}
 		}
 
+		event := TriggerOnInsert
+		var oldValuesByColID map[uint32]TypedValue
+
+		if err == nil {
+			event = TriggerOnUpdate
+
+			if table.hasTriggers(TriggerOnUpdate) {
+				oldRow, err := tx.fetchPKRow(ctx, table, valuesByColID)
+				if err != nil {
+					return nil, err
+				}
+
+				oldValuesByColID = valuesByColIDOf(oldRow, table)
+			}
+		}
+
 		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !stmt.isInsert)
 		if err != nil {
 			return nil, err
 		}
+
+		err = tx.fireTriggers(ctx, table, event, oldValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return tx, nil
 }
 
+func valuesByColIDOf(row *Row, table *Table) map[uint32]TypedValue {
+	valuesByColID := make(map[uint32]TypedValue, len(table.cols))
+
+	for _, col := range table.cols {
+		valuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+	}
+	return valuesByColID
+}
+
 func checkConstraints(tx *SQLTx, checks map[string]CheckConstraint, row *Row, table string) error {
 	for _, check := range checks {
 		val, err := check.exp.reduce(tx, row, table)
@@ -1440,10 +1789,7 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 		indexKeyLen := 0
 
 		for i, col := range index.cols {
-			rval, specified := valuesByColID[col.id]
-			if !specified {
-				rval = &NullValue{t: col.colType}
-			}
+			rval := col.valueFrom(valuesByColID)
 
 			encVal, n, err := EncodeValueAsKey(rval, col.colType, col.MaxLen())
 			if err != nil {
@@ -1577,15 +1923,8 @@ func (tx *SQLTx) deprecateIndexEntries(
 		sameIndexKey := true
 
 		for i, col := range index.cols {
-			currVal, specified := currValuesByColID[col.id]
-			if !specified {
-				currVal = &NullValue{t: col.colType}
-			}
-
-			newVal, specified := newValuesByColID[col.id]
-			if !specified {
-				newVal = &NullValue{t: col.colType}
-			}
+			currVal := col.valueFrom(currValuesByColID)
+			newVal := col.valueFrom(newValuesByColID)
 
 			r, err := currVal.Compare(newVal)
 			if err != nil {
@@ -1716,6 +2055,10 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 
 	table := rowReader.ScanSpecs().Index.table
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	err = stmt.validate(table)
 	if err != nil {
 		return nil, err
@@ -1734,11 +2077,11 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 			return nil, err
 		}
 
-		valuesByColID := make(map[uint32]TypedValue, len(row.ValuesBySelector))
+		valuesByColID := valuesByColIDOf(row, table)
 
-		for _, col := range table.cols {
-			encSel := EncodeSelector("", table.name, col.colName)
-			valuesByColID[col.id] = row.ValuesBySelector[encSel]
+		var oldValuesByColID map[uint32]TypedValue
+		if table.hasTriggers(TriggerOnUpdate) {
+			oldValuesByColID = valuesByColIDOf(row, table)
 		}
 
 		for _, update := range stmt.updates {
@@ -1794,6 +2137,11 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 		if err != nil {
 			return nil, err
 		}
+
+		err = tx.fireTriggers(ctx, table, TriggerOnUpdate, oldValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return tx, nil
@@ -1852,6 +2200,10 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 	table := rowReader.ScanSpecs().Index.table
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
 	for {
 		row, err := rowReader.Read(ctx)
 		if errors.Is(err, ErrNoMoreRows) {
@@ -1861,12 +2213,7 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			return nil, err
 		}
 
-		valuesByColID := make(map[uint32]TypedValue, len(row.ValuesBySelector))
-
-		for _, col := range table.cols {
-			encSel := EncodeSelector("", table.name, col.colName)
-			valuesByColID[col.id] = row.ValuesBySelector[encSel]
-		}
+		valuesByColID := valuesByColIDOf(row, table)
 
 		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
 		if err != nil {
@@ -1879,6 +2226,11 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		}
 
 		tx.updatedRows++
+
+		err = tx.fireTriggers(ctx, table, TriggerOnDelete, valuesByColID, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return tx, nil
 }
@@ -1900,10 +2252,7 @@ func (tx *SQLTx) deleteIndexEntries(pkEncVals []byte, valuesByColID map[uint32]T
 		encodedValues[2] = EncodeID(index.id)
 
 		for i, col := range index.cols {
-			val, specified := valuesByColID[col.id]
-			if !specified {
-				val = &NullValue{t: col.colType}
-			}
+			val := col.valueFrom(valuesByColID)
 
 			encVal, _, _ := EncodeValueAsKey(val, col.colType, col.MaxLen())
 
@@ -2720,6 +3069,10 @@ func (v *FnCall) inferType(cols map[string]ColDescriptor, params map[string]SQLV
 	if err != nil {
 		return AnyType, nil
 	}
+
+	if tfn, ok := fn.(argsTypedFunction); ok {
+		return tfn.inferTypeOf(v.params, cols, params, implicitTable)
+	}
 	return fn.InferType(cols, params, implicitTable)
 }
 
@@ -2728,6 +3081,10 @@ func (v *FnCall) requiresType(t SQLValueType, cols map[string]ColDescriptor, par
 	if err != nil {
 		return err
 	}
+
+	if tfn, ok := fn.(argsTypedFunction); ok {
+		return tfn.requiresTypeOf(t, v.params, cols, params, implicitTable)
+	}
 	return fn.RequiresType(t, cols, params, implicitTable)
 }
 
@@ -2755,7 +3112,7 @@ func (v *FnCall) substitute(params map[string]interface{}) (val ValueExp, err er
 }
 
 func (v *FnCall) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
-	fn, err := v.resolveFunc()
+	fn, err := v.resolveFuncAt(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -2790,8 +3147,22 @@ func (v *FnCall) resolveFunc() (Function, error) {
 	return fn, nil
 }
 
-func (v *FnCall) reduceSelectors(row *Row, implicitTable string) ValueExp {
-	return v
+// resolveFuncAt resolves builtin functions, and user-defined functions in the catalog of the transaction
+func (v *FnCall) resolveFuncAt(tx *SQLTx) (Function, error) {
+	fn, err := v.resolveFunc()
+	if err == nil || tx == nil {
+		return fn, err
+	}
+
+	routine, exists := tx.catalog.routinesByName[v.fn]
+	if !exists || routine.kind != FunctionRoutine {
+		return nil, err
+	}
+	return &routineFunction{routine: routine}, nil
+}
+
+func (v *FnCall) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return v
 }
 
 func (v *FnCall) isConstant() bool {
@@ -2839,8 +3210,7 @@ func (c *Cast) substitute(params map[string]interface{}) (ValueExp, error) {
 	if err != nil {
 		return nil, err
 	}
-	c.val = val
-	return c, nil
+	return &Cast{val: val, t: c.t}, nil
 }
 
 func (c *Cast) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -2953,6 +3323,11 @@ func (p *Param) substitute(params map[string]interface{}) (ValueExp, error) {
 		{
 			return &Float64{val: v}, nil
 		}
+	case TypedValue:
+		{
+			// variables of functions and procedures
+			return v, nil
+		}
 	}
 	return nil, ErrUnsupportedParameter
 }
@@ -3218,19 +3593,18 @@ type TargetEntry struct {
 }
 
 type SelectStmt struct {
-	distinct  bool
-	targets   []TargetEntry
-	selectors []Selector
-	ds        DataSource
-	indexOn   []string
-	joins     []*JoinSpec
-	where     ValueExp
-	groupBy   []*ColSelector
-	having    ValueExp
-	orderBy   []*OrdExp
-	limit     ValueExp
-	offset    ValueExp
-	as        string
+	distinct bool
+	targets  []TargetEntry
+	ds       DataSource
+	indexOn  []string
+	joins    []*JoinSpec
+	where    ValueExp
+	groupBy  []*ColSelector
+	having   ValueExp
+	orderBy  []*OrdExp
+	limit    ValueExp
+	offset   ValueExp
+	as       string
 }
 
 func NewSelectStmt(
@@ -3303,10 +3677,7 @@ func (stmt *SelectStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 }
 
 func (stmt *SelectStmt) targetSelectors() []Selector {
-	if stmt.selectors == nil {
-		stmt.selectors = stmt.extractSelectors()
-	}
-	return stmt.selectors
+	return stmt.extractSelectors()
 }
 
 func (stmt *SelectStmt) selectorAppearsInTargets(s Selector) bool {
@@ -3558,15 +3929,16 @@ func (stmt *SelectStmt) Alias() string {
 	return stmt.as
 }
 
-func (stmt *SelectStmt) hasTxMetadata() bool {
-	for _, sel := range stmt.targetSelectors() {
+// referencesCol returns true if the column is used anywhere in the statement
+func (stmt *SelectStmt) referencesCol(col string) bool {
+	for _, sel := range stmt.referencedSelectors() {
 		switch s := sel.(type) {
 		case *ColSelector:
-			if s.col == txMetadataCol {
+			if s.col == col {
 				return true
 			}
 		case *JSONSelector:
-			if s.ColSelector.col == txMetadataCol {
+			if s.ColSelector.col == col {
 				return true
 			}
 		}
@@ -3622,16 +3994,28 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 		sortingIndex = table.primaryIndex
 	}
 
+	if preferredIndex == nil && !tableRef.history && table.stats != nil && len(rangesByColID) > 0 {
+		sortCols := groupByCols
+		if len(sortCols) == 0 {
+			sortCols = orderByCols
+		}
+		sortingIndex = table.stats.selectCheapestIndex(table, sortingIndex, sortCols, rangesByColID)
+	}
+
 	if tableRef.history && !sortingIndex.IsPrimary() {
 		return nil, fmt.Errorf("%w: historical queries are supported over primary index", ErrIllegalArguments)
 	}
 
+	// the history of a truncated table is read from each of the ids it used, one after the other,
+	// so rows are not sorted by the index
+	sorted := !tableRef.history || len(table.truncatedIDs) == 0
+
 	var descOrder bool
-	if len(groupByCols) > 0 && sortingIndex.coversOrdCols(groupByCols, rangesByColID) {
+	if sorted && len(groupByCols) > 0 && sortingIndex.coversOrdCols(groupByCols, rangesByColID) {
 		groupByCols = nil
 	}
 
-	if len(groupByCols) == 0 && len(orderByCols) > 0 && sortingIndex.coversOrdCols(orderByCols, rangesByColID) {
+	if sorted && len(groupByCols) == 0 && len(orderByCols) > 0 && sortingIndex.coversOrdCols(orderByCols, rangesByColID) {
 		descOrder = orderByCols[0].descOrder
 		orderByCols = nil
 	}
@@ -3639,13 +4023,16 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 	groupByCols, orderByCols = stmt.rearrangeOrdExps(groupByCols, orderByCols)
 
 	return &ScanSpecs{
-		Index:             sortingIndex,
-		rangesByColID:     rangesByColID,
-		IncludeHistory:    tableRef.history,
-		IncludeTxMetadata: stmt.hasTxMetadata(),
-		DescOrder:         descOrder,
-		groupBySortExps:   groupByCols,
-		orderBySortExps:   orderByCols,
+		Index:              sortingIndex,
+		rangesByColID:      rangesByColID,
+		IncludeHistory:     tableRef.history,
+		IncludeTxMetadata:  stmt.referencesCol(txMetadataCol),
+		IncludeTxID:        stmt.referencesCol(txIDCol),
+		IncludeTxTimestamp: stmt.referencesCol(txTimestampCol),
+		IncludeTxUser:      stmt.referencesCol(txUserCol),
+		DescOrder:          descOrder,
+		groupBySortExps:    groupByCols,
+		orderBySortExps:    orderByCols,
 	}, nil
 }
 
@@ -3674,7 +4061,7 @@ func (stmt *SelectStmt) getPreferredIndex(table *Table) (*Index, error) {
 
 	cols := make([]*Column, len(stmt.indexOn))
 	for i, colName := range stmt.indexOn {
-		col, err := table.GetColumnByName(colName)
+		col, err := table.getIndexableColumnByName(colName)
 		if err != nil {
 			return nil, err
 		}
@@ -3922,14 +4309,31 @@ func (stmt *tableRef) Resolve(ctx context.Context, tx *SQLTx, params map[string]
 	}
 
 	if resolver := tx.engine.tableResolveFor(stmt.table); resolver != nil {
-		return resolver.Resolve(ctx, tx, stmt.Alias())
+		rowReader, err := resolver.Resolve(ctx, tx, stmt.Alias())
+		if err != nil {
+			return nil, err
+		}
+		return &resolvedRowReader{RowReader: rowReader, params: params}, nil
 	}
 	return nil, err
 }
 
+// resolvedRowReader makes the statement parameters available to the readers
+// built on top of the rows provided by a table resolver
+type resolvedRowReader struct {
+	RowReader
+
+	params map[string]interface{}
+}
+
+func (r *resolvedRowReader) Parameters() map[string]interface{} {
+	return r.params
+}
+
 func (stmt *tableRef) Alias() string {
 	if stmt.as == "" {
-		return stmt.table
+		_, table := splitTableName(stmt.table)
+		return table
 	}
 	return stmt.as
 }
@@ -4091,6 +4495,12 @@ func (sel *ColSelector) requiresType(t SQLValueType, cols map[string]ColDescript
 }
 
 func (sel *ColSelector) substitute(params map[string]interface{}) (ValueExp, error) {
+	// references to the row changed by the statement which fired a trigger
+	if sel.table == newRowRef || sel.table == oldRowRef {
+		if v, ok := params[rowRefParam(sel.table, sel.col)].(TypedValue); ok {
+			return v, nil
+		}
+	}
 	return sel, nil
 }
 
@@ -4168,6 +4578,14 @@ func (sel *AggColSelector) inferType(cols map[string]ColDescriptor, params map[s
 
 	colSelector := &ColSelector{table: sel.table, col: sel.col}
 
+	if sel.aggFn == JSONAGG {
+		_, err := colSelector.inferType(cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+		return JSONType, nil
+	}
+
 	if sel.aggFn == SUM || sel.aggFn == AVG {
 		t, err := colSelector.inferType(cols, params, implicitTable)
 		if err != nil {
@@ -4195,6 +4613,14 @@ func (sel *AggColSelector) requiresType(t SQLValueType, cols map[string]ColDescr
 
 	colSelector := &ColSelector{table: sel.table, col: sel.col}
 
+	if sel.aggFn == JSONAGG {
+		if t != JSONType {
+			return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, JSONType, t)
+		}
+		_, err := colSelector.inferType(cols, params, implicitTable)
+		return err
+	}
+
 	if sel.aggFn == SUM || sel.aggFn == AVG {
 		if t != IntegerType && t != Float64Type {
 			return fmt.Errorf("%w: %v or %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, Float64Type, t)
@@ -4341,10 +4767,7 @@ func (bexp *NumExp) substitute(params map[string]interface{}) (ValueExp, error)
 		return nil, err
 	}
 
-	bexp.left = rlexp
-	bexp.right = rrexp
-
-	return bexp, nil
+	return &NumExp{op: bexp.op, left: rlexp, right: rrexp}, nil
 }
 
 func (bexp *NumExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -4424,9 +4847,7 @@ func (bexp *NotBoolExp) substitute(params map[string]interface{}) (ValueExp, err
 		return nil, err
 	}
 
-	bexp.exp = rexp
-
-	return bexp, nil
+	return &NotBoolExp{exp: rexp}, nil
 }
 
 func (bexp *NotBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -4689,10 +5110,7 @@ func (bexp *CmpBoolExp) substitute(params map[string]interface{}) (ValueExp, err
 		return nil, err
 	}
 
-	bexp.left = rlexp
-	bexp.right = rrexp
-
-	return bexp, nil
+	return &CmpBoolExp{op: bexp.op, left: rlexp, right: rrexp}, nil
 }
 
 func (bexp *CmpBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -4731,9 +5149,13 @@ func (bexp *CmpBoolExp) isConstant() bool {
 }
 
 func (bexp *CmpBoolExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	if jsel, ok := bexp.left.(*JSONSelector); ok && bexp.right.isConstant() {
+		return jsel.pathRanges(table, asTable, params, bexp.op, bexp.right, rangesByColID)
+	}
+
 	matchingFunc := func(_, right ValueExp) (*ColSelector, ValueExp, bool) {
 		s, isSel := bexp.left.(*ColSelector)
-		if isSel && s.col != revCol && bexp.right.isConstant() {
+		if isSel && !isReservedCol(s.col) && bexp.right.isConstant() {
 			return s, right, true
 		}
 		return nil, nil, false
@@ -4923,10 +5345,7 @@ func (bexp *BinBoolExp) substitute(params map[string]interface{}) (ValueExp, err
 		return nil, err
 	}
 
-	bexp.left = rlexp
-	bexp.right = rrexp
-
-	return bexp, nil
+	return &BinBoolExp{op: bexp.op, left: rlexp, right: rrexp}, nil
 }
 
 func (bexp *BinBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -5288,6 +5707,8 @@ func (stmt *FnDataSourceStmt) Alias() string {
 		}
 	case GrantsFnCall:
 		return "grants"
+	case JSONBArrayElementsFnCall:
+		return "jsonb_array_elements"
 	}
 
 	// not reachable
@@ -5328,6 +5749,10 @@ func (stmt *FnDataSourceStmt) Resolve(ctx context.Context, tx *SQLTx, params map
 		{
 			return stmt.resolveListGrants(ctx, tx, params, scanSpecs)
 		}
+	case JSONBArrayElementsFnCall:
+		{
+			return stmt.resolveJSONBArrayElements(ctx, tx, params, scanSpecs)
+		}
 	}
 
 	return nil, fmt.Errorf("%w (%s)", ErrFunctionDoesNotExist, stmt.fnCall.fn)
@@ -5439,7 +5864,7 @@ func (stmt *FnDataSourceStmt) resolveShowTable(ctx context.Context, tx *SQLTx, p
 
 		var unique bool
 		for _, index := range table.GetIndexesByColID(c.ID()) {
-			if index.IsUnique() && len(index.Cols()) == 1 {
+			if index.IsUnique() && len(index.Cols()) == 1 && index.Cols()[0].ID() == c.ID() {
 				unique = true
 				break
 			}
@@ -5570,14 +5995,14 @@ func (stmt *FnDataSourceStmt) resolveListColumns(ctx context.Context, tx *SQLTx,
 
 		var unique bool
 		for _, index := range table.indexesByColID[c.id] {
-			if index.IsUnique() && len(index.Cols()) == 1 {
+			if index.IsUnique() && len(index.cols) == 1 && index.cols[0].id == c.id {
 				unique = true
 				break
 			}
 		}
 
 		values[i] = []ValueExp{
-			&Varchar{val: table.name},
+			&Varchar{val: table.Name()},
 			&Varchar{val: c.colName},
 			&Varchar{val: c.colType},
 			&Integer{val: int64(c.MaxLen())},
@@ -5639,7 +6064,7 @@ func (stmt *FnDataSourceStmt) resolveListIndexes(ctx context.Context, tx *SQLTx,
 
 	for i, index := range table.indexes {
 		values[i] = []ValueExp{
-			&Varchar{val: table.name},
+			&Varchar{val: table.Name()},
 			&Varchar{val: index.Name()},
 			&Bool{val: index.unique},
 			&Bool{val: index.IsPrimary()},
@@ -5649,6 +6074,70 @@ func (stmt *FnDataSourceStmt) resolveListIndexes(ctx context.Context, tx *SQLTx,
 	return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), values)
 }
 
+// reduceSelectors returns a copy of the statement whose arguments are reduced using the values of
+// the given row, allowing functions such as jsonb_array_elements to be applied on previous tables of a join
+func (stmt *FnDataSourceStmt) reduceSelectors(row *Row, implicitTable string) *FnDataSourceStmt {
+	params := make([]ValueExp, len(stmt.fnCall.params))
+	for i, p := range stmt.fnCall.params {
+		params[i] = p.reduceSelectors(row, implicitTable)
+	}
+
+	return &FnDataSourceStmt{
+		fnCall: &FnCall{fn: stmt.fnCall.fn, params: params},
+		as:     stmt.as,
+	}
+}
+
+func (stmt *FnDataSourceStmt) resolveJSONBArrayElements(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
+	if len(stmt.fnCall.params) != 1 {
+		return nil, fmt.Errorf("%w: function '%s' expects one argument of type %s", ErrIllegalArguments, JSONBArrayElementsFnCall, JSONType)
+	}
+
+	cols := []ColDescriptor{
+		{
+			Column: "value",
+			Type:   JSONType,
+		},
+	}
+
+	arg := stmt.fnCall.params[0]
+	if !arg.isConstant() {
+		// the argument refers to a column whose value is not yet known e.g. when resolving the columns of a join
+		return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), nil)
+	}
+
+	val, err := arg.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := val.reduce(tx, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if v.IsNull() {
+		return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), nil)
+	}
+
+	jsonVal, err := jsonArgument(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: function '%s' expects one argument of type %s", ErrIllegalArguments, JSONBArrayElementsFnCall, JSONType)
+	}
+
+	elems, isArray := jsonVal.val.([]interface{})
+	if !isArray {
+		return nil, fmt.Errorf("%w: cannot extract elements from a non-array JSON value", ErrIllegalArguments)
+	}
+
+	values := make([][]ValueExp, len(elems))
+	for i, e := range elems {
+		values[i] = []ValueExp{NewJson(e)}
+	}
+
+	return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), values)
+}
+
 func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
 	if len(stmt.fnCall.params) > 1 {
 		return nil, fmt.Errorf("%w: function '%s' expect at most one parameter of type %s", ErrIllegalArguments, GrantsFnCall, VarcharType)
@@ -5681,6 +6170,18 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 			Column: "privilege",
 			Type:   VarcharType,
 		},
+		{
+			Column: "schema_name",
+			Type:   VarcharType,
+		},
+		{
+			Column: "table_name",
+			Type:   VarcharType,
+		},
+		{
+			Column: "columns",
+			Type:   VarcharType,
+		},
 	}
 
 	var err error
@@ -5703,6 +6204,42 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 				values = append(values, []ValueExp{
 					&Varchar{val: user.Username()},
 					&Varchar{val: string(p)},
+					&NullValue{t: VarcharType},
+					&NullValue{t: VarcharType},
+					&NullValue{t: VarcharType},
+				})
+			}
+
+			for _, p := range user.TablePrivileges() {
+				var schema ValueExp = &NullValue{t: VarcharType}
+				var table ValueExp = &NullValue{t: VarcharType}
+				var cols ValueExp = &NullValue{t: VarcharType}
+
+				if p.Schema != "" {
+					schema = &Varchar{val: p.Schema}
+				}
+				if p.Table != "" {
+					tableName := p.Table
+
+					// the table may have been renamed since the privilege was granted
+					if t, err := tx.catalog.tableByPrivilegeID(p.TableID); err == nil {
+						tableName = t.Name()
+					}
+
+					table = &Varchar{val: tableName}
+				}
+				if len(p.Columns) > 0 {
+					cols = &Varchar{val: strings.Join(p.Columns, ",")}
+				} else if len(p.ExcludedColumns) > 0 {
+					cols = &Varchar{val: "EXCEPT " + strings.Join(p.ExcludedColumns, ",")}
+				}
+
+				values = append(values, []ValueExp{
+					&Varchar{val: user.Username()},
+					&Varchar{val: string(p.Privilege)},
+					schema,
+					table,
+					cols,
 				})
 			}
 		}
@@ -5749,6 +6286,208 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, err
 	}
 
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
+	err = dropTable(ctx, tx, table, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// TruncateTableStmt represents a statement deleting all the rows of a table
+type TruncateTableStmt struct {
+	table string
+}
+
+func (stmt *TruncateTableStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *TruncateTableStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDelete}
+}
+
+func (stmt *TruncateTableStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+/*
+Exec executes the truncate table statement.
+Instead of deleting its rows one by one, the table is dropped and created again
+with the same definition under a new id, so the table is emptied by updating the catalog.
+The ids used before are recorded in the catalog and their primary index is kept, so the
+truncated rows are still included by HISTORY OF and CHANGES OF.
+Incrementally refreshed materialized views over the table are emptied as well.
+*/
+func (stmt *TruncateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
+	// incremental views are refreshed with the changes made to the table,
+	// which would not include the deletion of the truncated rows
+	var views []*Table
+
+	for _, t := range tx.catalog.tables {
+		if t.view == nil || !t.view.incremental {
+			continue
+		}
+
+		iv, err := newIncrementalView(tx, t.view.selectStmt)
+		if err == nil && iv.source.id == table.id {
+			views = append(views, t)
+		}
+	}
+
+	_, err = truncateTable(ctx, tx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, view := range views {
+		truncatedView, err := truncateTable(ctx, tx, view)
+		if err != nil {
+			return nil, err
+		}
+
+		truncatedView.view.refreshedAt = tx.engine.store.LastCommittedTxID()
+
+		err = persistView(tx, truncatedView)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+// truncateTable replaces the table with an empty one having the same definition,
+// returning the new table
+func truncateTable(ctx context.Context, tx *SQLTx, table *Table) (*Table, error) {
+	colsSpec := make(map[uint32]*ColSpec, len(table.cols))
+	for _, col := range table.cols {
+		colsSpec[col.id] = &ColSpec{
+			colName:       col.colName,
+			colType:       col.colType,
+			maxLen:        col.maxLen,
+			autoIncrement: col.autoIncrement,
+			notNull:       col.notNull,
+		}
+	}
+
+	err := dropTable(ctx, tx, table, true)
+	if err != nil {
+		return nil, err
+	}
+
+	newTable, err := tx.catalog.newTable(table.Name(), colsSpec, table.checkConstraints, table.maxColID)
+	if err != nil {
+		return nil, err
+	}
+
+	newTable.truncatedIDs = make([]uint32, len(table.truncatedIDs), len(table.truncatedIDs)+1)
+	copy(newTable.truncatedIDs, table.truncatedIDs)
+	newTable.truncatedIDs = append(newTable.truncatedIDs, table.id)
+
+	err = persistTruncatedIDs(tx, newTable)
+	if err != nil {
+		return nil, err
+	}
+
+	err = closeTruncatedIndexOnCommit(tx, table.id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range table.indexes {
+		colIDs := make([]uint32, len(index.cols))
+		for i, col := range index.cols {
+			colIDs[i] = col.id
+
+			if col.source != nil {
+				source, err := newTable.GetColumnByID(col.source.id)
+				if err != nil {
+					return nil, err
+				}
+
+				jcol, err := newTable.jsonPathColumn(source, col.path)
+				if err != nil {
+					return nil, err
+				}
+				colIDs[i] = jcol.id
+			}
+		}
+
+		newIndex, err := newTable.newIndex(index.unique, colIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = persistIndex(tx, newIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, col := range newTable.cols {
+		err := persistColumn(tx, col)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, check := range newTable.checkConstraints {
+		if err := persistCheck(tx, newTable, &check); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, trigger := range table.triggers {
+		newTrigger, err := newTable.newTrigger(trigger.name, trigger.events, trigger.sql, trigger.stmt)
+		if err != nil {
+			return nil, err
+		}
+
+		err = persistTrigger(tx, newTrigger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if table.view != nil {
+		view := *table.view
+		newTable.view = &view
+
+		err = persistView(tx, newTable)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mappedKey := MapKey(tx.sqlPrefix(), catalogTablePrefix, EncodeID(DatabaseID), EncodeID(newTable.id))
+
+	err = tx.set(mappedKey, nil, []byte(newTable.Name()))
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return newTable, nil
+}
+
+// dropTable deletes the table from the catalog. When the table is being truncated,
+// its primary index is kept to preserve the history of its rows
+func dropTable(ctx context.Context, tx *SQLTx, table *Table, truncated bool) error {
 	// delete table
 	mappedKey := MapKey(
 		tx.sqlPrefix(),
@@ -5756,9 +6495,9 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		EncodeID(DatabaseID),
 		EncodeID(table.id),
 	)
-	err = tx.delete(ctx, mappedKey)
+	err := tx.delete(ctx, mappedKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// delete columns
@@ -5774,22 +6513,49 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		)
 		err = tx.delete(ctx, mappedKey)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// delete checks
-	for name := range table.checkConstraints {
+	for _, check := range table.checkConstraints {
+		if err := persistCheckDeletion(ctx, tx, table.id, check.id); err != nil {
+			return err
+		}
+	}
+
+	// delete statistics
+	if table.stats != nil {
 		key := MapKey(
 			tx.sqlPrefix(),
-			catalogCheckPrefix,
+			catalogStatsPrefix,
 			EncodeID(DatabaseID),
 			EncodeID(table.id),
-			[]byte(name),
 		)
 
 		if err := tx.delete(ctx, key); err != nil {
-			return nil, err
+			return err
+		}
+	}
+
+	// delete the definition of the view
+	if table.view != nil {
+		key := MapKey(
+			tx.sqlPrefix(),
+			catalogViewPrefix,
+			EncodeID(DatabaseID),
+			EncodeID(table.id),
+		)
+
+		if err := tx.delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	// delete triggers
+	for _, trigger := range table.triggers {
+		if err := tx.delete(ctx, triggerKey(tx.sqlPrefix(), trigger)); err != nil {
+			return err
 		}
 	}
 
@@ -5804,31 +6570,57 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		)
 		err = tx.delete(ctx, mappedKey)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		indexKey := MapKey(
-			tx.sqlPrefix(),
-			MappedPrefix,
-			EncodeID(table.id),
-			EncodeID(index.id),
-		)
-		err = tx.addOnCommittedCallback(func(sqlTx *SQLTx) error {
-			return sqlTx.engine.store.DeleteIndex(indexKey)
-		})
+		if truncated && index.IsPrimary() {
+			continue
+		}
+
+		err = deleteIndexOnCommit(tx, table.id, index.id)
 		if err != nil {
-			return nil, err
+			return err
+		}
+	}
+
+	// the ids used before previous truncations are carried over by the truncated table
+	if len(table.truncatedIDs) > 0 {
+		err = tx.delete(ctx, truncationKey(tx.sqlPrefix(), table.id))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !truncated {
+		for _, id := range table.truncatedIDs {
+			err = deleteTruncatedIndexOnCommit(tx, table, id)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	err = tx.catalog.deleteTable(table)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	tx.mutatedCatalog = true
 
-	return tx, nil
+	return nil
+}
+
+func deleteIndexOnCommit(tx *SQLTx, tableID, indexID uint32) error {
+	indexKey := MapKey(
+		tx.sqlPrefix(),
+		MappedPrefix,
+		EncodeID(tableID),
+		EncodeID(indexID),
+	)
+
+	return tx.addOnCommittedCallback(func(sqlTx *SQLTx) error {
+		return sqlTx.engine.store.DeleteIndex(indexKey)
+	})
 }
 
 // DropIndexStmt represents a statement to delete a table.
@@ -5871,7 +6663,7 @@ func (stmt *DropIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 	cols := make([]*Column, len(stmt.cols))
 
 	for i, colName := range stmt.cols {
-		col, err := table.GetColumnByName(colName)
+		col, err := table.getIndexableColumnByName(colName)
 		if err != nil {
 			return nil, err
 		}
@@ -5921,16 +6713,80 @@ func (stmt *DropIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 	return tx, nil
 }
 
+// AnalyzeStmt represents a statement to collect statistics about the rows of a table,
+// or of all the tables when no table is specified.
+type AnalyzeStmt struct {
+	table string
+}
+
+func NewAnalyzeStmt(table string) *AnalyzeStmt {
+	return &AnalyzeStmt{table: table}
+}
+
+func (stmt *AnalyzeStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *AnalyzeStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeAlter}
+}
+
+func (stmt *AnalyzeStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *AnalyzeStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	tables := tx.catalog.GetTables()
+
+	if stmt.table != "" {
+		table, err := tx.catalog.GetTableByName(stmt.table)
+		if err != nil {
+			return nil, err
+		}
+		tables = []*Table{table}
+	}
+
+	for _, table := range tables {
+		stats, err := analyzeTable(ctx, tx, table)
+		if err != nil {
+			return nil, err
+		}
+
+		encStats, err := stats.encode(table)
+		if err != nil {
+			return nil, err
+		}
+
+		key := MapKey(
+			tx.sqlPrefix(),
+			catalogStatsPrefix,
+			EncodeID(DatabaseID),
+			EncodeID(table.id),
+		)
+
+		if err := tx.set(key, nil, encStats); err != nil {
+			return nil, err
+		}
+
+		table.stats = stats
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
 type SQLPrivilege string
 
 const (
-	SQLPrivilegeSelect SQLPrivilege = "SELECT"
-	SQLPrivilegeCreate SQLPrivilege = "CREATE"
-	SQLPrivilegeInsert SQLPrivilege = "INSERT"
-	SQLPrivilegeUpdate SQLPrivilege = "UPDATE"
-	SQLPrivilegeDelete SQLPrivilege = "DELETE"
-	SQLPrivilegeDrop   SQLPrivilege = "DROP"
-	SQLPrivilegeAlter  SQLPrivilege = "ALTER"
+	SQLPrivilegeSelect  SQLPrivilege = "SELECT"
+	SQLPrivilegeCreate  SQLPrivilege = "CREATE"
+	SQLPrivilegeInsert  SQLPrivilege = "INSERT"
+	SQLPrivilegeUpdate  SQLPrivilege = "UPDATE"
+	SQLPrivilegeDelete  SQLPrivilege = "DELETE"
+	SQLPrivilegeDrop    SQLPrivilege = "DROP"
+	SQLPrivilegeAlter   SQLPrivilege = "ALTER"
+	SQLPrivilegeExecute SQLPrivilege = "EXECUTE"
 )
 
 var allPrivileges = []SQLPrivilege{
@@ -5941,6 +6797,39 @@ var allPrivileges = []SQLPrivilege{
 	SQLPrivilegeDelete,
 	SQLPrivilegeDrop,
 	SQLPrivilegeAlter,
+	SQLPrivilegeExecute,
+}
+
+// tablePrivileges are the privileges which can be granted over a single table
+var tablePrivileges = []SQLPrivilege{
+	SQLPrivilegeSelect,
+	SQLPrivilegeInsert,
+	SQLPrivilegeUpdate,
+	SQLPrivilegeDelete,
+}
+
+// IsTablePrivilege returns true if the privilege can be granted over a single table
+func IsTablePrivilege(p SQLPrivilege) bool {
+	for _, tp := range tablePrivileges {
+		if tp == p {
+			return true
+		}
+	}
+	return false
+}
+
+// TablePrivilege is a privilege granted over a single table, identified by TableID so that
+// it follows the table when renamed or truncated and it's not inherited by a new table with the same name.
+// When Columns is not empty, the privilege only applies to the specified columns,
+// otherwise it applies to all the columns but those in ExcludedColumns.
+// When Schema is set instead of Table, the privilege applies to all the tables of the schema.
+type TablePrivilege struct {
+	Schema          string
+	Table           string
+	TableID         uint32
+	Privilege       SQLPrivilege
+	Columns         []string
+	ExcludedColumns []string
 }
 
 func DefaultSQLPrivilegesForPermission(p Permission) []SQLPrivilege {
@@ -5948,7 +6837,7 @@ func DefaultSQLPrivilegesForPermission(p Permission) []SQLPrivilege {
 	case PermissionSysAdmin, PermissionAdmin, PermissionReadWrite:
 		return allPrivileges
 	case PermissionReadOnly:
-		return []SQLPrivilege{SQLPrivilegeSelect}
+		return []SQLPrivilege{SQLPrivilegeSelect, SQLPrivilegeExecute}
 	}
 	return nil
 }
@@ -5989,3 +6878,126 @@ func (stmt *AlterPrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params m
 func (stmt *AlterPrivilegesStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	return nil
 }
+
+// AlterTablePrivilegesStmt grants or revokes privileges over a single table of the
+// current database. SELECT privileges may be restricted to a subset of the table columns.
+type AlterTablePrivilegesStmt struct {
+	table      string
+	user       string
+	privileges []SQLPrivilege
+	columns    []string
+	isGrant    bool
+}
+
+func (stmt *AlterTablePrivilegesStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *AlterTablePrivilegesStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *AlterTablePrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: user privileges modification can not be done within a transaction", ErrNonTransactionalStmt)
+	}
+
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, colName := range stmt.columns {
+		_, err := table.GetColumnByName(colName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	privileges := stmt.privileges
+
+	if hasAllPrivileges(privileges, allPrivileges) {
+		privileges = tablePrivileges
+	}
+
+	for _, p := range privileges {
+		if !IsTablePrivilege(p) {
+			return nil, fmt.Errorf("%w: %s privilege can not be granted on a table", ErrIllegalArguments, p)
+		}
+	}
+
+	if stmt.isGrant {
+		err = tx.engine.multidbHandler.GrantTablePrivileges(ctx, table, stmt.user, privileges, stmt.columns)
+	} else {
+		err = tx.engine.multidbHandler.RevokeTablePrivileges(ctx, table, stmt.user, privileges, stmt.columns)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (stmt *AlterTablePrivilegesStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+// AlterSchemaPrivilegesStmt grants or revokes privileges over all the tables of a schema of the current database.
+type AlterSchemaPrivilegesStmt struct {
+	schema     string
+	user       string
+	privileges []SQLPrivilege
+	isGrant    bool
+}
+
+func (stmt *AlterSchemaPrivilegesStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *AlterSchemaPrivilegesStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *AlterSchemaPrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: user privileges modification can not be done within a transaction", ErrNonTransactionalStmt)
+	}
+
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	schema, err := tx.catalog.GetSchemaByName(stmt.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := stmt.privileges
+
+	if hasAllPrivileges(privileges, allPrivileges) {
+		privileges = tablePrivileges
+	}
+
+	for _, p := range privileges {
+		if !IsTablePrivilege(p) {
+			return nil, fmt.Errorf("%w: %s privilege can not be granted on a schema", ErrIllegalArguments, p)
+		}
+	}
+
+	if stmt.isGrant {
+		err = tx.engine.multidbHandler.GrantSchemaPrivileges(ctx, schema.name, stmt.user, privileges)
+	} else {
+		err = tx.engine.multidbHandler.RevokeSchemaPrivileges(ctx, schema.name, stmt.user, privileges)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (stmt *AlterSchemaPrivilegesStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
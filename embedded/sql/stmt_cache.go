@@ -0,0 +1,234 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/cache"
+)
+
+// statements whose SQL text is longer than this are not cached,
+// as they are unlikely to be repeated (e.g. bulk inserts of literal values)
+const maxCachedSQLLen = 16 << 10
+
+type stmtCacheKey struct {
+	sql        string
+	paramTypes string
+}
+
+// stmtCache holds parsed statements by SQL text and parameter types.
+// Cached statements are shared between concurrent executions, so they must never be mutated.
+//
+// Only parsing and the description of statements are cached. Statements are planned on every
+// execution (tables and columns are resolved, an index is selected and row readers are built),
+// as plans depend on the catalog and the snapshot of the transaction, on the values of the
+// parameters used to select an index and on the statistics of the tables.
+type stmtCache struct {
+	cache *cache.Cache
+}
+
+type cachedStmts struct {
+	stmts []SQLStmt
+
+	mutex sync.Mutex
+
+	// description of parameters and results, valid as long as
+	// the catalog and the search path don't change
+	described      bool
+	catalogVersion uint64
+	searchPath     string
+	params         map[string]SQLValueType
+	cols           []ColDescriptor
+}
+
+func newStmtCache(size int) (*stmtCache, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	c, err := cache.NewCache(size)
+	if err != nil {
+		return nil, err
+	}
+
+	c.SetOnEvict(func(_, _ interface{}) {
+		metricsStmtCacheEvicted.Inc()
+	})
+
+	return &stmtCache{cache: c}, nil
+}
+
+func (c *stmtCache) get(key stmtCacheKey) *cachedStmts {
+	if c == nil {
+		return nil
+	}
+
+	v, err := c.cache.Get(key)
+	if err != nil {
+		metricsStmtCacheMiss.Inc()
+		return nil
+	}
+
+	metricsStmtCacheHit.Inc()
+
+	return v.(*cachedStmts)
+}
+
+func (c *stmtCache) put(key stmtCacheKey, entry *cachedStmts) {
+	if c == nil || len(key.sql) > maxCachedSQLLen {
+		return
+	}
+
+	c.cache.Put(key, entry)
+}
+
+// paramTypesKey encodes the names and types of the given parameters
+func paramTypesKey(params map[string]interface{}) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sb, "%s:%T;", name, params[name])
+	}
+	return sb.String()
+}
+
+func (e *Engine) prepare(sql string, params map[string]interface{}) (*cachedStmts, error) {
+	key := stmtCacheKey{
+		sql:        sql,
+		paramTypes: paramTypesKey(params),
+	}
+
+	if entry := e.stmtCache.get(key); entry != nil {
+		return entry, nil
+	}
+
+	stmts, err := ParseSQL(strings.NewReader(sql))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrParsingError, err)
+	}
+
+	entry := &cachedStmts{stmts: stmts}
+
+	e.stmtCache.put(key, entry)
+
+	return entry, nil
+}
+
+// PrepareSQL parses the given SQL text, reusing the statements
+// in the statement cache if it was already parsed with parameters of the same types.
+// Returned statements may be shared and executed concurrently.
+func (e *Engine) PrepareSQL(sql string, params map[string]interface{}) ([]SQLStmt, error) {
+	entry, err := e.prepare(sql, params)
+	if err != nil {
+		return nil, err
+	}
+	return entry.stmts, nil
+}
+
+// DescribeSQL returns the types of the parameters of the given SQL text and, when it consists
+// of a single query, the columns it returns. Descriptions are cached until the catalog changes.
+func (e *Engine) DescribeSQL(ctx context.Context, tx *SQLTx, sql string) (stmts []SQLStmt, params map[string]SQLValueType, cols []ColDescriptor, err error) {
+	entry, err := e.prepare(sql, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	qtx := tx
+
+	if qtx == nil {
+		qtx, err = e.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		defer qtx.Cancel()
+	}
+
+	if e.multidbHandler != nil {
+		for _, stmt := range entry.stmts {
+			err := e.checkUserPermissions(ctx, qtx, stmt)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+
+	searchPath := strings.Join(qtx.catalog.searchPath, ",")
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	if entry.described &&
+		!qtx.mutatedCatalog &&
+		entry.catalogVersion == qtx.catalog.version &&
+		entry.searchPath == searchPath {
+		return entry.stmts, copyParamTypes(entry.params), append([]ColDescriptor(nil), entry.cols...), nil
+	}
+
+	params, err = e.InferParametersPreparedStmts(ctx, qtx, entry.stmts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if ds, ok := entry.stmts[0].(DataSource); ok && len(entry.stmts) == 1 {
+		cols, err = e.describeQuery(ctx, qtx, ds)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if !qtx.mutatedCatalog {
+		entry.described = true
+		entry.catalogVersion = qtx.catalog.version
+		entry.searchPath = searchPath
+		entry.params = copyParamTypes(params)
+		entry.cols = append([]ColDescriptor(nil), cols...)
+	}
+
+	return entry.stmts, params, cols, nil
+}
+
+func (e *Engine) describeQuery(ctx context.Context, tx *SQLTx, ds DataSource) ([]ColDescriptor, error) {
+	r, err := e.QueryPreparedStmt(ctx, tx, ds, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return r.Columns(ctx)
+}
+
+func copyParamTypes(params map[string]SQLValueType) map[string]SQLValueType {
+	cp := make(map[string]SQLValueType, len(params))
+	for name, t := range params {
+		cp[name] = t
+	}
+	return cp
+}
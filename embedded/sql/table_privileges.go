@@ -0,0 +1,338 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import "fmt"
+
+// tableAccess is a privilege required by a statement over a table.
+// cols holds the columns read by a SELECT, a nil value meaning all of them.
+type tableAccess struct {
+	table     *Table
+	privilege SQLPrivilege
+	cols      []string
+}
+
+// tableAccessesOf returns the table accesses required to execute the statement.
+// Tables which are not part of the catalog (e.g. resolved by a TableResolver) are ignored.
+func tableAccessesOf(tx *SQLTx, stmt SQLStmt, accesses []*tableAccess) []*tableAccess {
+	switch stmt := stmt.(type) {
+	case *SelectStmt:
+		return stmt.tableAccesses(tx, accesses)
+	case *UnionStmt:
+		accesses = tableAccessesOf(tx, stmt.left, accesses)
+		return tableAccessesOf(tx, stmt.right, accesses)
+	case *UpsertIntoStmt:
+		for _, p := range stmt.privileges() {
+			accesses = appendTableAccess(tx, accesses, stmt.tableRef, p)
+		}
+
+		if stmt.ds != nil {
+			accesses = tableAccessesOf(tx, stmt.ds, accesses)
+		}
+		return accesses
+	case *UpdateStmt:
+		accesses = appendTableAccess(tx, accesses, stmt.tableRef, SQLPrivilegeUpdate)
+
+		exps := []ValueExp{stmt.where}
+		for _, update := range stmt.updates {
+			exps = append(exps, update.val)
+		}
+		return appendReadColumnsAccess(tx, accesses, stmt.tableRef, exps)
+	case *DeleteFromStmt:
+		accesses = appendTableAccess(tx, accesses, stmt.tableRef, SQLPrivilegeDelete)
+
+		exps := []ValueExp{stmt.where}
+		for _, ord := range stmt.orderBy {
+			exps = append(exps, ord.exp)
+		}
+		return appendReadColumnsAccess(tx, accesses, stmt.tableRef, exps)
+	case *DeclareCursorStmt:
+		return tableAccessesOf(tx, stmt.query, accesses)
+	case *TruncateTableStmt:
+		return appendTableAccess(tx, accesses, &tableRef{table: stmt.table}, SQLPrivilegeDelete)
+	case *CreateTableAsSelectStmt:
+		return tableAccessesOf(tx, stmt.selectStmt, accesses)
+	case *CreateRoutineStmt:
+		return routineStmtsAccesses(tx, stmt.bodyStmts(), accesses)
+	case *SetVarStmt:
+		if stmt.query != nil {
+			return tableAccessesOf(tx, stmt.query, accesses)
+		}
+		return accesses
+	case *ReturnStmt:
+		if stmt.query != nil {
+			return tableAccessesOf(tx, stmt.query, accesses)
+		}
+		return accesses
+	case *CreateTriggerStmt:
+		accesses = appendTableAccess(tx, accesses, &tableRef{table: stmt.table}, SQLPrivilegeSelect)
+
+		if stmt.stmt != nil {
+			accesses = tableAccessesOf(tx, stmt.stmt, accesses)
+		}
+		return accesses
+	case *CreateMaterializedViewStmt:
+		return tableAccessesOf(tx, stmt.selectStmt, accesses)
+	case *RefreshMaterializedViewStmt:
+		table, err := tx.catalog.GetTableByName(stmt.view)
+		if err != nil || table.view == nil {
+			return accesses
+		}
+		return tableAccessesOf(tx, table.view.selectStmt, accesses)
+	case *changesDataSource:
+		table, err := stmt.referencedTable(tx)
+		if err != nil {
+			return accesses
+		}
+		return append(accesses, &tableAccess{table: table, privilege: SQLPrivilegeSelect})
+	}
+	return accesses
+}
+
+// routineStmtsAccesses appends the table accesses of the statements of a routine body, including the nested ones
+func routineStmtsAccesses(tx *SQLTx, stmts []SQLStmt, accesses []*tableAccess) []*tableAccess {
+	forEachRoutineStmt(stmts, func(stmt SQLStmt) error {
+		accesses = tableAccessesOf(tx, stmt, accesses)
+		return nil
+	})
+	return accesses
+}
+
+func appendTableAccess(tx *SQLTx, accesses []*tableAccess, ref *tableRef, privilege SQLPrivilege) []*tableAccess {
+	table, err := ref.referencedTable(tx)
+	if err != nil {
+		return accesses
+	}
+	return append(accesses, &tableAccess{table: table, privilege: privilege})
+}
+
+// appendReadColumnsAccess appends the SELECT privilege over the columns of the table read by the expressions
+// of an UPDATE or DELETE statement, as the number of affected rows reveals their values
+func appendReadColumnsAccess(tx *SQLTx, accesses []*tableAccess, ref *tableRef, exps []ValueExp) []*tableAccess {
+	table, err := ref.referencedTable(tx)
+	if err != nil {
+		return accesses
+	}
+
+	var cols []string
+
+	for _, exp := range exps {
+		if exp == nil {
+			continue
+		}
+
+		for _, sel := range exp.selectors() {
+			_, alias, colName := sel.resolve(ref.Alias())
+			if alias != ref.Alias() {
+				continue
+			}
+
+			if _, reserved := reservedColumns[colName]; reserved {
+				continue
+			}
+
+			if _, err := table.GetColumnByName(colName); err != nil {
+				continue
+			}
+
+			if !containsString(cols, colName) {
+				cols = append(cols, colName)
+			}
+		}
+	}
+
+	if len(cols) == 0 {
+		return accesses
+	}
+
+	return append(accesses, &tableAccess{table: table, privilege: SQLPrivilegeSelect, cols: cols})
+}
+
+func (stmt *SelectStmt) tableAccesses(tx *SQLTx, accesses []*tableAccess) []*tableAccess {
+	var aliases []string
+	tables := make(map[string]*Table)
+
+	addDataSource := func(ds DataSource) {
+		ref, isTableRef := ds.(*tableRef)
+		if !isTableRef {
+			accesses = tableAccessesOf(tx, ds, accesses)
+			return
+		}
+
+		table, err := ref.referencedTable(tx)
+		if err != nil {
+			return
+		}
+
+		aliases = append(aliases, ref.Alias())
+		tables[ref.Alias()] = table
+	}
+
+	addDataSource(stmt.ds)
+
+	for _, join := range stmt.joins {
+		addDataSource(join.ds)
+	}
+
+	if len(aliases) == 0 {
+		return accesses
+	}
+
+	if stmt.targets == nil {
+		for _, alias := range aliases {
+			accesses = append(accesses, &tableAccess{table: tables[alias], privilege: SQLPrivilegeSelect})
+		}
+		return accesses
+	}
+
+	colsByAlias := make(map[string][]string, len(aliases))
+	for _, alias := range aliases {
+		colsByAlias[alias] = []string{}
+	}
+
+	for _, sel := range stmt.referencedSelectors() {
+		_, alias, colName := sel.resolve(stmt.ds.Alias())
+
+		table, ok := tables[alias]
+		if !ok || colName == "*" {
+			continue
+		}
+
+		if _, reserved := reservedColumns[colName]; reserved {
+			continue
+		}
+
+		if _, err := table.GetColumnByName(colName); err != nil {
+			// it may be a reference to a target alias
+			continue
+		}
+
+		if !containsString(colsByAlias[alias], colName) {
+			colsByAlias[alias] = append(colsByAlias[alias], colName)
+		}
+	}
+
+	for _, alias := range aliases {
+		accesses = append(accesses, &tableAccess{
+			table:     tables[alias],
+			privilege: SQLPrivilegeSelect,
+			cols:      colsByAlias[alias],
+		})
+	}
+	return accesses
+}
+
+// referencedSelectors returns all the selectors used in the statement,
+// not only those used in the targets.
+func (stmt *SelectStmt) referencedSelectors() []Selector {
+	selectors := stmt.extractSelectors()
+
+	exps := []ValueExp{stmt.where, stmt.having}
+	for _, join := range stmt.joins {
+		exps = append(exps, join.cond)
+	}
+
+	for _, col := range stmt.groupBy {
+		exps = append(exps, col)
+	}
+
+	for _, ord := range stmt.orderBy {
+		exps = append(exps, ord.exp)
+	}
+
+	for _, exp := range exps {
+		if exp != nil {
+			selectors = append(selectors, exp.selectors()...)
+		}
+	}
+	return selectors
+}
+
+// checkTablePrivileges verifies the user holds the privileges required by each table access,
+// either over the whole database or over the accessed table and columns.
+func checkTablePrivileges(user User, accesses []*tableAccess) error {
+	for _, access := range accesses {
+		if hasAllPrivileges(user.SQLPrivileges(), []SQLPrivilege{access.privilege}) {
+			continue
+		}
+
+		err := checkTablePrivilege(user.TablePrivileges(), access)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkTablePrivilege(privileges []TablePrivilege, access *tableAccess) error {
+	// a privilege granted over the schema applies to all of its tables and columns
+	for _, p := range privileges {
+		if p.Table == "" && p.Schema == access.table.Schema() && p.Privilege == access.privilege {
+			return nil
+		}
+	}
+
+	for _, p := range privileges {
+		if p.Table == "" || !access.table.hasID(p.TableID) || p.Privilege != access.privilege {
+			continue
+		}
+
+		if len(p.Columns) == 0 {
+			return checkExcludedColumns(p, access)
+		}
+
+		if access.cols == nil {
+			return fmt.Errorf("%w: statement requires %s privilege on all columns of table %s", ErrAccessDenied, access.privilege, access.table.Name())
+		}
+
+		for _, col := range access.cols {
+			if !containsString(p.Columns, col) {
+				return fmt.Errorf("%w: statement requires %s privilege on column %s.%s", ErrAccessDenied, access.privilege, access.table.Name(), col)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: statement requires %s privilege on table %s", ErrAccessDenied, access.privilege, access.table.Name())
+}
+
+// checkExcludedColumns verifies the access does not involve columns revoked
+// from a privilege granted over the whole table
+func checkExcludedColumns(p TablePrivilege, access *tableAccess) error {
+	if len(p.ExcludedColumns) == 0 {
+		return nil
+	}
+
+	if access.cols == nil {
+		return fmt.Errorf("%w: statement requires %s privilege on all columns of table %s", ErrAccessDenied, access.privilege, access.table.Name())
+	}
+
+	for _, col := range access.cols {
+		if containsString(p.ExcludedColumns, col) {
+			return fmt.Errorf("%w: statement requires %s privilege on column %s.%s", ErrAccessDenied, access.privilege, access.table.Name(), col)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
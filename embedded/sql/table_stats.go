@@ -0,0 +1,508 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+const (
+	// maximum number of rows sampled by ANALYZE to build histograms and estimate distinct values
+	analyzeSampleSize = 30_000
+
+	// maximum number of buckets of each histogram
+	histogramBuckets = 32
+
+	// selectivities assumed when no statistics were collected for a column
+	defaultEqSelectivity    = 0.005
+	defaultRangeSelectivity = 1.0 / 3
+
+	// relative cost of sorting a row compared to reading it
+	sortRowCost = 0.05
+)
+
+// TableStats holds the statistics collected by ANALYZE over the rows of a table
+type TableStats struct {
+	rowCount   int64
+	analyzedAt time.Time
+	cols       map[uint32]*ColumnStats
+}
+
+// ColumnStats holds the statistics collected by ANALYZE over the values of a column
+type ColumnStats struct {
+	nullCount     int64
+	distinctCount int64
+
+	// bounds of equi-depth buckets, only built for columns of ordered types
+	histogram []TypedValue
+}
+
+func (s *TableStats) RowCount() int64 {
+	return s.rowCount
+}
+
+func (s *TableStats) AnalyzedAt() time.Time {
+	return s.analyzedAt
+}
+
+// ColumnStats returns the statistics of the given column, if they were collected
+func (s *TableStats) ColumnStats(colID uint32) (*ColumnStats, bool) {
+	cs, ok := s.cols[colID]
+	return cs, ok
+}
+
+func (s *ColumnStats) NullCount() int64 {
+	return s.nullCount
+}
+
+func (s *ColumnStats) DistinctCount() int64 {
+	return s.distinctCount
+}
+
+func (s *ColumnStats) HistogramBounds() []TypedValue {
+	return s.histogram
+}
+
+func (t *Table) Stats() *TableStats {
+	return t.stats
+}
+
+func histogramSupported(t SQLValueType) bool {
+	switch t {
+	case IntegerType, Float64Type, VarcharType, TimestampType, UUIDType, BooleanType:
+		return true
+	}
+	return false
+}
+
+// analyzeTable scans all the rows of the table, sampling them to build the statistics of each column
+func analyzeTable(ctx context.Context, tx *SQLTx, table *Table) (*TableStats, error) {
+	r, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// the seed is fixed so that statistics of the same rows are reproducible
+	rnd := rand.New(rand.NewSource(1))
+
+	var rowCount int64
+	var sample [][]TypedValue
+
+	for {
+		row, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rowCount++
+
+		// reservoir sampling
+		pos := len(sample)
+		if pos >= analyzeSampleSize {
+			pos = int(rnd.Int63n(rowCount))
+			if pos >= analyzeSampleSize {
+				continue
+			}
+		}
+
+		vals := make([]TypedValue, len(table.cols))
+		for i, col := range table.cols {
+			vals[i] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+		}
+
+		if pos == len(sample) {
+			sample = append(sample, vals)
+		} else {
+			sample[pos] = vals
+		}
+	}
+
+	stats := &TableStats{
+		rowCount:   rowCount,
+		analyzedAt: tx.Timestamp(),
+		cols:       make(map[uint32]*ColumnStats, len(table.cols)),
+	}
+
+	for i, col := range table.cols {
+		cs, err := analyzeColumn(col, sample, i, rowCount)
+		if err != nil {
+			return nil, err
+		}
+		stats.cols[col.id] = cs
+	}
+
+	return stats, nil
+}
+
+func analyzeColumn(col *Column, sample [][]TypedValue, pos int, rowCount int64) (*ColumnStats, error) {
+	var nulls int64
+	var vals []TypedValue
+
+	occurrences := make(map[string]int)
+
+	for _, row := range sample {
+		v := row[pos]
+		if v == nil || v.IsNull() {
+			nulls++
+			continue
+		}
+
+		encVal, err := EncodeValue(v, col.colType, col.MaxLen())
+		if err != nil {
+			return nil, err
+		}
+		occurrences[string(encVal)]++
+
+		vals = append(vals, v)
+	}
+
+	cs := &ColumnStats{}
+
+	if len(sample) == 0 {
+		return cs, nil
+	}
+
+	cs.nullCount = int64(math.Round(float64(nulls) * float64(rowCount) / float64(len(sample))))
+	cs.distinctCount = estimateDistinct(len(vals), occurrences, rowCount-cs.nullCount)
+
+	if histogramSupported(col.colType) && len(vals) > 0 {
+		sort.Slice(vals, func(i, j int) bool {
+			cmp, _ := vals[i].Compare(vals[j])
+			return cmp < 0
+		})
+
+		buckets := histogramBuckets
+		if len(vals) < buckets {
+			buckets = len(vals)
+		}
+
+		cs.histogram = make([]TypedValue, buckets+1)
+		for i := 0; i < buckets; i++ {
+			cs.histogram[i] = vals[i*len(vals)/buckets]
+		}
+		cs.histogram[buckets] = vals[len(vals)-1]
+	}
+
+	return cs, nil
+}
+
+// estimateDistinct estimates the number of distinct values out of the ones found in a sample,
+// using the Duj1 estimator by Haas and Stokes
+func estimateDistinct(sampled int, occurrences map[string]int, total int64) int64 {
+	if sampled == 0 {
+		return 0
+	}
+
+	if int64(sampled) >= total {
+		return int64(len(occurrences))
+	}
+
+	var singletons int
+	for _, n := range occurrences {
+		if n == 1 {
+			singletons++
+		}
+	}
+
+	n := float64(sampled)
+	d := float64(len(occurrences))
+	f1 := float64(singletons)
+
+	estimate := n * d / (n - f1 + f1*n/float64(total))
+	if estimate < d {
+		estimate = d
+	}
+	if estimate > float64(total) {
+		estimate = float64(total)
+	}
+	return int64(math.Round(estimate))
+}
+
+// eqSelectivity estimates the fraction of rows matching a single value of the column
+func (s *ColumnStats) eqSelectivity(rowCount int64) float64 {
+	if s == nil || s.distinctCount == 0 || rowCount == 0 {
+		return defaultEqSelectivity
+	}
+	return float64(rowCount-s.nullCount) / float64(rowCount) / float64(s.distinctCount)
+}
+
+// rangeSelectivity estimates the fraction of rows with values in the given range
+func (s *ColumnStats) rangeSelectivity(r *typedValueRange, rowCount int64) float64 {
+	if s == nil || len(s.histogram) < 2 || rowCount == 0 {
+		return defaultRangeSelectivity
+	}
+
+	lo := 0.0
+	if r.lRange != nil {
+		lo = s.histogramPosition(r.lRange.val)
+	}
+
+	hi := 1.0
+	if r.hRange != nil {
+		hi = s.histogramPosition(r.hRange.val)
+	}
+
+	sel := hi - lo
+	if sel <= 0 {
+		// values may still be found within a single bucket
+		sel = 0.5 / float64(len(s.histogram)-1)
+	}
+
+	return sel * float64(rowCount-s.nullCount) / float64(rowCount)
+}
+
+// histogramPosition returns the estimated fraction of values lower than the given one
+func (s *ColumnStats) histogramPosition(val TypedValue) float64 {
+	buckets := len(s.histogram) - 1
+
+	cmp, err := val.Compare(s.histogram[0])
+	if err != nil {
+		return 0.5
+	}
+	if cmp < 0 {
+		return 0
+	}
+
+	cmp, err = val.Compare(s.histogram[buckets])
+	if err != nil {
+		return 0.5
+	}
+	if cmp > 0 {
+		return 1
+	}
+
+	i := sort.Search(buckets, func(i int) bool {
+		cmp, err := s.histogram[i+1].Compare(val)
+		return err != nil || cmp >= 0
+	})
+
+	// values are assumed to be evenly distributed within the bucket
+	return (float64(i) + 0.5) / float64(buckets)
+}
+
+// estimateRows estimates the number of rows read when scanning the given index within the given ranges
+func (s *TableStats) estimateRows(index *Index, rangesByColID map[uint32]*typedValueRange) float64 {
+	sel := 1.0
+	eqCols := 0
+
+	for _, col := range index.cols {
+		colRange, ok := rangesByColID[col.id]
+		if !ok {
+			break
+		}
+
+		cs := s.cols[col.id]
+
+		if colRange.unitary() {
+			sel *= cs.eqSelectivity(s.rowCount)
+			eqCols++
+			continue
+		}
+
+		sel *= cs.rangeSelectivity(colRange, s.rowCount)
+		break
+	}
+
+	if index.unique && eqCols == len(index.cols) {
+		return math.Min(1, float64(s.rowCount))
+	}
+
+	return sel * float64(s.rowCount)
+}
+
+// selectCheapestIndex picks the index with the lowest estimated cost of reading the rows
+// within the given ranges and, when they are not read in the required order, sorting them.
+// Ties are resolved in favour of the index selected by rules.
+func (s *TableStats) selectCheapestIndex(table *Table, ruleIndex *Index, sortCols []*OrdExp, rangesByColID map[uint32]*typedValueRange) *Index {
+	cost := func(index *Index) float64 {
+		rows := s.estimateRows(index, rangesByColID)
+
+		if len(sortCols) > 0 && !index.coversOrdCols(sortCols, rangesByColID) {
+			rows += sortRowCost * rows * math.Log2(rows+1)
+		}
+		return rows
+	}
+
+	selected := ruleIndex
+	minCost := cost(ruleIndex)
+
+	for _, index := range table.indexes {
+		if c := cost(index); c < minCost {
+			selected = index
+			minCost = c
+		}
+	}
+	return selected
+}
+
+func (s *TableStats) encode(table *Table) ([]byte, error) {
+	var b []byte
+
+	var buf [8]byte
+
+	binary.BigEndian.PutUint64(buf[:], uint64(s.rowCount))
+	b = append(b, buf[:8]...)
+
+	binary.BigEndian.PutUint64(buf[:], uint64(s.analyzedAt.UnixMicro()))
+	b = append(b, buf[:8]...)
+
+	binary.BigEndian.PutUint32(buf[:], uint32(len(s.cols)))
+	b = append(b, buf[:4]...)
+
+	for _, col := range table.cols {
+		cs, ok := s.cols[col.id]
+		if !ok {
+			continue
+		}
+
+		binary.BigEndian.PutUint32(buf[:], col.id)
+		b = append(b, buf[:4]...)
+
+		binary.BigEndian.PutUint64(buf[:], uint64(cs.nullCount))
+		b = append(b, buf[:8]...)
+
+		binary.BigEndian.PutUint64(buf[:], uint64(cs.distinctCount))
+		b = append(b, buf[:8]...)
+
+		binary.BigEndian.PutUint32(buf[:], uint32(len(cs.histogram)))
+		b = append(b, buf[:4]...)
+
+		for _, v := range cs.histogram {
+			encVal, err := EncodeValue(v, col.colType, col.MaxLen())
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, encVal...)
+		}
+	}
+
+	return b, nil
+}
+
+func decodeTableStats(table *Table, b []byte) (*TableStats, error) {
+	if len(b) < 20 {
+		return nil, ErrCorruptedData
+	}
+
+	stats := &TableStats{
+		rowCount:   int64(binary.BigEndian.Uint64(b)),
+		analyzedAt: time.UnixMicro(int64(binary.BigEndian.Uint64(b[8:]))).UTC(),
+		cols:       make(map[uint32]*ColumnStats),
+	}
+
+	ncols := int(binary.BigEndian.Uint32(b[16:]))
+	off := 20
+
+	for i := 0; i < ncols; i++ {
+		if len(b) < off+24 {
+			return nil, ErrCorruptedData
+		}
+
+		colID := binary.BigEndian.Uint32(b[off:])
+		off += 4
+
+		cs := &ColumnStats{
+			nullCount:     int64(binary.BigEndian.Uint64(b[off:])),
+			distinctCount: int64(binary.BigEndian.Uint64(b[off+8:])),
+		}
+		off += 16
+
+		nbounds := int(binary.BigEndian.Uint32(b[off:]))
+		off += 4
+
+		col, colExists := table.colsByID[colID]
+
+		for j := 0; j < nbounds; j++ {
+			vlen, voff, err := DecodeValueLength(b[off:])
+			if err != nil {
+				return nil, err
+			}
+
+			// statistics of dropped columns are skipped
+			if colExists {
+				v, _, err := DecodeValue(b[off:], col.colType)
+				if err != nil {
+					return nil, err
+				}
+				cs.histogram = append(cs.histogram, v)
+			}
+
+			off += voff + vlen
+		}
+
+		if colExists {
+			stats.cols[colID] = cs
+		}
+	}
+
+	if off != len(b) {
+		return nil, ErrCorruptedData
+	}
+
+	return stats, nil
+}
+
+func (catlg *Catalog) loadStats(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogStatsPrefix, EncodeID(DatabaseID))
+
+	return catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		encID, err := trimPrefix(catlg.enginePrefix, key, []byte(catalogStatsPrefix))
+		if err != nil {
+			return err
+		}
+
+		if len(encID) != EncIDLen*2 || binary.BigEndian.Uint32(encID) != DatabaseID {
+			return ErrCorruptedData
+		}
+
+		tableID := binary.BigEndian.Uint32(encID[EncIDLen:])
+
+		// statistics of dropped tables are skipped
+		table, exists := catlg.tablesByID[tableID]
+		if !exists {
+			return nil
+		}
+
+		stats, err := decodeTableStats(table, value)
+		if err != nil {
+			return fmt.Errorf("%w: invalid statistics of table '%s'", err, table.name)
+		}
+		table.stats = stats
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
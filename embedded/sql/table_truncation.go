@@ -0,0 +1,279 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/codenotary/immudb/embedded/multierr"
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// A truncated table is created again under a new id, the ids it used before are kept in the catalog
+// so the history of the truncated rows can still be read. The primary index of each of them is preserved
+// until the table is dropped, but it's only opened while a transaction reads the history of the table,
+// so tables truncated over and over don't add up to the indexes being maintained.
+
+func truncationKey(sqlPrefix []byte, tableID uint32) []byte {
+	return MapKey(sqlPrefix, catalogTruncationPrefix, EncodeID(DatabaseID), EncodeID(tableID))
+}
+
+func persistTruncatedIDs(tx *SQLTx, table *Table) error {
+	// {tableID}*
+	value := make([]byte, 0, EncIDLen*len(table.truncatedIDs))
+	for _, id := range table.truncatedIDs {
+		value = append(value, EncodeID(id)...)
+	}
+
+	return tx.set(truncationKey(tx.sqlPrefix(), table.id), nil, value)
+}
+
+func (catlg *Catalog) loadTruncatedIDs(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogTruncationPrefix, EncodeID(DatabaseID))
+
+	return catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		encID, err := trimPrefix(catlg.enginePrefix, key, []byte(catalogTruncationPrefix))
+		if err != nil {
+			return err
+		}
+
+		if len(encID) != EncIDLen*2 || binary.BigEndian.Uint32(encID) != DatabaseID || len(value)%EncIDLen != 0 {
+			return ErrCorruptedData
+		}
+
+		tableID := binary.BigEndian.Uint32(encID[EncIDLen:])
+
+		table, exists := catlg.tablesByID[tableID]
+		if !exists {
+			return nil
+		}
+
+		table.truncatedIDs = make([]uint32, len(value)/EncIDLen)
+		for i := range table.truncatedIDs {
+			table.truncatedIDs[i] = binary.BigEndian.Uint32(value[i*EncIDLen:])
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
+
+// PrivilegeID returns the id under which privileges over the table are granted.
+// It's the id the table was created with, so granted privileges are kept when the table is truncated.
+func (t *Table) PrivilegeID() uint32 {
+	if len(t.truncatedIDs) > 0 {
+		return t.truncatedIDs[0]
+	}
+	return t.id
+}
+
+// hasID returns true if the table is currently identified by id or it was before one of its truncations
+func (t *Table) hasID(id uint32) bool {
+	if t.id == id {
+		return true
+	}
+
+	for _, truncatedID := range t.truncatedIDs {
+		if truncatedID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// tableByPrivilegeID returns the table privileges granted under id apply to
+func (catlg *Catalog) tableByPrivilegeID(id uint32) (*Table, error) {
+	if table, exists := catlg.tablesByID[id]; exists {
+		return table, nil
+	}
+
+	for _, table := range catlg.tables {
+		if table.hasID(id) {
+			return table, nil
+		}
+	}
+	return nil, ErrTableDoesNotExist
+}
+
+// truncatedTable returns the table as it was identified before one of its truncations,
+// only its primary index is available
+func (t *Table) truncatedTable(id uint32) *Table {
+	table := *t
+	table.id = id
+
+	primaryIndex := *t.primaryIndex
+	primaryIndex.table = &table
+
+	table.primaryIndex = &primaryIndex
+	table.indexes = []*Index{&primaryIndex}
+
+	return &table
+}
+
+// truncationOf returns the entry deleting the table identified by tableID from the catalog when it was truncated,
+// nil is returned if it was truncated after the snapshot of the transaction
+func truncationOf(ctx context.Context, tx *SQLTx, tableID uint32) (store.ValueRef, error) {
+	key := MapKey(tx.sqlPrefix(), catalogTablePrefix, EncodeID(DatabaseID), EncodeID(tableID))
+
+	vref, err := tx.tx.GetWithFilters(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if md := vref.KVMetadata(); md == nil || !md.Deleted() {
+		return nil, nil
+	}
+	return vref, nil
+}
+
+func truncatedIndexPrefix(sqlPrefix []byte, id uint32) []byte {
+	return MapKey(sqlPrefix, MappedPrefix, EncodeID(id), EncodeID(PKIndexID))
+}
+
+func (e *Engine) truncatedIndexSpec(table *Table, id uint32) *store.IndexSpec {
+	primaryIndex := table.truncatedTable(id).primaryIndex
+
+	return &store.IndexSpec{
+		SourcePrefix: MapKey(e.prefix, RowPrefix, EncodeID(DatabaseID), EncodeID(id), EncodeID(PKIndexID)),
+
+		TargetEntryMapper: indexEntryMapperFor(primaryIndex, primaryIndex),
+		TargetPrefix:      truncatedIndexPrefix(e.prefix, id),
+
+		InjectiveMapping: true,
+	}
+}
+
+// acquireTruncatedIndex opens the primary index of the table as it was identified by id,
+// it's kept open until all the transactions reading it are closed
+func (e *Engine) acquireTruncatedIndex(table *Table, id uint32) error {
+	e.truncatedIndexesMux.Lock()
+	defer e.truncatedIndexesMux.Unlock()
+
+	if e.truncatedIndexes[id] == 0 {
+		err := e.store.InitIndexing(e.truncatedIndexSpec(table, id))
+		if err != nil && !errors.Is(err, store.ErrIndexAlreadyInitialized) {
+			return err
+		}
+	}
+
+	e.truncatedIndexes[id]++
+
+	return nil
+}
+
+func (e *Engine) releaseTruncatedIndex(id uint32) error {
+	e.truncatedIndexesMux.Lock()
+	defer e.truncatedIndexesMux.Unlock()
+
+	n, ok := e.truncatedIndexes[id]
+	if !ok {
+		return nil
+	}
+
+	if n > 1 {
+		e.truncatedIndexes[id] = n - 1
+		return nil
+	}
+
+	delete(e.truncatedIndexes, id)
+
+	return e.closeTruncatedIndex(id)
+}
+
+func (e *Engine) closeTruncatedIndex(id uint32) error {
+	err := e.store.CloseIndexing(truncatedIndexPrefix(e.prefix, id))
+	if errors.Is(err, store.ErrIndexNotFound) {
+		return nil
+	}
+	return err
+}
+
+// closeTruncatedIndexOnCommit closes the primary index the table used before being truncated,
+// unless the history of the table is being read
+func closeTruncatedIndexOnCommit(tx *SQLTx, id uint32) error {
+	return tx.addOnCommittedCallback(func(sqlTx *SQLTx) error {
+		e := sqlTx.engine
+
+		e.truncatedIndexesMux.Lock()
+		defer e.truncatedIndexesMux.Unlock()
+
+		if e.truncatedIndexes[id] > 0 {
+			return nil
+		}
+		return e.closeTruncatedIndex(id)
+	})
+}
+
+// deleteTruncatedIndexOnCommit deletes the primary index the table used before being truncated,
+// it has to be opened first as it may not be in use
+func deleteTruncatedIndexOnCommit(tx *SQLTx, table *Table, id uint32) error {
+	return tx.addOnCommittedCallback(func(sqlTx *SQLTx) error {
+		e := sqlTx.engine
+
+		e.truncatedIndexesMux.Lock()
+		defer e.truncatedIndexesMux.Unlock()
+
+		delete(e.truncatedIndexes, id)
+
+		err := e.store.InitIndexing(e.truncatedIndexSpec(table, id))
+		if err != nil && !errors.Is(err, store.ErrIndexAlreadyInitialized) {
+			return err
+		}
+
+		return e.store.DeleteIndex(truncatedIndexPrefix(e.prefix, id))
+	})
+}
+
+// useTruncatedIndex makes the primary index of the table as it was identified by id available
+// until the transaction is closed
+func (sqlTx *SQLTx) useTruncatedIndex(table *Table, id uint32) error {
+	if _, ok := sqlTx.truncatedIndexes[id]; ok {
+		return nil
+	}
+
+	err := sqlTx.engine.acquireTruncatedIndex(table, id)
+	if err != nil {
+		return err
+	}
+
+	if sqlTx.truncatedIndexes == nil {
+		sqlTx.truncatedIndexes = make(map[uint32]struct{})
+	}
+	sqlTx.truncatedIndexes[id] = struct{}{}
+
+	return nil
+}
+
+func (sqlTx *SQLTx) releaseTruncatedIndexes() error {
+	merr := multierr.NewMultiErr()
+
+	for id := range sqlTx.truncatedIndexes {
+		merr.Append(sqlTx.engine.releaseTruncatedIndex(id))
+	}
+
+	sqlTx.truncatedIndexes = nil
+
+	return merr.Reduce()
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sql
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// timeoutRowReader binds the reading of rows to the context of the statement,
+// aborting it once the statement deadline, if any, is reached
+type timeoutRowReader struct {
+	rowReader RowReader
+
+	deadline time.Time
+}
+
+func newTimeoutRowReader(rowReader RowReader, deadline time.Time) *timeoutRowReader {
+	return &timeoutRowReader{
+		rowReader: rowReader,
+		deadline:  deadline,
+	}
+}
+
+func (tr *timeoutRowReader) onClose(callback func()) {
+	tr.rowReader.onClose(callback)
+}
+
+func (tr *timeoutRowReader) Tx() *SQLTx {
+	return tr.rowReader.Tx()
+}
+
+func (tr *timeoutRowReader) TableAlias() string {
+	return tr.rowReader.TableAlias()
+}
+
+func (tr *timeoutRowReader) Parameters() map[string]interface{} {
+	return tr.rowReader.Parameters()
+}
+
+func (tr *timeoutRowReader) OrderBy() []ColDescriptor {
+	return tr.rowReader.OrderBy()
+}
+
+func (tr *timeoutRowReader) ScanSpecs() *ScanSpecs {
+	return tr.rowReader.ScanSpecs()
+}
+
+func (tr *timeoutRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	return tr.rowReader.Columns(ctx)
+}
+
+func (tr *timeoutRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	return tr.rowReader.colsBySelector(ctx)
+}
+
+func (tr *timeoutRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	return tr.rowReader.InferParameters(ctx, params)
+}
+
+func (tr *timeoutRowReader) Read(ctx context.Context) (*Row, error) {
+	stmtCtx := ctx
+
+	if !tr.deadline.IsZero() {
+		var cancel context.CancelFunc
+
+		stmtCtx, cancel = context.WithDeadline(ctx, tr.deadline)
+		defer cancel()
+	}
+
+	// functions called while reading the row are bounded by the statement as well
+	tr.rowReader.Tx().stmtCtx = stmtCtx
+
+	row, err := tr.rowReader.Read(stmtCtx)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return nil, ErrStatementTimeout
+	}
+	return row, err
+}
+
+func (tr *timeoutRowReader) Close() error {
+	return tr.rowReader.Close()
+}
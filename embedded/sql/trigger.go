@@ -0,0 +1,343 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// TriggerEvent is the kind of change to the rows of a table which fires a trigger
+type TriggerEvent int
+
+const (
+	TriggerOnInsert TriggerEvent = 1 << iota
+	TriggerOnUpdate
+	TriggerOnDelete
+)
+
+const (
+	// references to the values of the changed row, available to the statement executed by a trigger
+	newRowRef = "new"
+	oldRowRef = "old"
+)
+
+// Trigger represents a statement executed after each row of a table is changed,
+// within the same transaction which changed the row
+type Trigger struct {
+	table  *Table
+	name   string
+	events TriggerEvent
+	sql    string
+	stmt   SQLStmt
+}
+
+func (t *Trigger) Name() string {
+	return t.name
+}
+
+func (t *Trigger) Table() *Table {
+	return t.table
+}
+
+func (t *Trigger) Events() TriggerEvent {
+	return t.events
+}
+
+// SQL returns the text of the statement executed by the trigger
+func (t *Trigger) SQL() string {
+	return t.sql
+}
+
+// Triggers returns the triggers of the table, sorted by name which is the order they are fired in
+func (t *Table) Triggers() []*Trigger {
+	return t.triggers
+}
+
+func (t *Table) hasTriggers(event TriggerEvent) bool {
+	for _, trigger := range t.triggers {
+		if trigger.events&event != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) newTrigger(name string, events TriggerEvent, sql string, stmt SQLStmt) (*Trigger, error) {
+	for _, trigger := range t.triggers {
+		if trigger.name == name {
+			return nil, fmt.Errorf("%w (%s)", ErrTriggerAlreadyExists, name)
+		}
+	}
+
+	trigger := &Trigger{
+		table:  t,
+		name:   name,
+		events: events,
+		sql:    sql,
+		stmt:   stmt,
+	}
+
+	t.triggers = append(t.triggers, trigger)
+
+	sort.Slice(t.triggers, func(i, j int) bool {
+		return t.triggers[i].name < t.triggers[j].name
+	})
+
+	return trigger, nil
+}
+
+func (t *Table) deleteTrigger(name string) (*Trigger, error) {
+	for i, trigger := range t.triggers {
+		if trigger.name == name {
+			t.triggers = append(t.triggers[:i], t.triggers[i+1:]...)
+			return trigger, nil
+		}
+	}
+	return nil, fmt.Errorf("%w (%s)", ErrTriggerDoesNotExist, name)
+}
+
+func rowRefParam(ref, col string) string {
+	return ref + "." + col
+}
+
+// fireTriggers executes the triggers of the table defined for the event.
+// The values of the row before and after the change can be referenced as OLD.col and NEW.col,
+// which are NULL when the row did not exist before the change, or no longer exists after it.
+func (tx *SQLTx) fireTriggers(ctx context.Context, table *Table, event TriggerEvent, oldValuesByColID, newValuesByColID map[uint32]TypedValue) error {
+	for _, trigger := range table.triggers {
+		if trigger.events&event == 0 {
+			continue
+		}
+
+		if tx.triggerDepth >= tx.engine.maxTriggerDepth {
+			return fmt.Errorf("%w: trigger '%s' on table '%s'", ErrMaxTriggerDepthExceeded, trigger.name, table.name)
+		}
+
+		params := make(map[string]interface{}, 2*len(table.cols))
+
+		for _, col := range table.cols {
+			params[rowRefParam(oldRowRef, col.colName)] = rowRefValue(oldValuesByColID, col)
+			params[rowRefParam(newRowRef, col.colName)] = rowRefValue(newValuesByColID, col)
+		}
+
+		// only the rows changed by the statement itself are reported
+		updatedRows := tx.updatedRows
+
+		tx.triggerDepth++
+		_, err := trigger.stmt.execAt(ctx, tx, params)
+		tx.triggerDepth--
+
+		tx.updatedRows = updatedRows
+
+		if err != nil {
+			return fmt.Errorf("%w: trigger '%s' on table '%s'", err, trigger.name, table.name)
+		}
+	}
+	return nil
+}
+
+func rowRefValue(valuesByColID map[uint32]TypedValue, col *Column) TypedValue {
+	v, ok := valuesByColID[col.id]
+	if !ok || v == nil {
+		return &NullValue{t: col.colType}
+	}
+	return v
+}
+
+// CreateTriggerStmt represents a statement to create a trigger on a table
+type CreateTriggerStmt struct {
+	trigger     string
+	ifNotExists bool
+	events      TriggerEvent
+	table       string
+	sql         string
+	stmt        SQLStmt
+}
+
+func (stmt *CreateTriggerStmt) setCapturedText(text string) {
+	stmt.sql = text
+}
+
+func (stmt *CreateTriggerStmt) readOnly() bool {
+	return false
+}
+
+// requiredPrivileges includes the privileges required by the statement executed by the trigger,
+// as well as reading the rows of the table, since it's executed on behalf of any user changing them
+func (stmt *CreateTriggerStmt) requiredPrivileges() []SQLPrivilege {
+	privileges := []SQLPrivilege{SQLPrivilegeCreate, SQLPrivilegeSelect}
+
+	if stmt.stmt != nil {
+		privileges = append(privileges, stmt.stmt.requiredPrivileges()...)
+	}
+	return privileges
+}
+
+func (stmt *CreateTriggerStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateTriggerStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.sql == "" || !isTriggerableStmt(stmt.stmt) {
+		return nil, fmt.Errorf("%w: triggers must execute an INSERT, UPSERT, UPDATE or DELETE statement", ErrIllegalArguments)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireRegularTable(table); err != nil {
+		return nil, err
+	}
+
+	trigger, err := table.newTrigger(stmt.trigger, stmt.events, stmt.sql, stmt.stmt)
+	if errors.Is(err, ErrTriggerAlreadyExists) && stmt.ifNotExists {
+		return tx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistTrigger(tx, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func isTriggerableStmt(stmt SQLStmt) bool {
+	switch stmt.(type) {
+	case *UpsertIntoStmt, *UpdateStmt, *DeleteFromStmt:
+		return true
+	}
+	return false
+}
+
+// DropTriggerStmt represents a statement to delete a trigger of a table
+type DropTriggerStmt struct {
+	trigger string
+	table   string
+}
+
+func (stmt *DropTriggerStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropTriggerStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropTriggerStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropTriggerStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger, err := table.deleteTrigger(stmt.trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.delete(ctx, triggerKey(tx.sqlPrefix(), trigger))
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func triggerKey(sqlPrefix []byte, trigger *Trigger) []byte {
+	return MapKey(
+		sqlPrefix,
+		catalogTriggerPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(trigger.table.id),
+		[]byte(trigger.name),
+	)
+}
+
+func persistTrigger(tx *SQLTx, trigger *Trigger) error {
+	// {events}{sql}
+	value := make([]byte, 1+len(trigger.sql))
+	value[0] = byte(trigger.events)
+	copy(value[1:], trigger.sql)
+
+	return tx.set(triggerKey(tx.sqlPrefix(), trigger), nil, value)
+}
+
+func (catlg *Catalog) loadTriggers(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogTriggerPrefix, EncodeID(DatabaseID))
+
+	return catlg.iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		enc, err := trimPrefix(catlg.enginePrefix, key, []byte(catalogTriggerPrefix))
+		if err != nil {
+			return err
+		}
+
+		if len(enc) <= EncIDLen*2 || binary.BigEndian.Uint32(enc) != DatabaseID || len(value) < 1 {
+			return ErrCorruptedData
+		}
+
+		tableID := binary.BigEndian.Uint32(enc[EncIDLen:])
+		name := string(enc[EncIDLen*2:])
+
+		// triggers of dropped tables are skipped
+		table, exists := catlg.tablesByID[tableID]
+		if !exists {
+			return nil
+		}
+
+		stmts, err := ParseSQLString(string(value[1:]))
+		if err != nil {
+			return fmt.Errorf("%w: invalid trigger '%s' on table '%s'", err, name, table.name)
+		}
+
+		if len(stmts) != 1 || !isTriggerableStmt(stmts[0]) {
+			return fmt.Errorf("%w: invalid trigger '%s' on table '%s'", ErrCorruptedData, name, table.name)
+		}
+
+		_, err = table.newTrigger(name, TriggerEvent(value[0]), string(value[1:]), stmts[0])
+		if err != nil {
+			return err
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/codenotary/immudb/embedded/hashing"
+	"github.com/codenotary/immudb/embedded/smt"
+)
+
+var ErrAuthenticatedIndexDisabled = errors.New("authenticated index is disabled")
+var ErrAuthenticatedIndexRootNotFound = errors.New("authenticated index root not found in tx metadata")
+var ErrRangeDeletionNotAuthenticated = errors.New("range deletions are not supported by the authenticated index")
+
+// AuthenticatedIndexEnabled returns true when the root of the authenticated index
+// is committed into every transaction
+func (s *ImmuStore) AuthenticatedIndexEnabled() bool {
+	return s.authIndex != nil
+}
+
+// AuthenticatedIndexProof returns a proof of membership or non-membership of the key in the
+// authenticated index as of the specified (committed) transaction, together with its header.
+// The proof is verified against the root committed into the metadata of the header.
+func (s *ImmuStore) AuthenticatedIndexProof(key []byte, atTx uint64) (*smt.Proof, *TxHeader, error) {
+	if s.authIndex == nil {
+		return nil, nil, ErrAuthenticatedIndexDisabled
+	}
+
+	if len(key) == 0 {
+		return nil, nil, fmt.Errorf("%w: %v", ErrIllegalArguments, ErrNullKey)
+	}
+
+	if atTx == 0 || atTx > s.LastCommittedTxID() {
+		return nil, nil, fmt.Errorf("%w: invalid transaction ID", ErrIllegalArguments)
+	}
+
+	hdr, err := s.ReadTxHeader(atTx, false, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if hdr.Metadata == nil {
+		return nil, nil, ErrAuthenticatedIndexRootNotFound
+	}
+
+	if _, ok := hdr.Metadata.GetAuthenticatedIndexRoot(); !ok {
+		return nil, nil, ErrAuthenticatedIndexRootNotFound
+	}
+
+	proof, err := s.authIndex.ProofAt(atTx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proof, hdr, nil
+}
+
+// openAuthenticatedIndex opens the authenticated index stored at path. Versions of the index are
+// only persisted once committed, the index is discarded if it's ahead of the pre-committed transactions
+// e.g. when the commit-log was truncated, so that it gets rebuilt from the transaction log.
+func openAuthenticatedIndex(path string, hashAlgorithm hashing.Algorithm, precommittedTxID uint64, opts *Options) (*smt.Tree, error) {
+	treeOpts := auxiliaryIndexOptions(opts).
+		WithMaxKeySize(smt.MaxNodeKeySize).
+		WithMaxValueSize(smt.MaxNodeDataSize)
+
+	tree, err := smt.Open(path, hashAlgorithm, treeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.Version() <= precommittedTxID {
+		return tree, nil
+	}
+
+	if opts.ReadOnly {
+		tree.Close()
+		return nil, fmt.Errorf("%w: authenticated index is ahead of the commit-log", ErrCorruptedIndex)
+	}
+
+	opts.logger.Infof("discarding authenticated index at '%s': index is ahead of the commit-log", path)
+
+	err = tree.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.RemoveAll(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return smt.Open(path, hashAlgorithm, treeOpts)
+}
+
+// buildAuthenticatedIndex replays the transactions not yet persisted into the authenticated index,
+// roots committed into the transactions are checked along the way
+func (s *ImmuStore) buildAuthenticatedIndex() error {
+	fromTxID := s.authIndex.Version() + 1
+
+	if fromTxID > s.inmemPrecommittedTxID {
+		s.logger.Infof("authenticated index up to date at '%s'", s.path)
+		return nil
+	}
+
+	s.logger.Infof("building authenticated index at '%s' from tx %d...", s.path, fromTxID)
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	txReader, err := s.newTxReader(fromTxID, false, true, false, tx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		tx, err := txReader.Read()
+		if errors.Is(err, ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		root, err := s.authIndex.Append(tx.header.ID, authenticatedIndexEntries(tx))
+		if err != nil {
+			return err
+		}
+
+		if tx.header.Metadata != nil {
+			expectedRoot, ok := tx.header.Metadata.GetAuthenticatedIndexRoot()
+			if ok && expectedRoot != root {
+				return fmt.Errorf("%w: authenticated index root differs at tx %d", ErrCorruptedIndex, tx.header.ID)
+			}
+		}
+
+		// only pre-committed transactions are kept in memory, unless the index can not be written
+		if tx.header.ID <= s.committedTxID && !s.readOnly {
+			err = s.authIndex.Persist(tx.header.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if tx.header.ID%1000 == 0 {
+			s.logger.Infof("building authenticated index at '%s' in progress: processing tx: %d", s.path, tx.header.ID)
+		}
+	}
+
+	s.logger.Infof("authenticated index up to date at '%s'", s.path)
+
+	return nil
+}
+
+// updateAuthenticatedIndex sets the entries of the transaction into the authenticated index
+// and commits the resulting root into the metadata of the transaction.
+// Replicated transactions are not modified, their root is checked when present.
+func (s *ImmuStore) updateAuthenticatedIndex(tx *Tx, txID uint64, replicated bool) error {
+	// a previous attempt to precommit a transaction may have failed after updating the index
+	if s.authIndex.Version() >= txID {
+		err := s.authIndex.Rollback(txID - 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	root, err := s.authIndex.Append(txID, authenticatedIndexEntries(tx))
+	if err != nil {
+		return err
+	}
+
+	md := NewTxMetadata()
+
+	if tx.header.Metadata != nil {
+		expectedRoot, ok := tx.header.Metadata.GetAuthenticatedIndexRoot()
+		if ok && expectedRoot != root {
+			return fmt.Errorf("%w: authenticated index root differs", ErrIllegalArguments)
+		}
+
+		if replicated {
+			return nil
+		}
+
+		// metadata is copied as it's provided by the ongoing transaction
+		err = md.ReadFrom(tx.header.Metadata.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	if replicated {
+		return nil
+	}
+
+	tx.header.Metadata = md.WithAuthenticatedIndexRoot(root)
+
+	return nil
+}
+
+// authenticatedIndexEntries returns the entries of the transaction as set into the authenticated index,
+// values are represented by the digest of the entry so that the key metadata is also authenticated
+func authenticatedIndexEntries(tx *Tx) []smt.Entry {
+	entries := make([]smt.Entry, tx.header.NEntries)
+
+	for i, e := range tx.Entries() {
+		// digest is computed as in EntrySpecDigest_v1 regardless of the tx header version
+		digest, _ := txEntryDigest_v1_2(tx.header.HashAlgorithm, e)
+
+		entries[i] = smt.Entry{
+			Key:         e.key(),
+			ValueDigest: digest,
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,362 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/hashing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticatedIndexDisabled(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithSynced(false).WithMaxConcurrency(1))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	require.False(t, immuStore.AuthenticatedIndexEnabled())
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	hdr, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+	require.True(t, hdr.Metadata.IsEmpty())
+
+	_, _, err = immuStore.AuthenticatedIndexProof([]byte("key"), hdr.ID)
+	require.ErrorIs(t, err, ErrAuthenticatedIndexDisabled)
+}
+
+func TestAuthenticatedIndexRequiresTxHeaderVersion(t *testing.T) {
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxConcurrency(1).
+		WithAuthenticatedIndex(true).
+		WithWriteTxHeaderVersion(0)
+
+	_, err := Open(t.TempDir(), opts)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
+
+func TestAuthenticatedIndexRejectsRangeDeletions(t *testing.T) {
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxConcurrency(1).
+		WithAuthenticatedIndex(true)
+
+	immuStore, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.DeleteRange([]byte("key0"), []byte("key2"))
+	require.ErrorIs(t, err, ErrRangeDeletionNotAuthenticated)
+
+	// range tombstones set directly are rejected on commit
+	md := NewKVMetadata()
+	md.AsDeletedRange(true)
+
+	err = tx.Set([]byte("key0"), md, []byte("key2"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.ErrorIs(t, err, ErrRangeDeletionNotAuthenticated)
+
+	require.Equal(t, uint64(1), immuStore.LastCommittedTxID())
+
+	_, err = immuStore.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+}
+
+func TestAuthenticatedIndex(t *testing.T) {
+	for _, alg := range []hashing.Algorithm{hashing.SHA256, hashing.BLAKE3} {
+		t.Run(alg.String(), func(t *testing.T) {
+			dir := t.TempDir()
+
+			opts := DefaultOptions().
+				WithSynced(false).
+				WithMaxConcurrency(1).
+				WithHashAlgorithm(alg).
+				WithAuthenticatedIndex(true)
+
+			immuStore, err := Open(dir, opts)
+			require.NoError(t, err)
+			require.True(t, immuStore.AuthenticatedIndexEnabled())
+
+			_, _, err = immuStore.AuthenticatedIndexProof([]byte("key0"), 1)
+			require.ErrorIs(t, err, ErrIllegalArguments)
+
+			txCount := 10
+
+			for i := 0; i < txCount; i++ {
+				tx, err := immuStore.NewWriteOnlyTx(context.Background())
+				require.NoError(t, err)
+
+				err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("value%d_0", i)))
+				require.NoError(t, err)
+
+				if i > 0 {
+					// the previous key is updated
+					err = tx.Set([]byte(fmt.Sprintf("key%d", i-1)), nil, []byte(fmt.Sprintf("value%d_1", i-1)))
+					require.NoError(t, err)
+				}
+
+				hdr, err := tx.Commit(context.Background())
+				require.NoError(t, err)
+				require.NotNil(t, hdr.Metadata)
+
+				_, ok := hdr.Metadata.GetAuthenticatedIndexRoot()
+				require.True(t, ok)
+			}
+
+			// key0 is deleted
+			tx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+			require.NoError(t, err)
+
+			err = tx.Delete(context.Background(), []byte("key0"))
+			require.NoError(t, err)
+
+			_, err = tx.Commit(context.Background())
+			require.NoError(t, err)
+
+			checkProofs := func(immuStore *ImmuStore) {
+				_, _, err := immuStore.AuthenticatedIndexProof(nil, 1)
+				require.ErrorIs(t, err, ErrIllegalArguments)
+
+				_, _, err = immuStore.AuthenticatedIndexProof([]byte("key0"), uint64(txCount+2))
+				require.ErrorIs(t, err, ErrIllegalArguments)
+
+				for atTx := uint64(1); atTx <= uint64(txCount); atTx++ {
+					for i := 0; i < txCount; i++ {
+						key := []byte(fmt.Sprintf("key%d", i))
+
+						proof, hdr, err := immuStore.AuthenticatedIndexProof(key, atTx)
+						require.NoError(t, err)
+						require.Equal(t, atTx, hdr.ID)
+
+						if uint64(i) >= atTx {
+							require.True(t, VerifyKeyNonMembership(proof, key, hdr))
+							continue
+						}
+
+						require.False(t, VerifyKeyNonMembership(proof, key, hdr))
+						require.NotNil(t, proof.Leaf)
+
+						txID := uint64(i + 1)
+						value := fmt.Sprintf("value%d_0", i)
+
+						if atTx > txID {
+							txID++
+							value = fmt.Sprintf("value%d_1", i)
+						}
+
+						require.Equal(t, txID, proof.Leaf.TxID)
+
+						entry := &EntrySpec{Key: key, Value: []byte(value)}
+						require.True(t, VerifyKeyMembership(proof, entry, txID, hdr))
+
+						require.False(t, VerifyKeyMembership(proof, entry, txID+1, hdr))
+						require.False(t, VerifyKeyMembership(proof, &EntrySpec{Key: key, Value: []byte("fake")}, txID, hdr))
+					}
+				}
+
+				// deleted entries remain in the index
+				lastTxID := uint64(txCount + 1)
+
+				proof, hdr, err := immuStore.AuthenticatedIndexProof([]byte("key0"), lastTxID)
+				require.NoError(t, err)
+
+				md := NewKVMetadata()
+				md.AsDeleted(true)
+
+				require.True(t, VerifyKeyMembership(proof, &EntrySpec{Key: []byte("key0"), Metadata: md}, lastTxID, hdr))
+				require.False(t, VerifyKeyMembership(proof, &EntrySpec{Key: []byte("key0")}, lastTxID, hdr))
+			}
+
+			checkProofs(immuStore)
+
+			// committed versions are persisted
+			require.Equal(t, uint64(txCount+1), immuStore.authIndex.PersistedVersion())
+
+			err = immuStore.Close()
+			require.NoError(t, err)
+
+			// the authenticated index is read from disk when reopening the store
+			immuStore, err = Open(dir, DefaultOptions().WithSynced(false).WithMaxConcurrency(1))
+			require.NoError(t, err)
+			require.True(t, immuStore.AuthenticatedIndexEnabled())
+			require.Equal(t, uint64(txCount+1), immuStore.authIndex.Version())
+
+			checkProofs(immuStore)
+
+			err = immuStore.Close()
+			require.NoError(t, err)
+
+			// and rebuilt from the transaction log when missing
+			err = os.RemoveAll(filepath.Join(dir, authIndexDirname))
+			require.NoError(t, err)
+
+			immuStore, err = Open(dir, DefaultOptions().WithSynced(false).WithMaxConcurrency(1))
+			require.NoError(t, err)
+			require.Equal(t, uint64(txCount+1), immuStore.authIndex.PersistedVersion())
+
+			defer immustoreClose(t, immuStore)
+
+			checkProofs(immuStore)
+		})
+	}
+}
+
+func TestAuthenticatedIndexReplication(t *testing.T) {
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxConcurrency(1).
+		WithAuthenticatedIndex(true)
+
+	primaryStore, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, primaryStore)
+
+	replicaStore, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, replicaStore)
+
+	txCount := 5
+
+	for i := 0; i < txCount; i++ {
+		tx, err := primaryStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	tx := tempTxHolder(t, primaryStore)
+
+	for i := 0; i < txCount; i++ {
+		txID := uint64(i + 1)
+
+		etx, err := primaryStore.ExportTx(txID, false, false, tx)
+		require.NoError(t, err)
+
+		hdr, err := replicaStore.ReplicateTx(context.Background(), etx, false, false)
+		require.NoError(t, err)
+		require.Equal(t, tx.header.Alh(), hdr.Alh())
+	}
+
+	proof, hdr, err := replicaStore.AuthenticatedIndexProof([]byte("key0"), uint64(txCount))
+	require.NoError(t, err)
+	require.True(t, VerifyKeyMembership(proof, &EntrySpec{Key: []byte("key0"), Value: []byte("value0")}, 1, hdr))
+}
+
+func TestAuthenticatedIndexDiscardPrecommittedTxs(t *testing.T) {
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithExternalCommitAllowance(true).
+		WithAuthenticatedIndex(true)
+
+	immuStore, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	var wg sync.WaitGroup
+
+	// transactions are precommitted but their commit is awaited until allowed
+	commit := func(key, value string) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), nil, []byte(value))
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			tx.Commit(context.Background())
+		}()
+	}
+
+	commit("key0", "value0")
+
+	err = immuStore.WaitForTx(context.Background(), 1, true)
+	require.NoError(t, err)
+
+	commit("key1", "value1")
+
+	err = immuStore.WaitForTx(context.Background(), 2, true)
+	require.NoError(t, err)
+
+	err = immuStore.AllowCommitUpto(1)
+	require.NoError(t, err)
+
+	err = immuStore.WaitForTx(context.Background(), 1, false)
+	require.NoError(t, err)
+
+	n, err := immuStore.DiscardPrecommittedTxsSince(2)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	commit("key2", "value2")
+
+	err = immuStore.WaitForTx(context.Background(), 2, true)
+	require.NoError(t, err)
+
+	err = immuStore.AllowCommitUpto(2)
+	require.NoError(t, err)
+
+	err = immuStore.WaitForTx(context.Background(), 2, false)
+	require.NoError(t, err)
+
+	wg.Wait()
+
+	// the discarded entry is not part of the authenticated index
+	proof, hdr, err := immuStore.AuthenticatedIndexProof([]byte("key1"), 2)
+	require.NoError(t, err)
+	require.True(t, VerifyKeyNonMembership(proof, []byte("key1"), hdr))
+
+	proof, hdr, err = immuStore.AuthenticatedIndexProof([]byte("key2"), 2)
+	require.NoError(t, err)
+	require.True(t, VerifyKeyMembership(proof, &EntrySpec{Key: []byte("key2"), Value: []byte("value2")}, 2, hdr))
+}
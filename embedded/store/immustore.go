@@ -38,9 +38,11 @@ import (
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 	"github.com/codenotary/immudb/embedded/cache"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/htree"
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/multierr"
+	"github.com/codenotary/immudb/embedded/smt"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/embedded/watchers"
 	"github.com/codenotary/immudb/pkg/helpers/semaphore"
@@ -97,6 +99,7 @@ var ErrInvalidPreconditionNull = fmt.Errorf("%w: null", ErrInvalidPrecondition)
 var ErrInvalidPreconditionNullKey = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrNullKey)
 var ErrInvalidPreconditionMaxKeyLenExceeded = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrMaxKeyLenExceeded)
 var ErrInvalidPreconditionInvalidTxID = fmt.Errorf("%w: invalid transaction ID", ErrInvalidPrecondition)
+var ErrInvalidPreconditionInvalidValueHash = fmt.Errorf("%w: invalid value hash", ErrInvalidPrecondition)
 
 var ErrSourceTxNewerThanTargetTx = fmt.Errorf("%w: source tx is newer than target tx", ErrIllegalArguments)
 
@@ -141,10 +144,14 @@ const (
 	metaFileSize       = "FILE_SIZE"
 	metaEmbeddedValues = "EMBEDDED_VALUES"
 	metaPreallocFiles  = "PREALLOC_FILES"
+	metaHashAlgorithm  = "HASH_ALGORITHM"
+
+	metaAuthenticatedIndex = "AUTHENTICATED_INDEX"
 )
 
 const indexDirname = "index"
 const ahtDirname = "aht"
+const authIndexDirname = "auth_index"
 
 type ImmuStore struct {
 	path string
@@ -181,6 +188,7 @@ type ImmuStore struct {
 
 	embeddedValues        bool
 	preallocFiles         bool
+	hashAlgorithm         hashing.Algorithm
 	readOnly              bool
 	synced                bool
 	syncFrequency         time.Duration
@@ -211,10 +219,23 @@ type ImmuStore struct {
 	_valBsMux sync.Mutex
 
 	aht                  *ahtree.AHtree
+	authIndex            *smt.Tree // authenticated index, only when enabled
 	inmemPrecommitWHub   *watchers.WatchersHub
 	durablePrecommitWHub *watchers.WatchersHub
 	commitWHub           *watchers.WatchersHub
 
+	rangeTombstonesIndex *tbtree.TBtree    // only created once a range tombstone is pre-committed
+	rangeTombstonesSnap  *tbtree.Snapshot  // includes every applied range tombstone
+	rangeTombstones      []*rangeTombstone // pending to be applied, sorted by transaction
+	rangeTombstonesMutex sync.RWMutex
+
+	redactedValuesLog       appendable.Appendable // only opened once a value is redacted
+	redactedValuesIndex     *tbtree.TBtree        // offsets of redacted values to their transaction
+	unappliedRedactedValues map[int64]uint64      // redacted values not in the index as the store is read-only
+	pendingRedactedValues   map[int64]uint64      // redacted values which may not be removed yet
+	redactedValuesMutex     sync.RWMutex
+	valueCompactionMutex    sync.Mutex
+
 	indexers      map[[sha256.Size]byte]*indexer
 	nextIndexerID uint64
 	indexCache    *cache.Cache
@@ -264,6 +285,8 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 	metadata.PutInt(metaMaxKeyLen, opts.MaxKeyLen)
 	metadata.PutInt(metaMaxValueLen, opts.MaxValueLen)
 	metadata.PutInt(metaFileSize, opts.FileSize)
+	metadata.PutInt(metaHashAlgorithm, int(opts.HashAlgorithm))
+	metadata.PutBool(metaAuthenticatedIndex, opts.AuthenticatedIndex)
 
 	appendableOpts := multiapp.DefaultOptions().
 		WithReadOnly(opts.ReadOnly).
@@ -272,6 +295,7 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 		WithAutoSync(true).
 		WithFileSize(opts.FileSize).
 		WithFileMode(opts.FileMode).
+		WithKeyProvider(opts.KeyProvider).
 		WithMetadata(metadata.Bytes())
 
 	appFactory := opts.appFactory
@@ -318,10 +342,16 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 		appendableOpts.WithPrealloc(false)
 		appendableOpts.WithCompressionFormat(opts.CompressionFormat)
 		appendableOpts.WithCompresionLevel(opts.CompressionLevel)
+		appendableOpts.WithCompressionDictionary(opts.CompressionDictionary)
 		appendableOpts.WithMaxOpenedFiles(opts.VLogMaxOpenedFiles)
 
+		vLogAppFactory := opts.vLogAppFactory
+		if vLogAppFactory == nil {
+			vLogAppFactory = appFactory
+		}
+
 		for i := 0; i < opts.MaxIOConcurrency; i++ {
-			vLog, err := appFactory(path, fmt.Sprintf("val_%d", i), appendableOpts)
+			vLog, err := vLogAppFactory(path, fmt.Sprintf("val_%d", i), appendableOpts)
 			if err != nil {
 				return nil, err
 			}
@@ -399,6 +429,26 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		return nil, fmt.Errorf("%w: can not read '%s' from metadata", ErrCorruptedCLog, "MaxValueLen")
 	}
 
+	// stores created before the hash algorithm was recorded are based on sha256
+	hashAlgorithm := hashing.SHA256
+
+	alg, ok := metadata.GetInt(metaHashAlgorithm)
+	if ok {
+		hashAlgorithm = hashing.Algorithm(alg)
+	}
+
+	if !hashAlgorithm.IsValid() {
+		return nil, fmt.Errorf("%w: invalid '%s' in metadata", ErrCorruptedCLog, "HashAlgorithm")
+	}
+
+	authenticatedIndex, ok := metadata.GetBool(metaAuthenticatedIndex)
+	authenticatedIndex = ok && authenticatedIndex
+
+	// the root of the authenticated index is committed into the tx metadata
+	if authenticatedIndex && opts.WriteTxHeaderVersion < 1 {
+		return nil, fmt.Errorf("%w: authenticated index requires tx header version 1 or greater", ErrIllegalArguments)
+	}
+
 	cLogSize, err := cLog.Size()
 	if err != nil {
 		return nil, fmt.Errorf("corrupted commit-log: could not get size: %w", err)
@@ -461,7 +511,7 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 
 	var committedTxID uint64
 
-	committedAlh := sha256.Sum256(nil)
+	committedAlh := hashAlgorithm.Sum(nil)
 
 	if cLogSize > 0 {
 		b := make([]byte, cLogEntrySize)
@@ -508,7 +558,7 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 
 		tx, _ := txPool.Alloc()
 
-		err = tx.readFrom(txReader, false)
+		err = tx.readFrom(txReader, hashAlgorithm, false)
 		if err != nil {
 			txPool.Release(tx)
 			return nil, fmt.Errorf("corrupted transaction log: could not read the last transaction: %w", err)
@@ -540,7 +590,7 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 	tx, _ := txPool.Alloc()
 
 	for {
-		err = tx.readFrom(txReader, false)
+		err = tx.readFrom(txReader, hashAlgorithm, false)
 		if errors.Is(err, io.EOF) {
 			break
 		}
@@ -592,11 +642,13 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 	ahtOpts := ahtree.DefaultOptions().
 		WithReadOnly(opts.ReadOnly).
 		WithFileMode(opts.FileMode).
+		WithKeyProvider(opts.KeyProvider).
 		WithFileSize(fileSize).
 		WithRetryableSync(opts.Synced).
 		WithAutoSync(true).
 		WithWriteBufferSize(opts.AHTOpts.WriteBufferSize).
-		WithSyncThld(opts.AHTOpts.SyncThld)
+		WithSyncThld(opts.AHTOpts.SyncThld).
+		WithHashAlgorithm(hashAlgorithm)
 
 	if opts.appFactory != nil {
 		ahtOpts.WithAppFactory(func(rootPath, subPath string, appOpts *multiapp.Options) (appendable.Appendable, error) {
@@ -609,6 +661,16 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		return nil, fmt.Errorf("could not open aht: %w", err)
 	}
 
+	var authIndex *smt.Tree
+
+	if authenticatedIndex {
+		authIndex, err = openAuthenticatedIndex(filepath.Join(path, authIndexDirname), hashAlgorithm, precommittedTxID, opts)
+		if err != nil {
+			aht.Close()
+			return nil, fmt.Errorf("could not open authenticated index: %w", err)
+		}
+	}
+
 	txLogCache, err := cache.NewCache(opts.TxLogCacheSize) // TODO: optionally it could include up to opts.MaxActiveTransactions upon start
 	if err != nil {
 		return nil, err
@@ -638,6 +700,7 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 
 		embeddedValues: embeddedValues,
 		preallocFiles:  preallocFiles,
+		hashAlgorithm:  hashAlgorithm,
 
 		readOnly:              opts.ReadOnly,
 		synced:                opts.Synced,
@@ -663,6 +726,8 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 
 		aht: aht,
 
+		authIndex: authIndex,
+
 		inmemPrecommitWHub:   watchers.New(0, opts.MaxActiveTransactions+1), // syncer (TODO: indexer may wait here instead)
 		durablePrecommitWHub: watchers.New(0, opts.MaxActiveTransactions+opts.MaxWaitees),
 		commitWHub:           watchers.New(0, 1+opts.MaxActiveTransactions+opts.MaxWaitees), // including indexer
@@ -693,6 +758,26 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		}
 	}
 
+	err = store.loadRangeTombstones()
+	if err != nil {
+		store.Close()
+		return nil, fmt.Errorf("range tombstones loading failed: %w", err)
+	}
+
+	err = store.loadRedactedValues()
+	if err != nil {
+		store.Close()
+		return nil, fmt.Errorf("redacted values loading failed: %w", err)
+	}
+
+	if store.authIndex != nil {
+		err = store.buildAuthenticatedIndex()
+		if err != nil {
+			store.Close()
+			return nil, fmt.Errorf("authenticated index building failed: %w", err)
+		}
+	}
+
 	err = store.inmemPrecommitWHub.DoneUpto(precommittedTxID)
 	if err != nil {
 		store.Close()
@@ -935,12 +1020,23 @@ func (s *ImmuStore) GetBetween(ctx context.Context, key []byte, initialTxID uint
 		return nil, err
 	}
 
+	// range tombstones are considered up to the indexed transaction
+	ts := indexer.Ts()
+	if ts > finalTxID {
+		ts = finalTxID
+	}
+
 	indexedVal, tx, hc, err := indexer.GetBetween(key, initialTxID, finalTxID)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.valueRefFrom(tx, hc, indexedVal)
+	valRef, err = s.valueRefFrom(tx, hc, indexedVal)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rangeDeletedValueRef(key, valRef, ts)
 }
 
 func (s *ImmuStore) Get(ctx context.Context, key []byte) (valRef ValueRef, err error) {
@@ -956,6 +1052,9 @@ func (s *ImmuStore) GetWithFilters(ctx context.Context, key []byte, filters ...F
 		return nil, err
 	}
 
+	// range tombstones are considered up to the indexed transaction
+	ts := indexer.Ts()
+
 	indexedVal, tx, hc, err := indexer.Get(key)
 	if err != nil {
 		return nil, err
@@ -966,6 +1065,11 @@ func (s *ImmuStore) GetWithFilters(ctx context.Context, key []byte, filters ...F
 		return nil, err
 	}
 
+	valRef, err = s.rangeDeletedValueRef(key, valRef, ts)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	for _, filter := range filters {
@@ -996,6 +1100,9 @@ func (s *ImmuStore) GetWithPrefixAndFilters(ctx context.Context, prefix []byte,
 		return nil, nil, err
 	}
 
+	// range tombstones are considered up to the indexed transaction
+	ts := indexer.Ts()
+
 	key, indexedVal, tx, hc, err := indexer.GetWithPrefix(prefix, neq)
 	if err != nil {
 		return nil, nil, err
@@ -1006,6 +1113,11 @@ func (s *ImmuStore) GetWithPrefixAndFilters(ctx context.Context, prefix []byte,
 		return nil, nil, err
 	}
 
+	valRef, err = s.rangeDeletedValueRef(key, valRef, ts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	now := time.Now()
 
 	for _, filter := range filters {
@@ -1022,6 +1134,34 @@ func (s *ImmuStore) GetWithPrefixAndFilters(ctx context.Context, prefix []byte,
 	return key, valRef, nil
 }
 
+// KeyWithPrefixModifiedAfter returns true if any key with the given prefix was modified after txID,
+// including deleted and expired entries. Keys with the prefix are scanned until the first one modified
+// after txID is found, see Snapshot.KeyWithPrefixModifiedAfter
+func (s *ImmuStore) KeyWithPrefixModifiedAfter(ctx context.Context, prefix []byte, txID uint64) (bool, error) {
+	indexer, err := s.getIndexerFor(prefix)
+	if err != nil {
+		if errors.Is(err, ErrIndexNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	// no key could be modified after the indexed transactions
+	if indexer.Ts() <= txID {
+		return false, nil
+	}
+
+	// the snapshot must include every indexed transaction
+	snap, err := s.SnapshotMustIncludeTxID(ctx, prefix, indexer.Ts())
+	if err != nil {
+		return false, err
+	}
+	defer snap.Close()
+
+	return snap.KeyWithPrefixModifiedAfter(ctx, prefix, txID)
+}
+
 func (s *ImmuStore) History(key []byte, offset uint64, descOrder bool, limit int) (valRefs []ValueRef, hCount uint64, err error) {
 	indexer, err := s.getIndexerFor(key)
 	if err != nil {
@@ -1383,6 +1523,12 @@ func (s *ImmuStore) MaxValueLen() int {
 	return s.maxValueLen
 }
 
+// HashAlgorithm returns the hash algorithm used to build the Merkle structures of the store,
+// it's chosen when the store is created and can not be changed afterwards
+func (s *ImmuStore) HashAlgorithm() hashing.Algorithm {
+	return s.hashAlgorithm
+}
+
 func (s *ImmuStore) Size() (uint64, error) {
 	var size uint64
 
@@ -1594,6 +1740,8 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 		tx.header.Version = hdr.Version
 	}
 
+	tx.header.HashAlgorithm = s.hashAlgorithm
+
 	tx.header.Metadata = otx.metadata
 
 	tx.header.NEntries = len(otx.entries)
@@ -1625,7 +1773,7 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 		if e.IsValueTruncated {
 			txe.hVal = e.HashValue
 		} else {
-			txe.hVal = sha256.Sum256(e.Value)
+			txe.hVal = s.hashAlgorithm.Sum(e.Value)
 		}
 	}
 
@@ -1742,6 +1890,18 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 		}
 	}
 
+	if s.authIndex != nil {
+		err = s.updateAuthenticatedIndex(tx, currPrecomittedTxID+1, hdr != nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.appendRangeTombstones(otx.entries, currPrecomittedTxID+1)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.performPrecommit(tx, otx.entries, ts, blTxID)
 	if err != nil {
 		return nil, err
@@ -2010,6 +2170,15 @@ func (s *ImmuStore) DiscardPrecommittedTxsSince(txID uint64) (int, error) {
 		return 0, err
 	}
 
+	if s.authIndex != nil && s.authIndex.Version() >= txID {
+		err = s.authIndex.Rollback(txID - 1)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	s.discardRangeTombstonesSince(txID)
+
 	// s.cLogBuf inludes all precommitted transactions (even durable ones)
 	err = s.cLogBuf.recedeWriter(txsToDiscard)
 	if err != nil {
@@ -2138,6 +2307,19 @@ func (s *ImmuStore) mayCommit() error {
 	s.committedTxID = commitUpToTxID
 	s.committedAlh = commitUpToTxAlh
 
+	if s.authIndex != nil {
+		// committed versions of the authenticated index are no longer kept in memory
+		err = s.authIndex.Persist(commitUpToTxID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.applyRangeTombstones(commitUpToTxID)
+	if err != nil {
+		return err
+	}
+
 	s.commitWHub.DoneUpto(commitUpToTxID)
 
 	return nil
@@ -2176,6 +2358,7 @@ type KeyIndex interface {
 	GetWithFilters(ctx context.Context, key []byte, filters ...FilterFn) (valRef ValueRef, err error)
 	GetWithPrefix(ctx context.Context, prefix []byte, neq []byte) (key []byte, valRef ValueRef, err error)
 	GetWithPrefixAndFilters(ctx context.Context, prefix []byte, neq []byte, filters ...FilterFn) (key []byte, valRef ValueRef, err error)
+	KeyWithPrefixModifiedAfter(ctx context.Context, prefix []byte, txID uint64) (bool, error)
 }
 
 type unsafeIndex struct {
@@ -2202,6 +2385,10 @@ func (index *unsafeIndex) GetWithPrefixAndFilters(ctx context.Context, prefix []
 	return index.st.GetWithPrefixAndFilters(ctx, prefix, neq, filters...)
 }
 
+func (index *unsafeIndex) KeyWithPrefixModifiedAfter(ctx context.Context, prefix []byte, txID uint64) (bool, error) {
+	return index.st.KeyWithPrefixModifiedAfter(ctx, prefix, txID)
+}
+
 func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64, index KeyIndex) ([]*EntrySpec, []Precondition, error)) (*TxHeader, error) {
 	if callback == nil {
 		return nil, ErrIllegalArguments
@@ -2277,6 +2464,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 	defer s.releaseAllocTx(tx)
 
 	tx.header.Version = s.writeTxHeaderVersion
+	tx.header.HashAlgorithm = s.hashAlgorithm
 	tx.header.NEntries = len(otx.entries)
 
 	doneWithValuesCh := make(chan appendableResult)
@@ -2306,7 +2494,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		if e.IsValueTruncated {
 			txe.hVal = e.HashValue
 		} else {
-			txe.hVal = sha256.Sum256(e.Value)
+			txe.hVal = s.hashAlgorithm.Sum(e.Value)
 		}
 	}
 
@@ -2645,6 +2833,21 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 
 	var isValueTruncated bool
 
+	// values are either all sent or none, thus redacted values are exported as a truncated transaction
+	hasRedactedValues := false
+
+	for _, e := range tx.Entries() {
+		redacted, err := s.isValueRedacted(e.vOff)
+		if err != nil {
+			return nil, err
+		}
+
+		if redacted {
+			hasRedactedValues = true
+			break
+		}
+	}
+
 	for i, e := range tx.Entries() {
 		var blen [lszSize]byte
 
@@ -2691,7 +2894,11 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 			valBuf = s._valBs[:e.vLen]
 		}
 
-		_, err = s.readValueAt(valBuf, e.vOff, e.hVal, skipIntegrityCheck)
+		if hasRedactedValues {
+			err = io.EOF
+		} else {
+			_, err = s.readValueAt(valBuf, e.vOff, e.hVal, skipIntegrityCheck)
+		}
 		if err != nil && !errors.Is(err, io.EOF) {
 			s._valBsMux.Unlock()
 			return nil, err
@@ -3051,7 +3258,7 @@ func (s *ImmuStore) readTx(txID uint64, allowPrecommitted bool, skipIntegrityChe
 		return err
 	}
 
-	err = tx.readFrom(r, skipIntegrityCheck)
+	err = tx.readFrom(r, s.hashAlgorithm, skipIntegrityCheck)
 	if errors.Is(err, io.EOF) {
 		return fmt.Errorf("%w: unexpected EOF while reading tx %d", ErrCorruptedTxData, txID)
 	}
@@ -3065,7 +3272,7 @@ func (s *ImmuStore) ReadTxHeader(txID uint64, allowPrecommitted bool, skipIntegr
 		return nil, err
 	}
 
-	tdr := &txDataReader{r: r, skipIntegrityCheck: skipIntegrityCheck}
+	tdr := &txDataReader{r: r, hashAlgorithm: s.hashAlgorithm, skipIntegrityCheck: skipIntegrityCheck}
 
 	header, err := tdr.readHeader(s.maxTxEntries)
 	if err != nil {
@@ -3081,7 +3288,7 @@ func (s *ImmuStore) ReadTxHeader(txID uint64, allowPrecommitted bool, skipIntegr
 		}
 	}
 
-	htree, err := htree.New(header.NEntries)
+	htree, err := htree.NewWith(header.NEntries, s.hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -3102,7 +3309,7 @@ func (s *ImmuStore) ReadTxEntry(txID uint64, key []byte, skipIntegrityCheck bool
 		return nil, nil, err
 	}
 
-	tdr := &txDataReader{r: r, skipIntegrityCheck: skipIntegrityCheck}
+	tdr := &txDataReader{r: r, hashAlgorithm: s.hashAlgorithm, skipIntegrityCheck: skipIntegrityCheck}
 
 	header, err := tdr.readHeader(s.maxTxEntries)
 	if err != nil {
@@ -3131,7 +3338,7 @@ func (s *ImmuStore) ReadTxEntry(txID uint64, key []byte, skipIntegrityCheck bool
 		return nil, nil, ErrKeyNotFound
 	}
 
-	htree, err := htree.New(header.NEntries)
+	htree, err := htree.NewWith(header.NEntries, s.hashAlgorithm)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -3187,6 +3394,17 @@ func (s *ImmuStore) readValueAt(b []byte, off int64, hvalue [sha256.Size]byte, s
 		return 0, io.EOF // it means value was not stored on any vlog i.e. a truncated transaction was replicated
 	}
 
+	if len(b) > 0 {
+		redacted, err := s.isValueRedacted(off)
+		if err != nil {
+			return 0, err
+		}
+
+		if redacted {
+			return 0, ErrValueRedacted
+		}
+	}
+
 	if len(b) > 0 {
 		foundInTheCache := false
 
@@ -3232,7 +3450,7 @@ func (s *ImmuStore) readValueAt(b []byte, off int64, hvalue [sha256.Size]byte, s
 	// either value was empty (n == 0)
 	// or a non-empty value (n > 0) was read from cache or disk
 
-	if !skipIntegrityCheck && (len(b) != n || hvalue != sha256.Sum256(b[:n])) {
+	if !skipIntegrityCheck && (len(b) != n || hvalue != s.hashAlgorithm.Sum(b[:n])) {
 		return n, fmt.Errorf("%w: value length or digest mismatch", ErrCorruptedData)
 	}
 
@@ -3258,6 +3476,19 @@ func (s *ImmuStore) validateEntries(entries []*EntrySpec) error {
 			return ErrMaxValueLenExceeded
 		}
 
+		if kv.Metadata != nil && kv.Metadata.DeletedRange() {
+			// keys covered by the range can not be removed from the authenticated index,
+			// they would otherwise be provable members once deleted
+			if s.authIndex != nil {
+				return ErrRangeDeletionNotAuthenticated
+			}
+
+			err := validateRangeTombstone(kv)
+			if err != nil {
+				return err
+			}
+		}
+
 		ks := slices.BytesToString(kv.Key)
 		if _, ok := m[ks]; ok {
 			return ErrDuplicatedKey
@@ -3400,6 +3631,19 @@ func (s *ImmuStore) sync() error {
 	s.committedTxID = commitUpToTxID
 	s.committedAlh = commitUpToTxAlh
 
+	if s.authIndex != nil {
+		// committed versions of the authenticated index are no longer kept in memory
+		err = s.authIndex.Persist(commitUpToTxID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.applyRangeTombstones(commitUpToTxID)
+	if err != nil {
+		return err
+	}
+
 	s.commitWHub.DoneUpto(commitUpToTxID)
 
 	return nil
@@ -3458,6 +3702,31 @@ func (s *ImmuStore) Close() error {
 	err = s.aht.Close()
 	merr.Append(err)
 
+	if s.authIndex != nil {
+		err = s.authIndex.Close()
+		merr.Append(err)
+	}
+
+	if s.rangeTombstonesSnap != nil {
+		err = s.rangeTombstonesSnap.Close()
+		merr.Append(err)
+	}
+
+	if s.rangeTombstonesIndex != nil {
+		err = s.rangeTombstonesIndex.Close()
+		merr.Append(err)
+	}
+
+	if s.redactedValuesLog != nil {
+		err = s.redactedValuesLog.Close()
+		merr.Append(err)
+	}
+
+	if s.redactedValuesIndex != nil {
+		err = s.redactedValuesIndex.Close()
+		merr.Append(err)
+	}
+
 	used, _, _ := s.txPool.Stats()
 	if used > 0 {
 		merr.Append(errors.New("not all tx holders were released"))
@@ -3513,7 +3782,7 @@ func (s *ImmuStore) readTxOffsetAt(txID uint64, allowPrecommitted bool, index in
 		return nil, err
 	}
 
-	tdr := &txDataReader{r: r}
+	tdr := &txDataReader{r: r, hashAlgorithm: s.hashAlgorithm}
 
 	hdr, err := tdr.readHeader(s.maxTxEntries)
 	if err != nil {
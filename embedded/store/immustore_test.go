@@ -35,8 +35,10 @@ import (
 	"github.com/codenotary/immudb/embedded"
 	"github.com/codenotary/immudb/embedded/ahtree"
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/mocked"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/htree"
 	"github.com/codenotary/immudb/embedded/tbtree"
 
@@ -620,6 +622,7 @@ func TestImmudbStoreEdgeCases(t *testing.T) {
 						SizeFn:      func() (int64, error) { return 0, nil },
 						CloseFn:     func() error { return nil },
 						SetOffsetFn: func(off int64) error { return nil },
+						MetadataFn:  func() []byte { return nil },
 					}, nil
 				}),
 		)
@@ -714,9 +717,10 @@ func TestImmudbStoreEdgeCases(t *testing.T) {
 						}, nil
 					}
 					return &mocked.MockedAppendable{
-						SizeFn:   func() (int64, error) { return 0, nil },
-						OffsetFn: func() int64 { return 0 },
-						CloseFn:  func() error { return nil },
+						SizeFn:     func() (int64, error) { return 0, nil },
+						OffsetFn:   func() int64 { return 0 },
+						MetadataFn: func() []byte { return nil },
+						CloseFn:    func() error { return nil },
 					}, nil
 				}),
 		)
@@ -945,6 +949,52 @@ func TestImmudbStoreEdgeCases(t *testing.T) {
 		})
 		require.ErrorIs(t, err, ErrInvalidPrecondition)
 		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyValueHashEquals{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyValueHashEquals{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionNoKeyWithPrefixModifiedAfterTx{
+				Prefix: make([]byte, immuStore.maxKeyLen+1),
+				TxID:   1,
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionNoKeyWithPrefixModifiedAfterTx{
+				Prefix: []byte("key"),
+				TxID:   0,
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyExpiresAfter{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyExpiresAfter{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
 	})
 }
 
@@ -2103,6 +2153,112 @@ func TestImmudbStoreConsistencyProof(t *testing.T) {
 	}
 }
 
+func TestImmudbStoreWithHashAlgorithm(t *testing.T) {
+	for _, alg := range []hashing.Algorithm{hashing.SHA512_256, hashing.BLAKE3} {
+		t.Run(alg.String(), func(t *testing.T) {
+			dir := t.TempDir()
+
+			immuStore, err := Open(dir, DefaultOptions().WithSynced(false).WithMaxConcurrency(1).WithHashAlgorithm(alg))
+			require.NoError(t, err)
+			require.Equal(t, alg, immuStore.HashAlgorithm())
+
+			txCount := 8
+			eCount := 4
+
+			for i := 0; i < txCount; i++ {
+				tx, err := immuStore.NewWriteOnlyTx(context.Background())
+				require.NoError(t, err)
+
+				for j := 0; j < eCount; j++ {
+					err = tx.Set([]byte(fmt.Sprintf("key%d_%d", i, j)), nil, []byte(fmt.Sprintf("value%d_%d", i, j)))
+					require.NoError(t, err)
+				}
+
+				hdr, err := tx.Commit(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, alg, hdr.HashAlgorithm)
+			}
+
+			err = immuStore.Close()
+			require.NoError(t, err)
+
+			// the hash algorithm is read from metadata when reopening the store
+			immuStore, err = Open(dir, DefaultOptions().WithSynced(false).WithMaxConcurrency(1))
+			require.NoError(t, err)
+			require.Equal(t, alg, immuStore.HashAlgorithm())
+
+			defer immustoreClose(t, immuStore)
+
+			sourceTx := tempTxHolder(t, immuStore)
+			targetTx := tempTxHolder(t, immuStore)
+
+			for i := 0; i < txCount; i++ {
+				sourceTxID := uint64(i + 1)
+
+				err := immuStore.ReadTx(sourceTxID, false, sourceTx)
+				require.NoError(t, err)
+
+				hdr := sourceTx.Header()
+				require.Equal(t, alg, hdr.HashAlgorithm)
+
+				sha256Hdr := *hdr
+				sha256Hdr.HashAlgorithm = hashing.SHA256
+				require.NotEqual(t, sha256Hdr.Alh(), hdr.Alh())
+
+				entrySpecDigest, err := hdr.EntrySpecDigest()
+				require.NoError(t, err)
+
+				for j := 0; j < eCount; j++ {
+					key := []byte(fmt.Sprintf("key%d_%d", i, j))
+
+					proof, err := sourceTx.Proof(key)
+					require.NoError(t, err)
+
+					entry := &EntrySpec{Key: key, Value: []byte(fmt.Sprintf("value%d_%d", i, j))}
+
+					require.True(t, VerifyInclusionWith(alg, proof, entrySpecDigest(entry), hdr.Eh))
+					require.False(t, VerifyInclusion(proof, EntrySpecDigest_v1(entry), hdr.Eh))
+				}
+
+				for j := i; j < txCount; j++ {
+					targetTxID := uint64(j + 1)
+
+					err := immuStore.ReadTx(targetTxID, false, targetTx)
+					require.NoError(t, err)
+
+					dproof, err := immuStore.DualProof(sourceTx.Header(), targetTx.Header())
+					require.NoError(t, err)
+
+					verifies := VerifyDualProof(dproof, sourceTxID, targetTxID, sourceTx.header.Alh(), targetTx.header.Alh())
+					require.True(t, verifies)
+
+					dproofV2, err := immuStore.DualProofV2(sourceTx.Header(), targetTx.Header())
+					require.NoError(t, err)
+
+					err = VerifyDualProofV2(dproofV2, sourceTxID, targetTxID, sourceTx.header.Alh(), targetTx.header.Alh())
+					require.NoError(t, err)
+
+					// headers produced with a different hash algorithm must not verify
+					dproof.SourceTxHeader.HashAlgorithm = hashing.SHA256
+					require.False(t, VerifyDualProof(dproof, sourceTxID, targetTxID, sourceTx.header.Alh(), targetTx.header.Alh()))
+
+					dproofV2.SourceTxHeader.HashAlgorithm = hashing.SHA256
+					err = VerifyDualProofV2(dproofV2, sourceTxID, targetTxID, sourceTx.header.Alh(), targetTx.header.Alh())
+					require.ErrorIs(t, err, ErrIllegalArguments)
+				}
+			}
+
+			valRef, err := immuStore.Get(context.Background(), []byte("key0_0"))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte("value0_0"), val)
+			require.Equal(t, alg.Sum(val), valRef.HVal())
+		})
+	}
+}
+
 func TestImmudbStoreConsistencyProofAgainstLatest(t *testing.T) {
 	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
 	immuStore, err := Open(t.TempDir(), opts)
@@ -2349,6 +2505,151 @@ func TestReOpeningWithCompressionEnabledImmudbStore(t *testing.T) {
 	}
 }
 
+func TestReOpeningWithMixedCompressionImmudbStore(t *testing.T) {
+	dir := t.TempDir()
+
+	dict := []byte(`{"name":"value-"}`)
+
+	_, err := Open(dir, DefaultOptions().WithCompressionFormat(appendable.LZ4Compression).WithCompressionDictionary(dict))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	formats := []*Options{
+		DefaultOptions().WithCompressionFormat(appendable.GZipCompression),
+		DefaultOptions().WithCompressionFormat(appendable.ZStdCompression).WithCompressionDictionary(dict),
+		DefaultOptions().WithCompressionFormat(appendable.LZ4Compression).WithCompresionLevel(appendable.BestCompression),
+		DefaultOptions(),
+	}
+
+	txCount := 10
+
+	for it, opts := range formats {
+		immuStore, err := Open(dir, opts.WithFileSize(1024).WithMaxIOConcurrency(1))
+		require.NoError(t, err)
+
+		for i := 0; i < txCount; i++ {
+			tx, err := immuStore.NewWriteOnlyTx(context.Background())
+			require.NoError(t, err)
+
+			err = tx.Set([]byte(fmt.Sprintf("key-%d-%d", it, i)), nil, []byte(fmt.Sprintf(`{"name":"value-%d-%d"}`, it, i)))
+			require.NoError(t, err)
+
+			_, err = tx.Commit(context.Background())
+			require.NoError(t, err)
+		}
+
+		err = immuStore.Close()
+		require.NoError(t, err)
+	}
+
+	immuStore, err := Open(dir, DefaultOptions().WithFileSize(1024).WithMaxIOConcurrency(1))
+	require.NoError(t, err)
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), uint64(len(formats)*txCount))
+	require.NoError(t, err)
+
+	for it := range formats {
+		for i := 0; i < txCount; i++ {
+			valRef, err := immuStore.Get(context.Background(), []byte(fmt.Sprintf("key-%d-%d", it, i)))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf(`{"name":"value-%d-%d"}`, it, i)), val)
+		}
+	}
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+}
+
+func TestReOpeningWithEncryptionEnabledImmudbStore(t *testing.T) {
+	dir := t.TempDir()
+
+	keys := map[string][]byte{
+		"k1": []byte("0123456789abcdef0123456789abcdef"),
+	}
+
+	kp, err := encryptedapp.NewStaticKeyProvider("k1", keys)
+	require.NoError(t, err)
+
+	_, err = Open(dir, DefaultOptions().WithKeyProvider(kp).WithCompressionFormat(appendable.GZipCompression))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = Open(dir, DefaultOptions().WithKeyProvider(kp).WithPreallocFiles(true))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	itCount := 3
+	txCount := 10
+
+	for it := 0; it < itCount; it++ {
+		if it == itCount-1 {
+			// rotated key is used for new files only
+			keys["k2"] = []byte("fedcba9876543210")
+
+			kp, err = encryptedapp.NewStaticKeyProvider("k2", keys)
+			require.NoError(t, err)
+		}
+
+		immuStore, err := Open(dir, DefaultOptions().WithKeyProvider(kp).WithFileSize(1024))
+		require.NoError(t, err)
+
+		for i := 0; i < txCount; i++ {
+			tx, err := immuStore.NewWriteOnlyTx(context.Background())
+			require.NoError(t, err)
+
+			err = tx.Set([]byte(fmt.Sprintf("secret-key-%d-%d", it, i)), nil, []byte(fmt.Sprintf("secret-value-%d-%d", it, i)))
+			require.NoError(t, err)
+
+			txhdr, err := tx.Commit(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, uint64(it*txCount+i+1), txhdr.ID)
+		}
+
+		err = immuStore.Close()
+		require.NoError(t, err)
+	}
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NotContains(t, string(raw), "secret-", path)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	immuStore, err := Open(dir, DefaultOptions().WithKeyProvider(kp).WithFileSize(1024))
+	require.NoError(t, err)
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), uint64(itCount*txCount))
+	require.NoError(t, err)
+
+	for it := 0; it < itCount; it++ {
+		for i := 0; i < txCount; i++ {
+			valRef, err := immuStore.Get(context.Background(), []byte(fmt.Sprintf("secret-key-%d-%d", it, i)))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("secret-value-%d-%d", it, i)), val)
+		}
+	}
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	// files encrypted with a key which is no longer available can not be read
+	kp, err = encryptedapp.NewStaticKeyProvider("k2", map[string][]byte{"k2": keys["k2"]})
+	require.NoError(t, err)
+
+	_, err = Open(dir, DefaultOptions().WithKeyProvider(kp).WithFileSize(1024))
+	require.ErrorIs(t, err, encryptedapp.ErrKeyNotFound)
+}
+
 func TestUncommittedTxOverwriting(t *testing.T) {
 	path := t.TempDir()
 
@@ -2875,6 +3176,123 @@ func TestImmudbStoreCommitWithPreconditions(t *testing.T) {
 	})
 }
 
+func TestImmudbStoreCommitWithContentPreconditions(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithMaxConcurrency(1))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	commitWithPrecondition := func(c Precondition) error {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("other"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(c)
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		return err
+	}
+
+	expiresAt := time.Now().Add(1 * time.Hour)
+
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.Set([]byte("agg/key1"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	md := NewKVMetadata()
+	err = md.ExpiresAt(expiresAt)
+	require.NoError(t, err)
+
+	err = otx.Set([]byte("agg/key2"), md, []byte("value2"))
+	require.NoError(t, err)
+
+	hdr1, err := otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	t.Run("value hash constraint should pass only when the value matches", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionKeyValueHashEquals{Key: []byte("agg/key1"), ValueHash: sha256.Sum256([]byte("value1"))})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyValueHashEquals{Key: []byte("agg/key1"), ValueHash: sha256.Sum256([]byte("value2"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionKeyValueHashEquals{Key: []byte("nonExistentKey"), ValueHash: sha256.Sum256(nil)})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("key expiration constraint should pass only when the key expires after specified time", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionKeyExpiresAfter{Key: []byte("agg/key1"), ExpiresAt: expiresAt.Add(time.Hour)})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyExpiresAfter{Key: []byte("agg/key2"), ExpiresAt: expiresAt.Add(-time.Minute)})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyExpiresAfter{Key: []byte("agg/key2"), ExpiresAt: expiresAt.Add(time.Minute)})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionKeyExpiresAfter{Key: []byte("nonExistentKey"), ExpiresAt: time.Now()})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("prefix constraint should pass when no key with the prefix exists", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionNoKeyWithPrefixModifiedAfterTx{Prefix: []byte("nonExistentPrefix/"), TxID: 1})
+		require.NoError(t, err)
+	})
+
+	t.Run("prefix constraint should pass when no key with the prefix was modified after specified tx", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionNoKeyWithPrefixModifiedAfterTx{Prefix: []byte("agg/"), TxID: hdr1.ID})
+		require.NoError(t, err)
+	})
+
+	// delete a key of the aggregate
+	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.Delete(context.Background(), []byte("agg/key1"))
+	require.NoError(t, err)
+
+	_, err = otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	t.Run("prefix constraint should not pass when a key with the prefix is deleted after specified tx", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionNoKeyWithPrefixModifiedAfterTx{Prefix: []byte("agg/"), TxID: hdr1.ID})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("value hash constraint should not pass when the key is deleted", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionKeyValueHashEquals{Key: []byte("agg/key1"), ValueHash: sha256.Sum256([]byte("value1"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	// delete the remaining keys of the aggregate by range
+	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.DeleteRange([]byte("agg/key2"), []byte("agg/key3"))
+	require.NoError(t, err)
+
+	hdr3, err := otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	t.Run("prefix constraint should not pass when a key with the prefix is range deleted after specified tx", func(t *testing.T) {
+		modified, err := immuStore.KeyWithPrefixModifiedAfter(context.Background(), []byte("agg/key2"), hdr3.ID-1)
+		require.NoError(t, err)
+		require.True(t, modified)
+
+		modified, err = immuStore.KeyWithPrefixModifiedAfter(context.Background(), []byte("agg/key2"), hdr3.ID)
+		require.NoError(t, err)
+		require.False(t, modified)
+
+		err = commitWithPrecondition(&PreconditionNoKeyWithPrefixModifiedAfterTx{Prefix: []byte("agg/key2"), TxID: hdr3.ID - 1})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+}
+
 func BenchmarkSyncedAppend(b *testing.B) {
 	opts := DefaultOptions().
 		WithMaxConcurrency(100).
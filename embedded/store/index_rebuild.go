@@ -0,0 +1,371 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+var ErrIndexRebuildUnsupported = errors.New("index rebuild unsupported")
+
+const (
+	// the index is rebuilt next to the current one and swapped once it is up to date
+	indexRebuildSuffix   = ".rebuild"
+	indexDiscardedSuffix = ".discarded"
+
+	// max number of transactions left to be replayed while indexing is stopped
+	indexRebuildMaxPendingTxs = 1_000
+
+	// the current index can only be swapped once its snapshots are closed,
+	// the rebuilt index is discarded if they are not closed in time
+	indexRebuildSnapshotsTimeout      = time.Minute
+	indexRebuildSnapshotsPollInterval = 10 * time.Millisecond
+)
+
+// RebuildIndexes builds new indexes from the transaction log while current ones keep serving requests.
+// Each index is replaced by its rebuilt version once it has caught up with the current one.
+func (s *ImmuStore) RebuildIndexes(ctx context.Context) error {
+	if s.opts.appFactory != nil {
+		return fmt.Errorf("%w: indexes are not stored locally", ErrIndexRebuildUnsupported)
+	}
+
+	for _, indexer := range s.currentIndexers() {
+		err := indexer.RebuildIndex(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// currentIndexers returns the indexers without holding the lock during long-running operations
+func (s *ImmuStore) currentIndexers() []*indexer {
+	s.indexersMux.RLock()
+	defer s.indexersMux.RUnlock()
+
+	indexers := make([]*indexer, 0, len(s.indexers))
+	for _, indexer := range s.indexers {
+		indexers = append(indexers, indexer)
+	}
+
+	return indexers
+}
+
+func (idx *indexer) RebuildIndex(ctx context.Context) (err error) {
+	idx.rebuildMutex.Lock()
+	defer idx.rebuildMutex.Unlock()
+
+	idx.store.logger.Infof("rebuilding index '%s'...", idx.path)
+
+	defer func() {
+		if err == nil {
+			idx.store.logger.Infof("index '%s' successfully rebuilt", idx.path)
+		} else {
+			idx.store.logger.Warningf("%v: while rebuilding index '%s'", err, idx.path)
+		}
+	}()
+
+	idx.rwmutex.RLock()
+	if idx.closed {
+		idx.rwmutex.RUnlock()
+		return ErrAlreadyClosed
+	}
+	opts := idx.index.GetOptions()
+	idx.rwmutex.RUnlock()
+
+	rebuildPath := idx.path + indexRebuildSuffix
+
+	// leftovers from an interrupted rebuild are discarded
+	err = os.RemoveAll(rebuildPath)
+	if err != nil {
+		return err
+	}
+
+	index, err := tbtree.Open(rebuildPath, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if index != nil {
+			// the rebuilt index was not swapped
+			index.Close()
+			os.RemoveAll(rebuildPath)
+		}
+	}()
+
+	replayer := idx.newReplayer()
+
+	deadline := time.Now().Add(indexRebuildSnapshotsTimeout)
+
+	for {
+		for {
+			indexedTxID := idx.Ts()
+
+			if indexedTxID <= index.Ts()+indexRebuildMaxPendingTxs {
+				break
+			}
+
+			idx.store.logger.Infof("rebuilding index '%s' {ts=%d, target_ts=%d}...", idx.path, index.Ts(), indexedTxID)
+
+			err = replayer.replayInto(ctx, index, indexedTxID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = idx.waitForSnapshotsClosed(ctx, deadline)
+		if err != nil {
+			return err
+		}
+
+		// snapshots may have been opened in the meantime, in such case the swap is attempted again
+		err = idx.swapRebuiltIndex(index, rebuildPath, replayer)
+		if errors.Is(err, tbtree.ErrSnapshotsNotClosed) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		index = nil
+
+		return nil
+	}
+}
+
+// waitForSnapshotsClosed waits until the snapshots of the current index are closed,
+// new snapshots are not prevented from being opened while waiting
+func (idx *indexer) waitForSnapshotsClosed(ctx context.Context, deadline time.Time) error {
+	for {
+		idx.rwmutex.RLock()
+		if idx.closed {
+			idx.rwmutex.RUnlock()
+			return ErrAlreadyClosed
+		}
+		n := idx.index.ActiveSnapshots()
+		idx.rwmutex.RUnlock()
+
+		if n == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w: %d snapshots of index '%s' are still in use", tbtree.ErrSnapshotsNotClosed, n, idx.path)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(indexRebuildSnapshotsPollInterval):
+		}
+	}
+}
+
+// swapRebuiltIndex replaces the current index by the rebuilt one once the remaining transactions are replayed,
+// tbtree.ErrSnapshotsNotClosed is returned if the current index is still in use
+func (idx *indexer) swapRebuiltIndex(index *tbtree.TBtree, rebuildPath string, replayer *indexReplayer) error {
+	idx.compactionMutex.Lock()
+	defer idx.compactionMutex.Unlock()
+
+	idx.rwmutex.Lock()
+	defer idx.rwmutex.Unlock()
+
+	if idx.closed {
+		return ErrAlreadyClosed
+	}
+
+	idx.stop()
+	defer idx.resume()
+
+	liveOpts := idx.index.GetOptions().
+		WithOnFlushFunc(func(releasedDataSize int) {
+			idx.store.memSemaphore.Release(uint64(releasedDataSize))
+		})
+
+	reopenCurrentIndex := func() {
+		currIndex, rerr := tbtree.Open(idx.path, liveOpts)
+		if rerr != nil {
+			idx.store.logger.Errorf("%v: while reopening index '%s'", rerr, idx.path)
+			return
+		}
+		idx.index = currIndex
+	}
+
+	// the current index can not be closed while snapshots are in use, in such case it's kept
+	err := idx.index.Close()
+	if errors.Is(err, tbtree.ErrSnapshotsNotClosed) {
+		return err
+	}
+	if err != nil {
+		reopenCurrentIndex()
+		return err
+	}
+
+	indexedTxID := idx.index.Ts()
+
+	// remaining transactions are replayed while indexing is stopped
+	err = replayer.replayInto(context.Background(), index, indexedTxID)
+	if err == nil {
+		err = index.Close()
+	}
+	if err == nil {
+		err = swapIndexDirs(idx.path, rebuildPath)
+	}
+	if err != nil {
+		reopenCurrentIndex()
+		return err
+	}
+
+	rebuiltIndex, err := tbtree.Open(idx.path, liveOpts)
+	if err != nil {
+		return err
+	}
+
+	idx.index = rebuiltIndex
+
+	err = os.RemoveAll(idx.path + indexDiscardedSuffix)
+	if err != nil {
+		idx.store.logger.Warningf("%v: while removing discarded index '%s'", err, idx.path+indexDiscardedSuffix)
+	}
+
+	return nil
+}
+
+func swapIndexDirs(path, rebuildPath string) error {
+	discardedPath := path + indexDiscardedSuffix
+
+	err := os.RemoveAll(discardedPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(path, discardedPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(rebuildPath, path)
+	if err != nil {
+		os.Rename(discardedPath, path)
+		return err
+	}
+
+	return nil
+}
+
+// recoverIndexRebuild completes a swap interrupted after the current index was moved away,
+// otherwise leftovers from a rebuild are discarded
+func recoverIndexRebuild(path string) error {
+	rebuildPath := path + indexRebuildSuffix
+	discardedPath := path + indexDiscardedSuffix
+
+	_, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		_, rerr := os.Stat(discardedPath)
+		if rerr == nil {
+			err = os.Rename(rebuildPath, path)
+			if errors.Is(err, os.ErrNotExist) {
+				// the rebuilt index was not completed, the current one is restored
+				err = os.Rename(discardedPath, path)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	} else if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(rebuildPath)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(discardedPath)
+}
+
+// indexReplayer indexes transactions read from the transaction log with its own buffers,
+// so it can be used concurrently with the indexing process
+type indexReplayer struct {
+	idx    *indexer
+	tx     *Tx
+	kvs    []*tbtree.KVT
+	valBuf []byte
+}
+
+func (idx *indexer) newReplayer() *indexReplayer {
+	// injective mapping may produce up to two entries for each transaction entry
+	kvs := make([]*tbtree.KVT, 2*idx.store.maxTxEntries)
+	for i := range kvs {
+		kvs[i] = &tbtree.KVT{}
+	}
+
+	return &indexReplayer{
+		idx:    idx,
+		tx:     NewTx(idx.store.maxTxEntries, idx.store.maxKeyLen),
+		kvs:    kvs,
+		valBuf: make([]byte, DefaultMaxValueLen),
+	}
+}
+
+// readIndexableEntries reads the transaction and returns its index entries,
+// they are only valid until the next call
+func (r *indexReplayer) readIndexableEntries(txID uint64) ([]*tbtree.KVT, error) {
+	err := r.idx.store.readTx(txID, false, false, r.tx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := r.idx.indexableEntries(r.tx, r.kvs, r.valBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.kvs[:n], nil
+}
+
+// replayInto indexes transactions following the ones already in the index up to uptoTxID
+func (r *indexReplayer) replayInto(ctx context.Context, index *tbtree.TBtree, uptoTxID uint64) error {
+	for txID := index.Ts() + 1; txID <= uptoTxID; txID++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		kvs, err := r.readIndexableEntries(txID)
+		if err != nil {
+			return err
+		}
+
+		if len(kvs) == 0 {
+			err = index.IncreaseTs(txID)
+		} else {
+			err = index.BulkInsert(kvs)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildIndexes(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := Open(dir, DefaultOptions().WithSynced(false))
+	require.NoError(t, err)
+
+	set := func(i int) {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i%100)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 1_200; i++ {
+		set(i)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	// transactions keep being committed and indexed during the rebuild
+	go func() {
+		defer wg.Done()
+
+		for i := 1_200; i < 1_500; i++ {
+			set(i)
+		}
+	}()
+
+	err = st.RebuildIndexes(context.Background())
+	require.NoError(t, err)
+
+	wg.Wait()
+
+	checkIndex := func(st *ImmuStore) {
+		err := st.WaitForIndexingUpto(context.Background(), 1_500)
+		require.NoError(t, err)
+
+		for i := 1_400; i < 1_500; i++ {
+			valRef, err := st.Get(context.Background(), []byte(fmt.Sprintf("key%d", i%100)))
+			require.NoError(t, err)
+			require.Equal(t, uint64(i+1), valRef.Tx())
+			require.Equal(t, uint64(15), valRef.HC())
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+		}
+
+		verifications, err := st.VerifyIndexes(context.Background(), 10)
+		require.NoError(t, err)
+		require.Len(t, verifications, 1)
+		require.Equal(t, uint64(1_500), verifications[0].IndexedTxID)
+		require.Equal(t, uint64(1_500), verifications[0].ExpectedEntries)
+		require.Equal(t, uint64(1_500), verifications[0].IndexedEntries)
+		require.Zero(t, verifications[0].DiscrepancyCount)
+		require.Empty(t, verifications[0].Discrepancies)
+	}
+
+	checkIndex(st)
+
+	require.NoDirExists(t, filepath.Join(dir, indexDirname+indexRebuildSuffix))
+	require.NoDirExists(t, filepath.Join(dir, indexDirname+indexDiscardedSuffix))
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	err = st.RebuildIndexes(context.Background())
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	st, err = Open(dir, DefaultOptions().WithSynced(false))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	checkIndex(st)
+}
+
+func TestVerifyIndexesDiscrepancies(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	_, err = st.VerifyIndexes(context.Background(), -1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	set := func(key string) {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), nil, []byte("value"))
+		require.NoError(t, err)
+
+		_, err = tx.AsyncCommit(context.Background())
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 10; i++ {
+		set(fmt.Sprintf("key%d", i))
+	}
+
+	err = st.WaitForIndexingUpto(context.Background(), 10)
+	require.NoError(t, err)
+
+	idx, err := st.getIndexerFor([]byte("key"))
+	require.NoError(t, err)
+
+	// the index gets an entry not present in the tx log and misses the following one
+	idx.stop()
+
+	err = idx.index.BulkInsert([]*tbtree.KVT{{K: []byte("unexpected"), V: []byte("value"), T: 11}})
+	require.NoError(t, err)
+
+	set("key10")
+
+	verifications, err := st.VerifyIndexes(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, verifications, 1)
+	require.Equal(t, uint64(11), verifications[0].IndexedTxID)
+	require.Equal(t, uint64(11), verifications[0].ExpectedEntries)
+	require.Equal(t, uint64(11), verifications[0].IndexedEntries)
+	require.Equal(t, uint64(2), verifications[0].DiscrepancyCount)
+	require.Len(t, verifications[0].Discrepancies, 1)
+	require.Equal(t, []byte("key10"), verifications[0].Discrepancies[0].Key)
+	require.Equal(t, uint64(11), verifications[0].Discrepancies[0].TxID)
+	require.Equal(t, IndexDiscrepancyMissingEntry, verifications[0].Discrepancies[0].Reason)
+
+	verifications, err = st.VerifyIndexes(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, verifications[0].Discrepancies, 2)
+	require.Equal(t, []byte("unexpected"), verifications[0].Discrepancies[1].Key)
+	require.Equal(t, uint64(11), verifications[0].Discrepancies[1].TxID)
+	require.Equal(t, IndexDiscrepancyUnexpectedEntry, verifications[0].Discrepancies[1].Reason)
+
+	idx.resume()
+
+	err = st.RebuildIndexes(context.Background())
+	require.NoError(t, err)
+
+	err = st.WaitForIndexingUpto(context.Background(), 11)
+	require.NoError(t, err)
+
+	_, err = st.Get(context.Background(), []byte("unexpected"))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, err = st.Get(context.Background(), []byte("key10"))
+	require.NoError(t, err)
+
+	verifications, err = st.VerifyIndexes(context.Background(), 10)
+	require.NoError(t, err)
+	require.Zero(t, verifications[0].DiscrepancyCount)
+}
+
+func TestRebuildIndexesWithOpenSnapshots(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	tx, err := st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	err = st.WaitForIndexingUpto(context.Background(), 1)
+	require.NoError(t, err)
+
+	snap, err := st.Snapshot(nil)
+	require.NoError(t, err)
+
+	// the rebuilt index is discarded if the snapshots are not closed in time
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err = st.RebuildIndexes(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = os.Stat(st.indexers[sha256.Sum256(nil)].path + indexRebuildSuffix)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.Equal(t, 1, st.indexers[sha256.Sum256(nil)].index.ActiveSnapshots())
+
+	err = snap.Close()
+	require.NoError(t, err)
+
+	// the current index is kept and keeps being updated
+	tx, err = st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key"), nil, []byte("value2"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	err = st.WaitForIndexingUpto(context.Background(), 2)
+	require.NoError(t, err)
+
+	valRef, err := st.Get(context.Background(), []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), valRef.Tx())
+
+	// the swap waits for the snapshots to be closed
+	snap, err = st.Snapshot(nil)
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		snap.Close()
+	}()
+
+	err = st.RebuildIndexes(context.Background())
+	require.NoError(t, err)
+
+	valRef, err = st.Get(context.Background(), []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), valRef.Tx())
+}
+
+func TestRecoverIndexRebuild(t *testing.T) {
+	mkdir := func(path string) {
+		err := os.MkdirAll(path, 0700)
+		require.NoError(t, err)
+
+		err = os.WriteFile(filepath.Join(path, "marker"), []byte(filepath.Base(path)), 0600)
+		require.NoError(t, err)
+	}
+
+	requireIndex := func(path, marker string) {
+		b, err := os.ReadFile(filepath.Join(path, "marker"))
+		require.NoError(t, err)
+		require.Equal(t, marker, string(b))
+
+		require.NoDirExists(t, path+indexRebuildSuffix)
+		require.NoDirExists(t, path+indexDiscardedSuffix)
+	}
+
+	t.Run("leftovers of an incomplete rebuild are discarded", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "index")
+
+		mkdir(path)
+		mkdir(path + indexRebuildSuffix)
+
+		err := recoverIndexRebuild(path)
+		require.NoError(t, err)
+
+		requireIndex(path, "index")
+	})
+
+	t.Run("interrupted swap is completed", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "index")
+
+		mkdir(path + indexRebuildSuffix)
+		mkdir(path + indexDiscardedSuffix)
+
+		err := recoverIndexRebuild(path)
+		require.NoError(t, err)
+
+		requireIndex(path, "index"+indexRebuildSuffix)
+	})
+
+	t.Run("current index is restored if the rebuilt one is missing", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "index")
+
+		mkdir(path + indexDiscardedSuffix)
+
+		err := recoverIndexRebuild(path)
+		require.NoError(t, err)
+
+		requireIndex(path, "index"+indexDiscardedSuffix)
+	})
+
+	t.Run("nothing to be done on a new index", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "index")
+
+		err := recoverIndexRebuild(path)
+		require.NoError(t, err)
+
+		require.NoDirExists(t, path)
+	})
+}
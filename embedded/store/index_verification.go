@@ -0,0 +1,245 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+// IndexDiscrepancy describes an index entry not matching the transaction log
+type IndexDiscrepancy struct {
+	Key    []byte
+	TxID   uint64
+	Reason string
+}
+
+const (
+	IndexDiscrepancyMissingEntry    = "missing entry"
+	IndexDiscrepancyValueMismatch   = "value mismatch"
+	IndexDiscrepancyUnexpectedEntry = "unexpected entry"
+)
+
+// IndexVerification summarizes the verification of an index against the transaction log.
+// At most maxDiscrepancies are reported, DiscrepancyCount holds the total number found.
+type IndexVerification struct {
+	SourcePrefix []byte
+	TargetPrefix []byte
+
+	IndexedTxID     uint64
+	ExpectedEntries uint64
+	IndexedEntries  uint64
+
+	DiscrepancyCount uint64
+	Discrepancies    []*IndexDiscrepancy
+}
+
+func (v *IndexVerification) addDiscrepancy(key []byte, txID uint64, reason string, maxDiscrepancies int) {
+	v.DiscrepancyCount++
+
+	if len(v.Discrepancies) < maxDiscrepancies {
+		v.Discrepancies = append(v.Discrepancies, &IndexDiscrepancy{
+			Key:    cp(key),
+			TxID:   txID,
+			Reason: reason,
+		})
+	}
+}
+
+// VerifyIndexes cross-checks every indexed key and transaction against the entries in the transaction log.
+// Indexes are verified as of their latest snapshot while they keep serving requests.
+func (s *ImmuStore) VerifyIndexes(ctx context.Context, maxDiscrepancies int) ([]*IndexVerification, error) {
+	if maxDiscrepancies < 0 {
+		return nil, fmt.Errorf("%w: invalid max number of discrepancies", ErrIllegalArguments)
+	}
+
+	indexers := s.currentIndexers()
+
+	verifications := make([]*IndexVerification, len(indexers))
+
+	for i, indexer := range indexers {
+		verification, err := indexer.VerifyIndex(ctx, maxDiscrepancies)
+		if err != nil {
+			return nil, err
+		}
+
+		verifications[i] = verification
+	}
+
+	return verifications, nil
+}
+
+func (idx *indexer) VerifyIndex(ctx context.Context, maxDiscrepancies int) (*IndexVerification, error) {
+	// a fresh snapshot is used so to verify everything indexed so far
+	snap, err := idx.SnapshotMustIncludeTxIDWithRenewalPeriod(ctx, idx.Ts(), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Close()
+
+	verification := &IndexVerification{
+		SourcePrefix: idx.spec.SourcePrefix,
+		TargetPrefix: idx.spec.TargetPrefix,
+		IndexedTxID:  snap.Ts(),
+	}
+
+	replayer := idx.newReplayer()
+
+	var missingEntries uint64
+
+	// every entry expected from the transaction log must be indexed
+	for txID := uint64(1); txID <= snap.Ts(); txID++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		kvs, err := replayer.readIndexableEntries(txID)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, kv := range kvs {
+			if duplicatedKey(kvs[i+1:], kv.K) {
+				// only the last entry for the same key in a transaction is kept
+				continue
+			}
+
+			verification.ExpectedEntries++
+
+			v, _, _, err := snap.GetBetween(kv.K, txID, txID)
+			if errors.Is(err, tbtree.ErrKeyNotFound) {
+				verification.addDiscrepancy(kv.K, txID, IndexDiscrepancyMissingEntry, maxDiscrepancies)
+				missingEntries++
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !bytes.Equal(v, kv.V) {
+				verification.addDiscrepancy(kv.K, txID, IndexDiscrepancyValueMismatch, maxDiscrepancies)
+			}
+		}
+	}
+
+	indexedEntries, err := countIndexedEntries(ctx, snap)
+	if err != nil {
+		return nil, err
+	}
+
+	verification.IndexedEntries = indexedEntries
+
+	if verification.IndexedEntries+missingEntries == verification.ExpectedEntries {
+		// every indexed entry corresponds to an expected one
+		return verification, nil
+	}
+
+	// the index holds entries not expected from the transaction log
+	err = idx.verifyIndexedEntries(ctx, snap, replayer, verification, maxDiscrepancies)
+	if err != nil {
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+func duplicatedKey(kvs []*tbtree.KVT, key []byte) bool {
+	for _, kv := range kvs {
+		if bytes.Equal(kv.K, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func countIndexedEntries(ctx context.Context, snap *tbtree.Snapshot) (uint64, error) {
+	r, err := snap.NewReader(tbtree.ReaderSpec{InclusiveSeek: true})
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	var count uint64
+
+	for {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		_, _, _, hc, err := r.Read()
+		if errors.Is(err, tbtree.ErrNoMoreEntries) {
+			return count, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		count += hc
+	}
+}
+
+// verifyIndexedEntries looks up every indexed key and transaction in the transaction log
+func (idx *indexer) verifyIndexedEntries(ctx context.Context, snap *tbtree.Snapshot, replayer *indexReplayer, verification *IndexVerification, maxDiscrepancies int) error {
+	r, err := snap.NewReader(tbtree.ReaderSpec{InclusiveSeek: true, IncludeHistory: true})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var prevKey []byte
+	var prevTxID uint64
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		key, _, txID, _, err := r.Read()
+		if errors.Is(err, tbtree.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if txID == prevTxID && bytes.Equal(key, prevKey) {
+			// the same entry was indexed more than once
+			verification.addDiscrepancy(key, txID, IndexDiscrepancyUnexpectedEntry, maxDiscrepancies)
+			continue
+		}
+
+		prevKey = key
+		prevTxID = txID
+
+		kvs, err := replayer.readIndexableEntries(txID)
+		if errors.Is(err, ErrTxNotFound) {
+			verification.addDiscrepancy(key, txID, IndexDiscrepancyUnexpectedEntry, maxDiscrepancies)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if !duplicatedKey(kvs, key) {
+			verification.addDiscrepancy(key, txID, IndexDiscrepancyUnexpectedEntry, maxDiscrepancies)
+		}
+	}
+}
@@ -70,6 +70,7 @@ type indexer struct {
 	closed bool
 
 	compactionMutex sync.Mutex
+	rebuildMutex    sync.Mutex
 	rwmutex         sync.RWMutex
 
 	metricsLastCommittedTrx prometheus.Gauge
@@ -103,6 +104,39 @@ var (
 	})
 )
 
+// auxiliaryIndexOptions returns the options of the tbtrees maintained by the store besides the indexers,
+// they don't share the cache of the indexers nor their memory limits
+func auxiliaryIndexOptions(opts *Options) *tbtree.Options {
+	indexOpts := tbtree.DefaultOptions().
+		WithReadOnly(opts.ReadOnly).
+		WithFileMode(opts.FileMode).
+		WithKeyProvider(opts.KeyProvider).
+		WithLogger(opts.logger).
+		WithFileSize(opts.FileSize).
+		WithCacheSize(opts.IndexOpts.CacheSize).
+		WithFlushThld(opts.IndexOpts.FlushThld).
+		WithSyncThld(opts.IndexOpts.SyncThld).
+		WithFlushBufferSize(opts.IndexOpts.FlushBufferSize).
+		WithCleanupPercentage(opts.IndexOpts.CleanupPercentage).
+		WithMaxNodeSize(opts.IndexOpts.MaxNodeSize).
+		WithNodesLogMaxOpenedFiles(opts.IndexOpts.NodesLogMaxOpenedFiles).
+		WithHistoryLogMaxOpenedFiles(opts.IndexOpts.HistoryLogMaxOpenedFiles).
+		WithCommitLogMaxOpenedFiles(opts.IndexOpts.CommitLogMaxOpenedFiles).
+		WithCompactionThld(opts.IndexOpts.CompactionThld).
+		WithDelayDuringCompaction(opts.IndexOpts.DelayDuringCompaction).
+		WithMaxBufferedDataSize(opts.IndexOpts.MaxBufferedDataSize)
+
+	if opts.appFactory != nil {
+		indexOpts.WithAppFactory(tbtree.AppFactoryFunc(opts.appFactory))
+	}
+
+	if opts.appRemove != nil {
+		indexOpts.WithAppRemoveFunc(tbtree.AppRemoveFunc(opts.appRemove))
+	}
+
+	return indexOpts
+}
+
 func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error) {
 	if store == nil {
 		return nil, fmt.Errorf("%w: nil store", ErrIllegalArguments)
@@ -117,6 +151,7 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		WithIdentifier(uint16(id - 1)).
 		WithReadOnly(opts.ReadOnly).
 		WithFileMode(opts.FileMode).
+		WithKeyProvider(opts.KeyProvider).
 		WithLogger(opts.logger).
 		WithFileSize(opts.FileSize).
 		WithCacheSize(opts.IndexOpts.CacheSize).
@@ -136,6 +171,8 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		WithCompactionThld(opts.IndexOpts.CompactionThld).
 		WithDelayDuringCompaction(opts.IndexOpts.DelayDuringCompaction).
 		WithMaxBufferedDataSize(opts.IndexOpts.MaxBufferedDataSize).
+		WithBloomFilterBitsPerKey(opts.IndexOpts.BloomFilterBitsPerKey).
+		WithBloomFilterPrefixLen(opts.IndexOpts.BloomFilterPrefixLen).
 		WithOnFlushFunc(func(releasedDataSize int) {
 			store.memSemaphore.Release(uint64(releasedDataSize))
 		})
@@ -148,6 +185,11 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		indexOpts.WithAppRemoveFunc(tbtree.AppRemoveFunc(opts.appRemove))
 	}
 
+	err := recoverIndexRebuild(path)
+	if err != nil {
+		return nil, err
+	}
+
 	index, err := tbtree.Open(path, indexOpts)
 	if err != nil {
 		return nil, err
@@ -538,12 +580,12 @@ func serializeIndexableEntry(b []byte, txmd []byte, e *TxEntry, kvmd []byte) int
 	return n
 }
 
-func (idx *indexer) mapKey(key []byte, vLen int, vOff int64, hVal [sha256.Size]byte, mapper EntryMapper) (mappedKey []byte, err error) {
+func (idx *indexer) mapKey(key []byte, vLen int, vOff int64, hVal [sha256.Size]byte, mapper EntryMapper, valBuf []byte) (mappedKey []byte, err error) {
 	if mapper == nil {
 		return key, nil
 	}
 
-	buf := idx.valBuffer(vLen)
+	buf := valBuffer(valBuf, vLen)
 	_, err = idx.store.readValueAt(buf, vOff, hVal, false)
 	if err != nil {
 		return nil, err
@@ -552,147 +594,170 @@ func (idx *indexer) mapKey(key []byte, vLen int, vOff int64, hVal [sha256.Size]b
 	return mapper(key, buf)
 }
 
-func (idx *indexer) valBuffer(vLen int) []byte {
-	if vLen > len(idx._val) {
+func valBuffer(buf []byte, vLen int) []byte {
+	if vLen > len(buf) {
 		return make([]byte, vLen)
 	}
-	return idx._val[:vLen]
+	return buf[:vLen]
 }
 
-func (idx *indexer) indexSince(txID uint64) error {
-	ctx, cancel := context.WithTimeout(context.Background(), idx.bulkPreparationTimeout)
-	defer cancel()
-
-	acquiredMem := 0
-	bulkSize := 0
+// indexableEntries fills kvs with the index entries of the transaction and returns how many were filled.
+// kvs must have room for twice the number of entries in the transaction when injective mapping is used.
+func (idx *indexer) indexableEntries(tx *Tx, kvs []*tbtree.KVT, valBuf []byte) (int, error) {
+	txID := tx.header.ID
 	indexableEntries := 0
 
-	for i := 0; i < idx.maxBulkSize; i++ {
-		err := idx.store.readTx(txID+uint64(i), false, false, idx.tx)
-		if err != nil {
-			return err
+	var txmd []byte
+
+	if tx.header.Metadata != nil {
+		txmd = tx.header.Metadata.Bytes()
+	}
+
+	for _, e := range tx.Entries() {
+		if e.md != nil && e.md.NonIndexable() {
+			continue
 		}
 
-		txIndexedEntries := 0
-		txEntries := idx.tx.Entries()
+		if !hasPrefix(e.key(), idx.spec.SourcePrefix) {
+			continue
+		}
 
-		var txmd []byte
+		sourceKey, err := idx.mapKey(e.key(), e.vLen, e.vOff, e.hVal, idx.spec.SourceEntryMapper, valBuf)
+		if errors.Is(err, ErrValueRedacted) {
+			// values are only redacted once a later deletion of the key was indexed,
+			// thus the entry can be skipped when the index is built again
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
 
-		if idx.tx.header.Metadata != nil {
-			txmd = idx.tx.header.Metadata.Bytes()
+		targetKey, err := idx.mapKey(sourceKey, e.vLen, e.vOff, e.hVal, idx.spec.TargetEntryMapper, valBuf)
+		if errors.Is(err, ErrValueRedacted) {
+			continue
+		}
+		if err != nil {
+			return 0, err
 		}
 
-		for _, e := range txEntries {
-			if e.md != nil && e.md.NonIndexable() {
-				continue
-			}
+		if !hasPrefix(targetKey, idx.spec.TargetPrefix) {
+			return 0, fmt.Errorf("%w: the target entry mapper has not generated a key with the specified target prefix", ErrIllegalArguments)
+		}
 
-			if !hasPrefix(e.key(), idx.spec.SourcePrefix) {
-				continue
-			}
+		// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmds
+		var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
 
-			sourceKey, err := idx.mapKey(e.key(), e.vLen, e.vOff, e.hVal, idx.spec.SourceEntryMapper)
-			if err != nil {
-				return err
-			}
+		var kvmd []byte
 
-			targetKey, err := idx.mapKey(sourceKey, e.vLen, e.vOff, e.hVal, idx.spec.TargetEntryMapper)
-			if err != nil {
-				return err
-			}
+		if e.Metadata() != nil {
+			kvmd = e.Metadata().Bytes()
+		}
 
-			if !hasPrefix(targetKey, idx.spec.TargetPrefix) {
-				return fmt.Errorf("%w: the target entry mapper has not generated a key with the specified target prefix", ErrIllegalArguments)
-			}
+		n := serializeIndexableEntry(b[:], txmd, e, kvmd)
 
-			// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmds
-			var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
+		kvs[indexableEntries].K = targetKey
+		kvs[indexableEntries].V = b[:n]
+		kvs[indexableEntries].T = txID
 
-			var kvmd []byte
+		indexableEntries++
 
-			if e.Metadata() != nil {
-				kvmd = e.Metadata().Bytes()
+		if idx.spec.InjectiveMapping && txID > 1 {
+			// wait for source indexer to be up to date
+			sourceIndexer, err := idx.store.getIndexerFor(sourceKey)
+			if errors.Is(err, ErrIndexNotFound) {
+				continue
+			} else if err != nil {
+				return 0, err
 			}
 
-			n := serializeIndexableEntry(b[:], txmd, e, kvmd)
+			err = sourceIndexer.WaitForIndexingUpto(context.Background(), txID-1)
+			if err != nil {
+				return 0, err
+			}
 
-			idx._kvs[indexableEntries].K = targetKey
-			idx._kvs[indexableEntries].V = b[:n]
-			idx._kvs[indexableEntries].T = txID + uint64(i)
+			// the previous entry as of txID must be deleted from the target index
+			_, prevTxID, _, err := sourceIndexer.index.GetBetween(sourceKey, 1, txID-1)
+			if err == nil {
+				prevEntry, prevTxHdr, err := idx.store.ReadTxEntry(prevTxID, e.key(), false)
+				if err != nil {
+					return 0, err
+				}
 
-			indexableEntries++
-			txIndexedEntries++
+				targetPrevKey, err := idx.mapKey(sourceKey, prevEntry.vLen, prevEntry.vOff, prevEntry.hVal, idx.spec.TargetEntryMapper, valBuf)
+				if errors.Is(err, ErrValueRedacted) {
+					// the previous entry was skipped as well
+					continue
+				}
+				if err != nil {
+					return 0, err
+				}
 
-			if idx.spec.InjectiveMapping && txID > 1 {
-				// wait for source indexer to be up to date
-				sourceIndexer, err := idx.store.getIndexerFor(sourceKey)
-				if errors.Is(err, ErrIndexNotFound) {
+				if bytes.Equal(targetKey, targetPrevKey) {
 					continue
-				} else if err != nil {
-					return err
 				}
 
-				err = sourceIndexer.WaitForIndexingUpto(context.Background(), txID-1)
-				if err != nil {
-					return err
+				if !hasPrefix(targetPrevKey, idx.spec.TargetPrefix) {
+					return 0, fmt.Errorf("%w: the target entry mapper has not generated a key with the specified target prefix", ErrIllegalArguments)
 				}
 
-				// the previous entry as of txID must be deleted from the target index
-				_, prevTxID, _, err := sourceIndexer.index.GetBetween(sourceKey, 1, txID-1)
-				if err == nil {
-					prevEntry, prevTxHdr, err := idx.store.ReadTxEntry(prevTxID, e.key(), false)
-					if err != nil {
-						return err
-					}
+				var txmd []byte
+
+				if prevTxHdr.Metadata != nil {
+					txmd = prevTxHdr.Metadata.Bytes()
+				}
 
-					targetPrevKey, err := idx.mapKey(sourceKey, prevEntry.vLen, prevEntry.vOff, prevEntry.hVal, idx.spec.TargetEntryMapper)
-					if err != nil {
-						return err
-					}
+				var kvmd *KVMetadata
 
-					if bytes.Equal(targetKey, targetPrevKey) {
-						continue
-					}
+				if prevEntry.Metadata() != nil {
+					kvmd = prevEntry.Metadata()
+				} else {
+					kvmd = NewKVMetadata()
+				}
 
-					if !hasPrefix(targetPrevKey, idx.spec.TargetPrefix) {
-						return fmt.Errorf("%w: the target entry mapper has not generated a key with the specified target prefix", ErrIllegalArguments)
-					}
+				kvmd.AsDeleted(true)
+				if err != nil {
+					return 0, err
+				}
 
-					var txmd []byte
+				var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
 
-					if prevTxHdr.Metadata != nil {
-						txmd = prevTxHdr.Metadata.Bytes()
-					}
+				n := serializeIndexableEntry(b[:], txmd, prevEntry, kvmd.Bytes())
 
-					var kvmd *KVMetadata
+				kvs[indexableEntries].K = targetPrevKey
+				kvs[indexableEntries].V = b[:n]
+				kvs[indexableEntries].T = txID
 
-					if prevEntry.Metadata() != nil {
-						kvmd = prevEntry.Metadata()
-					} else {
-						kvmd = NewKVMetadata()
-					}
+				indexableEntries++
+			} else if !errors.Is(err, ErrKeyNotFound) {
+				return 0, err
+			}
+		}
+	}
 
-					kvmd.AsDeleted(true)
-					if err != nil {
-						return err
-					}
+	return indexableEntries, nil
+}
 
-					var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
+func (idx *indexer) indexSince(txID uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), idx.bulkPreparationTimeout)
+	defer cancel()
 
-					n := serializeIndexableEntry(b[:], txmd, prevEntry, kvmd.Bytes())
+	acquiredMem := 0
+	bulkSize := 0
+	indexableEntries := 0
 
-					idx._kvs[indexableEntries].K = targetPrevKey
-					idx._kvs[indexableEntries].V = b[:n]
-					idx._kvs[indexableEntries].T = txID + uint64(i)
+	for i := 0; i < idx.maxBulkSize; i++ {
+		err := idx.store.readTx(txID+uint64(i), false, false, idx.tx)
+		if err != nil {
+			return err
+		}
 
-					indexableEntries++
-					txIndexedEntries++
-				} else if !errors.Is(err, ErrKeyNotFound) {
-					return err
-				}
-			}
+		txIndexedEntries, err := idx.indexableEntries(idx.tx, idx._kvs[indexableEntries:], idx._val[:])
+		if err != nil {
+			return err
 		}
 
+		indexableEntries += txIndexedEntries
+
 		if indexableEntries > 0 && txIndexedEntries > 0 {
 			size := estimateEntriesSize(idx._kvs[indexableEntries-txIndexedEntries : indexableEntries])
 			if !idx.store.memSemaphore.Acquire(uint64(size)) {
@@ -18,6 +18,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -299,3 +300,64 @@ func TestIndexerWriteStalling(t *testing.T) {
 		require.Equal(t, idx.Ts(), uint64(n))
 	}
 }
+
+func TestIndexBloomFilter(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithIndexOptions(
+		DefaultIndexOptions().
+			WithCompactionThld(1).
+			WithBloomFilterBitsPerKey(10),
+	)
+
+	st, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	checkLookups := func(st *ImmuStore) {
+		err := st.WaitForIndexingUpto(context.Background(), 10)
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			valRef, err := st.Get(context.Background(), []byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+		}
+
+		_, err = st.Get(context.Background(), []byte("missing"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	}
+
+	checkLookups(st)
+
+	err = st.FlushIndexes(0, true)
+	require.NoError(t, err)
+
+	err = st.CompactIndexes()
+	require.NoError(t, err)
+
+	checkLookups(st)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	st, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	checkLookups(st)
+}
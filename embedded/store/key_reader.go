@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
@@ -95,6 +96,16 @@ func (s *Snapshot) GetBetween(ctx context.Context, key []byte, initialTxID, fina
 		return nil, err
 	}
 
+	ts := s.snap.Ts()
+	if ts > finalTxID {
+		ts = finalTxID
+	}
+
+	valRef, err = s.st.rangeDeletedValueRef(key, valRef, ts)
+	if err != nil {
+		return nil, err
+	}
+
 	if s.refInterceptor != nil {
 		return s.refInterceptor(key, valRef), nil
 	}
@@ -113,6 +124,11 @@ func (s *Snapshot) GetWithFilters(ctx context.Context, key []byte, filters ...Fi
 		return nil, err
 	}
 
+	valRef, err = s.st.rangeDeletedValueRef(key, valRef, s.snap.Ts())
+	if err != nil {
+		return nil, err
+	}
+
 	for _, filter := range filters {
 		if filter == nil {
 			return nil, fmt.Errorf("%w: invalid filter function", ErrIllegalArguments)
@@ -146,6 +162,11 @@ func (s *Snapshot) GetWithPrefixAndFilters(ctx context.Context, prefix []byte, n
 		return nil, nil, err
 	}
 
+	valRef, err = s.st.rangeDeletedValueRef(key, valRef, s.snap.Ts())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	for _, filter := range filters {
 		if filter == nil {
 			return nil, nil, fmt.Errorf("%w: invalid filter function", ErrIllegalArguments)
@@ -164,6 +185,51 @@ func (s *Snapshot) GetWithPrefixAndFilters(ctx context.Context, prefix []byte, n
 	return key, valRef, nil
 }
 
+// KeyWithPrefixModifiedAfter returns true if the latest entry of any key with the given prefix
+// (it could be deleted or even expired) was set after txID, or if any of them was deleted by a range
+// tombstone after txID. Keys are scanned until the first one modified after txID is found, thus
+// the cost is linear in the number of keys with the prefix when none of them was modified.
+func (s *Snapshot) KeyWithPrefixModifiedAfter(ctx context.Context, prefix []byte, txID uint64) (bool, error) {
+	// no key could be modified after the snapshot
+	if s.snap.Ts() <= txID {
+		return false, nil
+	}
+
+	r, err := s.snap.NewReader(tbtree.ReaderSpec{Prefix: prefix})
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	for {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		// the timestamp of the indexed entry is the transaction it was set at, there is no need to decode it
+		key, _, ts, _, err := r.Read()
+		if errors.Is(err, ErrNoMoreEntries) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if ts > txID {
+			return true, nil
+		}
+
+		deletedAt, err := s.st.rangeDeletedAfter(key, txID, s.snap.Ts())
+		if err != nil {
+			return false, err
+		}
+
+		if deletedAt > 0 {
+			return true, nil
+		}
+	}
+}
+
 func (s *Snapshot) History(key []byte, offset uint64, descOrder bool, limit int) (valRefs []ValueRef, hCount uint64, err error) {
 	timedValues, hCount, err := s.snap.History(key, offset, descOrder, limit)
 	if err != nil {
@@ -416,6 +482,18 @@ func (r *storeKeyReader) ReadBetween(ctx context.Context, initialTxID, finalTxID
 			return nil, nil, err
 		}
 
+		if !r.includeHistory {
+			ts := r.snap.Ts()
+			if ts > finalTxID {
+				ts = finalTxID
+			}
+
+			val, err = r.snap.st.rangeDeletedValueRef(key, val, ts)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
 		valRef := r.refInterceptor(key, val)
 
 		filterEntry := false
@@ -455,6 +533,13 @@ func (r *storeKeyReader) Read(ctx context.Context) (key []byte, val ValueRef, er
 			return nil, nil, err
 		}
 
+		if !r.includeHistory {
+			val, err = r.snap.st.rangeDeletedValueRef(key, val, r.snap.Ts())
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
 		valRef := r.refInterceptor(key, val)
 
 		filterEntry := false
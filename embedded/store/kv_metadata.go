@@ -32,15 +32,18 @@ const (
 	deletedAttrCode      attributeCode = 0
 	expiresAtAttrCode    attributeCode = 1
 	nonIndexableAttrCode attributeCode = 2
+	deletedRangeAttrCode attributeCode = 3
 )
 
 const deletedAttrSize = 0
 const expiresAtAttrSize = tsSize
 const nonIndexableAttrSize = 0
+const deletedRangeAttrSize = 0
 
 const maxKVMetadataLen = (attrCodeSize + deletedAttrSize) +
 	(attrCodeSize + expiresAtAttrSize) +
-	(attrCodeSize + nonIndexableAttrSize)
+	(attrCodeSize + nonIndexableAttrSize) +
+	(attrCodeSize + deletedRangeAttrSize)
 
 type KVMetadata struct {
 	attributes map[attributeCode]attribute
@@ -101,6 +104,23 @@ func (a *nonIndexableAttribute) deserialize(b []byte) (int, error) {
 	return 0, nil
 }
 
+// deletedRangeAttribute marks the entry as a range tombstone,
+// the entry key is the start of the range and its value the (exclusive) end of it
+type deletedRangeAttribute struct {
+}
+
+func (a *deletedRangeAttribute) code() attributeCode {
+	return deletedRangeAttrCode
+}
+
+func (a *deletedRangeAttribute) serialize() []byte {
+	return nil
+}
+
+func (a *deletedRangeAttribute) deserialize(b []byte) (int, error) {
+	return 0, nil
+}
+
 func NewKVMetadata() *KVMetadata {
 	return &KVMetadata{
 		attributes: make(map[attributeCode]attribute),
@@ -204,10 +224,34 @@ func (md *KVMetadata) NonIndexable() bool {
 	return ok
 }
 
+// AsDeletedRange marks the entry as a range tombstone, range tombstones are also deleted entries
+func (md *KVMetadata) AsDeletedRange(deletedRange bool) error {
+	if md.readonly {
+		return ErrReadOnly
+	}
+
+	if !deletedRange {
+		delete(md.attributes, deletedRangeAttrCode)
+		return nil
+	}
+
+	_, ok := md.attributes[deletedRangeAttrCode]
+	if !ok {
+		md.attributes[deletedRangeAttrCode] = &deletedRangeAttribute{}
+	}
+
+	return md.AsDeleted(true)
+}
+
+func (md *KVMetadata) DeletedRange() bool {
+	_, ok := md.attributes[deletedRangeAttrCode]
+	return ok
+}
+
 func (md *KVMetadata) Bytes() []byte {
 	var b bytes.Buffer
 
-	for _, attrCode := range []attributeCode{deletedAttrCode, expiresAtAttrCode, nonIndexableAttrCode} {
+	for _, attrCode := range []attributeCode{deletedAttrCode, expiresAtAttrCode, nonIndexableAttrCode, deletedRangeAttrCode} {
 		attr, ok := md.attributes[attrCode]
 		if ok {
 			b.WriteByte(byte(attr.code()))
@@ -269,6 +313,10 @@ func newAttribute(attrCode attributeCode) (attribute, error) {
 		{
 			return &nonIndexableAttribute{}, nil
 		}
+	case deletedRangeAttrCode:
+		{
+			return &deletedRangeAttribute{}, nil
+		}
 	default:
 		{
 			return nil, fmt.Errorf("error reading metadata attributes: %w", ErrCorruptedData)
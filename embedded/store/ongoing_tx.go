@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/codenotary/immudb/embedded/hashing"
 )
 
 // OngoingTx (no-thread safe) represents an interactive or incremental transaction with support of RYOW.
@@ -130,10 +132,11 @@ func newOngoingTx(ctx context.Context, s *ImmuStore, opts *TxOptions) (*OngoingT
 }
 
 type ongoingValRef struct {
-	value []byte
-	hc    uint64
-	txmd  *TxMetadata
-	kvmd  *KVMetadata
+	value   []byte
+	hc      uint64
+	txmd    *TxMetadata
+	kvmd    *KVMetadata
+	hashAlg hashing.Algorithm
 }
 
 func (oref *ongoingValRef) Resolve() (val []byte, err error) {
@@ -157,7 +160,7 @@ func (oref *ongoingValRef) KVMetadata() *KVMetadata {
 }
 
 func (oref *ongoingValRef) HVal() [sha256.Size]byte {
-	return sha256.Sum256(oref.value)
+	return oref.hashAlg.Sum(oref.value)
 }
 
 func (oref *ongoingValRef) Len() uint32 {
@@ -227,10 +230,11 @@ func (tx *OngoingTx) snap(key []byte) (*Snapshot, error) {
 		}
 
 		return &ongoingValRef{
-			hc:    valRef.HC(),
-			value: entrySpec.Value,
-			txmd:  tx.metadata,
-			kvmd:  entrySpec.Metadata,
+			hc:      valRef.HC(),
+			value:   entrySpec.Value,
+			txmd:    tx.metadata,
+			kvmd:    entrySpec.Metadata,
+			hashAlg: tx.st.hashAlgorithm,
 		}
 	}
 
@@ -336,8 +340,9 @@ func (tx *OngoingTx) set(key []byte, md *KVMetadata, value []byte, hashValue [sh
 			if isKeyUpdate {
 				tx.transientEntries[keyRef] = e
 			} else {
-				tx.transientEntries[len(tx.entriesByKey)] = e
-				tx.entriesByKey[kid] = len(tx.entriesByKey)
+				keyRef := tx.newTransientRef()
+				tx.transientEntries[keyRef] = e
+				tx.entriesByKey[kid] = keyRef
 			}
 		}
 	}
@@ -350,8 +355,9 @@ func (tx *OngoingTx) set(key []byte, md *KVMetadata, value []byte, hashValue [sh
 		}
 	} else {
 		if isTransient {
-			tx.transientEntries[len(tx.entriesByKey)] = e
-			tx.entriesByKey[kid] = len(tx.entriesByKey)
+			keyRef := tx.newTransientRef()
+			tx.transientEntries[keyRef] = e
+			tx.entriesByKey[kid] = keyRef
 		} else {
 			tx.entries = append(tx.entries, e)
 			tx.entriesByKey[kid] = len(tx.entries) - 1
@@ -362,6 +368,13 @@ func (tx *OngoingTx) set(key []byte, md *KVMetadata, value []byte, hashValue [sh
 	return nil
 }
 
+// newTransientRef returns a reference for a new transient entry.
+// Transient entries are referenced by negative numbers so they never
+// collide with the positions of the entries of the transaction.
+func (tx *OngoingTx) newTransientRef() int {
+	return -(len(tx.transientEntries) + 1)
+}
+
 func mapKey(key []byte, value []byte, mapper EntryMapper) (mappedKey []byte, err error) {
 	if mapper == nil {
 		return key, nil
@@ -426,6 +439,34 @@ func (tx *OngoingTx) Delete(ctx context.Context, key []byte) error {
 	return tx.Set(key, md, nil)
 }
 
+// DeleteRange logically deletes every key in the range [start, end) set by previous transactions,
+// keys set by the transaction itself are not deleted. An empty end means the range has no upper bound.
+// A single range tombstone entry is written, set at the start key.
+// Range deletions are not supported when the authenticated index is enabled.
+func (tx *OngoingTx) DeleteRange(start, end []byte) error {
+	if tx.closed {
+		return ErrAlreadyClosed
+	}
+
+	if tx.IsReadOnly() {
+		return ErrReadOnlyTx
+	}
+
+	if tx.st.AuthenticatedIndexEnabled() {
+		return ErrRangeDeletionNotAuthenticated
+	}
+
+	if len(end) > 0 && bytes.Compare(start, end) >= 0 {
+		return fmt.Errorf("%w: the end of the range must be greater than its start", ErrIllegalArguments)
+	}
+
+	md := NewKVMetadata()
+
+	md.AsDeletedRange(true)
+
+	return tx.Set(start, md, end)
+}
+
 func (tx *OngoingTx) Get(ctx context.Context, key []byte) (ValueRef, error) {
 	return tx.GetWithFilters(ctx, key, IgnoreExpired, IgnoreDeleted)
 }
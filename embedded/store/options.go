@@ -23,8 +23,10 @@ import (
 
 	"github.com/codenotary/immudb/embedded/ahtree"
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/cache"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/pkg/helpers/semaphore"
@@ -44,6 +46,8 @@ const DefaultCompressionFormat = appendable.DefaultCompressionFormat
 const DefaultCompressionLevel = appendable.DefaultCompressionLevel
 const DefaultEmbeddedValues = false
 const DefaultPreallocFiles = false
+const DefaultHashAlgorithm = hashing.DefaultAlgorithm
+const DefaultAuthenticatedIndex = false
 const DefaultTxLogCacheSize = 1000
 const DefaultVLogCacheSize = 0
 const DefaultMaxWaitees = 1000
@@ -91,10 +95,18 @@ type Options struct {
 
 	FileMode os.FileMode
 
+	// Key provider used to encrypt data at rest, data is not encrypted when not set.
+	// Keys used by existing files must remain available
+	KeyProvider encryptedapp.KeyProvider
+
 	logger logger.Logger
 
 	appFactory AppFactoryFunc
 
+	// vLogAppFactory is used to open value logs, e.g. to place values into a tiered storage
+	// while the transaction log and indexes are kept local. When not set, appFactory is used.
+	vLogAppFactory AppFactoryFunc
+
 	appRemove AppRemoveFunc
 
 	CompactionDisabled bool
@@ -147,6 +159,18 @@ type Options struct {
 	CompressionLevel  int
 	EmbeddedValues    bool
 	PreallocFiles     bool
+	HashAlgorithm     hashing.Algorithm
+
+	// AuthenticatedIndex maintains a sparse Merkle tree of the latest entry of every key,
+	// its root is committed into the metadata of each transaction so to provide proofs
+	// of membership and non-membership of keys at any transaction.
+	// The tree is kept in memory and rebuilt from the transaction log when the store is opened.
+	// Range deletions are rejected while it's enabled.
+	AuthenticatedIndex bool
+
+	// CompressionFormat, CompressionLevel and CompressionDictionary are recorded in
+	// the header of each value log file, so they may be changed between openings
+	CompressionDictionary []byte
 
 	// options below affect indexing
 	IndexOpts *IndexOptions
@@ -206,6 +230,12 @@ type IndexOptions struct {
 
 	// Maximum time waiting for more transactions to be committed and included into the same bulk
 	BulkPreparationTimeout time.Duration
+
+	// Bits per key of the Btree bloom filter used to speed up lookups of missing keys, zero disables it
+	BloomFilterBitsPerKey int
+
+	// Length of key prefixes added to the Btree bloom filter to speed up prefix lookups, zero disables it
+	BloomFilterPrefixLen int
 }
 
 type AHTOptions struct {
@@ -255,6 +285,9 @@ func DefaultOptions() *Options {
 		CompressionLevel:  DefaultCompressionLevel,
 		EmbeddedValues:    DefaultEmbeddedValues,
 		PreallocFiles:     DefaultPreallocFiles,
+		HashAlgorithm:     DefaultHashAlgorithm,
+
+		AuthenticatedIndex: DefaultAuthenticatedIndex,
 
 		IndexOpts: DefaultIndexOptions(),
 		AHTOpts:   DefaultAHTOptions(),
@@ -278,6 +311,8 @@ func DefaultIndexOptions() *IndexOptions {
 		NodesLogMaxOpenedFiles:    tbtree.DefaultNodesLogMaxOpenedFiles,
 		HistoryLogMaxOpenedFiles:  tbtree.DefaultHistoryLogMaxOpenedFiles,
 		CommitLogMaxOpenedFiles:   tbtree.DefaultCommitLogMaxOpenedFiles,
+		BloomFilterBitsPerKey:     tbtree.DefaultBloomFilterBitsPerKey,
+		BloomFilterPrefixLen:      tbtree.DefaultBloomFilterPrefixLen,
 
 		MaxBulkSize:            DefaultIndexingMaxBulkSize,
 		BulkPreparationTimeout: DefaultBulkPreparationTimeout,
@@ -367,6 +402,21 @@ func (opts *Options) Validate() error {
 	if opts.FileSize <= 0 || opts.FileSize >= MaxFileSize {
 		return fmt.Errorf("%w: invalid FileSize", ErrInvalidOptions)
 	}
+	if opts.CompressionFormat < appendable.NoCompression || opts.CompressionFormat > appendable.LZ4Compression {
+		return fmt.Errorf("%w: invalid CompressionFormat", ErrInvalidOptions)
+	}
+	if !opts.HashAlgorithm.IsValid() {
+		return fmt.Errorf("%w: invalid HashAlgorithm", ErrInvalidOptions)
+	}
+	if len(opts.CompressionDictionary) > 0 && opts.CompressionFormat != appendable.ZStdCompression {
+		return fmt.Errorf("%w: invalid CompressionDictionary, dictionaries are only supported with zstd compression", ErrInvalidOptions)
+	}
+	if opts.KeyProvider != nil && opts.CompressionFormat != appendable.NoCompression {
+		return fmt.Errorf("%w: invalid CompressionFormat, compression is not supported with encryption", ErrInvalidOptions)
+	}
+	if opts.KeyProvider != nil && opts.PreallocFiles {
+		return fmt.Errorf("%w: invalid PreallocFiles, preallocation is not supported with encryption", ErrInvalidOptions)
+	}
 	if opts.logger == nil {
 		return fmt.Errorf("%w: invalid log", ErrInvalidOptions)
 	}
@@ -437,6 +487,12 @@ func (opts *IndexOptions) Validate() error {
 	if opts.CommitLogMaxOpenedFiles <= 0 {
 		return fmt.Errorf("%w: invalid index option CommitLogMaxOpenedFiles", ErrInvalidOptions)
 	}
+	if opts.BloomFilterBitsPerKey < 0 || opts.BloomFilterBitsPerKey > tbtree.MaxBloomFilterBitsPerKey {
+		return fmt.Errorf("%w: invalid index option BloomFilterBitsPerKey", ErrInvalidOptions)
+	}
+	if opts.BloomFilterPrefixLen < 0 {
+		return fmt.Errorf("%w: invalid index option BloomFilterPrefixLen", ErrInvalidOptions)
+	}
 
 	return nil
 }
@@ -490,6 +546,11 @@ func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	return opts
 }
 
+func (opts *Options) WithVLogAppFactory(vLogAppFactory AppFactoryFunc) *Options {
+	opts.vLogAppFactory = vLogAppFactory
+	return opts
+}
+
 func (opts *Options) WithAppRemoveFunc(appRemove AppRemoveFunc) *Options {
 	opts.appRemove = appRemove
 	return opts
@@ -590,6 +651,11 @@ func (opts *Options) WithWriteTxHeaderVersion(version int) *Options {
 	return opts
 }
 
+func (opts *Options) WithKeyProvider(keyProvider encryptedapp.KeyProvider) *Options {
+	opts.KeyProvider = keyProvider
+	return opts
+}
+
 func (opts *Options) WithCompressionFormat(compressionFormat int) *Options {
 	opts.CompressionFormat = compressionFormat
 	return opts
@@ -600,6 +666,21 @@ func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opts
 }
 
+func (opts *Options) WithCompressionDictionary(dict []byte) *Options {
+	opts.CompressionDictionary = dict
+	return opts
+}
+
+func (opts *Options) WithHashAlgorithm(hashAlgorithm hashing.Algorithm) *Options {
+	opts.HashAlgorithm = hashAlgorithm
+	return opts
+}
+
+func (opts *Options) WithAuthenticatedIndex(authenticatedIndex bool) *Options {
+	opts.AuthenticatedIndex = authenticatedIndex
+	return opts
+}
+
 func (opts *Options) WithEmbeddedValues(embeddedValues bool) *Options {
 	opts.EmbeddedValues = embeddedValues
 	return opts
@@ -707,6 +788,16 @@ func (opts *IndexOptions) WithMaxGlobalBufferedDataSize(size int) *IndexOptions
 	return opts
 }
 
+func (opts *IndexOptions) WithBloomFilterBitsPerKey(bitsPerKey int) *IndexOptions {
+	opts.BloomFilterBitsPerKey = bitsPerKey
+	return opts
+}
+
+func (opts *IndexOptions) WithBloomFilterPrefixLen(prefixLen int) *IndexOptions {
+	opts.BloomFilterPrefixLen = prefixLen
+	return opts
+}
+
 // AHTOptions
 
 func (opts *AHTOptions) WithWriteBufferSize(writeBufferSize int) *AHTOptions {
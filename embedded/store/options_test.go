@@ -22,6 +22,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,6 +55,9 @@ func TestInvalidOptions(t *testing.T) {
 		{"MaxKeyLen-max", DefaultOptions().WithMaxKeyLen(MaxKeyLen + 1)},
 		{"MaxValueLen", DefaultOptions().WithMaxValueLen(0)},
 		{"FileSize", DefaultOptions().WithFileSize(0)},
+		{"CompressionFormat", DefaultOptions().WithCompressionFormat(appendable.LZ4Compression + 1)},
+		{"CompressionDictionary", DefaultOptions().WithCompressionFormat(appendable.LZ4Compression).WithCompressionDictionary([]byte{1})},
+		{"HashAlgorithm", DefaultOptions().WithHashAlgorithm(hashing.Algorithm(99))},
 		{"FileSize-max", DefaultOptions().WithFileSize(MaxFileSize)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
@@ -88,6 +92,8 @@ func TestInvalidIndexOptions(t *testing.T) {
 		{"MaxGlobalBufferedDataSize", DefaultIndexOptions().WithMaxGlobalBufferedDataSize(0)},
 		{"MaxGlobalBufferedDataSize", DefaultIndexOptions().WithMaxGlobalBufferedDataSize(DefaultIndexOptions().MaxBufferedDataSize - 1)},
 		{"MaxBufferedDataSize", DefaultIndexOptions().WithMaxBufferedDataSize(0)},
+		{"BloomFilterBitsPerKey", DefaultIndexOptions().WithBloomFilterBitsPerKey(-1)},
+		{"BloomFilterPrefixLen", DefaultIndexOptions().WithBloomFilterPrefixLen(-1)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -124,6 +130,10 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, DefaultMaxConcurrency, opts.WithMaxConcurrency(DefaultMaxConcurrency).MaxConcurrency)
 	require.Equal(t, 1<<20, opts.WithWriteBufferSize(1<<20).WriteBufferSize)
 	require.Equal(t, DefaultFileMode, opts.WithFileMode(DefaultFileMode).FileMode)
+	require.Nil(t, opts.WithKeyProvider(nil).KeyProvider)
+	require.Equal(t, []byte{1}, opts.WithCompressionDictionary([]byte{1}).CompressionDictionary)
+	require.Equal(t, hashing.BLAKE3, opts.WithHashAlgorithm(hashing.BLAKE3).HashAlgorithm)
+	opts.WithCompressionDictionary(nil)
 	require.Equal(t, DefaultFileSize, opts.WithFileSize(DefaultFileSize).FileSize)
 	require.Equal(t, DefaultSyncFrequency, opts.WithSyncFrequency(DefaultSyncFrequency).SyncFrequency)
 	require.Equal(t, DefaultMaxActiveTransactions, opts.WithMaxActiveTransactions(DefaultMaxActiveTransactions).MaxActiveTransactions)
@@ -174,6 +184,9 @@ func TestValidOptions(t *testing.T) {
 	opts.appFactory("", "", nil)
 	require.True(t, appFactoryCalled)
 
+	require.NotNil(t, opts.WithVLogAppFactory(appFactory).vLogAppFactory)
+	require.NoError(t, opts.Validate())
+
 	require.Nil(t, opts.WithIndexOptions(nil).IndexOpts)
 	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
 
@@ -194,6 +207,8 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 10, indexOpts.WithNodesLogMaxOpenedFiles(10).NodesLogMaxOpenedFiles)
 	require.Equal(t, 11, indexOpts.WithHistoryLogMaxOpenedFiles(11).HistoryLogMaxOpenedFiles)
 	require.Equal(t, 12, indexOpts.WithCommitLogMaxOpenedFiles(12).CommitLogMaxOpenedFiles)
+	require.Equal(t, 10, indexOpts.WithBloomFilterBitsPerKey(10).BloomFilterBitsPerKey)
+	require.Equal(t, 4, indexOpts.WithBloomFilterPrefixLen(4).BloomFilterPrefixLen)
 	require.Equal(t, 3, indexOpts.WithCompactionThld(3).CompactionThld)
 	require.Equal(t, 1*time.Millisecond, indexOpts.WithDelayDuringCompaction(1*time.Millisecond).DelayDuringCompaction)
 	require.Equal(t, 4096*2, indexOpts.WithFlushBufferSize(4096*2).FlushBufferSize)
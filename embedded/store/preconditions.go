@@ -18,7 +18,9 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/tbtree"
 )
@@ -123,3 +125,112 @@ func (cs *PreconditionKeyNotModifiedAfterTx) Check(ctx context.Context, idx KeyI
 
 	return valRef.Tx() <= cs.TxID, nil
 }
+
+type PreconditionKeyValueHashEquals struct {
+	Key       []byte
+	ValueHash [sha256.Size]byte
+}
+
+func (cs *PreconditionKeyValueHashEquals) String() string { return "KeyValueHashEquals" }
+
+func (cs *PreconditionKeyValueHashEquals) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyValueHashEquals) Check(ctx context.Context, idx KeyIndex) (bool, error) {
+	valRef, err := idx.Get(ctx, cs.Key)
+	if err != nil && errors.Is(err, ErrKeyNotFound) {
+		// deleted or expired keys have no value to compare with
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return valRef.HVal() == cs.ValueHash, nil
+}
+
+// PreconditionNoKeyWithPrefixModifiedAfterTx holds when no key with the prefix was modified after the transaction.
+// It's checked while committing by scanning the keys with the prefix until one modified after the transaction
+// is found, thus its cost grows with the number of keys with the prefix and it should be used with narrow prefixes.
+type PreconditionNoKeyWithPrefixModifiedAfterTx struct {
+	Prefix []byte
+	TxID   uint64
+}
+
+func (cs *PreconditionNoKeyWithPrefixModifiedAfterTx) String() string {
+	return "NoKeyWithPrefixModifiedAfterTxID"
+}
+
+func (cs *PreconditionNoKeyWithPrefixModifiedAfterTx) Validate(st *ImmuStore) error {
+	if len(cs.Prefix) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	if cs.TxID == 0 {
+		return ErrInvalidPreconditionInvalidTxID
+	}
+
+	return nil
+}
+
+func (cs *PreconditionNoKeyWithPrefixModifiedAfterTx) Check(ctx context.Context, idx KeyIndex) (bool, error) {
+	// the latest entry of every key with the prefix is considered (it could be deleted or even expired)
+	modified, err := idx.KeyWithPrefixModifiedAfter(ctx, cs.Prefix, cs.TxID)
+	if err != nil {
+		return false, err
+	}
+
+	return !modified, nil
+}
+
+type PreconditionKeyExpiresAfter struct {
+	Key       []byte
+	ExpiresAt time.Time
+}
+
+func (cs *PreconditionKeyExpiresAfter) String() string { return "KeyExpiresAfter" }
+
+func (cs *PreconditionKeyExpiresAfter) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyExpiresAfter) Check(ctx context.Context, idx KeyIndex) (bool, error) {
+	valRef, err := idx.Get(ctx, cs.Key)
+	if err != nil && errors.Is(err, ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	md := valRef.KVMetadata()
+
+	if md == nil || !md.IsExpirable() {
+		// the key never expires
+		return true, nil
+	}
+
+	expiresAt, err := md.ExpirationTime()
+	if err != nil {
+		return false, err
+	}
+
+	return expiresAt.After(cs.ExpiresAt), nil
+}
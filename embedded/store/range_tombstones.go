@@ -0,0 +1,523 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+const rangeTombstonesDirname = "range_tombstones"
+
+// rangeTombstone logically deletes every key in [start, end) set before the transaction txID
+type rangeTombstone struct {
+	txID  uint64
+	start []byte
+	end   []byte // exclusive, an empty end means the range has no upper bound
+}
+
+func (t *rangeTombstone) covers(key []byte) bool {
+	return bytes.Compare(t.start, key) <= 0 && (len(t.end) == 0 || bytes.Compare(key, t.end) < 0)
+}
+
+// Range tombstones of committed transactions are applied into a tbtree which splits the key space
+// into disjoint segments. Every segment is stored under the key it starts at, its value is the
+// transaction of the latest tombstone covering it (zero when none) and its timestamp is the
+// transaction in which the segment was created or covered. As tombstones are only applied in
+// increasing order of transactions, the latest tombstone covering a key as of a given transaction
+// is found by looking up the greatest segment starting at or before the key as of that transaction.
+//
+// Tombstones of pre-committed transactions are kept in memory until they are applied, as
+// pre-committed transactions may be discarded.
+
+// rangeDeletedValRef is the value reference of a key deleted by a range tombstone
+type rangeDeletedValRef struct {
+	tx   uint64
+	hc   uint64
+	kvmd *KVMetadata
+}
+
+func (v *rangeDeletedValRef) Resolve() (val []byte, err error) {
+	return nil, nil
+}
+
+func (v *rangeDeletedValRef) Tx() uint64 {
+	return v.tx
+}
+
+func (v *rangeDeletedValRef) HC() uint64 {
+	return v.hc
+}
+
+func (v *rangeDeletedValRef) TxMetadata() *TxMetadata {
+	return nil
+}
+
+func (v *rangeDeletedValRef) KVMetadata() *KVMetadata {
+	return v.kvmd
+}
+
+func (v *rangeDeletedValRef) HVal() [sha256.Size]byte {
+	return [sha256.Size]byte{}
+}
+
+func (v *rangeDeletedValRef) Len() uint32 {
+	return 0
+}
+
+func (v *rangeDeletedValRef) VOff() int64 {
+	return 0
+}
+
+// rangeDeletedValueRef returns a deleted value reference when the key was deleted by a range tombstone
+// after the transaction the value was set at, only tombstones up to transaction ts are considered
+func (s *ImmuStore) rangeDeletedValueRef(key []byte, valRef ValueRef, ts uint64) (ValueRef, error) {
+	txID, err := s.rangeDeletedAfter(key, valRef.Tx(), ts)
+	if err != nil {
+		return nil, err
+	}
+
+	if txID == 0 {
+		return valRef, nil
+	}
+
+	kvmd := newReadOnlyKVMetadata()
+	kvmd.attributes[deletedAttrCode] = &deletedAttribute{}
+
+	return &rangeDeletedValRef{
+		tx:   txID,
+		hc:   valRef.HC(),
+		kvmd: kvmd,
+	}, nil
+}
+
+// rangeDeletedAfter returns the transaction of the latest range tombstone covering the key
+// set after sinceTxID and up to transaction ts, zero if there is none
+func (s *ImmuStore) rangeDeletedAfter(key []byte, sinceTxID, ts uint64) (uint64, error) {
+	s.rangeTombstonesMutex.RLock()
+	defer s.rangeTombstonesMutex.RUnlock()
+
+	return s.rangeDeletedAt(key, sinceTxID, ts)
+}
+
+// rangeDeletedAt returns the transaction of the latest range tombstone covering the key
+// set after sinceTxID and up to transaction ts, zero if there is none.
+// The caller must hold the range tombstones mutex.
+func (s *ImmuStore) rangeDeletedAt(key []byte, sinceTxID, ts uint64) (uint64, error) {
+	// pending tombstones are sorted by transaction, the latest one covering the key is taken
+	for i := len(s.rangeTombstones) - 1; i >= 0; i-- {
+		t := s.rangeTombstones[i]
+
+		if t.txID <= sinceTxID {
+			return 0, nil
+		}
+
+		if t.txID <= ts && t.covers(key) {
+			return t.txID, nil
+		}
+	}
+
+	if s.rangeTombstonesIndex == nil {
+		return 0, nil
+	}
+
+	appliedTxID := s.rangeTombstonesIndex.Ts()
+	if ts > appliedTxID {
+		ts = appliedTxID
+	}
+
+	if ts <= sinceTxID {
+		return 0, nil
+	}
+
+	_, txID, err := rangeTombstonesSegmentOf(s.rangeTombstonesSnap, key, true, ts)
+	if err != nil {
+		return 0, err
+	}
+
+	if txID <= sinceTxID {
+		return 0, nil
+	}
+
+	return txID, nil
+}
+
+// rangeTombstonesSegmentOf returns the start of the segment holding the key as of transaction ts,
+// and the transaction of the latest tombstone covering it
+func rangeTombstonesSegmentOf(snap *tbtree.Snapshot, key []byte, inclusive bool, ts uint64) ([]byte, uint64, error) {
+	r, err := snap.NewReader(tbtree.ReaderSpec{
+		SeekKey:       key,
+		InclusiveSeek: inclusive,
+		DescOrder:     true,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer r.Close()
+
+	start, v, _, _, err := r.ReadBetween(0, ts)
+	if errors.Is(err, tbtree.ErrNoMoreEntries) {
+		// keys before the first segment were never covered by a tombstone
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return start, binary.BigEndian.Uint64(v), nil
+}
+
+func (s *ImmuStore) openRangeTombstonesIndex() (*tbtree.TBtree, error) {
+	indexOpts := auxiliaryIndexOptions(s.opts).
+		WithMaxKeySize(s.maxKeyLen).
+		WithMaxValueSize(txIDSize)
+
+	return tbtree.Open(filepath.Join(s.path, rangeTombstonesDirname), indexOpts)
+}
+
+// loadRangeTombstones opens the index of range tombstones and replays the transactions not yet applied,
+// the index is only created once the first range tombstone is pre-committed
+func (s *ImmuStore) loadRangeTombstones() error {
+	_, err := os.Stat(filepath.Join(s.path, rangeTombstonesDirname))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.rangeTombstonesIndex, err = s.openRangeTombstonesIndex()
+	if err != nil {
+		return err
+	}
+
+	s.rangeTombstonesSnap, err = s.rangeTombstonesIndex.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	fromTxID := s.rangeTombstonesIndex.Ts() + 1
+
+	if fromTxID > s.inmemPrecommittedTxID {
+		return nil
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	txReader, err := s.newTxReader(fromTxID, false, true, false, tx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		tx, err := txReader.Read()
+		if errors.Is(err, ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, e := range tx.Entries() {
+			if e.md == nil || !e.md.DeletedRange() {
+				continue
+			}
+
+			end := make([]byte, e.vLen)
+
+			_, err = s.readValueAt(end, e.vOff, e.hVal, false)
+			if errors.Is(err, io.EOF) {
+				// the value was not replicated
+				s.logger.Warningf("range tombstone with truncated value at tx %d is not honored", tx.header.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			start := make([]byte, e.kLen)
+			copy(start, e.Key())
+
+			s.rangeTombstones = append(s.rangeTombstones, &rangeTombstone{
+				txID:  tx.header.ID,
+				start: start,
+				end:   end,
+			})
+		}
+	}
+
+	if s.readOnly {
+		return nil
+	}
+
+	return s.applyRangeTombstones(s.committedTxID)
+}
+
+// appendRangeTombstones registers the range tombstones contained in the transaction,
+// they are kept in memory until the transaction is committed
+func (s *ImmuStore) appendRangeTombstones(entries []*EntrySpec, txID uint64) error {
+	// a previous attempt to precommit a transaction may have failed after appending its tombstones
+	s.discardRangeTombstonesSince(txID)
+
+	for _, e := range entries {
+		if e.Metadata == nil || !e.Metadata.DeletedRange() {
+			continue
+		}
+
+		if e.IsValueTruncated {
+			// the end of the range is unknown when the value was not provided
+			s.logger.Warningf("range tombstone with truncated value at tx %d is not honored", txID)
+			continue
+		}
+
+		if s.rangeTombstonesIndex == nil {
+			err := s.createRangeTombstonesIndex()
+			if err != nil {
+				return err
+			}
+		}
+
+		s.rangeTombstonesMutex.Lock()
+		s.rangeTombstones = append(s.rangeTombstones, &rangeTombstone{
+			txID:  txID,
+			start: e.Key,
+			end:   e.Value,
+		})
+		s.rangeTombstonesMutex.Unlock()
+	}
+
+	return nil
+}
+
+func (s *ImmuStore) createRangeTombstonesIndex() error {
+	index, err := s.openRangeTombstonesIndex()
+	if err != nil {
+		return err
+	}
+
+	// previous transactions contain no range tombstones, thus they are not replayed
+	if s.committedTxID > 0 {
+		err = index.IncreaseTs(s.committedTxID)
+		if err == nil {
+			_, _, err = index.Flush()
+		}
+		if err != nil {
+			index.Close()
+			return err
+		}
+	}
+
+	snap, err := index.Snapshot()
+	if err != nil {
+		index.Close()
+		return err
+	}
+
+	s.rangeTombstonesMutex.Lock()
+	defer s.rangeTombstonesMutex.Unlock()
+
+	s.rangeTombstonesIndex = index
+	s.rangeTombstonesSnap = snap
+
+	return nil
+}
+
+// discardRangeTombstonesSince discards the range tombstones of transactions since txID (inclusive),
+// only tombstones of pre-committed transactions can be discarded
+func (s *ImmuStore) discardRangeTombstonesSince(txID uint64) {
+	s.rangeTombstonesMutex.Lock()
+	defer s.rangeTombstonesMutex.Unlock()
+
+	i := len(s.rangeTombstones)
+	for i > 0 && s.rangeTombstones[i-1].txID >= txID {
+		i--
+	}
+
+	s.rangeTombstones = s.rangeTombstones[:i]
+}
+
+// applyRangeTombstones applies the range tombstones of transactions up to txID (inclusive) into the index,
+// applied tombstones are no longer kept in memory
+func (s *ImmuStore) applyRangeTombstones(txID uint64) error {
+	s.rangeTombstonesMutex.Lock()
+	defer s.rangeTombstonesMutex.Unlock()
+
+	if s.rangeTombstonesIndex == nil {
+		return nil
+	}
+
+	applied := 0
+
+	for applied < len(s.rangeTombstones) && s.rangeTombstones[applied].txID <= txID {
+		// tombstones of the same transaction are applied at once
+		n := 1
+		for applied+n < len(s.rangeTombstones) && s.rangeTombstones[applied+n].txID == s.rangeTombstones[applied].txID {
+			n++
+		}
+
+		err := s.applyTxRangeTombstones(s.rangeTombstones[applied : applied+n])
+		if err != nil {
+			return err
+		}
+
+		applied += n
+	}
+
+	if applied > 0 {
+		pending := make([]*rangeTombstone, len(s.rangeTombstones)-applied)
+		copy(pending, s.rangeTombstones[applied:])
+		s.rangeTombstones = pending
+
+		// readers are released before the snapshot is renewed
+		err := s.rangeTombstonesSnap.Close()
+		if err != nil {
+			return err
+		}
+
+		s.rangeTombstonesSnap, err = s.rangeTombstonesIndex.SnapshotMustIncludeTs(s.rangeTombstonesIndex.Ts())
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.rangeTombstonesIndex.Ts() < txID {
+		return s.rangeTombstonesIndex.IncreaseTs(txID)
+	}
+
+	return nil
+}
+
+// applyTxRangeTombstones updates the segments covered by the range tombstones of a single transaction
+func (s *ImmuStore) applyTxRangeTombstones(tombstones []*rangeTombstone) error {
+	txID := tombstones[0].txID
+
+	snap, err := s.rangeTombstonesIndex.SnapshotMustIncludeTs(s.rangeTombstonesIndex.Ts())
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	// segments updated by the transaction
+	segments := make(map[string]uint64)
+
+	segmentOf := func(key []byte) (uint64, error) {
+		var pendingStart []byte
+
+		for start := range segments {
+			if start <= string(key) && start > string(pendingStart) {
+				pendingStart = []byte(start)
+			}
+		}
+
+		start, coveredBy, err := rangeTombstonesSegmentOf(snap, key, true, 0)
+		if err != nil {
+			return 0, err
+		}
+
+		if pendingStart != nil && bytes.Compare(pendingStart, start) >= 0 {
+			return segments[string(pendingStart)], nil
+		}
+
+		return coveredBy, nil
+	}
+
+	for _, t := range tombstones {
+		if len(t.end) > 0 {
+			// the segment following the range keeps its coverage
+			coveredBy, err := segmentOf(t.end)
+			if err != nil {
+				return err
+			}
+
+			segments[string(t.end)] = coveredBy
+		}
+
+		segments[string(t.start)] = txID
+
+		// segments starting within the range are covered by the tombstone
+		r, err := snap.NewReader(tbtree.ReaderSpec{SeekKey: t.start, EndKey: t.end})
+		if err != nil {
+			return err
+		}
+
+		for {
+			start, _, _, _, err := r.Read()
+			if errors.Is(err, tbtree.ErrNoMoreEntries) {
+				break
+			}
+			if err != nil {
+				r.Close()
+				return err
+			}
+
+			segments[string(start)] = txID
+		}
+
+		r.Close()
+
+		for start := range segments {
+			if t.covers([]byte(start)) {
+				segments[start] = txID
+			}
+		}
+	}
+
+	kvts := make([]*tbtree.KVT, 0, len(segments))
+
+	for start, coveredBy := range segments {
+		var v [txIDSize]byte
+		binary.BigEndian.PutUint64(v[:], coveredBy)
+
+		kvts = append(kvts, &tbtree.KVT{K: []byte(start), V: v[:], T: txID})
+	}
+
+	err = s.rangeTombstonesIndex.BulkInsert(kvts)
+	if err != nil {
+		return fmt.Errorf("%w: can not apply range tombstones of tx %d", err, txID)
+	}
+
+	return nil
+}
+
+// validateRangeTombstone checks the bounds of the range and that it's not mixed with other attributes
+func validateRangeTombstone(e *EntrySpec) error {
+	if !e.Metadata.Deleted() {
+		return fmt.Errorf("%w: range tombstones must be deleted entries", ErrIllegalArguments)
+	}
+
+	if len(e.Value) > 0 && bytes.Compare(e.Key, e.Value) >= 0 {
+		return fmt.Errorf("%w: the end of the range must be greater than its start", ErrIllegalArguments)
+	}
+
+	if e.Metadata.NonIndexable() || e.Metadata.IsExpirable() {
+		return fmt.Errorf("%w: range tombstones can not be non-indexable nor expirable", ErrIllegalArguments)
+	}
+
+	return nil
+}
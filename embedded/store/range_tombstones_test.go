@@ -0,0 +1,435 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeTombstones(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	keyCount := 10
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	for i := 0; i < keyCount; i++ {
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	hdr1, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	t.Run("invalid ranges should fail", func(t *testing.T) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		err = tx.DeleteRange([]byte("key5"), []byte("key2"))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = tx.DeleteRange([]byte("key5"), []byte("key5"))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		md := NewKVMetadata()
+		md.AsDeletedRange(true)
+		md.AsNonIndexable(true)
+
+		err = tx.Set([]byte("key5"), md, []byte("key6"))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	// keys set by the same transaction are not deleted
+	err = tx.Set([]byte("key4_1"), nil, []byte("value4_1"))
+	require.NoError(t, err)
+
+	err = tx.DeleteRange([]byte("key2"), []byte("key6"))
+	require.NoError(t, err)
+
+	hdr2, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	// keys set after the tombstone are not deleted
+	err = tx.Set([]byte("key3"), nil, []byte("value3_1"))
+	require.NoError(t, err)
+
+	hdr3, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	checkRangeDeletion := func(immuStore *ImmuStore) {
+		err := immuStore.WaitForIndexingUpto(context.Background(), hdr3.ID)
+		require.NoError(t, err)
+
+		for i := 0; i < keyCount; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+
+			valRef, err := immuStore.Get(context.Background(), key)
+
+			switch {
+			case i == 3:
+				require.NoError(t, err)
+				require.Equal(t, hdr3.ID, valRef.Tx())
+			case i >= 2 && i < 6:
+				require.ErrorIs(t, err, ErrKeyNotFound)
+
+				valRef, err = immuStore.GetWithFilters(context.Background(), key)
+				require.NoError(t, err)
+				require.Equal(t, hdr2.ID, valRef.Tx())
+				require.True(t, valRef.KVMetadata().Deleted())
+
+				valRef, err = immuStore.GetBetween(context.Background(), key, 1, hdr1.ID)
+				require.NoError(t, err)
+				require.Equal(t, hdr1.ID, valRef.Tx())
+				require.Nil(t, valRef.KVMetadata())
+			default:
+				require.NoError(t, err)
+				require.Equal(t, hdr1.ID, valRef.Tx())
+			}
+		}
+
+		valRef, err := immuStore.Get(context.Background(), []byte("key4_1"))
+		require.NoError(t, err)
+		require.Equal(t, hdr2.ID, valRef.Tx())
+
+		snap, err := immuStore.Snapshot(nil)
+		require.NoError(t, err)
+		defer snap.Close()
+
+		_, err = snap.Get(context.Background(), []byte("key4"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		reader, err := snap.NewKeyReader(KeyReaderSpec{
+			Filters: []FilterFn{IgnoreExpired, IgnoreDeleted},
+		})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		var keys []string
+
+		for {
+			key, _, err := reader.Read(context.Background())
+			if err != nil {
+				require.ErrorIs(t, err, ErrNoMoreEntries)
+				break
+			}
+
+			keys = append(keys, string(key))
+		}
+
+		require.Equal(t, []string{"key0", "key1", "key3", "key4_1", "key6", "key7", "key8", "key9"}, keys)
+	}
+
+	checkRangeDeletion(immuStore)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	// range tombstones are loaded when reopening the store
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	checkRangeDeletion(immuStore)
+
+	t.Run("range tombstones without upper bound", func(t *testing.T) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.DeleteRange([]byte("key8"), nil)
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		tx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		_, err = tx.Get(context.Background(), []byte("key7"))
+		require.NoError(t, err)
+
+		_, err = tx.Get(context.Background(), []byte("key9"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		err = tx.Delete(context.Background(), []byte("key9"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestRangeTombstonesOverlapping(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	// keys and the transactions they were set at
+	sets := make(map[string][]uint64)
+
+	type tombstone struct {
+		txID       uint64
+		start, end string
+	}
+
+	var tombstones []tombstone
+
+	commit := func(keys []string, ranges [][2]string) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		for _, k := range keys {
+			err = tx.Set([]byte(k), nil, []byte(k))
+			require.NoError(t, err)
+		}
+
+		for _, r := range ranges {
+			var end []byte
+			if r[1] != "" {
+				end = []byte(r[1])
+			}
+
+			err = tx.DeleteRange([]byte(r[0]), end)
+			require.NoError(t, err)
+		}
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		for _, k := range keys {
+			sets[k] = append(sets[k], hdr.ID)
+		}
+
+		for _, r := range ranges {
+			tombstones = append(tombstones, tombstone{txID: hdr.ID, start: r[0], end: r[1]})
+		}
+
+		// tombstones are no longer kept in memory once committed
+		require.Empty(t, immuStore.rangeTombstones)
+	}
+
+	var keys []string
+	for i := 0; i < 10; i++ {
+		keys = append(keys, fmt.Sprintf("key%d", i), fmt.Sprintf("key%d_", i))
+	}
+
+	commit(keys, nil)
+	commit(nil, [][2]string{{"key2", "key6"}})
+	commit([]string{"key3", "key4"}, nil)
+	commit(nil, [][2]string{{"key0", "key4"}, {"key8", ""}})
+	commit(nil, [][2]string{{"key3_", "key5"}})
+	commit([]string{"key9"}, [][2]string{{"key1", "key2"}})
+
+	lastTxID := uint64(6)
+
+	// the latest tombstone covering the key after its value was set
+	expectedDeletion := func(key string, ts uint64) (valueTx, deletionTx uint64) {
+		for _, txID := range sets[key] {
+			if txID <= ts {
+				valueTx = txID
+			}
+		}
+
+		for _, t := range tombstones {
+			if t.txID > valueTx && t.txID <= ts && t.start <= key && (t.end == "" || key < t.end) {
+				deletionTx = t.txID
+			}
+		}
+
+		return valueTx, deletionTx
+	}
+
+	checkRangeDeletion := func(immuStore *ImmuStore) {
+		err := immuStore.WaitForIndexingUpto(context.Background(), lastTxID)
+		require.NoError(t, err)
+
+		for _, key := range keys {
+			for ts := uint64(1); ts <= lastTxID; ts++ {
+				valueTx, deletionTx := expectedDeletion(key, ts)
+
+				valRef, err := immuStore.GetBetween(context.Background(), []byte(key), 1, ts)
+				require.NoError(t, err)
+
+				if deletionTx == 0 {
+					require.Equal(t, valueTx, valRef.Tx(), "key %s at tx %d", key, ts)
+					require.False(t, valRef.KVMetadata() != nil && valRef.KVMetadata().Deleted())
+					continue
+				}
+
+				require.Equal(t, deletionTx, valRef.Tx(), "key %s at tx %d", key, ts)
+				require.True(t, valRef.KVMetadata().Deleted())
+			}
+		}
+	}
+
+	checkRangeDeletion(immuStore)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	// the index of range tombstones is read from disk when reopening the store
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	require.Empty(t, immuStore.rangeTombstones)
+
+	checkRangeDeletion(immuStore)
+}
+
+func TestRangeTombstonesReadConflict(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithSynced(false))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx1, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	_, err = tx1.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	err = tx1.Set([]byte("key2"), nil, []byte("value2"))
+	require.NoError(t, err)
+
+	tx2, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx2.DeleteRange([]byte("key"), []byte("kez"))
+	require.NoError(t, err)
+
+	_, err = tx2.Commit(context.Background())
+	require.NoError(t, err)
+
+	_, err = tx1.Commit(context.Background())
+	require.ErrorIs(t, err, ErrTxReadConflict)
+}
+
+func TestRangeTombstonesDiscardPrecommittedTxs(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithExternalCommitAllowance(true)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	// transactions are precommitted but their commit is awaited until allowed
+	commit := func(fn func(tx *OngoingTx) error) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = fn(tx)
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			tx.Commit(context.Background())
+		}()
+	}
+
+	commit(func(tx *OngoingTx) error {
+		return tx.Set([]byte("key1"), nil, []byte("value1"))
+	})
+
+	err = immuStore.WaitForTx(context.Background(), 1, true)
+	require.NoError(t, err)
+
+	commit(func(tx *OngoingTx) error {
+		return tx.DeleteRange([]byte("key"), nil)
+	})
+
+	err = immuStore.WaitForTx(context.Background(), 2, true)
+	require.NoError(t, err)
+
+	err = immuStore.AllowCommitUpto(1)
+	require.NoError(t, err)
+
+	err = immuStore.WaitForTx(context.Background(), 1, false)
+	require.NoError(t, err)
+
+	n, err := immuStore.DiscardPrecommittedTxsSince(2)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	commit(func(tx *OngoingTx) error {
+		return tx.Set([]byte("key2"), nil, []byte("value2"))
+	})
+
+	err = immuStore.WaitForTx(context.Background(), 2, true)
+	require.NoError(t, err)
+
+	err = immuStore.AllowCommitUpto(2)
+	require.NoError(t, err)
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), 2)
+	require.NoError(t, err)
+
+	wg.Wait()
+
+	// the discarded tombstone is not honored
+	_, err = immuStore.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	_, err = immuStore.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+}
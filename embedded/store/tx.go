@@ -24,6 +24,7 @@ import (
 	"io"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/htree"
 )
 
@@ -48,6 +49,10 @@ type TxHeader struct {
 
 	NEntries int
 	Eh       [sha256.Size]byte
+
+	// HashAlgorithm is not part of the serialized header,
+	// it's determined by the store the transaction belongs to
+	HashAlgorithm hashing.Algorithm
 }
 
 func NewTx(nentries int, maxKeyLen int) *Tx {
@@ -97,6 +102,8 @@ func (tx *Tx) Header() *TxHeader {
 
 		NEntries: tx.header.NEntries,
 		Eh:       tx.header.Eh,
+
+		HashAlgorithm: tx.header.HashAlgorithm,
 	}
 }
 
@@ -298,7 +305,7 @@ func (hdr *TxHeader) innerHash() [sha256.Size]byte {
 	i += sha256.Size
 
 	// hash(ts + version + (mdLen + md) + nentries + eH + blTxID + blRoot)
-	return sha256.Sum256(b[:i])
+	return hdr.HashAlgorithm.Sum(b[:i])
 }
 
 // Alh calculates the Accumulative Linear Hash up to this transaction
@@ -315,20 +322,33 @@ func (hdr *TxHeader) Alh() [sha256.Size]byte {
 	copy(bi[txIDSize+sha256.Size:], innerHash[:])
 
 	// hash(txID + prevAlh + innerHash)
-	return sha256.Sum256(bi[:])
+	return hdr.HashAlgorithm.Sum(bi[:])
 }
 
 func (hdr *TxHeader) TxEntryDigest() (TxEntryDigest, error) {
+	alg := hdr.HashAlgorithm
+
+	err := alg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	switch hdr.Version {
 	case 0:
-		return TxEntryDigest_v1_1, nil
+		return func(e *TxEntry) ([sha256.Size]byte, error) { return txEntryDigest_v1_1(alg, e) }, nil
 	case 1:
-		return TxEntryDigest_v1_2, nil
+		return func(e *TxEntry) ([sha256.Size]byte, error) { return txEntryDigest_v1_2(alg, e) }, nil
 	}
 
 	return nil, ErrCorruptedTxDataUnknownHeaderVersion
 }
 
+// EntrySpecDigest returns the function used to calculate the digest of entries
+// included in the transaction, based on its version and hash algorithm
+func (hdr *TxHeader) EntrySpecDigest() (EntrySpecDigest, error) {
+	return EntrySpecDigestWith(hdr.Version, hdr.HashAlgorithm)
+}
+
 func (tx *Tx) BuildHashTree() error {
 	digests := make([][sha256.Size]byte, tx.header.NEntries)
 
@@ -344,16 +364,38 @@ func (tx *Tx) BuildHashTree() error {
 		}
 	}
 
-	err = tx.htree.BuildWith(digests)
+	htree, err := tx.hashTree()
 	if err != nil {
 		return err
 	}
 
-	tx.header.Eh = tx.htree.Root()
+	err = htree.BuildWith(digests)
+	if err != nil {
+		return err
+	}
+
+	tx.header.Eh = htree.Root()
 
 	return nil
 }
 
+// hashTree returns the tree used to calculate the entries hash,
+// it's recreated when the hash algorithm of the transaction changes
+func (tx *Tx) hashTree() (*htree.HTree, error) {
+	if tx.htree.HashAlgorithm() == tx.header.HashAlgorithm {
+		return tx.htree, nil
+	}
+
+	htree, err := htree.NewWith(len(tx.entries), tx.header.HashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.htree = htree
+
+	return htree, nil
+}
+
 func (tx *Tx) Entries() []*TxEntry {
 	return tx.entries[:tx.header.NEntries]
 }
@@ -385,8 +427,8 @@ func (tx *Tx) Proof(key []byte) (*htree.InclusionProof, error) {
 	return tx.htree.InclusionProof(kindex)
 }
 
-func (tx *Tx) readFrom(r *appendable.Reader, skipIntegrityCheck bool) error {
-	tdr := &txDataReader{r: r, skipIntegrityCheck: skipIntegrityCheck}
+func (tx *Tx) readFrom(r *appendable.Reader, hashAlgorithm hashing.Algorithm, skipIntegrityCheck bool) error {
+	tdr := &txDataReader{r: r, hashAlgorithm: hashAlgorithm, skipIntegrityCheck: skipIntegrityCheck}
 
 	header, err := tdr.readHeader(len(tx.entries))
 	if err != nil {
@@ -402,7 +444,12 @@ func (tx *Tx) readFrom(r *appendable.Reader, skipIntegrityCheck bool) error {
 		}
 	}
 
-	err = tdr.buildAndValidateHtree(tx.htree)
+	htree, err := tx.hashTree()
+	if err != nil {
+		return err
+	}
+
+	err = tdr.buildAndValidateHtree(htree)
 	if err != nil {
 		return err
 	}
@@ -415,11 +462,12 @@ type txDataReader struct {
 	h                  *TxHeader
 	digests            [][sha256.Size]byte
 	digestFunc         TxEntryDigest
+	hashAlgorithm      hashing.Algorithm
 	skipIntegrityCheck bool
 }
 
 func (t *txDataReader) readHeader(maxEntries int) (*TxHeader, error) {
-	header := &TxHeader{}
+	header := &TxHeader{HashAlgorithm: t.hashAlgorithm}
 
 	id, err := t.r.ReadUint64()
 	if err != nil {
@@ -688,6 +736,10 @@ func (e *TxEntry) VLen() int {
 type TxEntryDigest func(e *TxEntry) ([sha256.Size]byte, error)
 
 func TxEntryDigest_v1_1(e *TxEntry) ([sha256.Size]byte, error) {
+	return txEntryDigest_v1_1(hashing.SHA256, e)
+}
+
+func txEntryDigest_v1_1(alg hashing.Algorithm, e *TxEntry) ([sha256.Size]byte, error) {
 	if e.md != nil && len(e.md.Bytes()) > 0 {
 		return [sha256.Size]byte{}, ErrMetadataUnsupported
 	}
@@ -697,10 +749,14 @@ func TxEntryDigest_v1_1(e *TxEntry) ([sha256.Size]byte, error) {
 	copy(b[:], e.k[:e.kLen])
 	copy(b[e.kLen:], e.hVal[:])
 
-	return sha256.Sum256(b), nil
+	return alg.Sum(b), nil
 }
 
 func TxEntryDigest_v1_2(e *TxEntry) ([sha256.Size]byte, error) {
+	return txEntryDigest_v1_2(hashing.SHA256, e)
+}
+
+func txEntryDigest_v1_2(alg hashing.Algorithm, e *TxEntry) ([sha256.Size]byte, error) {
 	var mdbs []byte
 
 	if e.md != nil {
@@ -727,5 +783,5 @@ func TxEntryDigest_v1_2(e *TxEntry) ([sha256.Size]byte, error) {
 	copy(b[i:], e.hVal[:])
 	i += sha256.Size
 
-	return sha256.Sum256(b[:i]), nil
+	return alg.Sum(b[:i]), nil
 }
@@ -18,23 +18,27 @@ package store
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 )
 
 // attributeCode is used to identify the attribute.
 const (
-	truncatedUptoTxAttrCode attributeCode = 0
-	extraAttrCode           attributeCode = 1
+	truncatedUptoTxAttrCode        attributeCode = 0
+	extraAttrCode                  attributeCode = 1
+	authenticatedIndexRootAttrCode attributeCode = 2
 )
 
 // attribute size is the size of the attribute in bytes.
 const (
-	truncatedUptoTxAttrSize = txIDSize
+	truncatedUptoTxAttrSize        = txIDSize
+	authenticatedIndexRootAttrSize = sha256.Size
 )
 
 const maxTxMetadataLen = (attrCodeSize + truncatedUptoTxAttrSize) +
-	(attrCodeSize + sszSize + maxExtraLen)
+	(attrCodeSize + sszSize + maxExtraLen) +
+	(attrCodeSize + authenticatedIndexRootAttrSize)
 
 const maxExtraLen = 256
 
@@ -98,6 +102,32 @@ func (a *extraAttribute) deserialize(b []byte) (int, error) {
 	return sszSize + len(a.extra), nil
 }
 
+// authenticatedIndexRootAttribute holds the root of the authenticated index
+// after applying the entries of the transaction.
+type authenticatedIndexRootAttribute struct {
+	root [sha256.Size]byte
+}
+
+// code returns the attribute code.
+func (a *authenticatedIndexRootAttribute) code() attributeCode {
+	return authenticatedIndexRootAttrCode
+}
+
+// serialize returns the serialized attribute.
+func (a *authenticatedIndexRootAttribute) serialize() []byte {
+	return a.root[:]
+}
+
+// deserialize deserializes the attribute.
+func (a *authenticatedIndexRootAttribute) deserialize(b []byte) (int, error) {
+	if len(b) < sha256.Size {
+		return 0, ErrCorruptedData
+	}
+
+	copy(a.root[:], b)
+	return sha256.Size, nil
+}
+
 func getAttributeFrom(attrCode attributeCode) (attribute, error) {
 	switch attrCode {
 	case truncatedUptoTxAttrCode:
@@ -108,6 +138,10 @@ func getAttributeFrom(attrCode attributeCode) (attribute, error) {
 		{
 			return &extraAttribute{}, nil
 		}
+	case authenticatedIndexRootAttrCode:
+		{
+			return &authenticatedIndexRootAttribute{}, nil
+		}
 	default:
 		{
 			return nil, fmt.Errorf("error reading tx metadata attributes: %w", ErrCorruptedData)
@@ -145,7 +179,7 @@ func (md *TxMetadata) Equal(amd *TxMetadata) bool {
 func (md *TxMetadata) Bytes() []byte {
 	var b bytes.Buffer
 
-	for _, attrCode := range []attributeCode{truncatedUptoTxAttrCode, extraAttrCode} {
+	for _, attrCode := range []attributeCode{truncatedUptoTxAttrCode, extraAttrCode, authenticatedIndexRootAttrCode} {
 		attr, ok := md.attributes[attrCode]
 		if ok {
 			b.WriteByte(byte(attr.code()))
@@ -251,3 +285,21 @@ func (md *TxMetadata) WithExtra(data []byte) error {
 
 	return nil
 }
+
+// GetAuthenticatedIndexRoot returns the root of the authenticated index
+// after applying the entries of the transaction.
+func (md *TxMetadata) GetAuthenticatedIndexRoot() ([sha256.Size]byte, bool) {
+	attr, ok := md.attributes[authenticatedIndexRootAttrCode]
+	if !ok {
+		return [sha256.Size]byte{}, false
+	}
+
+	return attr.(*authenticatedIndexRootAttribute).root, true
+}
+
+// WithAuthenticatedIndexRoot sets the root of the authenticated index
+// after applying the entries of the transaction.
+func (md *TxMetadata) WithAuthenticatedIndexRoot(root [sha256.Size]byte) *TxMetadata {
+	md.attributes[authenticatedIndexRootAttrCode] = &authenticatedIndexRootAttribute{root: root}
+	return md
+}
@@ -0,0 +1,805 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/htree"
+	"github.com/codenotary/immudb/embedded/tbtree"
+)
+
+const redactedValuesDirname = "redacted_values"
+const redactedValuesIndexDirname = "redacted_values_index"
+
+// Redaction records are durably appended to a log before values are removed, then applied into
+// a tbtree indexing them by the offset of the redacted value. The timestamp of the tbtree is the
+// size of the log applied into it, so the records not yet applied are replayed when the store is
+// opened. The tbtree also holds the size of the log up to the latest record stating that every
+// value redacted before it was removed, values redacted afterwards are kept in memory as they
+// may not be removed yet.
+
+const (
+	redactedValueKeyPrefix = 'v' // v{vOff} -> {txID}
+	redactionsCompletedKey = 'c' // c -> {log size}
+)
+
+var ErrValueRedacted = errors.New("value redacted")
+var ErrValueCompactionUnsupported = errors.New("value compaction unsupported")
+
+// compactableAppendable is implemented by value logs whose content can be rewritten in place
+type compactableAppendable interface {
+	SupportsCompaction() bool
+	Compactable(r appendable.Range) bool
+	Compact(ranges []appendable.Range) (int, error)
+}
+
+// redactedValue records that the value of an entry was physically removed from the value log
+type redactedValue struct {
+	txID uint64
+	vOff int64
+	key  []byte
+}
+
+// ValueRedactionProof shows that the entry whose value was redacted is still included in its
+// transaction, the digest of the redacted value is kept in the entry.
+// Redaction records are not part of the committed state, so the proof does not authenticate
+// the redaction itself: that the value was redacted, and when, is only stated by the store.
+type ValueRedactionProof struct {
+	TxHeader       *TxHeader
+	Entry          *TxEntry
+	InclusionProof *htree.InclusionProof
+}
+
+// VerifyValueRedaction returns true if the redacted entry with the given key is proven
+// to be included in the transaction with the given accumulated linear hash.
+// It does not prove the value was redacted, see ValueRedactionProof.
+func VerifyValueRedaction(proof *ValueRedactionProof, key []byte, alh [sha256.Size]byte) bool {
+	if proof == nil || proof.TxHeader == nil || proof.Entry == nil || proof.InclusionProof == nil {
+		return false
+	}
+
+	if !bytes.Equal(proof.Entry.Key(), key) || proof.TxHeader.Alh() != alh {
+		return false
+	}
+
+	entryDigest, err := proof.TxHeader.TxEntryDigest()
+	if err != nil {
+		return false
+	}
+
+	digest, err := entryDigest(proof.Entry)
+	if err != nil {
+		return false
+	}
+
+	return VerifyInclusionWith(proof.TxHeader.HashAlgorithm, proof.InclusionProof, digest, proof.TxHeader.Eh)
+}
+
+// CompactValues physically removes from the value logs the values of entries committed up to
+// transaction upToTxID that are no longer readable: expired values, values of keys deleted
+// afterwards either by a deletion or a range tombstone, and the values of deletion entries.
+// Offsets are preserved and transactions are kept intact, so proofs can still be built.
+// A redaction record is durably written for every value before it gets removed, values still
+// held by the active chunk of a value log are left in place until the chunk gets sealed.
+// Values of keys indexed through entry mappers are only removed when the key was deleted
+// afterwards and such deletion was already indexed, as they are needed to maintain such indexes.
+// The number of redacted values is returned.
+func (s *ImmuStore) CompactValues(ctx context.Context, upToTxID uint64) (int, error) {
+	s.valueCompactionMutex.Lock()
+	defer s.valueCompactionMutex.Unlock()
+
+	s.mutex.Lock()
+	closed := s.closed
+	s.mutex.Unlock()
+
+	if closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if s.readOnly {
+		return 0, ErrReadOnly
+	}
+
+	if s.embeddedValues {
+		return 0, fmt.Errorf("%w: values are embedded into the transaction log", ErrValueCompactionUnsupported)
+	}
+
+	for _, vLog := range s.vLogs {
+		capp, ok := vLog.vLog.(compactableAppendable)
+		if !ok || !capp.SupportsCompaction() {
+			return 0, ErrValueCompactionUnsupported
+		}
+	}
+
+	if upToTxID == 0 || upToTxID > s.LastCommittedTxID() {
+		return 0, fmt.Errorf("%w: invalid transaction ID", ErrIllegalArguments)
+	}
+
+	dead, err := s.deadValuesUpto(ctx, upToTxID)
+	if err != nil {
+		return 0, err
+	}
+
+	// only values whose bytes get actually rewritten are redacted,
+	// values redacted but not yet removed (e.g. due to a crash) are removed again
+	var redacted, compactable []*deadValue
+
+	pending := make(map[int64]uint64)
+
+	for _, v := range dead {
+		vLogID, off := decodeOffset(v.vOff)
+
+		if !s.vLogs[vLogID-1].vLog.(compactableAppendable).Compactable(appendable.Range{Off: off, Len: int64(v.vLen)}) {
+			if v.recorded {
+				pending[v.vOff] = v.txID
+			}
+			continue
+		}
+
+		if !v.recorded {
+			redacted = append(redacted, v)
+		}
+
+		compactable = append(compactable, v)
+	}
+
+	if len(compactable) == 0 {
+		return 0, nil
+	}
+
+	err = s.appendRedactedValues(redacted)
+	if err != nil {
+		return 0, err
+	}
+
+	ranges := make(map[byte][]appendable.Range)
+
+	for _, v := range compactable {
+		if s.vLogCache != nil {
+			s.vLogCache.Pop(v.vOff)
+		}
+
+		vLogID, off := decodeOffset(v.vOff)
+		ranges[vLogID] = append(ranges[vLogID], appendable.Range{Off: off, Len: int64(v.vLen)})
+	}
+
+	for vLogID, vLogRanges := range ranges {
+		vLog, err := s.fetchVLog(vLogID)
+		if err != nil {
+			return 0, err
+		}
+
+		n, err := vLog.(compactableAppendable).Compact(vLogRanges)
+
+		s.releaseVLog(vLogID)
+
+		if err != nil {
+			return 0, err
+		}
+
+		s.logger.Infof("%d chunks of vlog '%d' compacted at '%s'", n, vLogID, s.path)
+	}
+
+	err = s.completeRedactedValues(pending)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(redacted), nil
+}
+
+// mappedIndexing holds the source prefix of an index built from values and how far it was indexed
+type mappedIndexing struct {
+	prefix []byte
+	ts     uint64
+}
+
+// mappedIndexings returns the source prefixes of the indexes built from values
+func (s *ImmuStore) mappedIndexings() []mappedIndexing {
+	s.indexersMux.RLock()
+	defer s.indexersMux.RUnlock()
+
+	var indexings []mappedIndexing
+
+	for _, indexer := range s.indexers {
+		if indexer.spec.SourceEntryMapper != nil || indexer.spec.TargetEntryMapper != nil {
+			indexings = append(indexings, mappedIndexing{prefix: indexer.spec.SourcePrefix, ts: indexer.Ts()})
+		}
+	}
+
+	return indexings
+}
+
+// mappedIndexingTs returns true if the key is indexed through entry mappers,
+// together with the transaction up to which all such indexes were built
+func mappedIndexingTs(key []byte, indexings []mappedIndexing) (bool, uint64) {
+	mapped := false
+	var ts uint64
+
+	for _, indexing := range indexings {
+		if !hasPrefix(key, indexing.prefix) {
+			continue
+		}
+
+		if !mapped || indexing.ts < ts {
+			ts = indexing.ts
+		}
+
+		mapped = true
+	}
+
+	return mapped, ts
+}
+
+type deadValue struct {
+	redactedValue
+	vLen     int
+	recorded bool // redaction was already recorded but the value may not be removed yet
+}
+
+// deadValuesUpto returns the values of entries up to transaction upToTxID which can no longer be read,
+// together with the values redacted but not yet known to be removed
+func (s *ImmuStore) deadValuesUpto(ctx context.Context, upToTxID uint64) ([]*deadValue, error) {
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseAllocTx(tx)
+
+	lastTxID := s.LastCommittedTxID()
+
+	// latest deletion of each key
+	deletions := make(map[string]uint64)
+
+	txr, err := s.NewTxReader(1, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for txID := uint64(1); txID <= lastTxID; txID++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		tx, err := txr.Read()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range tx.Entries() {
+			if e.md != nil && e.md.Deleted() {
+				deletions[string(e.Key())] = txID
+			}
+		}
+	}
+
+	s.redactedValuesMutex.RLock()
+	pending := make(map[int64]struct{}, len(s.pendingRedactedValues))
+	scanUpToTxID := upToTxID
+
+	for vOff, txID := range s.pendingRedactedValues {
+		pending[vOff] = struct{}{}
+
+		if txID > scanUpToTxID {
+			scanUpToTxID = txID
+		}
+	}
+	s.redactedValuesMutex.RUnlock()
+
+	indexings := s.mappedIndexings()
+
+	now := time.Now()
+
+	var dead []*deadValue
+
+	txr, err = s.NewTxReader(1, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for txID := uint64(1); txID <= scanUpToTxID; txID++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		tx, err := txr.Read()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range tx.Entries() {
+			vLogID, _ := decodeOffset(e.vOff)
+
+			if e.vLen == 0 || vLogID == 0 {
+				continue
+			}
+
+			_, recorded := pending[e.vOff]
+
+			if !recorded && txID > upToTxID {
+				continue
+			}
+
+			if !recorded {
+				redacted, err := s.isValueRedacted(e.vOff)
+				if err != nil {
+					return nil, err
+				}
+				if redacted {
+					continue
+				}
+
+				isDead, err := s.isValueDead(e, txID, lastTxID, now, deletions, indexings)
+				if err != nil {
+					return nil, err
+				}
+				if !isDead {
+					continue
+				}
+			}
+
+			key := make([]byte, e.kLen)
+			copy(key, e.Key())
+
+			dead = append(dead, &deadValue{
+				redactedValue: redactedValue{txID: txID, vOff: e.vOff, key: key},
+				vLen:          e.vLen,
+				recorded:      recorded,
+			})
+		}
+	}
+
+	return dead, nil
+}
+
+func (s *ImmuStore) isValueDead(e *TxEntry, txID, lastTxID uint64, now time.Time, deletions map[string]uint64, indexings []mappedIndexing) (bool, error) {
+	if e.md != nil && e.md.DeletedRange() {
+		// range tombstones are kept
+		return false, nil
+	}
+
+	mapped, indexedTs := mappedIndexingTs(e.Key(), indexings)
+	if mapped {
+		// the value of a deletion is needed to index the following entry of the key, and the
+		// values of previous entries are needed until the deletion is indexed
+		if e.md != nil && e.md.Deleted() {
+			return false, nil
+		}
+
+		deletedAt := deletions[string(e.Key())]
+
+		return deletedAt > txID && deletedAt <= indexedTs, nil
+	}
+
+	if e.md != nil && (e.md.Deleted() || e.md.ExpiredAt(now)) {
+		return true, nil
+	}
+
+	if deletions[string(e.Key())] > txID {
+		return true, nil
+	}
+
+	deletedAt, err := s.rangeDeletedAfter(e.Key(), txID, lastTxID)
+	if err != nil {
+		return false, err
+	}
+
+	return deletedAt > 0, nil
+}
+
+func redactedValueKey(vOff int64) []byte {
+	var k [1 + offsetSize]byte
+	k[0] = redactedValueKeyPrefix
+	binary.BigEndian.PutUint64(k[1:], uint64(vOff))
+	return k[:]
+}
+
+func (s *ImmuStore) isValueRedacted(vOff int64) (bool, error) {
+	s.redactedValuesMutex.RLock()
+	defer s.redactedValuesMutex.RUnlock()
+
+	if _, unapplied := s.unappliedRedactedValues[vOff]; unapplied {
+		return true, nil
+	}
+
+	if s.redactedValuesIndex == nil {
+		return false, nil
+	}
+
+	_, _, _, err := s.redactedValuesIndex.Get(redactedValueKey(vOff))
+	if errors.Is(err, tbtree.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsValueRedacted returns true if the value of the entry was physically removed by value compaction
+func (s *ImmuStore) IsValueRedacted(entry *TxEntry) (bool, error) {
+	if entry == nil {
+		return false, ErrIllegalArguments
+	}
+
+	return s.isValueRedacted(entry.vOff)
+}
+
+// ValueRedactionProof returns a proof of the inclusion of the entry set at transaction txID
+// for the given key, whose value was redacted
+func (s *ImmuStore) ValueRedactionProof(txID uint64, key []byte) (*ValueRedactionProof, error) {
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseAllocTx(tx)
+
+	err = s.ReadTx(txID, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := tx.EntryOf(key)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted, err := s.isValueRedacted(e.vOff)
+	if err != nil {
+		return nil, err
+	}
+
+	if !redacted {
+		return nil, fmt.Errorf("%w: value is not redacted", ErrIllegalArguments)
+	}
+
+	inclusionProof, err := tx.Proof(key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := NewTxEntry(key, e.md, e.vLen, e.hVal, e.vOff)
+	entry.readonly = true
+
+	return &ValueRedactionProof{
+		TxHeader:       tx.Header(),
+		Entry:          entry,
+		InclusionProof: inclusionProof,
+	}, nil
+}
+
+func (s *ImmuStore) openRedactedValuesLog() (appendable.Appendable, error) {
+	appendableOpts := multiapp.DefaultOptions().
+		WithReadOnly(s.readOnly).
+		WithRetryableSync(s.synced).
+		WithAutoSync(true).
+		WithFileMode(s.opts.FileMode).
+		WithKeyProvider(s.opts.KeyProvider).
+		WithFileExt("rdv")
+
+	if s.opts.appFactory != nil {
+		return s.opts.appFactory(s.path, redactedValuesDirname, appendableOpts)
+	}
+
+	return multiapp.Open(filepath.Join(s.path, redactedValuesDirname), appendableOpts)
+}
+
+func (s *ImmuStore) openRedactedValuesIndex() (*tbtree.TBtree, error) {
+	indexOpts := auxiliaryIndexOptions(s.opts).
+		WithMaxKeySize(1 + offsetSize).
+		WithMaxValueSize(offsetSize)
+
+	return tbtree.Open(filepath.Join(s.path, redactedValuesIndexDirname), indexOpts)
+}
+
+// loadRedactedValues opens the index of redacted values and replays the redaction records not yet applied,
+// the log is only created once the first value is redacted
+func (s *ImmuStore) loadRedactedValues() error {
+	_, err := os.Stat(filepath.Join(s.path, redactedValuesDirname))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rdvLog, err := s.openRedactedValuesLog()
+	if err != nil {
+		return err
+	}
+
+	s.redactedValuesLog = rdvLog
+	s.pendingRedactedValues = make(map[int64]uint64)
+	s.unappliedRedactedValues = make(map[int64]uint64)
+
+	_, err = os.Stat(filepath.Join(s.path, redactedValuesIndexDirname))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// in read-only mode the index can not be created, every record is kept in memory
+	if err == nil || !s.readOnly {
+		s.redactedValuesIndex, err = s.openRedactedValuesIndex()
+		if err != nil {
+			return err
+		}
+	}
+
+	var appliedOff, completedOff int64
+
+	if s.redactedValuesIndex != nil {
+		appliedOff = int64(s.redactedValuesIndex.Ts())
+
+		v, _, _, err := s.redactedValuesIndex.Get([]byte{redactionsCompletedKey})
+		if err == nil {
+			completedOff = int64(binary.BigEndian.Uint64(v))
+		} else if !errors.Is(err, tbtree.ErrKeyNotFound) {
+			return err
+		}
+	}
+
+	// records are read from the latest one completing the redaction of the previous ones,
+	// which was applied into the index before the records following it
+	r := appendable.NewReaderFrom(rdvLog, completedOff, multiapp.DefaultReadBufferSize)
+
+	off := completedOff
+
+	for {
+		rv, err := readRedactedValue(r)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			// a partially written record is discarded
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		off = completedOff + r.ReadCount()
+
+		if off > appliedOff {
+			if s.readOnly {
+				if rv.txID > 0 {
+					s.unappliedRedactedValues[rv.vOff] = rv.txID
+				}
+			} else {
+				err = s.applyRedactedValues([]*redactedValue{rv}, []int64{off})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if rv.txID == 0 {
+			// every value redacted so far was removed
+			s.pendingRedactedValues = make(map[int64]uint64)
+			continue
+		}
+
+		s.pendingRedactedValues[rv.vOff] = rv.txID
+	}
+
+	if s.readOnly {
+		return nil
+	}
+
+	if off > appliedOff {
+		_, _, err = s.redactedValuesIndex.Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	if off == rdvLog.Offset() {
+		return nil
+	}
+
+	return rdvLog.SetOffset(off)
+}
+
+// applyRedactedValues inserts the redaction records into the index,
+// each one along with the size of the log including it
+func (s *ImmuStore) applyRedactedValues(values []*redactedValue, offs []int64) error {
+	kvts := make([]*tbtree.KVT, len(values))
+
+	for i, v := range values {
+		var b [offsetSize]byte
+
+		if v.txID == 0 {
+			binary.BigEndian.PutUint64(b[:], uint64(offs[i]))
+			kvts[i] = &tbtree.KVT{K: []byte{redactionsCompletedKey}, V: b[:], T: uint64(offs[i])}
+			continue
+		}
+
+		binary.BigEndian.PutUint64(b[:], v.txID)
+		kvts[i] = &tbtree.KVT{K: redactedValueKey(v.vOff), V: b[:], T: uint64(offs[i])}
+	}
+
+	s.redactedValuesMutex.Lock()
+	defer s.redactedValuesMutex.Unlock()
+
+	return s.redactedValuesIndex.BulkInsert(kvts)
+}
+
+func readRedactedValue(r *appendable.Reader) (*redactedValue, error) {
+	txID, err := r.ReadUint64()
+	if err != nil {
+		return nil, err
+	}
+
+	vOff, err := r.ReadUint64()
+	if err != nil {
+		return nil, err
+	}
+
+	keyLen, err := r.ReadUint16()
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, keyLen)
+
+	_, err = r.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redactedValue{txID: txID, vOff: int64(vOff), key: key}, nil
+}
+
+// appendRedactedValues durably writes the redaction records before values are removed
+func (s *ImmuStore) appendRedactedValues(values []*deadValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	if s.redactedValuesLog == nil {
+		rdvLog, err := s.openRedactedValuesLog()
+		if err != nil {
+			return err
+		}
+
+		s.redactedValuesLog = rdvLog
+	}
+
+	if s.redactedValuesIndex == nil {
+		index, err := s.openRedactedValuesIndex()
+		if err != nil {
+			return err
+		}
+
+		s.redactedValuesMutex.Lock()
+		s.redactedValuesIndex = index
+		s.redactedValuesMutex.Unlock()
+	}
+
+	rvs := make([]*redactedValue, len(values))
+	offs := make([]int64, len(values))
+
+	for i, v := range values {
+		off, err := s.appendRedactedValue(&v.redactedValue)
+		if err != nil {
+			return err
+		}
+
+		rvs[i] = &v.redactedValue
+		offs[i] = off
+	}
+
+	err := s.syncRedactedValues()
+	if err != nil {
+		return err
+	}
+
+	err = s.applyRedactedValues(rvs, offs)
+	if err != nil {
+		return err
+	}
+
+	s.redactedValuesMutex.Lock()
+	defer s.redactedValuesMutex.Unlock()
+
+	if s.pendingRedactedValues == nil {
+		s.pendingRedactedValues = make(map[int64]uint64, len(values))
+	}
+
+	for _, v := range values {
+		s.pendingRedactedValues[v.vOff] = v.txID
+	}
+
+	return nil
+}
+
+// completeRedactedValues is called once redacted values were removed, but the pending ones.
+// When there are no pending values, a record with no transaction is durably written so
+// values redacted before it are not removed again after reopening the store.
+func (s *ImmuStore) completeRedactedValues(pending map[int64]uint64) error {
+	if len(pending) == 0 {
+		completion := &redactedValue{}
+
+		off, err := s.appendRedactedValue(completion)
+		if err != nil {
+			return err
+		}
+
+		err = s.syncRedactedValues()
+		if err != nil {
+			return err
+		}
+
+		err = s.applyRedactedValues([]*redactedValue{completion}, []int64{off})
+		if err != nil {
+			return err
+		}
+	}
+
+	// records are replayed from the latest applied into the index when the store is reopened
+	if s.redactedValuesIndex != nil {
+		_, _, err := s.redactedValuesIndex.Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	s.redactedValuesMutex.Lock()
+	defer s.redactedValuesMutex.Unlock()
+
+	s.pendingRedactedValues = pending
+
+	return nil
+}
+
+// appendRedactedValue returns the size of the log including the record
+func (s *ImmuStore) appendRedactedValue(v *redactedValue) (int64, error) {
+	b := make([]byte, txIDSize+offsetSize+sszSize+len(v.key))
+	i := 0
+
+	binary.BigEndian.PutUint64(b[i:], v.txID)
+	i += txIDSize
+
+	binary.BigEndian.PutUint64(b[i:], uint64(v.vOff))
+	i += offsetSize
+
+	binary.BigEndian.PutUint16(b[i:], uint16(len(v.key)))
+	i += sszSize
+
+	copy(b[i:], v.key)
+
+	off, n, err := s.redactedValuesLog.Append(b)
+	return off + int64(n), err
+}
+
+func (s *ImmuStore) syncRedactedValues() error {
+	err := s.redactedValuesLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	return s.redactedValuesLog.Sync()
+}
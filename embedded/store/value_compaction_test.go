@@ -0,0 +1,542 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactValues(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(64).
+		WithVLogCacheSize(10)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	value := func(s string) []byte {
+		return []byte(s + strings.Repeat("_", 40-len(s)))
+	}
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	for _, k := range []string{"key1", "key2", "key3"} {
+		err = tx.Set([]byte(k), nil, value("value_"+k))
+		require.NoError(t, err)
+	}
+
+	hdr1, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	md := NewKVMetadata()
+	err = md.ExpiresAt(time.Now().Add(-time.Second))
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key4"), md, value("value_key4"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = tx.Delete(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.DeleteRange([]byte("key2"), []byte("key3"))
+	require.NoError(t, err)
+
+	hdr4, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key5"), nil, value("value_key5"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// values are cached before being redacted
+	valRef, err := immuStore.GetBetween(context.Background(), []byte("key1"), 1, hdr1.ID)
+	require.NoError(t, err)
+
+	_, err = valRef.Resolve()
+	require.NoError(t, err)
+
+	_, err = immuStore.CompactValues(context.Background(), 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = immuStore.CompactValues(context.Background(), hdr4.ID+2)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	n, err := immuStore.CompactValues(context.Background(), hdr4.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	n, err = immuStore.CompactValues(context.Background(), hdr4.ID)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	checkRedaction := func(immuStore *ImmuStore) {
+		for _, k := range []string{"key1", "key2", "key3"} {
+			valRef, err := immuStore.GetBetween(context.Background(), []byte(k), 1, hdr1.ID)
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+
+			if k == "key3" {
+				require.NoError(t, err)
+				require.Equal(t, value("value_key3"), val)
+				continue
+			}
+
+			require.ErrorIs(t, err, ErrValueRedacted)
+
+			proof, err := immuStore.ValueRedactionProof(hdr1.ID, []byte(k))
+			require.NoError(t, err)
+			redacted, err := immuStore.IsValueRedacted(proof.Entry)
+			require.NoError(t, err)
+			require.True(t, redacted)
+			require.Equal(t, immuStore.HashAlgorithm().Sum(value("value_"+k)), proof.Entry.HVal())
+
+			require.True(t, VerifyValueRedaction(proof, []byte(k), hdr1.Alh()))
+			require.False(t, VerifyValueRedaction(proof, []byte("key3"), hdr1.Alh()))
+			require.False(t, VerifyValueRedaction(proof, []byte(k), hdr4.Alh()))
+			require.False(t, VerifyValueRedaction(nil, []byte(k), hdr1.Alh()))
+		}
+
+		_, err := immuStore.ValueRedactionProof(hdr1.ID, []byte("key3"))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		valRef, err := immuStore.Get(context.Background(), []byte("key5"))
+		require.NoError(t, err)
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+		require.Equal(t, value("value_key5"), val)
+
+		// transactions with redacted values are exported without values
+		tx := NewTx(immuStore.MaxTxEntries(), immuStore.MaxKeyLen())
+
+		etx, err := immuStore.ExportTx(hdr1.ID, false, false, tx)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(etx, value("value_key3")))
+	}
+
+	checkRedaction(immuStore)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	// redacted values are physically removed from the value log
+	chunks, err := os.ReadDir(filepath.Join(dir, "val_0"))
+	require.NoError(t, err)
+
+	var content []byte
+
+	for _, chunk := range chunks {
+		bs, err := os.ReadFile(filepath.Join(dir, "val_0", chunk.Name()))
+		require.NoError(t, err)
+
+		content = append(content, bs...)
+	}
+
+	require.False(t, bytes.Contains(content, []byte("value_key1")))
+	require.False(t, bytes.Contains(content, []byte("value_key2")))
+	require.False(t, bytes.Contains(content, []byte("value_key4")))
+	require.True(t, bytes.Contains(content, []byte("value_key3")))
+
+	// redaction records are loaded when reopening the store
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	checkRedaction(immuStore)
+
+	n, err = immuStore.CompactValues(context.Background(), hdr4.ID)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestCompactValuesUnsupported(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithEmbeddedValues(true))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	_, err = immuStore.CompactValues(context.Background(), 1)
+	require.ErrorIs(t, err, ErrValueCompactionUnsupported)
+}
+
+func TestCompactValuesActiveChunk(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(64)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	value := []byte("value_key1" + strings.Repeat("_", 30))
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, value)
+	require.NoError(t, err)
+
+	hdr1, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.Delete(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	hdr2, err := otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// the value is held by the active chunk thus it's not redacted
+	n, err := immuStore.CompactValues(context.Background(), hdr2.ID)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	valRef, err := immuStore.GetBetween(context.Background(), []byte("key1"), 1, hdr1.ID)
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, value, val)
+
+	// the chunk gets sealed once enough values are written
+	tx, err = immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte(strings.Repeat("_", 64)))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	n, err = immuStore.CompactValues(context.Background(), hdr2.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	_, err = valRef.Resolve()
+	require.ErrorIs(t, err, ErrValueRedacted)
+}
+
+func TestCompactValuesPendingRedactions(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(64)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	value := []byte("value_key1" + strings.Repeat("_", 30))
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, value)
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte(strings.Repeat("_", 64)))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.Delete(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	hdr2, err := otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// the redaction is recorded but the value is not removed as if the process crashed
+	dead, err := immuStore.deadValuesUpto(context.Background(), hdr2.ID)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+
+	err = immuStore.appendRedactedValues(dead)
+	require.NoError(t, err)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	vLogContent := func() []byte {
+		chunks, err := os.ReadDir(filepath.Join(dir, "val_0"))
+		require.NoError(t, err)
+
+		var content []byte
+
+		for _, chunk := range chunks {
+			bs, err := os.ReadFile(filepath.Join(dir, "val_0", chunk.Name()))
+			require.NoError(t, err)
+
+			content = append(content, bs...)
+		}
+
+		return content
+	}
+
+	require.True(t, bytes.Contains(vLogContent(), value))
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	// the value is removed without being redacted again
+	n, err := immuStore.CompactValues(context.Background(), hdr2.ID)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Empty(t, immuStore.pendingRedactedValues)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	require.False(t, bytes.Contains(vLogContent(), value))
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	require.Empty(t, immuStore.pendingRedactedValues)
+
+	rtx := NewTx(immuStore.MaxTxEntries(), immuStore.MaxKeyLen())
+
+	err = immuStore.ReadTx(1, false, rtx)
+	require.NoError(t, err)
+
+	redacted, err := immuStore.IsValueRedacted(rtx.Entries()[0])
+	require.NoError(t, err)
+	require.True(t, redacted)
+
+	redacted, err = immuStore.IsValueRedacted(rtx.Entries()[1])
+	require.NoError(t, err)
+	require.False(t, redacted)
+}
+
+func TestCompactValuesRedactionsReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(64)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("value_key1"+strings.Repeat("_", 30)))
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte(strings.Repeat("_", 64)))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.Delete(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	hdr2, err := otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	n, err := immuStore.CompactValues(context.Background(), hdr2.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	// the index of redacted values is lost, records are replayed from the log
+	err = os.RemoveAll(filepath.Join(dir, redactedValuesIndexDirname))
+	require.NoError(t, err)
+
+	checkRedacted := func(immuStore *ImmuStore) {
+		tx := NewTx(immuStore.MaxTxEntries(), immuStore.MaxKeyLen())
+
+		err := immuStore.ReadTx(1, false, tx)
+		require.NoError(t, err)
+
+		redacted, err := immuStore.IsValueRedacted(tx.Entries()[0])
+		require.NoError(t, err)
+		require.True(t, redacted)
+
+		redacted, err = immuStore.IsValueRedacted(tx.Entries()[1])
+		require.NoError(t, err)
+		require.False(t, redacted)
+	}
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	require.NotNil(t, immuStore.redactedValuesIndex)
+	require.Empty(t, immuStore.unappliedRedactedValues)
+
+	checkRedacted(immuStore)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	// records already applied are not replayed
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	require.Equal(t, uint64(immuStore.redactedValuesLog.Offset()), immuStore.redactedValuesIndex.Ts())
+	require.Empty(t, immuStore.pendingRedactedValues)
+
+	checkRedacted(immuStore)
+}
+
+func TestCompactValuesMappedPrefix(t *testing.T) {
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(64).
+		WithMultiIndexing(true)
+
+	immuStore, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	spec := &IndexSpec{
+		SourcePrefix: []byte("r"),
+		TargetPrefix: []byte("i"),
+		TargetEntryMapper: func(key, value []byte) ([]byte, error) {
+			return append([]byte("i"), value...), nil
+		},
+	}
+
+	err = immuStore.InitIndexing(spec)
+	require.NoError(t, err)
+
+	value := func(s string) []byte {
+		return []byte(s + strings.Repeat("_", 40-len(s)))
+	}
+
+	set := func(key string, md *KVMetadata, val []byte) *TxHeader {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), md, val)
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		return hdr
+	}
+
+	deleted := NewKVMetadata()
+	err = deleted.AsDeleted(true)
+	require.NoError(t, err)
+
+	hdr1 := set("r1", nil, value("v1"))
+	// rows are deleted with their values, as those are needed to update the index
+	hdr2 := set("r1", deleted, value("v1"))
+	set("r2", nil, value("v2"))
+	hdr4 := set("r3", nil, value("v3"))
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), hdr4.ID)
+	require.NoError(t, err)
+
+	// the value of the deletion is kept as it's needed to index a new entry of the key
+	n, err := immuStore.CompactValues(context.Background(), hdr2.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	tx := NewTx(immuStore.MaxTxEntries(), immuStore.MaxKeyLen())
+
+	err = immuStore.ReadTx(hdr1.ID, false, tx)
+	require.NoError(t, err)
+
+	redacted, err := immuStore.IsValueRedacted(tx.Entries()[0])
+	require.NoError(t, err)
+	require.True(t, redacted)
+
+	err = immuStore.ReadTx(hdr2.ID, false, tx)
+	require.NoError(t, err)
+
+	redacted, err = immuStore.IsValueRedacted(tx.Entries()[0])
+	require.NoError(t, err)
+	require.False(t, redacted)
+
+	// the index is built again skipping redacted values
+	err = immuStore.DeleteIndex([]byte("i"))
+	require.NoError(t, err)
+
+	err = immuStore.InitIndexing(spec)
+	require.NoError(t, err)
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), hdr4.ID)
+	require.NoError(t, err)
+
+	_, err = immuStore.Get(context.Background(), append([]byte("i"), value("v1")...))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, err = immuStore.Get(context.Background(), append([]byte("i"), value("v2")...))
+	require.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package store
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrValueTieringUnsupported = errors.New("value tiering unsupported")
+
+// tieredAppendable is implemented by value logs which can move older data into a cold storage tier
+type tieredAppendable interface {
+	MigrateUpto(off int64) (int, error)
+}
+
+// MigrateValuesUptoTx moves into the cold storage tier the value log chunks holding only
+// values of transactions up to txID. Values remain readable. The number of migrated chunks is returned.
+func (s *ImmuStore) MigrateValuesUptoTx(txID uint64) (int, error) {
+	if s.IsClosed() {
+		return 0, ErrAlreadyClosed
+	}
+
+	if s.embeddedValues {
+		return 0, fmt.Errorf("%w: values are embedded into the transaction log", ErrValueTieringUnsupported)
+	}
+
+	tieredVLogs := make(map[byte]tieredAppendable, len(s.vLogs))
+
+	for i, vLog := range s.vLogs {
+		tvLog, ok := vLog.vLog.(tieredAppendable)
+		if !ok {
+			return 0, ErrValueTieringUnsupported
+		}
+		tieredVLogs[i+1] = tvLog
+	}
+
+	lastTxID := s.LastPrecommittedTxID()
+
+	if txID == 0 || txID > lastTxID {
+		return 0, fmt.Errorf("%w: invalid transaction ID", ErrIllegalArguments)
+	}
+
+	// the lowest offset of values of later transactions bounds the data that can be migrated,
+	// values of ongoing transactions are beyond the current offset of each value log
+	bounds := make(map[byte]int64, len(s.vLogs))
+
+	for vLogID, vLog := range s.vLogs {
+		bounds[vLogID+1] = vLog.vLog.Offset()
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return 0, err
+	}
+	defer s.releaseAllocTx(tx)
+
+	for id := txID + 1; id <= lastTxID; id++ {
+		err := s.readTx(id, true, false, tx)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, e := range tx.Entries() {
+			if e.vLen == 0 {
+				continue
+			}
+
+			vLogID, off := decodeOffset(e.vOff)
+			if vLogID == 0 {
+				continue
+			}
+
+			if off < bounds[vLogID] {
+				bounds[vLogID] = off
+			}
+		}
+	}
+
+	migrated := 0
+
+	for vLogID, tvLog := range tieredVLogs {
+		n, err := tvLog.MigrateUpto(bounds[vLogID])
+		migrated += n
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	return migrated, nil
+}
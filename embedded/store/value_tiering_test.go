@@ -0,0 +1,120 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/tieredapp"
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateValuesUptoTx(t *testing.T) {
+	dir := t.TempDir()
+	coldStorage := memory.Open()
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(64).
+		WithVLogAppFactory(func(rootPath, subPath string, opts *multiapp.Options) (appendable.Appendable, error) {
+			tieredOpts := tieredapp.DefaultOptions()
+			tieredOpts.Options = *opts
+
+			return tieredapp.Open(filepath.Join(rootPath, subPath), subPath+"/", coldStorage, tieredOpts)
+		})
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	var hdrs []*TxHeader
+
+	for i := 0; i < 10; i++ {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("value%d_0123456789", i)))
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	_, err = immuStore.MigrateValuesUptoTx(0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = immuStore.MigrateValuesUptoTx(hdrs[9].ID + 1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	// each value takes 17 bytes, chunks 0 and 1 only hold values of the first 8 transactions
+	n, err := immuStore.MigrateValuesUptoTx(hdrs[7].ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	entries, _, err := coldStorage.ListEntries(context.Background(), "val_0/")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// transaction log and indexes are kept local
+	_, subPaths, err := coldStorage.ListEntries(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"val_0"}, subPaths)
+
+	_, err = os.Stat(filepath.Join(dir, "val_0", "00000000.val"))
+	require.True(t, os.IsNotExist(err))
+
+	checkValues := func(immuStore *ImmuStore) {
+		for i := 0; i < 10; i++ {
+			valRef, err := immuStore.Get(context.Background(), []byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d_0123456789", i)), val)
+		}
+	}
+
+	checkValues(immuStore)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	checkValues(immuStore)
+}
+
+func TestMigrateValuesUnsupported(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	_, err = immuStore.MigrateValuesUptoTx(1)
+	require.ErrorIs(t, err, ErrValueTieringUnsupported)
+}
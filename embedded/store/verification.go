@@ -22,23 +22,34 @@ import (
 	"fmt"
 
 	"github.com/codenotary/immudb/embedded/ahtree"
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/htree"
+	"github.com/codenotary/immudb/embedded/smt"
 )
 
 func VerifyInclusion(proof *htree.InclusionProof, entryDigest, root [sha256.Size]byte) bool {
-	return htree.VerifyInclusion(proof, entryDigest, root)
+	return VerifyInclusionWith(hashing.SHA256, proof, entryDigest, root)
 }
 
-func advanceLinearHash(alh [sha256.Size]byte, txID uint64, term [sha256.Size]byte) [sha256.Size]byte {
+// VerifyInclusionWith verifies the inclusion of an entry in a transaction hashed with the specified algorithm
+func VerifyInclusionWith(hashAlgorithm hashing.Algorithm, proof *htree.InclusionProof, entryDigest, root [sha256.Size]byte) bool {
+	return htree.VerifyInclusionWith(hashAlgorithm, proof, entryDigest, root)
+}
+
+func advanceLinearHash(hashAlgorithm hashing.Algorithm, alh [sha256.Size]byte, txID uint64, term [sha256.Size]byte) [sha256.Size]byte {
 	var bs [txIDSize + 2*sha256.Size]byte
 	binary.BigEndian.PutUint64(bs[:], txID)
 	copy(bs[txIDSize:], alh[:])
 	copy(bs[txIDSize+sha256.Size:], term[:]) // innerHash = hash(ts + mdLen + md + nentries + eH + blTxID + blRoot)
-	return sha256.Sum256(bs[:])              // hash(txID + prevAlh + innerHash)
+	return hashAlgorithm.Sum(bs[:])          // hash(txID + prevAlh + innerHash)
 }
 
 func VerifyLinearProof(proof *LinearProof, sourceTxID, targetTxID uint64, sourceAlh, targetAlh [sha256.Size]byte) bool {
-	if proof == nil || proof.SourceTxID != sourceTxID || proof.TargetTxID != targetTxID {
+	return VerifyLinearProofWith(hashing.SHA256, proof, sourceTxID, targetTxID, sourceAlh, targetAlh)
+}
+
+func VerifyLinearProofWith(hashAlgorithm hashing.Algorithm, proof *LinearProof, sourceTxID, targetTxID uint64, sourceAlh, targetAlh [sha256.Size]byte) bool {
+	if !hashAlgorithm.IsValid() || proof == nil || proof.SourceTxID != sourceTxID || proof.TargetTxID != targetTxID {
 		return false
 	}
 
@@ -54,7 +65,7 @@ func VerifyLinearProof(proof *LinearProof, sourceTxID, targetTxID uint64, source
 	calculatedAlh := proof.Terms[0]
 
 	for i := 1; i < len(proof.Terms); i++ {
-		calculatedAlh = advanceLinearHash(calculatedAlh, proof.SourceTxID+uint64(i), proof.Terms[i])
+		calculatedAlh = advanceLinearHash(hashAlgorithm, calculatedAlh, proof.SourceTxID+uint64(i), proof.Terms[i])
 	}
 
 	return targetAlh == calculatedAlh
@@ -67,6 +78,18 @@ func VerifyLinearAdvanceProof(
 	endAlh [sha256.Size]byte,
 	treeRoot [sha256.Size]byte,
 	treeSize uint64,
+) bool {
+	return VerifyLinearAdvanceProofWith(hashing.SHA256, proof, startTxID, endTxID, endAlh, treeRoot, treeSize)
+}
+
+func VerifyLinearAdvanceProofWith(
+	hashAlgorithm hashing.Algorithm,
+	proof *LinearAdvanceProof,
+	startTxID uint64,
+	endTxID uint64,
+	endAlh [sha256.Size]byte,
+	treeRoot [sha256.Size]byte,
+	treeSize uint64,
 ) bool {
 	//
 	//       Old
@@ -87,7 +110,7 @@ func VerifyLinearAdvanceProof(
 	//
 
 	// This must not happen - that's an invalid proof
-	if endTxID < startTxID {
+	if !hashAlgorithm.IsValid() || endTxID < startTxID {
 		return false
 	}
 
@@ -106,33 +129,39 @@ func VerifyLinearAdvanceProof(
 	calculatedAlh := proof.LinearProofTerms[0] // alh at startTx+1
 	for txID := startTxID + 1; txID < endTxID; txID++ {
 		// Ensure the node in the chain is included in the target Merkle Tree
-		if !ahtree.VerifyInclusion(
+		if !ahtree.VerifyInclusionWith(
+			hashAlgorithm,
 			proof.InclusionProofs[txID-startTxID-1],
 			txID,
 			treeSize,
-			leafFor(calculatedAlh),
+			leafFor(hashAlgorithm, calculatedAlh),
 			treeRoot,
 		) {
 			return false
 		}
 
 		// Get the Alh for the next transaction
-		calculatedAlh = advanceLinearHash(calculatedAlh, txID+1, proof.LinearProofTerms[txID-startTxID])
+		calculatedAlh = advanceLinearHash(hashAlgorithm, calculatedAlh, txID+1, proof.LinearProofTerms[txID-startTxID])
 	}
 
 	// We must end up with the final Alh - that one is also checked for inclusion but in different part of the proof
 	return calculatedAlh == endAlh
 }
 
+// VerifyDualProof verifies the proof using the hash algorithm of the transaction headers included in it
 func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh, targetAlh [sha256.Size]byte) bool {
 	if proof == nil ||
 		proof.SourceTxHeader == nil ||
 		proof.TargetTxHeader == nil ||
 		proof.SourceTxHeader.ID != sourceTxID ||
-		proof.TargetTxHeader.ID != targetTxID {
+		proof.TargetTxHeader.ID != targetTxID ||
+		proof.SourceTxHeader.HashAlgorithm != proof.TargetTxHeader.HashAlgorithm ||
+		!proof.TargetTxHeader.HashAlgorithm.IsValid() {
 		return false
 	}
 
+	hashAlgorithm := proof.TargetTxHeader.HashAlgorithm
+
 	if proof.SourceTxHeader.ID == 0 || proof.SourceTxHeader.ID > proof.TargetTxHeader.ID {
 		return false
 	}
@@ -148,11 +177,12 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 	}
 
 	if sourceTxID < proof.TargetTxHeader.BlTxID {
-		verifies := ahtree.VerifyInclusion(
+		verifies := ahtree.VerifyInclusionWith(
+			hashAlgorithm,
 			proof.InclusionProof,
 			sourceTxID,
 			proof.TargetTxHeader.BlTxID,
-			leafFor(sourceAlh),
+			leafFor(hashAlgorithm, sourceAlh),
 			proof.TargetTxHeader.BlRoot,
 		)
 
@@ -162,7 +192,8 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 	}
 
 	if proof.SourceTxHeader.BlTxID > 0 {
-		verifies := ahtree.VerifyConsistency(
+		verifies := ahtree.VerifyConsistencyWith(
+			hashAlgorithm,
 			proof.ConsistencyProof,
 			proof.SourceTxHeader.BlTxID,
 			proof.TargetTxHeader.BlTxID,
@@ -176,10 +207,11 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 	}
 
 	if proof.TargetTxHeader.BlTxID > 0 {
-		verifies := ahtree.VerifyLastInclusion(
+		verifies := ahtree.VerifyLastInclusionWith(
+			hashAlgorithm,
 			proof.LastInclusionProof,
 			proof.TargetTxHeader.BlTxID,
-			leafFor(proof.TargetBlTxAlh),
+			leafFor(hashAlgorithm, proof.TargetBlTxAlh),
 			proof.TargetTxHeader.BlRoot,
 		)
 
@@ -189,7 +221,7 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 	}
 
 	if sourceTxID < proof.TargetTxHeader.BlTxID {
-		verifies := VerifyLinearProof(proof.LinearProof, proof.TargetTxHeader.BlTxID, targetTxID, proof.TargetBlTxAlh, targetAlh)
+		verifies := VerifyLinearProofWith(hashAlgorithm, proof.LinearProof, proof.TargetTxHeader.BlTxID, targetTxID, proof.TargetBlTxAlh, targetAlh)
 		if !verifies {
 			return false
 		}
@@ -197,7 +229,8 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 		// Verify that the part of the linear proof consumed by the new merkle tree is consistent with that Merkle Tree
 		// In this case, this is the whole chain to the SourceTxID from the previous Merkle Tree.
 		// The sourceTxID consistency is already proven using proof.InclusionProof
-		if !VerifyLinearAdvanceProof(
+		if !VerifyLinearAdvanceProofWith(
+			hashAlgorithm,
 			proof.LinearAdvanceProof,
 			proof.SourceTxHeader.BlTxID,
 			sourceTxID,
@@ -210,7 +243,7 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 
 	} else {
 
-		verifies := VerifyLinearProof(proof.LinearProof, sourceTxID, targetTxID, sourceAlh, targetAlh)
+		verifies := VerifyLinearProofWith(hashAlgorithm, proof.LinearProof, sourceTxID, targetTxID, sourceAlh, targetAlh)
 		if !verifies {
 			return false
 		}
@@ -219,7 +252,8 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 		// In this case, this is the whole linear chain between the old Merkle Tree and the new Merkle Tree. The last entry
 		// in the new Merkle Tree is already proven through the LastInclusionProof, the remaining part of the liner proof
 		// that goes outside of the target Merkle Tree will be validated in future DualProof validations
-		if !VerifyLinearAdvanceProof(
+		if !VerifyLinearAdvanceProofWith(
+			hashAlgorithm,
 			proof.LinearAdvanceProof,
 			proof.SourceTxHeader.BlTxID,
 			proof.TargetTxHeader.BlTxID,
@@ -234,35 +268,53 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 	return true
 }
 
-func leafFor(d [sha256.Size]byte) [sha256.Size]byte {
+func leafFor(hashAlgorithm hashing.Algorithm, d [sha256.Size]byte) [sha256.Size]byte {
 	var b [1 + sha256.Size]byte
 	b[0] = ahtree.LeafPrefix
 	copy(b[1:], d[:])
-	return sha256.Sum256(b[:])
+	return hashAlgorithm.Sum(b[:])
 }
 
 type EntrySpecDigest func(kv *EntrySpec) [sha256.Size]byte
 
 func EntrySpecDigestFor(version int) (EntrySpecDigest, error) {
+	return EntrySpecDigestWith(version, hashing.SHA256)
+}
+
+// EntrySpecDigestWith returns the entry digest function for the given tx version and hash algorithm
+func EntrySpecDigestWith(version int, hashAlgorithm hashing.Algorithm) (EntrySpecDigest, error) {
+	err := hashAlgorithm.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	switch version {
 	case 0:
-		return EntrySpecDigest_v0, nil
+		return func(kv *EntrySpec) [sha256.Size]byte { return entrySpecDigest_v0(hashAlgorithm, kv) }, nil
 	case 1:
-		return EntrySpecDigest_v1, nil
+		return func(kv *EntrySpec) [sha256.Size]byte { return entrySpecDigest_v1(hashAlgorithm, kv) }, nil
 	}
 
 	return nil, ErrUnsupportedTxVersion
 }
 
 func EntrySpecDigest_v0(kv *EntrySpec) [sha256.Size]byte {
+	return entrySpecDigest_v0(hashing.SHA256, kv)
+}
+
+func entrySpecDigest_v0(hashAlgorithm hashing.Algorithm, kv *EntrySpec) [sha256.Size]byte {
 	b := make([]byte, len(kv.Key)+sha256.Size)
 	copy(b[:], kv.Key)
-	hvalue := sha256.Sum256(kv.Value)
+	hvalue := hashAlgorithm.Sum(kv.Value)
 	copy(b[len(kv.Key):], hvalue[:])
-	return sha256.Sum256(b)
+	return hashAlgorithm.Sum(b)
 }
 
 func EntrySpecDigest_v1(kv *EntrySpec) [sha256.Size]byte {
+	return entrySpecDigest_v1(hashing.SHA256, kv)
+}
+
+func entrySpecDigest_v1(hashAlgorithm hashing.Algorithm, kv *EntrySpec) [sha256.Size]byte {
 	var mdbs []byte
 
 	if kv.Metadata != nil {
@@ -292,13 +344,13 @@ func EntrySpecDigest_v1(kv *EntrySpec) [sha256.Size]byte {
 	if kv.IsValueTruncated {
 		hvalue = kv.HashValue
 	} else {
-		hvalue = sha256.Sum256(kv.Value)
+		hvalue = hashAlgorithm.Sum(kv.Value)
 	}
 
 	copy(b[i:], hvalue[:])
 	i += sha256.Size
 
-	return sha256.Sum256(b[:i])
+	return hashAlgorithm.Sum(b[:i])
 }
 
 func VerifyDualProofV2(proof *DualProofV2, sourceTxID, targetTxID uint64, sourceAlh, targetAlh [sha256.Size]byte) error {
@@ -307,10 +359,14 @@ func VerifyDualProofV2(proof *DualProofV2, sourceTxID, targetTxID uint64, source
 		proof.TargetTxHeader == nil ||
 		proof.SourceTxHeader.ID == 0 ||
 		proof.SourceTxHeader.ID != sourceTxID ||
-		proof.TargetTxHeader.ID != targetTxID {
+		proof.TargetTxHeader.ID != targetTxID ||
+		proof.SourceTxHeader.HashAlgorithm != proof.TargetTxHeader.HashAlgorithm ||
+		!proof.TargetTxHeader.HashAlgorithm.IsValid() {
 		return ErrIllegalArguments
 	}
 
+	hashAlgorithm := proof.TargetTxHeader.HashAlgorithm
+
 	if sourceTxID > targetTxID {
 		return ErrSourceTxNewerThanTargetTx
 	}
@@ -333,11 +389,12 @@ func VerifyDualProofV2(proof *DualProofV2, sourceTxID, targetTxID uint64, source
 		return nil
 	}
 
-	verifies := ahtree.VerifyInclusion(
+	verifies := ahtree.VerifyInclusionWith(
+		hashAlgorithm,
 		proof.InclusionProof,
 		sourceTxID,
 		proof.TargetTxHeader.BlTxID,
-		leafFor(sourceAlh),
+		leafFor(hashAlgorithm, sourceAlh),
 		proof.TargetTxHeader.BlRoot,
 	)
 
@@ -348,15 +405,17 @@ func VerifyDualProofV2(proof *DualProofV2, sourceTxID, targetTxID uint64, source
 	if sourceTxID == 1 {
 		// corner case to validate the first transaction
 		// alh is empty when the digest of the first transaction is calculated
-		verifies = ahtree.VerifyConsistency(
+		verifies = ahtree.VerifyConsistencyWith(
+			hashAlgorithm,
 			proof.ConsistencyProof,
 			sourceTxID,
 			proof.TargetTxHeader.BlTxID,
-			leafFor(sourceAlh),
+			leafFor(hashAlgorithm, sourceAlh),
 			proof.TargetTxHeader.BlRoot,
 		)
 	} else {
-		verifies = ahtree.VerifyConsistency(
+		verifies = ahtree.VerifyConsistencyWith(
+			hashAlgorithm,
 			proof.ConsistencyProof,
 			proof.SourceTxHeader.BlTxID,
 			proof.TargetTxHeader.BlTxID,
@@ -370,3 +429,33 @@ func VerifyDualProofV2(proof *DualProofV2, sourceTxID, targetTxID uint64, source
 
 	return nil
 }
+
+// VerifyKeyMembership returns true if the proof shows that the entry was the latest one set
+// for its key, at transaction txID, as of the transaction with the given header
+func VerifyKeyMembership(proof *smt.Proof, e *EntrySpec, txID uint64, hdr *TxHeader) bool {
+	if proof == nil || e == nil || hdr == nil || hdr.Metadata == nil || txID > hdr.ID {
+		return false
+	}
+
+	root, ok := hdr.Metadata.GetAuthenticatedIndexRoot()
+	if !ok {
+		return false
+	}
+
+	return smt.VerifyMembership(hdr.HashAlgorithm, proof, e.Key, txID, entrySpecDigest_v1(hdr.HashAlgorithm, e), root)
+}
+
+// VerifyKeyNonMembership returns true if the proof shows that the key was never set
+// as of the transaction with the given header
+func VerifyKeyNonMembership(proof *smt.Proof, key []byte, hdr *TxHeader) bool {
+	if proof == nil || hdr == nil || hdr.Metadata == nil {
+		return false
+	}
+
+	root, ok := hdr.Metadata.GetAuthenticatedIndexRoot()
+	if !ok {
+		return false
+	}
+
+	return smt.VerifyNonMembership(hdr.HashAlgorithm, proof, key, root)
+}
@@ -0,0 +1,511 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// bloom filters are stored as a single file per snapshot, next to nodes and commit folders
+	bloomFilterFilePrefix = "bloom"
+	bloomFilterVersion    = 1
+
+	// number of keys the first stage of a bloom filter is sized for,
+	// every new stage doubles the capacity of the previous one
+	bloomFilterInitialCapacity = 1 << 16
+
+	bloomFilterMaxHashCount = 30
+)
+
+const (
+	bloomFilterKeyDomain byte = iota
+	bloomFilterPrefixDomain
+)
+
+// bloomFilter is a scalable bloom filter holding every key ever inserted into the tree.
+// Keys are never removed from a tbtree, thus the filter only grows until it is rebuilt from
+// a full snapshot during compaction. When a prefix length is set, prefixes of such length
+// are also added so to answer prefix lookups.
+type bloomFilter struct {
+	bitsPerKey int
+	hashCount  int
+	prefixLen  int
+	stages     []*bloomFilterStage
+}
+
+type bloomFilterStage struct {
+	capacity uint64
+	count    uint64
+	bits     []uint64
+}
+
+func newBloomFilter(bitsPerKey, prefixLen int, capacity uint64) *bloomFilter {
+	if capacity < bloomFilterInitialCapacity {
+		capacity = bloomFilterInitialCapacity
+	}
+
+	return &bloomFilter{
+		bitsPerKey: bitsPerKey,
+		hashCount:  bloomFilterHashCount(bitsPerKey),
+		prefixLen:  prefixLen,
+		stages:     []*bloomFilterStage{newBloomFilterStage(capacity, bitsPerKey)},
+	}
+}
+
+// bloomFilterHashCount returns the number of hash functions minimizing the false positive rate
+func bloomFilterHashCount(bitsPerKey int) int {
+	k := int(math.Round(float64(bitsPerKey) * math.Ln2))
+
+	if k < 1 {
+		return 1
+	}
+
+	if k > bloomFilterMaxHashCount {
+		return bloomFilterMaxHashCount
+	}
+
+	return k
+}
+
+func newBloomFilterStage(capacity uint64, bitsPerKey int) *bloomFilterStage {
+	nbits := capacity * uint64(bitsPerKey)
+
+	return &bloomFilterStage{
+		capacity: capacity,
+		bits:     make([]uint64, (nbits+63)/64),
+	}
+}
+
+func bloomFilterHash(domain byte, b []byte) (h1, h2 uint64) {
+	h := fnv.New64a()
+	h.Write([]byte{domain})
+	h.Write(b)
+
+	sum := h.Sum64()
+
+	// fnv is mixed so that all bits are evenly distributed, h2 is odd so to visit different positions
+	return mix64(sum), mix64(sum^0x9e3779b97f4a7c15) | 1
+}
+
+// mix64 is the finalizer of murmur3 hash
+func mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+func (s *bloomFilterStage) add(h1, h2 uint64, hashCount int) {
+	nbits := uint64(len(s.bits)) * 64
+
+	for i := 0; i < hashCount; i++ {
+		pos := (h1 + uint64(i)*h2) % nbits
+		s.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (s *bloomFilterStage) contains(h1, h2 uint64, hashCount int) bool {
+	nbits := uint64(len(s.bits)) * 64
+
+	for i := 0; i < hashCount; i++ {
+		pos := (h1 + uint64(i)*h2) % nbits
+		if s.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *bloomFilter) add(key []byte) {
+	f.addHashed(bloomFilterHash(bloomFilterKeyDomain, key))
+
+	if f.prefixLen > 0 && len(key) >= f.prefixLen {
+		f.addHashed(bloomFilterHash(bloomFilterPrefixDomain, key[:f.prefixLen]))
+	}
+}
+
+func (f *bloomFilter) addHashed(h1, h2 uint64) {
+	if f.containsHashed(h1, h2) {
+		// already present (or a false positive), no need to consume capacity
+		return
+	}
+
+	s := f.stages[len(f.stages)-1]
+
+	if s.count >= s.capacity {
+		s = newBloomFilterStage(s.capacity*2, f.bitsPerKey)
+		f.stages = append(f.stages, s)
+	}
+
+	s.add(h1, h2, f.hashCount)
+	s.count++
+}
+
+func (f *bloomFilter) containsHashed(h1, h2 uint64) bool {
+	for _, s := range f.stages {
+		if s.contains(h1, h2, f.hashCount) {
+			return true
+		}
+	}
+	return false
+}
+
+// mayContainKey returns false only if the key was never added to the filter
+func (f *bloomFilter) mayContainKey(key []byte) bool {
+	return f.containsHashed(bloomFilterHash(bloomFilterKeyDomain, key))
+}
+
+// mayContainPrefix returns false only if no key starting with prefix was ever added to the filter.
+// Prefixes shorter than the configured prefix length can not be answered by the filter.
+func (f *bloomFilter) mayContainPrefix(prefix []byte) bool {
+	if f.prefixLen == 0 || len(prefix) < f.prefixLen {
+		return true
+	}
+
+	return f.containsHashed(bloomFilterHash(bloomFilterPrefixDomain, prefix[:f.prefixLen]))
+}
+
+// count returns the number of entries added to the filter, it may be used as capacity when rebuilding it
+func (f *bloomFilter) count() uint64 {
+	var c uint64
+	for _, s := range f.stages {
+		c += s.count
+	}
+	return c
+}
+
+func (f *bloomFilter) size() int {
+	sz := 0
+	for _, s := range f.stages {
+		sz += len(s.bits) * 8
+	}
+	return sz
+}
+
+// bloomFilterTag identifies the commit entry a persisted bloom filter is consistent with
+func bloomFilterTag(e *cLogEntry) []byte {
+	entry := *e
+	entry.synced = true
+	return entry.serialize()
+}
+
+func bloomFilterPath(path string, snapID uint64) string {
+	return filepath.Join(path, snapFolder(bloomFilterFilePrefix, snapID))
+}
+
+// serialization format:
+// version | bits per key | prefix length | tag | stage count | stages | sha256 digest
+// where each stage is serialized as: capacity | count | words count | words
+func (f *bloomFilter) serialize(tag []byte) []byte {
+	var buf bytes.Buffer
+
+	var b [8]byte
+
+	binary.BigEndian.PutUint32(b[:], bloomFilterVersion)
+	buf.Write(b[:4])
+
+	binary.BigEndian.PutUint32(b[:], uint32(f.bitsPerKey))
+	buf.Write(b[:4])
+
+	binary.BigEndian.PutUint32(b[:], uint32(f.prefixLen))
+	buf.Write(b[:4])
+
+	buf.Write(tag)
+
+	binary.BigEndian.PutUint32(b[:], uint32(len(f.stages)))
+	buf.Write(b[:4])
+
+	for _, s := range f.stages {
+		binary.BigEndian.PutUint64(b[:], s.capacity)
+		buf.Write(b[:])
+
+		binary.BigEndian.PutUint64(b[:], s.count)
+		buf.Write(b[:])
+
+		binary.BigEndian.PutUint32(b[:], uint32(len(s.bits)))
+		buf.Write(b[:4])
+
+		for _, w := range s.bits {
+			binary.BigEndian.PutUint64(b[:], w)
+			buf.Write(b[:])
+		}
+	}
+
+	digest := sha256.Sum256(buf.Bytes())
+	buf.Write(digest[:])
+
+	return buf.Bytes()
+}
+
+func deserializeBloomFilter(b []byte) (f *bloomFilter, tag []byte, err error) {
+	if len(b) < 4+4+4+cLogEntrySize+4+sha256.Size {
+		return nil, nil, fmt.Errorf("%w: bloom filter is too short", ErrCorruptedFile)
+	}
+
+	data := b[:len(b)-sha256.Size]
+
+	digest := sha256.Sum256(data)
+	if !bytes.Equal(digest[:], b[len(data):]) {
+		return nil, nil, fmt.Errorf("%w: invalid bloom filter checksum", ErrCorruptedFile)
+	}
+
+	i := 0
+
+	version := binary.BigEndian.Uint32(data[i:])
+	i += 4
+
+	if version != bloomFilterVersion {
+		return nil, nil, fmt.Errorf("%w: unsupported bloom filter version %d", ErrIncompatibleDataFormat, version)
+	}
+
+	bitsPerKey := int(binary.BigEndian.Uint32(data[i:]))
+	i += 4
+
+	prefixLen := int(binary.BigEndian.Uint32(data[i:]))
+	i += 4
+
+	tag = data[i : i+cLogEntrySize]
+	i += cLogEntrySize
+
+	stageCount := int(binary.BigEndian.Uint32(data[i:]))
+	i += 4
+
+	f = &bloomFilter{
+		bitsPerKey: bitsPerKey,
+		hashCount:  bloomFilterHashCount(bitsPerKey),
+		prefixLen:  prefixLen,
+	}
+
+	for s := 0; s < stageCount; s++ {
+		if len(data)-i < 8+8+4 {
+			return nil, nil, fmt.Errorf("%w: bloom filter is too short", ErrCorruptedFile)
+		}
+
+		stage := &bloomFilterStage{}
+
+		stage.capacity = binary.BigEndian.Uint64(data[i:])
+		i += 8
+
+		stage.count = binary.BigEndian.Uint64(data[i:])
+		i += 8
+
+		words := int(binary.BigEndian.Uint32(data[i:]))
+		i += 4
+
+		if words == 0 || (len(data)-i)/8 < words {
+			return nil, nil, fmt.Errorf("%w: invalid bloom filter stage", ErrCorruptedFile)
+		}
+
+		stage.bits = make([]uint64, words)
+
+		for w := range stage.bits {
+			stage.bits[w] = binary.BigEndian.Uint64(data[i:])
+			i += 8
+		}
+
+		f.stages = append(f.stages, stage)
+	}
+
+	if len(f.stages) == 0 || i != len(data) {
+		return nil, nil, fmt.Errorf("%w: invalid bloom filter", ErrCorruptedFile)
+	}
+
+	return f, tag, nil
+}
+
+func readBloomFilterFile(fpath string) (f *bloomFilter, tag []byte, err error) {
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deserializeBloomFilter(b)
+}
+
+func writeBloomFilterFile(fpath string, f *bloomFilter, tag []byte, fileMode os.FileMode) error {
+	tmpPath := fpath + ".tmp"
+
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(f.serialize(tag))
+	if err == nil {
+		err = file.Sync()
+	}
+
+	cerr := file.Close()
+	if err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, fpath)
+}
+
+func removeBloomFilterFile(fpath string) error {
+	err := os.Remove(fpath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// loadBloomFilter reads the bloom filter persisted for the latest commit entry,
+// it's rebuilt from the current root when missing, outdated or created with different settings
+func (t *TBtree) loadBloomFilter(validatedCLogEntry *cLogEntry) error {
+	if t.bloomFilterBitsPerKey == 0 {
+		return nil
+	}
+
+	if validatedCLogEntry == nil {
+		// fresh btree
+		t.bloomFilter = newBloomFilter(t.bloomFilterBitsPerKey, t.bloomFilterPrefixLen, 0)
+		return nil
+	}
+
+	expectedTag := bloomFilterTag(validatedCLogEntry)
+
+	f, tag, err := readBloomFilterFile(t.bloomFilterPath)
+	if err == nil &&
+		bytes.Equal(tag, expectedTag) &&
+		f.bitsPerKey == t.bloomFilterBitsPerKey &&
+		f.prefixLen == t.bloomFilterPrefixLen {
+
+		t.bloomFilter = f
+		t.bloomFilterPersistedTag = expectedTag
+
+		metricsBloomFilterSize.WithLabelValues(t.path).Set(float64(f.size()))
+
+		return nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		t.logger.Warningf("reading bloom filter at '%s' returned: %v", t.bloomFilterPath, err)
+	}
+
+	t.logger.Infof("rebuilding bloom filter of index '%s' {ts=%d}...", t.path, t.root.ts())
+
+	f = newBloomFilter(t.bloomFilterBitsPerKey, t.bloomFilterPrefixLen, 0)
+
+	err = t.addKeysToBloomFilter(t.root, f)
+	if err != nil {
+		return err
+	}
+
+	t.bloomFilter = f
+
+	metricsBloomFilterSize.WithLabelValues(t.path).Set(float64(f.size()))
+
+	t.logger.Infof("bloom filter of index '%s' {ts=%d} successfully rebuilt", t.path, t.root.ts())
+
+	return nil
+}
+
+func (t *TBtree) addKeysToBloomFilter(n node, f *bloomFilter) error {
+	switch nn := n.(type) {
+	case *innerNode:
+		for _, c := range nn.nodes {
+			err := t.addKeysToBloomFilter(c, f)
+			if err != nil {
+				return err
+			}
+		}
+	case *nodeRef:
+		c, err := t.nodeAt(nn.off, false)
+		if err != nil {
+			return err
+		}
+
+		return t.addKeysToBloomFilter(c, f)
+	case *leafNode:
+		for _, v := range nn.values {
+			f.add(v.key)
+		}
+	}
+
+	return nil
+}
+
+// persistBloomFilter stores the bloom filter for the latest commit entry.
+// Failures are not critical as the filter is rebuilt when opening the index.
+func (t *TBtree) persistBloomFilter() {
+	if t.bloomFilter == nil || t.readOnly || t.lastCommitTag == nil ||
+		bytes.Equal(t.lastCommitTag, t.bloomFilterPersistedTag) {
+		return
+	}
+
+	err := writeBloomFilterFile(t.bloomFilterPath, t.bloomFilter, t.lastCommitTag, t.fileMode)
+	if err != nil {
+		t.logger.Warningf("persisting bloom filter of index '%s' returned: %v", t.path, err)
+		return
+	}
+
+	t.bloomFilterPersistedTag = t.lastCommitTag
+}
+
+// mayContainKey consults the bloom filter, if any, and accounts the outcome
+func (t *TBtree) mayContainKey(key []byte) bool {
+	if t.bloomFilter == nil {
+		return true
+	}
+
+	if t.bloomFilter.mayContainKey(key) {
+		metricsBloomFilterMiss.WithLabelValues(t.path).Inc()
+		return true
+	}
+
+	metricsBloomFilterHit.WithLabelValues(t.path).Inc()
+	return false
+}
+
+func (t *TBtree) mayContainPrefix(prefix []byte) bool {
+	if t.bloomFilter == nil || t.bloomFilterPrefixLen == 0 || len(prefix) < t.bloomFilterPrefixLen {
+		return true
+	}
+
+	if t.bloomFilter.mayContainPrefix(prefix) {
+		metricsBloomFilterMiss.WithLabelValues(t.path).Inc()
+		return true
+	}
+
+	metricsBloomFilterHit.WithLabelValues(t.path).Inc()
+	return false
+}
+
+// accountBloomFilterLookup tracks false positives i.e. lookups the filter did not prevent but ended with no entry found
+func (t *TBtree) accountBloomFilterLookup(err error) {
+	if t.bloomFilter != nil && errors.Is(err, ErrKeyNotFound) {
+		metricsBloomFilterFalsePositive.WithLabelValues(t.path).Inc()
+	}
+}
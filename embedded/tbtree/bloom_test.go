@@ -0,0 +1,257 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	f := newBloomFilter(10, 2, 0)
+	require.Equal(t, 7, f.hashCount)
+
+	keyCount := bloomFilterInitialCapacity + 1000
+
+	var k [8]byte
+
+	for i := 0; i < keyCount; i++ {
+		binary.BigEndian.PutUint64(k[:], uint64(2*i))
+		f.add(k[:])
+	}
+
+	// capacity of the first stage was exceeded
+	require.Len(t, f.stages, 2)
+	require.Equal(t, uint64(bloomFilterInitialCapacity), f.stages[0].count)
+
+	for i := 0; i < keyCount; i++ {
+		binary.BigEndian.PutUint64(k[:], uint64(2*i))
+		require.True(t, f.mayContainKey(k[:]))
+		require.True(t, f.mayContainPrefix(k[:]))
+	}
+
+	falsePositives := 0
+
+	for i := 0; i < keyCount; i++ {
+		binary.BigEndian.PutUint64(k[:], uint64(2*i+1))
+		if f.mayContainKey(k[:]) {
+			falsePositives++
+		}
+	}
+
+	require.Less(t, falsePositives, keyCount/20)
+
+	t.Run("prefix lookups", func(t *testing.T) {
+		f := newBloomFilter(10, 2, 0)
+		f.add([]byte("abcd"))
+
+		require.True(t, f.mayContainPrefix([]byte("ab")))
+		require.True(t, f.mayContainPrefix([]byte("abzz")))
+		require.True(t, f.mayContainPrefix([]byte("a")))
+		require.False(t, f.mayContainPrefix([]byte("zz")))
+		require.False(t, f.mayContainKey([]byte("ab")))
+	})
+
+	t.Run("adding an existing key does not consume capacity", func(t *testing.T) {
+		f := newBloomFilter(10, 0, 0)
+		f.add([]byte("key1"))
+		f.add([]byte("key1"))
+
+		require.Equal(t, uint64(1), f.count())
+	})
+}
+
+func TestBloomFilterSerialization(t *testing.T) {
+	f := newBloomFilter(8, 3, 0)
+
+	for i := 0; i < 100; i++ {
+		f.add([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	tag := bloomFilterTag(&cLogEntry{finalNLogSize: 100, rootNodeSize: 10})
+
+	b := f.serialize(tag)
+
+	f1, tag1, err := deserializeBloomFilter(b)
+	require.NoError(t, err)
+	require.Equal(t, tag, tag1)
+	require.Equal(t, f, f1)
+
+	_, _, err = deserializeBloomFilter(b[:10])
+	require.ErrorIs(t, err, ErrCorruptedFile)
+
+	b[20] ^= 0xFF
+	_, _, err = deserializeBloomFilter(b)
+	require.ErrorIs(t, err, ErrCorruptedFile)
+
+	t.Run("file", func(t *testing.T) {
+		fpath := filepath.Join(t.TempDir(), bloomFilterFilePrefix)
+
+		err := writeBloomFilterFile(fpath, f, tag, DefaultFileMode)
+		require.NoError(t, err)
+
+		f1, tag1, err := readBloomFilterFile(fpath)
+		require.NoError(t, err)
+		require.Equal(t, tag, tag1)
+		require.Equal(t, f, f1)
+
+		require.NoError(t, removeBloomFilterFile(fpath))
+		require.NoError(t, removeBloomFilterFile(fpath))
+
+		_, _, err = readBloomFilterFile(fpath)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestTBtreeBloomFilter(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithBloomFilterBitsPerKey(10).
+		WithBloomFilterPrefixLen(4).
+		WithCompactionThld(1)
+
+	tree, err := Open(dir, opts)
+	require.NoError(t, err)
+	require.NotNil(t, tree.bloomFilter)
+
+	for i := 0; i < 100; i++ {
+		err = tree.Insert([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	checkLookups := func(tree *TBtree) {
+		for i := 0; i < 100; i++ {
+			v, _, _, err := tree.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), v)
+
+			_, _, _, err = tree.GetBetween([]byte(fmt.Sprintf("key%d", i)), 1, tree.Ts())
+			require.NoError(t, err)
+		}
+
+		require.False(t, tree.mayContainKey([]byte("missing")))
+
+		_, _, _, err := tree.Get([]byte("missing"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, _, _, err = tree.GetBetween([]byte("missing"), 1, tree.Ts())
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		k, _, _, _, err := tree.GetWithPrefix([]byte("key5"), nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("key5"), k)
+
+		k, _, _, _, err = tree.GetWithPrefix([]byte("ke"), nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("key0"), k)
+
+		_, _, _, _, err = tree.GetWithPrefix([]byte("miss"), nil)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	}
+
+	checkLookups(tree)
+
+	err = tree.Close()
+	require.NoError(t, err)
+
+	bloomPath := bloomFilterPath(dir, 0)
+	require.FileExists(t, bloomPath)
+
+	t.Run("persisted bloom filter should be loaded", func(t *testing.T) {
+		tree, err := Open(dir, opts)
+		require.NoError(t, err)
+		require.NotNil(t, tree.bloomFilterPersistedTag)
+		require.Equal(t, tree.lastCommitTag, tree.bloomFilterPersistedTag)
+
+		checkLookups(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("corrupted bloom filter should be rebuilt", func(t *testing.T) {
+		err := os.WriteFile(bloomPath, []byte("corrupted"), 0644)
+		require.NoError(t, err)
+
+		tree, err := Open(dir, opts)
+		require.NoError(t, err)
+		require.Nil(t, tree.bloomFilterPersistedTag)
+
+		checkLookups(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+
+		_, tag, err := readBloomFilterFile(bloomPath)
+		require.NoError(t, err)
+		require.Equal(t, tree.lastCommitTag, tag)
+	})
+
+	t.Run("bloom filter with different settings should be rebuilt", func(t *testing.T) {
+		tree, err := Open(dir, DefaultOptions().WithBloomFilterBitsPerKey(12))
+		require.NoError(t, err)
+		require.Nil(t, tree.bloomFilterPersistedTag)
+		require.Equal(t, 12, tree.bloomFilter.bitsPerKey)
+		require.Zero(t, tree.bloomFilter.prefixLen)
+
+		err = tree.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("bloom filter should be rebuilt on compaction", func(t *testing.T) {
+		tree, err := Open(dir, opts)
+		require.NoError(t, err)
+
+		ts, err := tree.Compact()
+		require.NoError(t, err)
+		require.FileExists(t, bloomFilterPath(dir, ts))
+
+		err = tree.Close()
+		require.NoError(t, err)
+
+		tree, err = Open(dir, opts)
+		require.NoError(t, err)
+		require.NotNil(t, tree.bloomFilterPersistedTag)
+
+		checkLookups(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+
+		// bloom filter of the discarded snapshot is removed as well
+		require.NoFileExists(t, bloomPath)
+	})
+
+	t.Run("disabled bloom filter", func(t *testing.T) {
+		tree, err := Open(dir, DefaultOptions())
+		require.NoError(t, err)
+		require.Nil(t, tree.bloomFilter)
+		require.True(t, tree.mayContainKey([]byte("missing")))
+
+		_, _, _, err = tree.Get([]byte("missing"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		err = tree.Close()
+		require.NoError(t, err)
+	})
+}
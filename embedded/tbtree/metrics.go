@@ -117,3 +117,23 @@ var metricsBtreeNodesDataEndOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Name: "immudb_btree_nodes_data_end",
 	Help: "End offset for btree nodes data appendable",
 }, []string{"id"})
+
+var metricsBloomFilterHit = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_btree_bloom_filter_hit",
+	Help: "Number of btree lookups answered by the bloom filter without visiting btree nodes",
+}, []string{"id"})
+
+var metricsBloomFilterMiss = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_btree_bloom_filter_miss",
+	Help: "Number of btree lookups the bloom filter could not answer, requiring btree nodes to be visited",
+}, []string{"id"})
+
+var metricsBloomFilterFalsePositive = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_btree_bloom_filter_false_positive",
+	Help: "Number of btree lookups not answered by the bloom filter which did not find any entry",
+}, []string{"id"})
+
+var metricsBloomFilterSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "immudb_btree_bloom_filter_size",
+	Help: "Size in bytes of the btree bloom filter",
+}, []string{"id"})
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/cache"
 	"github.com/codenotary/immudb/embedded/logger"
@@ -44,12 +45,16 @@ const (
 	DefaultMaxValueSize                  = 512
 	DefaultCompactionThld                = 2
 	DefaultDelayDuringCompaction         = time.Duration(10) * time.Millisecond
+	DefaultBloomFilterBitsPerKey         = 0 // bloom filter is disabled by default
+	DefaultBloomFilterPrefixLen          = 0
 
 	DefaultNodesLogMaxOpenedFiles   = 10
 	DefaultHistoryLogMaxOpenedFiles = 1
 	DefaultCommitLogMaxOpenedFiles  = 1
 
 	MinCacheSize = 1
+
+	MaxBloomFilterBitsPerKey = 64
 )
 
 type AppFactoryFunc func(
@@ -77,6 +82,7 @@ type Options struct {
 	cache               *cache.Cache
 	readOnly            bool
 	fileMode            os.FileMode
+	keyProvider         encryptedapp.KeyProvider
 
 	nodesLogMaxOpenedFiles   int
 	historyLogMaxOpenedFiles int
@@ -85,6 +91,11 @@ type Options struct {
 	compactionThld        int
 	delayDuringCompaction time.Duration
 
+	// bits per key used by bloom filters, zero disables them
+	bloomFilterBitsPerKey int
+	// length of key prefixes added to bloom filters to speed up prefix lookups, zero disables them
+	bloomFilterPrefixLen int
+
 	// options below are only set during initialization and stored as metadata
 	maxNodeSize  int
 	maxKeySize   int
@@ -112,6 +123,8 @@ func DefaultOptions() *Options {
 		fileMode:              DefaultFileMode,
 		compactionThld:        DefaultCompactionThld,
 		delayDuringCompaction: DefaultDelayDuringCompaction,
+		bloomFilterBitsPerKey: DefaultBloomFilterBitsPerKey,
+		bloomFilterPrefixLen:  DefaultBloomFilterPrefixLen,
 
 		nodesLogMaxOpenedFiles:   DefaultNodesLogMaxOpenedFiles,
 		historyLogMaxOpenedFiles: DefaultHistoryLogMaxOpenedFiles,
@@ -194,6 +207,14 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid CompactionThld", ErrInvalidOptions)
 	}
 
+	if opts.bloomFilterBitsPerKey < 0 || opts.bloomFilterBitsPerKey > MaxBloomFilterBitsPerKey {
+		return fmt.Errorf("%w: invalid BloomFilterBitsPerKey", ErrInvalidOptions)
+	}
+
+	if opts.bloomFilterPrefixLen < 0 || opts.bloomFilterPrefixLen > opts.maxKeySize {
+		return fmt.Errorf("%w: invalid BloomFilterPrefixLen", ErrInvalidOptions)
+	}
+
 	if opts.logger == nil {
 		return fmt.Errorf("%w: invalid Logger", ErrInvalidOptions)
 	}
@@ -206,6 +227,11 @@ func (opts *Options) WithLogger(logger logger.Logger) *Options {
 	return opts
 }
 
+func (opts *Options) WithKeyProvider(keyProvider encryptedapp.KeyProvider) *Options {
+	opts.keyProvider = keyProvider
+	return opts
+}
+
 func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	opts.appFactory = appFactory
 	return opts
@@ -311,6 +337,16 @@ func (opts *Options) WithDelayDuringCompaction(delay time.Duration) *Options {
 	return opts
 }
 
+func (opts *Options) WithBloomFilterBitsPerKey(bitsPerKey int) *Options {
+	opts.bloomFilterBitsPerKey = bitsPerKey
+	return opts
+}
+
+func (opts *Options) WithBloomFilterPrefixLen(prefixLen int) *Options {
+	opts.bloomFilterPrefixLen = prefixLen
+	return opts
+}
+
 func (opts *Options) WithIdentifier(id uint16) *Options {
 	opts.ID = id
 	return opts
@@ -50,6 +50,10 @@ func TestInvalidOptions(t *testing.T) {
 		{"NodesLogMaxOpenedFiles", DefaultOptions().WithNodesLogMaxOpenedFiles(0)},
 		{"HistoryLogMaxOpenedFiles", DefaultOptions().WithHistoryLogMaxOpenedFiles(0)},
 		{"CommitLogMaxOpenedFiles", DefaultOptions().WithCommitLogMaxOpenedFiles(0)},
+		{"BloomFilterBitsPerKey<0", DefaultOptions().WithBloomFilterBitsPerKey(-1)},
+		{"BloomFilterBitsPerKey>Max", DefaultOptions().WithBloomFilterBitsPerKey(MaxBloomFilterBitsPerKey + 1)},
+		{"BloomFilterPrefixLen<0", DefaultOptions().WithBloomFilterPrefixLen(-1)},
+		{"BloomFilterPrefixLen>MaxKeySize", DefaultOptions().WithBloomFilterPrefixLen(DefaultMaxKeySize + 1)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -76,6 +80,9 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, DefaultMaxNodeSize, opts.WithMaxNodeSize(DefaultMaxNodeSize).maxNodeSize)
 	require.Equal(t, DefaultRenewSnapRootAfter, opts.WithRenewSnapRootAfter(DefaultRenewSnapRootAfter).renewSnapRootAfter)
 
+	require.Equal(t, 10, opts.WithBloomFilterBitsPerKey(10).bloomFilterBitsPerKey)
+	require.Equal(t, 4, opts.WithBloomFilterPrefixLen(4).bloomFilterPrefixLen)
+
 	require.Equal(t, 256, opts.WithMaxKeySize(256).maxKeySize)
 	require.Equal(t, 256, opts.WithMaxValueSize(256).maxValueSize)
 
@@ -93,6 +100,7 @@ func TestValidOptions(t *testing.T) {
 	require.True(t, opts.WithReadOnly(true).readOnly)
 	require.NoError(t, opts.Validate())
 	require.Nil(t, opts.WithAppFactory(nil).appFactory)
+	require.Nil(t, opts.WithKeyProvider(nil).keyProvider)
 	require.NoError(t, opts.Validate())
 
 	appFactoryCalled := false
@@ -406,6 +406,7 @@ func (n *innerNode) writeTo(nw, hw io.Writer, writeOpts *WriteOpts, buf []byte)
 		commitLog:      writeOpts.commitLog,
 		reportProgress: writeOpts.reportProgress,
 		MinOffset:      writeOpts.MinOffset,
+		bloomFilter:    writeOpts.bloomFilter,
 	}
 
 	offsets := make([]int64, len(n.nodes))
@@ -588,6 +589,12 @@ func (l *leafNode) writeTo(nw, hw io.Writer, writeOpts *WriteOpts, buf []byte) (
 		}
 	}
 
+	if writeOpts.bloomFilter != nil {
+		for _, v := range l.values {
+			writeOpts.bloomFilter.add(v.key)
+		}
+	}
+
 	writeOpts.reportProgress(0, 1, len(l.values))
 
 	return nOff, nOff, wN, accH, nil
@@ -33,6 +33,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded"
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/cache"
 	"github.com/codenotary/immudb/embedded/logger"
@@ -196,6 +197,7 @@ type TBtree struct {
 	cacheSize                  int
 	fileSize                   int
 	fileMode                   os.FileMode
+	keyProvider                encryptedapp.KeyProvider
 	maxKeySize                 int
 	maxValueSize               int
 	compactionThld             int
@@ -205,6 +207,13 @@ type TBtree struct {
 	commitLogMaxOpenedFiles    int
 	appFactory                 AppFactoryFunc
 	appRemove                  AppRemoveFunc
+	bloomFilterBitsPerKey      int
+	bloomFilterPrefixLen       int
+
+	bloomFilter             *bloomFilter
+	bloomFilterPath         string
+	bloomFilterPersistedTag []byte
+	lastCommitTag           []byte
 
 	bufferedDataSize int
 	onFlush          OnFlushFunc
@@ -257,6 +266,7 @@ type WriteOpts struct {
 	commitLog      bool
 	reportProgress writeProgressOutputFunc
 	MinOffset      int64
+	bloomFilter    *bloomFilter // when set, keys of written leaf nodes are added to it
 }
 
 type innerNode struct {
@@ -326,6 +336,7 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		WithRetryableSync(false).
 		WithFileSize(opts.fileSize).
 		WithFileMode(opts.fileMode).
+		WithKeyProvider(opts.keyProvider).
 		WithWriteBufferSize(opts.flushBufferSize).
 		WithMetadata(metadata.Bytes())
 
@@ -396,7 +407,7 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		}
 		if err == nil && !discardSnapshotsFolder {
 			// TODO: semantic validation and further amendment procedures may be done instead of a full initialization
-			t, err = OpenWith(path, nLog, hLog, cLog, opts)
+			t, err = openWith(path, snapID, nLog, hLog, cLog, opts)
 		}
 		if err != nil {
 			opts.logger.Infof("skipping snapshots at '%s', opening btree returned: %v", snapPath, err)
@@ -447,7 +458,7 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		return nil, err
 	}
 
-	return OpenWith(path, nLog, hLog, cLog, opts)
+	return openWith(path, 0, nLog, hLog, cLog, opts)
 }
 
 func snapFolder(folder string, snapID uint64) string {
@@ -501,6 +512,11 @@ func discardSnapshots(path string, snapIDs []uint64, appRemove AppRemoveFunc, lo
 			return err
 		}
 
+		err = removeBloomFilterFile(bloomFilterPath(path, snapID))
+		if err != nil {
+			return err
+		}
+
 		logger.Infof("snapshot with id=%d at '%s' has been discarded, %d", snapID, path)
 	}
 
@@ -508,6 +524,10 @@ func discardSnapshots(path string, snapIDs []uint64, appRemove AppRemoveFunc, lo
 }
 
 func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options) (*TBtree, error) {
+	return openWith(path, 0, nLog, hLog, cLog, opts)
+}
+
+func openWith(path string, snapID uint64, nLog, hLog, cLog appendable.Appendable, opts *Options) (*TBtree, error) {
 	if nLog == nil || hLog == nil || cLog == nil {
 		return nil, ErrIllegalArguments
 	}
@@ -590,6 +610,7 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		fileSize:                 opts.fileSize,
 		cacheSize:                opts.cacheSize,
 		fileMode:                 opts.fileMode,
+		keyProvider:              opts.keyProvider,
 		compactionThld:           opts.compactionThld,
 		delayDuringCompaction:    opts.delayDuringCompaction,
 		nodesLogMaxOpenedFiles:   opts.nodesLogMaxOpenedFiles,
@@ -598,6 +619,9 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		readOnly:                 opts.readOnly,
 		appFactory:               opts.appFactory,
 		appRemove:                opts.appRemove,
+		bloomFilterBitsPerKey:    opts.bloomFilterBitsPerKey,
+		bloomFilterPrefixLen:     opts.bloomFilterPrefixLen,
+		bloomFilterPath:          bloomFilterPath(path, snapID),
 		snapshots:                make(map[uint64]*Snapshot),
 	}
 
@@ -677,6 +701,12 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		t.committedNLogSize = validatedCLogEntry.finalNLogSize
 		t.committedHLogSize = validatedCLogEntry.finalHLogSize
 		t.minOffset = t.root.minOffset()
+		t.lastCommitTag = bloomFilterTag(validatedCLogEntry)
+	}
+
+	err = t.loadBloomFilter(validatedCLogEntry)
+	if err != nil {
+		return nil, fmt.Errorf("%w: while loading bloom filter of index '%s'", err, path)
 	}
 
 	metricsBtreeNodesDataBeginOffset.WithLabelValues(t.path).Set(float64(t.minOffset))
@@ -723,6 +753,7 @@ func (t *TBtree) GetOptions() *Options {
 	return DefaultOptions().
 		WithReadOnly(t.readOnly).
 		WithFileMode(t.fileMode).
+		WithKeyProvider(t.keyProvider).
 		WithFileSize(t.fileSize).
 		WithMaxKeySize(t.maxKeySize).
 		WithMaxValueSize(t.maxValueSize).
@@ -741,7 +772,9 @@ func (t *TBtree) GetOptions() *Options {
 		WithHistoryLogMaxOpenedFiles(t.historyLogMaxOpenedFiles).
 		WithCommitLogMaxOpenedFiles(t.commitLogMaxOpenedFiles).
 		WithAppFactory(t.appFactory).
-		WithAppRemoveFunc(t.appRemove)
+		WithAppRemoveFunc(t.appRemove).
+		WithBloomFilterBitsPerKey(t.bloomFilterBitsPerKey).
+		WithBloomFilterPrefixLen(t.bloomFilterPrefixLen)
 }
 
 func (t *TBtree) cachePut(n node) {
@@ -975,7 +1008,13 @@ func (t *TBtree) Get(key []byte) (value []byte, ts uint64, hc uint64, err error)
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !t.mayContainKey(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	v, ts, hc, err := t.root.get(key)
+	t.accountBloomFilterLookup(err)
+
 	return cp(v), ts, hc, err
 }
 
@@ -991,6 +1030,10 @@ func (t *TBtree) GetBetween(key []byte, initialTs, finalTs uint64) (value []byte
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !t.mayContainKey(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	return t.root.getBetween(key, initialTs, finalTs)
 }
 
@@ -1021,6 +1064,10 @@ func (t *TBtree) GetWithPrefix(prefix []byte, neq []byte) (key []byte, value []b
 		return nil, nil, 0, 0, ErrAlreadyClosed
 	}
 
+	if !t.mayContainPrefix(prefix) {
+		return nil, nil, 0, 0, ErrKeyNotFound
+	}
+
 	path, leaf, off, err := t.root.findLeafNode(prefix, nil, 0, neq, false)
 	if err != nil {
 		return nil, nil, 0, 0, err
@@ -1219,6 +1266,8 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 			t.path, t.root.ts(), cleanupPercentageHint, cleanupPercentage, err)
 	}
 
+	t.lastCommitTag = bloomFilterTag(cLogEntry)
+
 	t.insertionCountSinceFlush = 0
 	if t.onFlush != nil {
 		t.onFlush(t.bufferedDataSize)
@@ -1286,6 +1335,10 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 
 	metricsBtreeNodesDataEndOffset.WithLabelValues(t.path).Set(float64(t.committedNLogSize))
 
+	if sync {
+		t.persistBloomFilter()
+	}
+
 	// current root can be used as latest snapshot as !t.root.mutated() holds
 	t.lastSnapRoot = t.root
 	t.lastSnapRootAt = time.Now()
@@ -1293,6 +1346,14 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 	return wN, wH, nil
 }
 
+// ActiveSnapshots returns the number of snapshots not yet closed
+func (t *TBtree) ActiveSnapshots() int {
+	t.rwmutex.RLock()
+	defer t.rwmutex.RUnlock()
+
+	return len(t.snapshots)
+}
+
 // SnapshotCount returns the number of stored snapshots
 // Note: snapshotCount(compact(t)) = 1
 func (t *TBtree) SnapshotCount() (uint64, error) {
@@ -1393,6 +1454,12 @@ func (t *TBtree) Compact() (uint64, error) {
 
 	snap := t.newSnapshot(0, t.root)
 
+	// bloom filter is rebuilt from the dumped snapshot, sized for the keys currently in the tree
+	var bf *bloomFilter
+	if t.bloomFilter != nil {
+		bf = newBloomFilter(t.bloomFilterBitsPerKey, t.bloomFilterPrefixLen, t.bloomFilter.count())
+	}
+
 	t.compacting = true
 	defer func() {
 		t.compacting = false
@@ -1415,7 +1482,7 @@ func (t *TBtree) Compact() (uint64, error) {
 	)
 	defer finishOutput()
 
-	err = t.fullDump(snap, progressOutput)
+	err = t.fullDump(snap, bf, progressOutput)
 	if err != nil {
 		return 0, t.wrapNwarn("dumping index '%s' {ts=%d} returned: %v", t.path, snap.Ts(), err)
 	}
@@ -1425,7 +1492,7 @@ func (t *TBtree) Compact() (uint64, error) {
 	return snap.Ts(), nil
 }
 
-func (t *TBtree) fullDump(snap *Snapshot, progressOutput writeProgressOutputFunc) error {
+func (t *TBtree) fullDump(snap *Snapshot, bf *bloomFilter, progressOutput writeProgressOutputFunc) error {
 	metadata := appendable.NewMetadata(nil)
 	metadata.PutInt(MetaVersion, Version)
 	metadata.PutInt(MetaMaxNodeSize, t.maxNodeSize)
@@ -1435,6 +1502,7 @@ func (t *TBtree) fullDump(snap *Snapshot, progressOutput writeProgressOutputFunc
 		WithRetryableSync(false).
 		WithFileSize(t.fileSize).
 		WithFileMode(t.fileMode).
+		WithKeyProvider(t.keyProvider).
 		WithWriteBufferSize(t.flushBufferSize).
 		WithMetadata(t.cLog.Metadata())
 
@@ -1464,15 +1532,40 @@ func (t *TBtree) fullDump(snap *Snapshot, progressOutput writeProgressOutputFunc
 		cLog.Close()
 	}()
 
-	return t.fullDumpTo(snap, nLog, cLog, progressOutput)
+	err = t.fullDumpTo(snap, nLog, cLog, bf, progressOutput)
+	if err != nil {
+		return err
+	}
+
+	if bf == nil {
+		return nil
+	}
+
+	var b [cLogEntrySize]byte
+	_, err = cLog.ReadAt(b[:], 0)
+	if err != nil {
+		return err
+	}
+
+	cLogEntry := &cLogEntry{}
+	cLogEntry.deserialize(b[:])
+
+	// a missing bloom filter is rebuilt when opening the index, thus failures are not critical
+	err = writeBloomFilterFile(bloomFilterPath(t.path, snap.Ts()), bf, bloomFilterTag(cLogEntry), t.fileMode)
+	if err != nil {
+		t.logger.Warningf("persisting bloom filter of index '%s' {ts=%d} returned: %v", t.path, snap.Ts(), err)
+	}
+
+	return nil
 }
 
-func (t *TBtree) fullDumpTo(snapshot *Snapshot, nLog, cLog appendable.Appendable, progressOutput writeProgressOutputFunc) error {
+func (t *TBtree) fullDumpTo(snapshot *Snapshot, nLog, cLog appendable.Appendable, bf *bloomFilter, progressOutput writeProgressOutputFunc) error {
 	wopts := &WriteOpts{
 		OnlyMutated:    false,
 		BaseNLogOffset: 0,
 		BaseHLogOffset: 0,
 		reportProgress: progressOutput,
+		bloomFilter:    bf,
 	}
 
 	_, _, wN, _, err := snapshot.WriteTo(&appendableWriter{nLog}, nil, wopts)
@@ -1566,6 +1659,10 @@ func (t *TBtree) Close() error {
 	_, _, err := t.flushTree(0, true, false, "close")
 	merrors.Append(err)
 
+	if err == nil {
+		t.persistBloomFilter()
+	}
+
 	err = t.nLog.Close()
 	merrors.Append(err)
 
@@ -1723,6 +1820,15 @@ func (t *TBtree) bulkInsert(kvts []*KVT) error {
 		}
 	}
 
+	if t.bloomFilter != nil {
+		// keys are added beforehand as the filter must hold every key which may be found in the tree
+		for _, kvt := range immutableKVTs {
+			t.bloomFilter.add(kvt.K)
+		}
+
+		metricsBloomFilterSize.WithLabelValues(t.path).Set(float64(t.bloomFilter.size()))
+	}
+
 	nodes, depth, err := t.root.insert(immutableKVTs)
 	if err != nil {
 		// INVARIANT: if !node.mutated() then for every node 'n' in the subtree with node as root !n.mutated() also holds
@@ -2691,7 +2797,8 @@ func (lv *leafValue) lastUpdateBetween(hLog appendable.Appendable, initialTs, fi
 	hOff := lv.hOff
 	skippedUpdates := uint64(0)
 
-	for i := uint64(0); i < lv.hCount; i++ {
+	// hCount is the number of values in the history log, not the number of records holding them
+	for skippedUpdates < lv.hCount {
 		r := appendable.NewReaderFrom(hLog, hOff, DefaultMaxNodeSize)
 
 		hc, err := r.ReadUint32()
@@ -296,9 +296,11 @@ func TestEdgeCases(t *testing.T) {
 
 	err = tree.Close()
 	require.ErrorIs(t, err, ErrSnapshotsNotClosed)
+	require.Equal(t, 1, tree.ActiveSnapshots())
 
 	err = s1.Close()
 	require.NoError(t, err)
+	require.Zero(t, tree.ActiveSnapshots())
 
 	for i := 1; i < 100; i++ {
 		var k [4]byte
@@ -781,7 +783,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		nLog.AppendFn = func(bs []byte) (off int64, n int, err error) {
 			return 0, 0, injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -798,7 +800,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		cLog.AppendFn = func(bs []byte) (off int64, n int, err error) {
 			return 0, 0, injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -812,7 +814,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		nLog.FlushFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -829,7 +831,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		nLog.SyncFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -849,7 +851,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		cLog.FlushFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -872,7 +874,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		cLog.SyncFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 }
@@ -981,6 +983,54 @@ func TestTBTreeInsertionInAscendingOrder(t *testing.T) {
 	checkAfterMonotonicInsertions(t, tbtree, itCount, keyCount, true)
 }
 
+func TestTBTreeGetBetweenFromHistoryLog(t *testing.T) {
+	dir := t.TempDir()
+
+	tbtree, err := Open(dir, DefaultOptions())
+	require.NoError(t, err)
+
+	// history records hold every value updated between flushes
+	for ts := uint64(1); ts <= 5; ts++ {
+		var kvts []*KVT
+
+		if ts <= 3 {
+			kvts = append(kvts, &KVT{K: []byte("key1"), V: []byte(fmt.Sprintf("value1_%d", ts)), T: ts})
+		}
+
+		if ts >= 3 {
+			kvts = append(kvts, &KVT{K: []byte("key2"), V: []byte(fmt.Sprintf("value2_%d", ts)), T: ts})
+		}
+
+		err = tbtree.BulkInsert(kvts)
+		require.NoError(t, err)
+	}
+
+	err = tbtree.Close()
+	require.NoError(t, err)
+
+	// older values are only read from the history log after reopening the tree
+	tbtree, err = Open(dir, DefaultOptions())
+	require.NoError(t, err)
+
+	defer tbtree.Close()
+
+	for ts := uint64(3); ts <= 5; ts++ {
+		v, vts, hc, err := tbtree.GetBetween([]byte("key2"), 0, ts)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value2_%d", ts)), v)
+		require.Equal(t, ts, vts)
+		require.Equal(t, ts-2, hc)
+	}
+
+	// values of other keys must not be read beyond the history of the key
+	_, _, _, err = tbtree.GetBetween([]byte("key2"), 0, 2)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	v, _, _, err := tbtree.GetBetween([]byte("key1"), 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1_1"), v)
+}
+
 func TestTBTreeInsertionInDescendingOrder(t *testing.T) {
 	dir := t.TempDir()
 	tbtree, err := Open(dir, DefaultOptions())
@@ -0,0 +1,209 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+var compressionFormats = map[string]int{
+	"no-compression": appendable.NoCompression,
+	"flate":          appendable.FlateCompression,
+	"gzip":           appendable.GZipCompression,
+	"lzw":            appendable.LZWCompression,
+	"zlib":           appendable.ZLibCompression,
+	"zstd":           appendable.ZStdCompression,
+	"lz4":            appendable.LZ4Compression,
+}
+
+type compressionBenchConfig struct {
+	dataDir          string
+	compressionLevel int
+	compressionDict  []byte
+	fileSize         int
+	committers       int
+	txCount          int
+	kvCount          int
+	vLen             int
+}
+
+// jsonValue generates a JSON document of about vLen bytes,
+// resembling the kind of payloads compression is meant for
+func jsonValue(id, vLen int) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, `{"id":%d,"name":"item-%d","tags":[`, id, id%97)
+
+	for i := 0; b.Len() < vLen-2; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"key":"attr-%d","value":%d}`, i%13, (id*31+i)%1000)
+	}
+
+	b.WriteString("]}")
+
+	return []byte(b.String())
+}
+
+func trainDictionary(vLen int) []byte {
+	samples := make([][]byte, 1000)
+
+	for i := range samples {
+		samples[i] = jsonValue(i, vLen)
+	}
+
+	return appendable.TrainCompressionDictionary(samples, appendable.DefaultCompressionDictionarySize)
+}
+
+func benchCompression(c *compressionBenchConfig) {
+	fmt.Printf("Benchmarking compression formats with %d committers, %d txs of %d JSON values of %d bytes each...\r\n",
+		c.committers, c.txCount, c.kvCount, c.vLen)
+
+	fmt.Printf("%-16s %12s %12s %14s %8s\r\n", "format", "elapsed", "txs/s", "value bytes", "ratio")
+
+	var rawSize int64
+
+	for _, name := range []string{"no-compression", "flate", "gzip", "lzw", "zlib", "zstd", "zstd+dict", "lz4"} {
+		format, ok := compressionFormats[strings.TrimSuffix(name, "+dict")]
+		if !ok {
+			panic("invalid compression format")
+		}
+
+		var dict []byte
+
+		if strings.HasSuffix(name, "+dict") {
+			if len(c.compressionDict) == 0 {
+				continue
+			}
+			dict = c.compressionDict
+		}
+
+		dir := filepath.Join(c.dataDir, name)
+
+		err := os.RemoveAll(dir)
+		if err != nil {
+			panic(err)
+		}
+
+		err = os.MkdirAll(c.dataDir, 0700)
+		if err != nil {
+			panic(err)
+		}
+
+		opts := store.DefaultOptions().
+			WithSynced(false).
+			WithMaxConcurrency(c.committers).
+			WithFileSize(c.fileSize).
+			WithCompressionFormat(format).
+			WithCompresionLevel(c.compressionLevel).
+			WithCompressionDictionary(dict).
+			WithMaxValueLen(1 << 26) // 64Mb
+
+		st, err := store.Open(dir, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		elapsed := commitJSONValues(st, c)
+
+		err = st.Close()
+		if err != nil {
+			panic(err)
+		}
+
+		size := valueLogsSize(dir)
+
+		if format == appendable.NoCompression {
+			rawSize = size
+		}
+
+		ratio := "-"
+		if rawSize > 0 {
+			ratio = fmt.Sprintf("%.3f", float64(size)/float64(rawSize))
+		}
+
+		fmt.Printf("%-16s %12s %12.0f %14d %8s\r\n",
+			name, elapsed.Round(time.Millisecond), float64(c.committers*c.txCount)/elapsed.Seconds(), size, ratio)
+	}
+}
+
+func commitJSONValues(st *store.ImmuStore, c *compressionBenchConfig) time.Duration {
+	var wg sync.WaitGroup
+	wg.Add(c.committers)
+
+	start := time.Now()
+
+	for cm := 0; cm < c.committers; cm++ {
+		go func(cm int) {
+			defer wg.Done()
+
+			for t := 0; t < c.txCount; t++ {
+				tx, err := st.NewWriteOnlyTx(context.Background())
+				if err != nil {
+					panic(err)
+				}
+
+				for i := 0; i < c.kvCount; i++ {
+					id := (cm*c.txCount+t)*c.kvCount + i
+
+					err = tx.Set([]byte(fmt.Sprintf("key-%d", id)), nil, jsonValue(id, c.vLen))
+					if err != nil {
+						panic(err)
+					}
+				}
+
+				_, err = tx.Commit(context.Background())
+				if err != nil {
+					panic(err)
+				}
+			}
+		}(cm)
+	}
+
+	wg.Wait()
+
+	return time.Since(start)
+}
+
+func valueLogsSize(dir string) (size int64) {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && strings.HasPrefix(filepath.Base(filepath.Dir(path)), "val_") {
+			size += info.Size()
+		}
+
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return size
+}
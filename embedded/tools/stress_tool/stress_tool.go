@@ -25,6 +25,7 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"os"
 	"sync"
 	"time"
 
@@ -38,13 +39,14 @@ func main() {
 
 	parallelIO := flag.Int("parallelIO", 1, "number of parallel IO")
 	fileSize := flag.Int("fileSize", 1<<26, "file size up to which a new ones are created")
-	cFormat := flag.String("compressionFormat", "no-compression", "one of: no-compression, flate, gzip, lzw, zlib")
+	cFormat := flag.String("compressionFormat", "no-compression", "one of: no-compression, flate, gzip, lzw, zlib, zstd, lz4")
 	cLevel := flag.String("compressionLevel", "best-speed", "one of: best-speed, best-compression, default-compression, huffman-only")
+	cDict := flag.String("compressionDictionary", "", "file holding a raw zstd dictionary, or 'train' to build it from generated values")
 
 	synced := flag.Bool("synced", false, "strict sync mode - no data lost")
 	openedLogFiles := flag.Int("openedLogFiles", 10, "number of maximum number of opened files per each log type")
 
-	mode := flag.String("mode", "", "interactive|auto")
+	mode := flag.String("mode", "", "interactive|auto|compression-bench")
 
 	action := flag.String("action", "get", "get|set")
 	waitForIndexing := flag.Int("waitForIndexing", 1000, "amount of millis waiting for indexing entries")
@@ -66,23 +68,10 @@ func main() {
 
 	flag.Parse()
 
-	fmt.Println("Opening Immutable Transactional Key-Value Log...")
-
-	var compressionFormat int
 	var compressionLevel int
 
-	switch *cFormat {
-	case "no-compression":
-		compressionFormat = appendable.NoCompression
-	case "flate":
-		compressionFormat = appendable.FlateCompression
-	case "gzip":
-		compressionFormat = appendable.GZipCompression
-	case "lzw":
-		compressionFormat = appendable.LZWCompression
-	case "zlib":
-		compressionFormat = appendable.ZLibCompression
-	default:
+	compressionFormat, ok := compressionFormats[*cFormat]
+	if !ok {
 		panic("invalid compression format")
 	}
 
@@ -99,6 +88,34 @@ func main() {
 		panic("invalid compression level")
 	}
 
+	var compressionDict []byte
+
+	if *cDict == "train" {
+		compressionDict = trainDictionary(*vLen)
+	} else if *cDict != "" {
+		dict, err := os.ReadFile(*cDict)
+		if err != nil {
+			panic(err)
+		}
+		compressionDict = dict
+	}
+
+	if *mode == "compression-bench" {
+		benchCompression(&compressionBenchConfig{
+			dataDir:          *dataDir,
+			compressionLevel: compressionLevel,
+			compressionDict:  compressionDict,
+			fileSize:         *fileSize,
+			committers:       *committers,
+			txCount:          *txCount,
+			kvCount:          *kvCount,
+			vLen:             *vLen,
+		})
+		return
+	}
+
+	fmt.Println("Opening Immutable Transactional Key-Value Log...")
+
 	opts := store.DefaultOptions().
 		WithSynced(*synced).
 		WithMaxConcurrency(*committers).
@@ -109,6 +126,7 @@ func main() {
 		WithCommitLogMaxOpenedFiles(*openedLogFiles).
 		WithCompressionFormat(compressionFormat).
 		WithCompresionLevel(compressionLevel).
+		WithCompressionDictionary(compressionDict).
 		WithMaxValueLen(1 << 26) // 64Mb
 
 	immuStore, err := store.Open(*dataDir, opts)
@@ -372,7 +390,7 @@ func main() {
 					panic(err)
 				}
 
-				entrySpecDigest, err := store.EntrySpecDigestFor(tx.Header().Version)
+				entrySpecDigest, err := tx.Header().EntrySpecDigest()
 				if err != nil {
 					panic(err)
 				}
@@ -391,7 +409,7 @@ func main() {
 
 						kv := &store.EntrySpec{Key: e.Key(), Value: val}
 
-						verifies := htree.VerifyInclusion(proof, entrySpecDigest(kv), tx.Header().Eh)
+						verifies := htree.VerifyInclusionWith(tx.Header().HashAlgorithm, proof, entrySpecDigest(kv), tx.Header().Eh)
 						if !verifies {
 							panic("kv does not verify")
 						}
@@ -416,5 +434,5 @@ func main() {
 		return
 	}
 
-	panic("please specify a valid mode of operation: interactive|auto")
+	panic("please specify a valid mode of operation: interactive|auto|compression-bench")
 }
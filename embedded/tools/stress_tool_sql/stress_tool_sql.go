@@ -57,7 +57,7 @@ func parseConfig() (c cfg) {
 
 	flag.IntVar(&c.parallelIO, "parallelIO", 1, "number of parallel IO")
 	flag.IntVar(&c.fileSize, "fileSize", 1<<26, "file size up to which a new ones are created")
-	cFormat := flag.String("compressionFormat", "no-compression", "one of: no-compression, flate, gzip, lzw, zlib")
+	cFormat := flag.String("compressionFormat", "no-compression", "one of: no-compression, flate, gzip, lzw, zlib, zstd, lz4")
 	cLevel := flag.String("compressionLevel", "best-speed", "one of: best-speed, best-compression, default-compression, huffman-only")
 
 	flag.BoolVar(&c.synced, "synced", false, "strict sync mode - no data lost")
@@ -86,6 +86,10 @@ func parseConfig() (c cfg) {
 		c.compressionFormat = appendable.LZWCompression
 	case "zlib":
 		c.compressionFormat = appendable.ZLibCompression
+	case "zstd":
+		c.compressionFormat = appendable.ZStdCompression
+	case "lz4":
+		c.compressionFormat = appendable.LZ4Compression
 	default:
 		panic("invalid compression format")
 	}
@@ -20,7 +20,9 @@ import (
 	"crypto/sha256"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/htree"
+	"github.com/codenotary/immudb/embedded/smt"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -56,6 +58,7 @@ func KVMetadataToProto(md *store.KVMetadata) *KVMetadata {
 	kvmd := &KVMetadata{
 		Deleted:      md.Deleted(),
 		NonIndexable: md.NonIndexable(),
+		DeletedRange: md.DeletedRange(),
 	}
 
 	if md.IsExpirable() {
@@ -83,6 +86,8 @@ func TxFromProto(stx *Tx) *store.Tx {
 	header.NEntries = int(stx.Header.Nentries)
 	header.Eh = DigestFromProto(stx.Header.EH)
 
+	header.HashAlgorithm = HashAlgorithmFromProto(stx.Header.HashAlgorithm)
+
 	for i, e := range stx.Entries {
 		entries[i] = store.NewTxEntry(e.Key, KVMetadataFromProto(e.Metadata), int(e.VLen), DigestFromProto(e.HValue), 0)
 	}
@@ -109,6 +114,8 @@ func KVMetadataFromProto(md *KVMetadata) *store.KVMetadata {
 
 	kvmd.AsNonIndexable(md.NonIndexable)
 
+	kvmd.AsDeletedRange(md.DeletedRange)
+
 	return kvmd
 }
 
@@ -165,7 +172,28 @@ func TxHeaderToProto(hdr *store.TxHeader) *TxHeader {
 		EH:       hdr.Eh[:],
 		BlTxId:   hdr.BlTxID,
 		BlRoot:   hdr.BlRoot[:],
+
+		HashAlgorithm: HashAlgorithmToProto(hdr.HashAlgorithm),
+	}
+}
+
+// HashAlgorithmToProto returns the name of the hash algorithm,
+// sha256 is represented with an empty name to preserve compatibility
+func HashAlgorithmToProto(alg hashing.Algorithm) string {
+	if alg == hashing.SHA256 {
+		return ""
 	}
+	return alg.String()
+}
+
+// HashAlgorithmFromProto returns the hash algorithm with the given name,
+// unknown names are mapped into an invalid algorithm so that proofs won't verify
+func HashAlgorithmFromProto(name string) hashing.Algorithm {
+	alg, err := hashing.ParseAlgorithm(name)
+	if err != nil {
+		return hashing.Algorithm(-1)
+	}
+	return alg
 }
 
 func TxMetadataToProto(md *store.TxMetadata) *TxMetadata {
@@ -181,6 +209,10 @@ func TxMetadataToProto(md *store.TxMetadata) *TxMetadata {
 
 	txmd.Extra = md.Extra()
 
+	if root, ok := md.GetAuthenticatedIndexRoot(); ok {
+		txmd.AuthenticatedIndexRoot = root[:]
+	}
+
 	return txmd
 }
 
@@ -243,6 +275,8 @@ func TxHeaderFromProto(hdr *TxHeader) *store.TxHeader {
 		Eh:       DigestFromProto(hdr.EH),
 		BlTxID:   hdr.BlTxId,
 		BlRoot:   DigestFromProto(hdr.BlRoot),
+
+		HashAlgorithm: HashAlgorithmFromProto(hdr.HashAlgorithm),
 	}
 }
 
@@ -258,6 +292,13 @@ func TxMetadataFromProto(md *TxMetadata) *store.TxMetadata {
 
 	txmd.WithExtra(md.Extra)
 
+	if len(md.AuthenticatedIndexRoot) > 0 {
+		var root [sha256.Size]byte
+		copy(root[:], md.AuthenticatedIndexRoot)
+
+		txmd.WithAuthenticatedIndexRoot(root)
+	}
+
 	return txmd
 }
 
@@ -311,3 +352,43 @@ func DigestsFromProto(slicedTerms [][]byte) [][sha256.Size]byte {
 
 	return terms
 }
+
+func AuthenticatedIndexProofToProto(proof *smt.Proof) *AuthenticatedIndexProof {
+	if proof == nil {
+		return nil
+	}
+
+	p := &AuthenticatedIndexProof{
+		Siblings: DigestsToProto(proof.Siblings),
+	}
+
+	if proof.Leaf != nil {
+		p.Leaf = &AuthenticatedIndexLeaf{
+			KeyDigest:   proof.Leaf.KeyDigest[:],
+			TxId:        proof.Leaf.TxID,
+			ValueDigest: proof.Leaf.ValueDigest[:],
+		}
+	}
+
+	return p
+}
+
+func AuthenticatedIndexProofFromProto(proof *AuthenticatedIndexProof) *smt.Proof {
+	if proof == nil {
+		return nil
+	}
+
+	p := &smt.Proof{
+		Siblings: DigestsFromProto(proof.Siblings),
+	}
+
+	if proof.Leaf != nil {
+		p.Leaf = &smt.Leaf{
+			KeyDigest:   DigestFromProto(proof.Leaf.KeyDigest),
+			TxID:        proof.Leaf.TxId,
+			ValueDigest: DigestFromProto(proof.Leaf.ValueDigest),
+		}
+	}
+
+	return p
+}
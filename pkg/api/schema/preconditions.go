@@ -16,6 +16,8 @@ limitations under the License.
 
 package schema
 
+import "time"
+
 func PreconditionKeyMustExist(key []byte) *Precondition {
 	return &Precondition{
 		Precondition: &Precondition_KeyMustExist{
@@ -46,3 +48,36 @@ func PreconditionKeyNotModifiedAfterTX(key []byte, txID uint64) *Precondition {
 		},
 	}
 }
+
+func PreconditionKeyValueHashEquals(key []byte, valueHash []byte) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_KeyValueHashEquals{
+			KeyValueHashEquals: &Precondition_KeyValueHashEqualsPrecondition{
+				Key:       key,
+				ValueHash: valueHash,
+			},
+		},
+	}
+}
+
+func PreconditionNoKeyWithPrefixModifiedAfterTX(prefix []byte, txID uint64) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_NoKeyWithPrefixModifiedAfterTX{
+			NoKeyWithPrefixModifiedAfterTX: &Precondition_NoKeyWithPrefixModifiedAfterTXPrecondition{
+				Prefix: prefix,
+				TxID:   txID,
+			},
+		},
+	}
+}
+
+func PreconditionKeyExpiresAfter(key []byte, expiresAt time.Time) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_KeyExpiresAfter{
+			KeyExpiresAfter: &Precondition_KeyExpiresAfterPrecondition{
+				Key:       key,
+				ExpiresAt: expiresAt.Unix(),
+			},
+		},
+	}
+}
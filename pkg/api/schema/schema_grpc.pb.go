@@ -45,7 +45,10 @@ type ImmuServiceClient interface {
 	VerifiableSet(ctx context.Context, in *VerifiableSetRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	Get(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Entry, error)
 	VerifiableGet(ctx context.Context, in *VerifiableGetRequest, opts ...grpc.CallOption) (*VerifiableEntry, error)
+	VerifiableGetKeyProof(ctx context.Context, in *VerifiableGetKeyProofRequest, opts ...grpc.CallOption) (*VerifiableKeyProof, error)
 	Delete(ctx context.Context, in *DeleteKeysRequest, opts ...grpc.CallOption) (*TxHeader, error)
+	DeleteRange(ctx context.Context, in *DeleteRangeRequest, opts ...grpc.CallOption) (*TxHeader, error)
+	VerifiableDeleteRange(ctx context.Context, in *VerifiableDeleteRangeRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	GetAll(ctx context.Context, in *KeyListRequest, opts ...grpc.CallOption) (*Entries, error)
 	ExecAll(ctx context.Context, in *ExecAllRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*Entries, error)
@@ -94,6 +97,9 @@ type ImmuServiceClient interface {
 	GetDatabaseSettingsV2(ctx context.Context, in *DatabaseSettingsRequest, opts ...grpc.CallOption) (*DatabaseSettingsResponse, error)
 	FlushIndex(ctx context.Context, in *FlushIndexRequest, opts ...grpc.CallOption) (*FlushIndexResponse, error)
 	CompactIndex(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RebuildIndex(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	VerifyIndex(ctx context.Context, in *VerifyIndexRequest, opts ...grpc.CallOption) (*VerifyIndexResponse, error)
+	CompactValues(ctx context.Context, in *CompactValuesRequest, opts ...grpc.CallOption) (*CompactValuesResponse, error)
 	// Streams
 	StreamGet(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (ImmuService_StreamGetClient, error)
 	StreamSet(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamSetClient, error)
@@ -350,6 +356,15 @@ func (c *immuServiceClient) VerifiableGet(ctx context.Context, in *VerifiableGet
 	return out, nil
 }
 
+func (c *immuServiceClient) VerifiableGetKeyProof(ctx context.Context, in *VerifiableGetKeyProofRequest, opts ...grpc.CallOption) (*VerifiableKeyProof, error) {
+	out := new(VerifiableKeyProof)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableGetKeyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Delete(ctx context.Context, in *DeleteKeysRequest, opts ...grpc.CallOption) (*TxHeader, error) {
 	out := new(TxHeader)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Delete", in, out, opts...)
@@ -359,6 +374,24 @@ func (c *immuServiceClient) Delete(ctx context.Context, in *DeleteKeysRequest, o
 	return out, nil
 }
 
+func (c *immuServiceClient) DeleteRange(ctx context.Context, in *DeleteRangeRequest, opts ...grpc.CallOption) (*TxHeader, error) {
+	out := new(TxHeader)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/DeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) VerifiableDeleteRange(ctx context.Context, in *VerifiableDeleteRangeRequest, opts ...grpc.CallOption) (*VerifiableTx, error) {
+	out := new(VerifiableTx)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableDeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) GetAll(ctx context.Context, in *KeyListRequest, opts ...grpc.CallOption) (*Entries, error) {
 	out := new(Entries)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/GetAll", in, out, opts...)
@@ -661,6 +694,33 @@ func (c *immuServiceClient) CompactIndex(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *immuServiceClient) RebuildIndex(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/RebuildIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) VerifyIndex(ctx context.Context, in *VerifyIndexRequest, opts ...grpc.CallOption) (*VerifyIndexResponse, error) {
+	out := new(VerifyIndexResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifyIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) CompactValues(ctx context.Context, in *CompactValuesRequest, opts ...grpc.CallOption) (*CompactValuesResponse, error) {
+	out := new(CompactValuesResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CompactValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) StreamGet(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (ImmuService_StreamGetClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[1], "/immudb.schema.ImmuService/streamGet", opts...)
 	if err != nil {
@@ -1136,7 +1196,10 @@ type ImmuServiceServer interface {
 	VerifiableSet(context.Context, *VerifiableSetRequest) (*VerifiableTx, error)
 	Get(context.Context, *KeyRequest) (*Entry, error)
 	VerifiableGet(context.Context, *VerifiableGetRequest) (*VerifiableEntry, error)
+	VerifiableGetKeyProof(context.Context, *VerifiableGetKeyProofRequest) (*VerifiableKeyProof, error)
 	Delete(context.Context, *DeleteKeysRequest) (*TxHeader, error)
+	DeleteRange(context.Context, *DeleteRangeRequest) (*TxHeader, error)
+	VerifiableDeleteRange(context.Context, *VerifiableDeleteRangeRequest) (*VerifiableTx, error)
 	GetAll(context.Context, *KeyListRequest) (*Entries, error)
 	ExecAll(context.Context, *ExecAllRequest) (*TxHeader, error)
 	Scan(context.Context, *ScanRequest) (*Entries, error)
@@ -1185,6 +1248,9 @@ type ImmuServiceServer interface {
 	GetDatabaseSettingsV2(context.Context, *DatabaseSettingsRequest) (*DatabaseSettingsResponse, error)
 	FlushIndex(context.Context, *FlushIndexRequest) (*FlushIndexResponse, error)
 	CompactIndex(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	RebuildIndex(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	VerifyIndex(context.Context, *VerifyIndexRequest) (*VerifyIndexResponse, error)
+	CompactValues(context.Context, *CompactValuesRequest) (*CompactValuesResponse, error)
 	// Streams
 	StreamGet(*KeyRequest, ImmuService_StreamGetServer) error
 	StreamSet(ImmuService_StreamSetServer) error
@@ -1278,9 +1344,18 @@ func (UnimplementedImmuServiceServer) Get(context.Context, *KeyRequest) (*Entry,
 func (UnimplementedImmuServiceServer) VerifiableGet(context.Context, *VerifiableGetRequest) (*VerifiableEntry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifiableGet not implemented")
 }
+func (UnimplementedImmuServiceServer) VerifiableGetKeyProof(context.Context, *VerifiableGetKeyProofRequest) (*VerifiableKeyProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableGetKeyProof not implemented")
+}
 func (UnimplementedImmuServiceServer) Delete(context.Context, *DeleteKeysRequest) (*TxHeader, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedImmuServiceServer) DeleteRange(context.Context, *DeleteRangeRequest) (*TxHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRange not implemented")
+}
+func (UnimplementedImmuServiceServer) VerifiableDeleteRange(context.Context, *VerifiableDeleteRangeRequest) (*VerifiableTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableDeleteRange not implemented")
+}
 func (UnimplementedImmuServiceServer) GetAll(context.Context, *KeyListRequest) (*Entries, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
@@ -1380,6 +1455,15 @@ func (UnimplementedImmuServiceServer) FlushIndex(context.Context, *FlushIndexReq
 func (UnimplementedImmuServiceServer) CompactIndex(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompactIndex not implemented")
 }
+func (UnimplementedImmuServiceServer) RebuildIndex(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildIndex not implemented")
+}
+func (UnimplementedImmuServiceServer) VerifyIndex(context.Context, *VerifyIndexRequest) (*VerifyIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIndex not implemented")
+}
+func (UnimplementedImmuServiceServer) CompactValues(context.Context, *CompactValuesRequest) (*CompactValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactValues not implemented")
+}
 func (UnimplementedImmuServiceServer) StreamGet(*KeyRequest, ImmuService_StreamGetServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamGet not implemented")
 }
@@ -1845,6 +1929,24 @@ func _ImmuService_VerifiableGet_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_VerifiableGetKeyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableGetKeyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableGetKeyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableGetKeyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableGetKeyProof(ctx, req.(*VerifiableGetKeyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKeysRequest)
 	if err := dec(in); err != nil {
@@ -1863,6 +1965,42 @@ func _ImmuService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_DeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).DeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/DeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).DeleteRange(ctx, req.(*DeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_VerifiableDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableDeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableDeleteRange(ctx, req.(*VerifiableDeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyListRequest)
 	if err := dec(in); err != nil {
@@ -2457,6 +2595,60 @@ func _ImmuService_CompactIndex_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_RebuildIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).RebuildIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/RebuildIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).RebuildIndex(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_VerifyIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifyIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifyIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifyIndex(ctx, req.(*VerifyIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_CompactValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CompactValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CompactValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CompactValues(ctx, req.(*CompactValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_StreamGet_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(KeyRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2933,10 +3125,22 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifiableGet",
 			Handler:    _ImmuService_VerifiableGet_Handler,
 		},
+		{
+			MethodName: "VerifiableGetKeyProof",
+			Handler:    _ImmuService_VerifiableGetKeyProof_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ImmuService_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteRange",
+			Handler:    _ImmuService_DeleteRange_Handler,
+		},
+		{
+			MethodName: "VerifiableDeleteRange",
+			Handler:    _ImmuService_VerifiableDeleteRange_Handler,
+		},
 		{
 			MethodName: "GetAll",
 			Handler:    _ImmuService_GetAll_Handler,
@@ -3069,6 +3273,18 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompactIndex",
 			Handler:    _ImmuService_CompactIndex_Handler,
 		},
+		{
+			MethodName: "RebuildIndex",
+			Handler:    _ImmuService_RebuildIndex_Handler,
+		},
+		{
+			MethodName: "VerifyIndex",
+			Handler:    _ImmuService_VerifyIndex_Handler,
+		},
+		{
+			MethodName: "CompactValues",
+			Handler:    _ImmuService_CompactValues_Handler,
+		},
 		{
 			MethodName: "SQLExec",
 			Handler:    _ImmuService_SQLExec_Handler,
@@ -26,7 +26,7 @@ import (
 )
 
 func (state *ImmutableState) ToBytes() []byte {
-	b := make([]byte, 4+len(state.Db)+8+sha256.Size)
+	b := make([]byte, 4+len(state.Db)+8+sha256.Size, 4+len(state.Db)+8+sha256.Size+4+len(state.HashAlgorithm))
 	i := 0
 
 	binary.BigEndian.PutUint32(b[i:], uint32(len(state.Db)))
@@ -40,6 +40,16 @@ func (state *ImmutableState) ToBytes() []byte {
 
 	copy(b[i:], state.TxHash[:])
 
+	// the hash algorithm is only included when it's not the default one,
+	// so signatures of states based on sha256 remain unchanged
+	if state.HashAlgorithm != "" {
+		var lenBs [4]byte
+		binary.BigEndian.PutUint32(lenBs[:], uint32(len(state.HashAlgorithm)))
+
+		b = append(b, lenBs[:]...)
+		b = append(b, state.HashAlgorithm...)
+	}
+
 	return b
 }
 
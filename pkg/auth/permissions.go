@@ -61,10 +61,13 @@ var maintenanceMethods = map[string]struct{}{
 	"ProofDocument":       {},
 
 	// admin methods
-	"ListUsers":    {},
-	"Dump":         {},
-	"FlushIndex":   {},
-	"CompactIndex": {},
+	"ListUsers":     {},
+	"Dump":          {},
+	"FlushIndex":    {},
+	"CompactIndex":  {},
+	"RebuildIndex":  {},
+	"VerifyIndex":   {},
+	"CompactValues": {},
 }
 
 // PermissionSysAdmin the admin permission byte
@@ -84,6 +87,8 @@ var methodsPermissions = map[string][]uint32{
 	// readwrite methods
 	"Set":                    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"Delete":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteRange":            {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"VerifiableDeleteRange":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"VerifiableSet":          {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"StreamSet":              {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"StreamVerifiableSet":    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
@@ -91,6 +96,7 @@ var methodsPermissions = map[string][]uint32{
 	"VerifiableGet":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamGet":              {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamVerifiableGet":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableGetKeyProof":  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"GetAll":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"ExecAll":                {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"StreamExecAll":          {PermissionSysAdmin, PermissionAdmin, PermissionRW},
@@ -154,6 +160,9 @@ var methodsPermissions = map[string][]uint32{
 	"Dump":             {PermissionSysAdmin, PermissionAdmin},
 	"FlushIndex":       {PermissionSysAdmin, PermissionAdmin},
 	"CompactIndex":     {PermissionSysAdmin, PermissionAdmin},
+	"RebuildIndex":     {PermissionSysAdmin, PermissionAdmin},
+	"VerifyIndex":      {PermissionSysAdmin, PermissionAdmin},
+	"CompactValues":    {PermissionSysAdmin, PermissionAdmin},
 	"ExportTx":         {PermissionSysAdmin, PermissionAdmin},
 	"ReplicateTx":      {PermissionSysAdmin, PermissionAdmin},
 }
@@ -31,8 +31,13 @@ type Permission struct {
 }
 
 type SQLPrivilege struct {
-	Privilege string `json:"privilege"` // sql privilege
-	Database  string `json:"database"`  // database to which the privilege applies
+	Privilege       string   `json:"privilege"`                 // sql privilege
+	Database        string   `json:"database"`                  // database to which the privilege applies
+	Schema          string   `json:"schema,omitempty"`          // schema to which the privilege applies, empty when granted on the whole database or on a single table
+	Table           string   `json:"table,omitempty"`           // name of the table to which the privilege applies when it was granted, empty when granted on the whole database
+	TableID         uint32   `json:"tableId,omitempty"`         // id of the table to which the privilege applies, table names may be reused after a table is dropped
+	Columns         []string `json:"columns,omitempty"`         // columns to which the privilege applies, empty when granted on the whole table
+	ExcludedColumns []string `json:"excludedColumns,omitempty"` // columns revoked from a privilege granted on the whole table
 }
 
 // User ...
@@ -154,8 +159,21 @@ func (u *User) HasSQLPrivilege(database string, privilege string) bool {
 }
 
 func (u *User) indexOfPrivilege(database string, privilege string) int {
+	return u.indexOfScopedPrivilege(database, "", "", privilege)
+}
+
+func (u *User) indexOfTablePrivilege(database string, tableID uint32, privilege string) int {
 	for i, p := range u.SQLPrivileges {
-		if p.Database == database && p.Privilege == privilege {
+		if p.Database == database && p.Table != "" && p.TableID == tableID && p.Privilege == privilege {
+			return i
+		}
+	}
+	return -1
+}
+
+func (u *User) indexOfScopedPrivilege(database, schema, table, privilege string) int {
+	for i, p := range u.SQLPrivileges {
+		if p.Database == database && p.Schema == schema && p.Table == table && p.Privilege == privilege {
 			return i
 		}
 	}
@@ -173,6 +191,115 @@ func (u *User) RevokeSQLPrivileges(database string, privileges []string) bool {
 	return true
 }
 
+// GrantTableSQLPrivileges grants sql privileges on a table of the specified database.
+// When columns are specified, privileges are restricted to them, otherwise they apply to the whole table.
+// Privileges are bound to the table id, the table name is kept for informational purposes.
+func (u *User) GrantTableSQLPrivileges(database string, tableID uint32, table string, privileges []string, columns []string) {
+	for _, p := range privileges {
+		idx := u.indexOfTablePrivilege(database, tableID, p)
+		if idx < 0 {
+			u.SQLPrivileges = append(u.SQLPrivileges, SQLPrivilege{
+				Database:  database,
+				Table:     table,
+				TableID:   tableID,
+				Privilege: p,
+				Columns:   append([]string(nil), columns...),
+			})
+			continue
+		}
+
+		u.SQLPrivileges[idx].Table = table
+
+		if len(columns) == 0 {
+			u.SQLPrivileges[idx].Columns = nil
+			u.SQLPrivileges[idx].ExcludedColumns = nil
+			continue
+		}
+
+		// a privilege granted on the whole table already includes any column not explicitly revoked
+		if len(u.SQLPrivileges[idx].Columns) == 0 {
+			u.SQLPrivileges[idx].ExcludedColumns = removeColumns(u.SQLPrivileges[idx].ExcludedColumns, columns)
+			continue
+		}
+
+		for _, col := range columns {
+			if !containsColumn(u.SQLPrivileges[idx].Columns, col) {
+				u.SQLPrivileges[idx].Columns = append(u.SQLPrivileges[idx].Columns, col)
+			}
+		}
+	}
+}
+
+// RevokeTableSQLPrivileges revokes sql privileges on a table of the specified database.
+// When columns are specified, only such columns are revoked. Columns revoked from a privilege
+// granted on the whole table are excluded from it.
+func (u *User) RevokeTableSQLPrivileges(database string, tableID uint32, privileges []string, columns []string) {
+	for _, p := range privileges {
+		idx := u.indexOfTablePrivilege(database, tableID, p)
+		if idx < 0 {
+			continue
+		}
+
+		if len(columns) > 0 {
+			grantedCols := u.SQLPrivileges[idx].Columns
+			if len(grantedCols) == 0 {
+				for _, col := range columns {
+					if !containsColumn(u.SQLPrivileges[idx].ExcludedColumns, col) {
+						u.SQLPrivileges[idx].ExcludedColumns = append(u.SQLPrivileges[idx].ExcludedColumns, col)
+					}
+				}
+				continue
+			}
+
+			remainingCols := removeColumns(grantedCols, columns)
+			if len(remainingCols) > 0 {
+				u.SQLPrivileges[idx].Columns = remainingCols
+				continue
+			}
+		}
+
+		u.SQLPrivileges = append(u.SQLPrivileges[:idx], u.SQLPrivileges[idx+1:]...)
+	}
+}
+
+// GrantSchemaSQLPrivileges grants sql privileges on all the tables of a schema of the specified database.
+func (u *User) GrantSchemaSQLPrivileges(database, schema string, privileges []string) {
+	for _, p := range privileges {
+		if u.indexOfScopedPrivilege(database, schema, "", p) < 0 {
+			u.SQLPrivileges = append(u.SQLPrivileges, SQLPrivilege{Database: database, Schema: schema, Privilege: p})
+		}
+	}
+}
+
+// RevokeSchemaSQLPrivileges revokes sql privileges on a schema of the specified database.
+// Privileges granted on single tables of the schema are not affected.
+func (u *User) RevokeSchemaSQLPrivileges(database, schema string, privileges []string) {
+	for _, p := range privileges {
+		if idx := u.indexOfScopedPrivilege(database, schema, "", p); idx >= 0 {
+			u.SQLPrivileges = append(u.SQLPrivileges[:idx], u.SQLPrivileges[idx+1:]...)
+		}
+	}
+}
+
+func removeColumns(columns []string, removed []string) []string {
+	var remaining []string
+	for _, col := range columns {
+		if !containsColumn(removed, col) {
+			remaining = append(remaining, col)
+		}
+	}
+	return remaining
+}
+
+func containsColumn(columns []string, col string) bool {
+	for _, c := range columns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
 // SetSQLPrivileges sets user default privileges. Required to guarantee backward compatibility.
 func (u *User) SetSQLPrivileges() {
 	if u.HasPrivileges {
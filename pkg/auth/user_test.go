@@ -70,3 +70,70 @@ func TestUser(t *testing.T) {
 		t.Errorf("WhichPermission sysadmin fail")
 	}
 }
+
+func TestUserTableSQLPrivileges(t *testing.T) {
+	u := User{}
+
+	u.GrantSQLPrivileges("immudb", []string{"CREATE"})
+	u.GrantTableSQLPrivileges("immudb", 1, "customers", []string{"SELECT"}, []string{"id"})
+	u.GrantTableSQLPrivileges("immudb", 1, "customers", []string{"SELECT"}, []string{"id", "name"})
+	u.GrantTableSQLPrivileges("immudb", 2, "orders", []string{"SELECT", "INSERT"}, nil)
+	require.Len(t, u.SQLPrivileges, 4)
+
+	require.True(t, u.HasSQLPrivilege("immudb", "CREATE"))
+	require.False(t, u.HasSQLPrivilege("immudb", "SELECT"))
+	require.Equal(t, []string{"id", "name"}, u.SQLPrivileges[1].Columns)
+	require.Equal(t, uint32(1), u.SQLPrivileges[1].TableID)
+
+	u.RevokeTableSQLPrivileges("immudb", 1, []string{"SELECT"}, []string{"id"})
+	require.Equal(t, []string{"name"}, u.SQLPrivileges[1].Columns)
+
+	u.RevokeTableSQLPrivileges("immudb", 1, []string{"SELECT"}, []string{"name"})
+	require.Len(t, u.SQLPrivileges, 3)
+
+	u.GrantTableSQLPrivileges("immudb", 2, "orders", []string{"SELECT"}, []string{"id"})
+	require.Empty(t, u.SQLPrivileges[1].Columns)
+
+	// columns revoked from a privilege granted on the whole table are excluded from it
+	u.RevokeTableSQLPrivileges("immudb", 2, []string{"SELECT"}, []string{"customer_id", "amount"})
+	require.Len(t, u.SQLPrivileges, 3)
+	require.Empty(t, u.SQLPrivileges[1].Columns)
+	require.Equal(t, []string{"customer_id", "amount"}, u.SQLPrivileges[1].ExcludedColumns)
+
+	u.GrantTableSQLPrivileges("immudb", 2, "orders", []string{"SELECT"}, []string{"amount"})
+	require.Equal(t, []string{"customer_id"}, u.SQLPrivileges[1].ExcludedColumns)
+
+	u.GrantTableSQLPrivileges("immudb", 2, "orders", []string{"SELECT"}, nil)
+	require.Empty(t, u.SQLPrivileges[1].ExcludedColumns)
+
+	// a new table reusing the name of a dropped one does not inherit its privileges
+	u.GrantTableSQLPrivileges("immudb", 3, "orders", []string{"SELECT"}, []string{"id"})
+	require.Len(t, u.SQLPrivileges, 4)
+	require.Equal(t, uint32(3), u.SQLPrivileges[3].TableID)
+
+	u.RevokeTableSQLPrivileges("immudb", 3, []string{"SELECT"}, nil)
+	require.Len(t, u.SQLPrivileges, 3)
+
+	u.RevokeTableSQLPrivileges("immudb", 2, []string{"SELECT", "INSERT"}, nil)
+	require.Len(t, u.SQLPrivileges, 1)
+
+	u.RevokeSQLPrivileges("immudb", []string{"CREATE"})
+	require.Empty(t, u.SQLPrivileges)
+}
+
+func TestUserSchemaSQLPrivileges(t *testing.T) {
+	u := User{}
+
+	u.GrantSchemaSQLPrivileges("immudb", "sales", []string{"SELECT", "INSERT"})
+	u.GrantSchemaSQLPrivileges("immudb", "sales", []string{"SELECT"})
+	u.GrantTableSQLPrivileges("immudb", 1, "sales.orders", []string{"SELECT"}, nil)
+	require.Len(t, u.SQLPrivileges, 3)
+
+	require.False(t, u.HasSQLPrivilege("immudb", "SELECT"))
+	require.Equal(t, "sales", u.SQLPrivileges[0].Schema)
+	require.Empty(t, u.SQLPrivileges[0].Table)
+
+	u.RevokeSchemaSQLPrivileges("immudb", "sales", []string{"SELECT", "INSERT"})
+	require.Len(t, u.SQLPrivileges, 1)
+	require.Equal(t, "sales.orders", u.SQLPrivileges[0].Table)
+}
@@ -309,7 +309,12 @@ func (a *defaultAuditor) audit() error {
 			return noErr
 		}
 
-		verified = store.VerifyDualProof(dualProof, prevState.TxId, state.TxId, schema.DigestFromProto(prevState.TxHash), schema.DigestFromProto(state.TxHash))
+		// the hash algorithm is chosen at database creation time and must never change
+		hashAlgorithm := schema.HashAlgorithmFromProto(state.HashAlgorithm)
+
+		verified = store.VerifyDualProof(dualProof, prevState.TxId, state.TxId, schema.DigestFromProto(prevState.TxHash), schema.DigestFromProto(state.TxHash)) &&
+			hashAlgorithm == schema.HashAlgorithmFromProto(prevState.HashAlgorithm) &&
+			hashAlgorithm == dualProof.TargetTxHeader.HashAlgorithm
 
 		a.logger.Infof("audit #%d result:\n db: %s, consistent:	%t previous state:	%x at tx: %d\n  current state:	%x at tx: %d",
 			a.index, dbName, verified, prevState.TxHash, prevState.TxId, state.TxHash, state.TxId)
@@ -0,0 +1,210 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/database"
+	"google.golang.org/grpc"
+)
+
+// VerifiedKeyMembership reads the latest entry set for the given key as of transaction `tx`
+// (the latest committed one if 0), including deleted entries, and proves it was the latest one
+// using the authenticated index of the database.
+//
+// This function also verifies the transaction containing the root of the authenticated index
+// against the signed state. If verification does not succeed the store.ErrCorruptedData error is returned.
+// If the key was never set as of transaction `tx`, the store.ErrKeyNotFound error is returned.
+func (c *immuClient) VerifiedKeyMembership(ctx context.Context, key []byte, tx uint64) (*schema.Entry, error) {
+	entry, err := c.verifiedGetKeyProof(ctx, key, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry == nil {
+		return nil, store.ErrKeyNotFound
+	}
+
+	return entry, nil
+}
+
+// VerifiedKeyNonMembership proves that the given key was never set as of transaction `tx`
+// (the latest committed one if 0) using the authenticated index of the database.
+//
+// This function also verifies the transaction containing the root of the authenticated index
+// against the signed state. If verification does not succeed the store.ErrCorruptedData error is returned.
+// If the key was set, the ErrKeyIsMember error is returned.
+func (c *immuClient) VerifiedKeyNonMembership(ctx context.Context, key []byte, tx uint64) error {
+	entry, err := c.verifiedGetKeyProof(ctx, key, tx)
+	if err != nil {
+		return err
+	}
+
+	if entry != nil {
+		return ErrKeyIsMember
+	}
+
+	return nil
+}
+
+// VerifiableGetKeyProof returns a proof of membership or non-membership of a given key,
+// and internal data used to perform the verification.
+func (c *immuClient) VerifiableGetKeyProof(ctx context.Context, in *schema.VerifiableGetKeyProofRequest, opts ...grpc.CallOption) (*schema.VerifiableKeyProof, error) {
+	return c.ServiceClient.VerifiableGetKeyProof(ctx, in, opts...)
+}
+
+// verifiedGetKeyProof verifies the proof of membership or non-membership of the key,
+// the verified entry is returned only in case of membership
+func (c *immuClient) verifiedGetKeyProof(ctx context.Context, key []byte, tx uint64) (*schema.Entry, error) {
+	if len(key) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &schema.VerifiableGetKeyProofRequest{
+		Key:          key,
+		AtTx:         tx,
+		ProveSinceTx: state.TxId,
+	}
+
+	vProof, err := c.ServiceClient.VerifiableGetKeyProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if vProof.VerifiableTx == nil || vProof.VerifiableTx.Tx == nil || vProof.VerifiableTx.DualProof == nil {
+		return nil, store.ErrCorruptedData
+	}
+
+	hashAlgorithm, err := stateHashAlgorithm(
+		state,
+		vProof.VerifiableTx.Tx.Header,
+		vProof.VerifiableTx.DualProof.SourceTxHeader,
+		vProof.VerifiableTx.DualProof.TargetTxHeader,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := schema.TxHeaderFromProto(vProof.VerifiableTx.Tx.Header)
+	if tx > 0 && hdr.ID != tx {
+		return nil, store.ErrCorruptedData
+	}
+
+	proof := schema.AuthenticatedIndexProofFromProto(vProof.Proof)
+	entry := vProof.Entry
+
+	if entry == nil {
+		if !store.VerifyKeyNonMembership(proof, database.EncodeKey(key), hdr) {
+			return nil, store.ErrCorruptedData
+		}
+	} else {
+		var eTx uint64
+		var e *store.EntrySpec
+
+		if entry.Metadata.GetDeleted() {
+			// deleted entries are set without value
+			eTx = entry.Tx
+			e = &store.EntrySpec{Key: database.EncodeKey(key), Metadata: schema.KVMetadataFromProto(entry.Metadata)}
+		} else if entry.ReferencedBy == nil {
+			eTx = entry.Tx
+			e = database.EncodeEntrySpec(key, schema.KVMetadataFromProto(entry.Metadata), entry.Value)
+		} else {
+			ref := entry.ReferencedBy
+
+			eTx = ref.Tx
+			e = database.EncodeReference(key, schema.KVMetadataFromProto(ref.Metadata), entry.Key, ref.AtTx)
+		}
+
+		if !store.VerifyKeyMembership(proof, e, eTx, hdr) {
+			return nil, store.ErrCorruptedData
+		}
+	}
+
+	dualProof := schema.DualProofFromProto(vProof.VerifiableTx.DualProof)
+
+	var sourceID, targetID uint64
+	var sourceAlh, targetAlh [sha256.Size]byte
+
+	// the header containing the root of the authenticated index is bound to the state by the dual proof
+	if state.TxId <= hdr.ID {
+		sourceID = state.TxId
+		sourceAlh = schema.DigestFromProto(state.TxHash)
+		targetID = hdr.ID
+		targetAlh = hdr.Alh()
+	} else {
+		sourceID = hdr.ID
+		sourceAlh = hdr.Alh()
+		targetID = state.TxId
+		targetAlh = schema.DigestFromProto(state.TxHash)
+	}
+
+	if state.TxId > 0 {
+		err := c.verifyDualProof(
+			ctx,
+			dualProof,
+			sourceID,
+			targetID,
+			sourceAlh,
+			targetAlh,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newState := &schema.ImmutableState{
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     vProof.VerifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
+	}
+
+	if c.serverSigningPubKey != nil {
+		err := newState.CheckSignature(c.serverSigningPubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
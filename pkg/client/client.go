@@ -38,6 +38,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
@@ -277,6 +278,29 @@ type ImmuClient interface {
 	// cleanupPercentage database option.
 	CompactIndex(ctx context.Context, req *empty.Empty) error
 
+	// RebuildIndex rebuilds database indexes from the transaction log.
+	// This call requires SysAdmin or Admin permission to given database.
+	//
+	// Indexes keep serving requests while being rebuilt and are replaced once
+	// the rebuilt ones are up to date.
+	RebuildIndex(ctx context.Context) error
+
+	// VerifyIndex cross-checks every indexed entry against the transaction log.
+	// This call requires SysAdmin or Admin permission to given database.
+	//
+	// The maxDiscrepancies value limits the number of discrepancies reported per index,
+	// the default limit is used if it's zero.
+	VerifyIndex(ctx context.Context, maxDiscrepancies uint32) (*schema.VerifyIndexResponse, error)
+
+	// CompactValues physically removes from the value logs the values which can no longer be read,
+	// i.e. expired values and values of deleted keys, while transactions are kept intact.
+	// This call requires SysAdmin or Admin permission to given database.
+	//
+	// Values of entries committed up to transaction upToTxID are compacted,
+	// the last committed transaction is used if it's zero.
+	// The number of values removed is returned.
+	CompactValues(ctx context.Context, upToTxID uint64) (uint64, error)
+
 	// ServerInfo returns information about the server instance.
 	ServerInfo(ctx context.Context, req *schema.ServerInfoRequest) (*schema.ServerInfoResponse, error)
 
@@ -350,6 +374,29 @@ type ImmuClient interface {
 	// You can use this function if you want to have visibility on the verification data
 	VerifiableGet(ctx context.Context, in *schema.VerifiableGetRequest, opts ...grpc.CallOption) (*schema.VerifiableEntry, error)
 
+	// VerifiedKeyMembership reads the latest entry set for the given key as of transaction `tx`
+	// (the latest committed one if 0), including deleted entries, and proves it was the latest one
+	// using the authenticated index of the database.
+	//
+	// This function also verifies the transaction containing the root of the authenticated index
+	// against the signed state. If verification does not succeed the store.ErrCorruptedData error is returned.
+	// If the key was never set as of transaction `tx`, the store.ErrKeyNotFound error is returned.
+	VerifiedKeyMembership(ctx context.Context, key []byte, tx uint64) (*schema.Entry, error)
+
+	// VerifiedKeyNonMembership proves that the given key was never set as of transaction `tx`
+	// (the latest committed one if 0) using the authenticated index of the database.
+	//
+	// This function also verifies the transaction containing the root of the authenticated index
+	// against the signed state. If verification does not succeed the store.ErrCorruptedData error is returned.
+	// If the key was set, the ErrKeyIsMember error is returned.
+	VerifiedKeyNonMembership(ctx context.Context, key []byte, tx uint64) error
+
+	// VerifiableGetKeyProof returns a proof of membership or non-membership of a given key,
+	// and internal data used to perform the verification.
+	//
+	// You can use this function if you want to have visibility on the verification data
+	VerifiableGetKeyProof(ctx context.Context, in *schema.VerifiableGetKeyProofRequest, opts ...grpc.CallOption) (*schema.VerifiableKeyProof, error)
+
 	// History returns history for a single key.
 	History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
 
@@ -421,6 +468,18 @@ type ImmuClient interface {
 	// Delete performs a logical deletion for a list of keys marking them as deleted.
 	Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error)
 
+	// DeleteRange performs a logical deletion of every key from start (inclusive) up to end (exclusive)
+	// with a single entry. If end is empty, the range has no upper bound.
+	DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error)
+
+	// VerifiedDeleteRange performs a logical deletion of every key from start (inclusive) up to end (exclusive).
+	// If end is empty, the range has no upper bound.
+	//
+	// This function also requests a server-generated proof, verifies the range tombstone in the transaction
+	// using the proof and verifies the signature of the signed state.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedDeleteRange(ctx context.Context, start, end []byte) (*schema.TxHeader, error)
+
 	// ExecAll performs multiple write operations (values, references, sorted set entries)
 	// in a single transaction.
 	ExecAll(ctx context.Context, in *schema.ExecAllRequest) (*schema.TxHeader, error)
@@ -1087,6 +1146,24 @@ func (c *immuClient) VerifiedGetAtRevision(ctx context.Context, key []byte, rev
 	return c.VerifiedGet(ctx, key, AtRevision(rev))
 }
 
+// stateHashAlgorithm returns the hash algorithm used by the database the given state belongs to.
+// Every provided transaction header must have been produced with the same algorithm,
+// otherwise the store.ErrCorruptedData error is returned.
+func stateHashAlgorithm(state *schema.ImmutableState, hdrs ...*schema.TxHeader) (hashing.Algorithm, error) {
+	hashAlgorithm := schema.HashAlgorithmFromProto(state.GetHashAlgorithm())
+	if !hashAlgorithm.IsValid() {
+		return hashAlgorithm, store.ErrCorruptedData
+	}
+
+	for _, hdr := range hdrs {
+		if hdr != nil && schema.HashAlgorithmFromProto(hdr.HashAlgorithm) != hashAlgorithm {
+			return hashAlgorithm, store.ErrCorruptedData
+		}
+	}
+
+	return hashAlgorithm, nil
+}
+
 func (c *immuClient) verifyDualProof(
 	ctx context.Context,
 	dualProof *store.DualProof,
@@ -1142,7 +1219,17 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 		return nil, err
 	}
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(int(vEntry.VerifiableTx.Tx.Header.Version))
+	hashAlgorithm, err := stateHashAlgorithm(
+		state,
+		vEntry.VerifiableTx.Tx.Header,
+		vEntry.VerifiableTx.DualProof.SourceTxHeader,
+		vEntry.VerifiableTx.DualProof.TargetTxHeader,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestWith(int(vEntry.VerifiableTx.Tx.Header.Version), hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -1190,7 +1277,8 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 		targetAlh = schema.DigestFromProto(state.TxHash)
 	}
 
-	verifies := store.VerifyInclusion(
+	verifies := store.VerifyInclusionWith(
+		hashAlgorithm,
 		inclusionProof,
 		entrySpecDigest(e),
 		eh)
@@ -1213,10 +1301,11 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: vEntry.VerifiableTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     vEntry.VerifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
@@ -1338,9 +1427,14 @@ func (c *immuClient) VerifiedSet(ctx context.Context, key []byte, value []byte)
 		return nil, store.ErrCorruptedData
 	}
 
+	hashAlgorithm, err := stateHashAlgorithm(state, verifiableTx.Tx.Header, verifiableTx.DualProof.SourceTxHeader, verifiableTx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := schema.TxFromProto(verifiableTx.Tx)
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(tx.Header().Version)
+	entrySpecDigest, err := store.EntrySpecDigestWith(tx.Header().Version, hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -1358,7 +1452,7 @@ func (c *immuClient) VerifiedSet(ctx context.Context, key []byte, value []byte)
 
 	e := database.EncodeEntrySpec(key, md, value)
 
-	verifies := store.VerifyInclusion(inclusionProof, entrySpecDigest(e), tx.Header().Eh)
+	verifies := store.VerifyInclusionWith(hashAlgorithm, inclusionProof, entrySpecDigest(e), tx.Header().Eh)
 	if !verifies {
 		return nil, store.ErrCorruptedData
 	}
@@ -1391,10 +1485,11 @@ func (c *immuClient) VerifiedSet(ctx context.Context, key []byte, value []byte)
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: verifiableTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     verifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
@@ -1551,6 +1646,11 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 		return nil, err
 	}
 
+	hashAlgorithm, err := stateHashAlgorithm(state, vTx.Tx.Header, vTx.DualProof.SourceTxHeader, vTx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+
 	dualProof := schema.DualProofFromProto(vTx.DualProof)
 
 	var sourceID, targetID uint64
@@ -1583,10 +1683,11 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: vTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     vTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
@@ -1717,9 +1818,14 @@ func (c *immuClient) VerifiedSetReferenceAt(ctx context.Context, key []byte, ref
 		return nil, store.ErrCorruptedData
 	}
 
+	hashAlgorithm, err := stateHashAlgorithm(state, verifiableTx.Tx.Header, verifiableTx.DualProof.SourceTxHeader, verifiableTx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := schema.TxFromProto(verifiableTx.Tx)
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(tx.Header().Version)
+	entrySpecDigest, err := store.EntrySpecDigestWith(tx.Header().Version, hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -1731,7 +1837,7 @@ func (c *immuClient) VerifiedSetReferenceAt(ctx context.Context, key []byte, ref
 
 	e := database.EncodeReference(key, nil, referencedKey, atTx)
 
-	verifies := store.VerifyInclusion(inclusionProof, entrySpecDigest(e), tx.Header().Eh)
+	verifies := store.VerifyInclusionWith(hashAlgorithm, inclusionProof, entrySpecDigest(e), tx.Header().Eh)
 	if !verifies {
 		return nil, store.ErrCorruptedData
 	}
@@ -1764,10 +1870,11 @@ func (c *immuClient) VerifiedSetReferenceAt(ctx context.Context, key []byte, ref
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: verifiableTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     verifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
@@ -1883,9 +1990,14 @@ func (c *immuClient) VerifiedZAddAt(ctx context.Context, set []byte, score float
 		return nil, store.ErrCorruptedData
 	}
 
+	hashAlgorithm, err := stateHashAlgorithm(state, vtx.Tx.Header, vtx.DualProof.SourceTxHeader, vtx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := schema.TxFromProto(vtx.Tx)
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(tx.Header().Version)
+	entrySpecDigest, err := store.EntrySpecDigestWith(tx.Header().Version, hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -1901,7 +2013,7 @@ func (c *immuClient) VerifiedZAddAt(ctx context.Context, set []byte, score float
 		return nil, err
 	}
 
-	verifies := store.VerifyInclusion(inclusionProof, entrySpecDigest(ekv), tx.Header().Eh)
+	verifies := store.VerifyInclusionWith(hashAlgorithm, inclusionProof, entrySpecDigest(ekv), tx.Header().Eh)
 	if !verifies {
 		return nil, store.ErrCorruptedData
 	}
@@ -1934,10 +2046,11 @@ func (c *immuClient) VerifiedZAddAt(ctx context.Context, set []byte, score float
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: vtx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     vtx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
@@ -2229,6 +2342,67 @@ func (c *immuClient) CompactIndex(ctx context.Context, req *empty.Empty) error {
 	return err
 }
 
+// RebuildIndex rebuilds database indexes from the transaction log.
+// This call requires SysAdmin or Admin permission to given database.
+//
+// Indexes keep serving requests while being rebuilt and are replaced once
+// the rebuilt ones are up to date.
+func (c *immuClient) RebuildIndex(ctx context.Context) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return errors.FromError(ErrNotConnected)
+	}
+
+	_, err := c.ServiceClient.RebuildIndex(ctx, &empty.Empty{})
+
+	c.Logger.Debugf("RebuildIndex finished in %s", time.Since(start))
+
+	return err
+}
+
+// VerifyIndex cross-checks every indexed entry against the transaction log.
+// This call requires SysAdmin or Admin permission to given database.
+//
+// The maxDiscrepancies value limits the number of discrepancies reported per index,
+// the default limit is used if it's zero.
+func (c *immuClient) VerifyIndex(ctx context.Context, maxDiscrepancies uint32) (*schema.VerifyIndexResponse, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	res, err := c.ServiceClient.VerifyIndex(ctx, &schema.VerifyIndexRequest{
+		MaxDiscrepancies: maxDiscrepancies,
+	})
+	if err != nil {
+		return nil, errors.FromError(err)
+	}
+
+	return res, nil
+}
+
+// CompactValues physically removes from the value logs the values which can no longer be read,
+// i.e. expired values and values of deleted keys, while transactions are kept intact.
+// This call requires SysAdmin or Admin permission to given database.
+//
+// Values of entries committed up to transaction upToTxID are compacted,
+// the last committed transaction is used if it's zero.
+// The number of values removed is returned.
+func (c *immuClient) CompactValues(ctx context.Context, upToTxID uint64) (uint64, error) {
+	if !c.IsConnected() {
+		return 0, errors.FromError(ErrNotConnected)
+	}
+
+	res, err := c.ServiceClient.CompactValues(ctx, &schema.CompactValuesRequest{
+		UpToTxID: upToTxID,
+	})
+	if err != nil {
+		return 0, errors.FromError(err)
+	}
+
+	return res.RedactedValues, nil
+}
+
 // ChangePermission grants or revokes permission to one database for given user.
 //
 // This call requires SysAdmin or admin permission to the database where we grant permissions.
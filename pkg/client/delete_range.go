@@ -0,0 +1,159 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+)
+
+// DeleteRange performs a logical deletion of every key from start (inclusive) up to end (exclusive)
+// with a single entry. If end is empty, the range has no upper bound.
+func (c *immuClient) DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	return c.ServiceClient.DeleteRange(ctx, req)
+}
+
+// VerifiedDeleteRange performs a logical deletion of every key from start (inclusive) up to end (exclusive).
+// If end is empty, the range has no upper bound.
+//
+// This function also requests a server-generated proof, verifies the range tombstone in the transaction
+// using the proof and verifies the signature of the signed state.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedDeleteRange(ctx context.Context, start, end []byte) (*schema.TxHeader, error) {
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	startTime := time.Now()
+	defer c.debugElapsedTime("VerifiedDeleteRange", startTime)
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &schema.VerifiableDeleteRangeRequest{
+		DeleteRangeRequest: &schema.DeleteRangeRequest{Start: start, End: end},
+		ProveSinceTx:       state.TxId,
+	}
+
+	var metadata runtime.ServerMetadata
+
+	verifiableTx, err := c.ServiceClient.VerifiableDeleteRange(
+		ctx,
+		req,
+		grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if verifiableTx.Tx.Header.Nentries != 1 || len(verifiableTx.Tx.Entries) != 1 {
+		return nil, store.ErrCorruptedData
+	}
+
+	hashAlgorithm, err := stateHashAlgorithm(state, verifiableTx.Tx.Header, verifiableTx.DualProof.SourceTxHeader, verifiableTx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := schema.TxFromProto(verifiableTx.Tx)
+
+	entrySpecDigest, err := store.EntrySpecDigestWith(tx.Header().Version, hashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	e := database.EncodeRangeTombstone(start, end)
+
+	inclusionProof, err := tx.Proof(e.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	verifies := store.VerifyInclusionWith(hashAlgorithm, inclusionProof, entrySpecDigest(e), tx.Header().Eh)
+	if !verifies {
+		return nil, store.ErrCorruptedData
+	}
+
+	if tx.Header().Eh != schema.DigestFromProto(verifiableTx.DualProof.TargetTxHeader.EH) {
+		return nil, store.ErrCorruptedData
+	}
+
+	var sourceID, targetID uint64
+	var sourceAlh, targetAlh [sha256.Size]byte
+
+	sourceID = state.TxId
+	sourceAlh = schema.DigestFromProto(state.TxHash)
+	targetID = tx.Header().ID
+	targetAlh = tx.Header().Alh()
+
+	if state.TxId > 0 {
+		dualProof := schema.DualProofFromProto(verifiableTx.DualProof)
+		err := c.verifyDualProof(
+			ctx,
+			dualProof,
+			sourceID,
+			targetID,
+			sourceAlh,
+			targetAlh,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newState := &schema.ImmutableState{
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     verifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
+	}
+
+	if c.serverSigningPubKey != nil {
+		err := newState.CheckSignature(c.serverSigningPubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifiableTx.Tx.Header, nil
+}
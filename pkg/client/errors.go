@@ -44,6 +44,9 @@ var (
 
 	// ErrSessionAlreadyOpen is used when trying to create a new session but there's a valid session already set up.
 	ErrSessionAlreadyOpen = errors.New("session already opened")
+
+	// ErrKeyIsMember is used when a proof of non-membership was requested for a key that was set.
+	ErrKeyIsMember = errors.New("key is a member of the authenticated index")
 )
 
 // Server errors mapping
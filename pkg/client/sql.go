@@ -161,7 +161,17 @@ func (c *immuClient) VerifyRow(ctx context.Context, row *schema.Row, table strin
 		return ErrIllegalArguments
 	}
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(int(vEntry.VerifiableTx.Tx.Header.Version))
+	hashAlgorithm, err := stateHashAlgorithm(
+		state,
+		vEntry.VerifiableTx.Tx.Header,
+		vEntry.VerifiableTx.DualProof.SourceTxHeader,
+		vEntry.VerifiableTx.DualProof.TargetTxHeader,
+	)
+	if err != nil {
+		return err
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestWith(int(vEntry.VerifiableTx.Tx.Header.Version), hashAlgorithm)
 	if err != nil {
 		return err
 	}
@@ -241,7 +251,8 @@ func (c *immuClient) VerifyRow(ctx context.Context, row *schema.Row, table strin
 		targetAlh = schema.DigestFromProto(state.TxHash)
 	}
 
-	verifies := store.VerifyInclusion(
+	verifies := store.VerifyInclusionWith(
+		hashAlgorithm,
 		inclusionProof,
 		entrySpecDigest(e),
 		eh)
@@ -264,10 +275,11 @@ func (c *immuClient) VerifyRow(ctx context.Context, row *schema.Row, table strin
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: vEntry.VerifiableTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     vEntry.VerifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
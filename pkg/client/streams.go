@@ -206,9 +206,14 @@ func (c *immuClient) _streamVerifiedSet(ctx context.Context, kvs []*stream.KeyVa
 		return nil, store.ErrCorruptedData
 	}
 
+	hashAlgorithm, err := stateHashAlgorithm(state, verifiableTx.Tx.Header, verifiableTx.DualProof.SourceTxHeader, verifiableTx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := schema.TxFromProto(verifiableTx.Tx)
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(tx.Header().Version)
+	entrySpecDigest, err := store.EntrySpecDigestWith(tx.Header().Version, hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +229,7 @@ func (c *immuClient) _streamVerifiedSet(ctx context.Context, kvs []*stream.KeyVa
 		md := tx.Entries()[i].Metadata()
 		e := database.EncodeEntrySpec(kv.Key, md, kv.Value)
 
-		verifies = store.VerifyInclusion(inclusionProof, entrySpecDigest(e), tx.Header().Eh)
+		verifies = store.VerifyInclusionWith(hashAlgorithm, inclusionProof, entrySpecDigest(e), tx.Header().Eh)
 		if !verifies {
 			return nil, store.ErrCorruptedData
 		}
@@ -258,10 +263,11 @@ func (c *immuClient) _streamVerifiedSet(ctx context.Context, kvs []*stream.KeyVa
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: verifiableTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     verifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
@@ -313,7 +319,17 @@ func (c *immuClient) _streamVerifiedGet(ctx context.Context, req *schema.Verifia
 		return nil, err
 	}
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(int(vEntry.VerifiableTx.Tx.Header.Version))
+	hashAlgorithm, err := stateHashAlgorithm(
+		state,
+		vEntry.VerifiableTx.Tx.Header,
+		vEntry.VerifiableTx.DualProof.SourceTxHeader,
+		vEntry.VerifiableTx.DualProof.TargetTxHeader,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestWith(int(vEntry.VerifiableTx.Tx.Header.Version), hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -352,7 +368,7 @@ func (c *immuClient) _streamVerifiedGet(ctx context.Context, req *schema.Verifia
 		targetAlh = schema.DigestFromProto(state.TxHash)
 	}
 
-	verifies := store.VerifyInclusion(inclusionProof, entrySpecDigest(e), eh)
+	verifies := store.VerifyInclusionWith(hashAlgorithm, inclusionProof, entrySpecDigest(e), eh)
 	if !verifies {
 		return nil, store.ErrCorruptedData
 	}
@@ -372,10 +388,11 @@ func (c *immuClient) _streamVerifiedGet(ctx context.Context, req *schema.Verifia
 	}
 
 	newState := &schema.ImmutableState{
-		Db:        c.currentDatabase(),
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: vEntry.VerifiableTx.Signature,
+		Db:            c.currentDatabase(),
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     vEntry.VerifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if c.serverSigningPubKey != nil {
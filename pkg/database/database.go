@@ -17,6 +17,7 @@ limitations under the License.
 package database
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
@@ -40,6 +41,8 @@ import (
 const (
 	MaxKeyResolutionLimit = 1
 	MaxKeyScanLimit       = 2500
+
+	DefaultMaxIndexDiscrepancies = 100
 )
 
 var (
@@ -84,9 +87,12 @@ type DB interface {
 
 	Get(ctx context.Context, req *schema.KeyRequest) (*schema.Entry, error)
 	VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest) (*schema.VerifiableEntry, error)
+	VerifiableGetKeyProof(ctx context.Context, req *schema.VerifiableGetKeyProofRequest) (*schema.VerifiableKeyProof, error)
 	GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error)
 
 	Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error)
+	DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error)
+	VerifiableDeleteRange(ctx context.Context, req *schema.VerifiableDeleteRangeRequest) (*schema.VerifiableTx, error)
 
 	SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error)
 	VerifiableSetReference(ctx context.Context, req *schema.VerifiableReferenceRequest) (*schema.VerifiableTx, error)
@@ -107,6 +113,9 @@ type DB interface {
 	// SQL-related
 	NewSQLTx(ctx context.Context, opts *sql.TxOptions) (*sql.SQLTx, error)
 
+	SQLPrepare(sql string, params map[string]interface{}) ([]sql.SQLStmt, error)
+	SQLDescribe(ctx context.Context, tx *sql.SQLTx, sql string) (stmts []sql.SQLStmt, params map[string]sql.SQLValueType, cols []sql.ColDescriptor, err error)
+
 	SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error)
 	SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []sql.SQLStmt, params map[string]interface{}) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error)
 
@@ -116,6 +125,7 @@ type DB interface {
 	SQLQuery(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRequest) (sql.RowReader, error)
 	SQLQueryAll(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRequest) ([]*sql.Row, error)
 	SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error)
+	DeclareSQLCursor(ctx context.Context, opts *sql.TxOptions, name string, stmt sql.DataSource, params map[string]interface{}) (*sql.Cursor, error)
 
 	VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetRequest) (*schema.VerifiableSQLEntry, error)
 
@@ -123,6 +133,7 @@ type DB interface {
 
 	ListTables(ctx context.Context, tx *sql.SQLTx) (*schema.SQLQueryResult, error)
 	DescribeTable(ctx context.Context, tx *sql.SQLTx, table string) (*schema.SQLQueryResult, error)
+	GetTableID(ctx context.Context, table string) (uint32, error)
 
 	// Transactional layer
 	WaitForTx(ctx context.Context, txID uint64, allowPrecommitted bool) error
@@ -144,6 +155,12 @@ type DB interface {
 	// Maintenance
 	FlushIndex(req *schema.FlushIndexRequest) error
 	CompactIndex() error
+	RebuildIndex(ctx context.Context) error
+	VerifyIndex(ctx context.Context, req *schema.VerifyIndexRequest) (*schema.VerifyIndexResponse, error)
+	CompactValues(ctx context.Context, req *schema.CompactValuesRequest) (*schema.CompactValuesResponse, error)
+
+	// Value tiering
+	MigrateValuesUptoTx(ctx context.Context, txID uint64) (int, error)
 
 	IsClosed() bool
 	Close() error
@@ -240,7 +257,11 @@ func OpenDB(
 		WithPrefix([]byte{SQLPrefix}).
 		WithMultiDBHandler(multidbHandler).
 		WithParseTxMetadataFunc(parseTxMetadata).
-		WithTableResolvers(pgschema.PgCatalogResolvers()...)
+		WithTxUserMetadataKey(schema.UserRequestMetadataKey).
+		WithTableResolvers(pgschema.PgCatalogResolvers()...).
+		WithTableResolvers(pgschema.InformationSchemaResolvers(dbName)...).
+		WithStatementTimeout(opts.StatementTimeout).
+		WithQueryMemoryLimit(opts.QueryMemoryLimit)
 
 	dbi.sqlEngine, err = sql.NewEngine(dbi.st, sqlOpts)
 	if err != nil {
@@ -366,7 +387,11 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log
 		WithPrefix([]byte{SQLPrefix}).
 		WithMultiDBHandler(multidbHandler).
 		WithParseTxMetadataFunc(parseTxMetadata).
-		WithTableResolvers(pgschema.PgCatalogResolvers()...)
+		WithTxUserMetadataKey(schema.UserRequestMetadataKey).
+		WithTableResolvers(pgschema.PgCatalogResolvers()...).
+		WithTableResolvers(pgschema.InformationSchemaResolvers(dbName)...).
+		WithStatementTimeout(opts.StatementTimeout).
+		WithQueryMemoryLimit(opts.QueryMemoryLimit)
 
 	dbi.Logger.Infof("loading sql-engine for database '%s' {replica = %v}...", dbName, opts.replica)
 
@@ -404,6 +429,75 @@ func (d *db) CompactIndex() error {
 	return d.st.CompactIndexes()
 }
 
+// RebuildIndex rebuilds database indexes from the transaction log while current ones keep serving requests
+func (d *db) RebuildIndex(ctx context.Context) error {
+	return d.st.RebuildIndexes(ctx)
+}
+
+// VerifyIndex cross-checks every indexed entry against the transaction log
+func (d *db) VerifyIndex(ctx context.Context, req *schema.VerifyIndexRequest) (*schema.VerifyIndexResponse, error) {
+	if req == nil {
+		return nil, store.ErrIllegalArguments
+	}
+
+	maxDiscrepancies := int(req.MaxDiscrepancies)
+	if maxDiscrepancies == 0 {
+		maxDiscrepancies = DefaultMaxIndexDiscrepancies
+	}
+
+	verifications, err := d.st.VerifyIndexes(ctx, maxDiscrepancies)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &schema.VerifyIndexResponse{
+		Indexes: make([]*schema.IndexVerification, len(verifications)),
+	}
+
+	for i, v := range verifications {
+		discrepancies := make([]*schema.IndexDiscrepancy, len(v.Discrepancies))
+
+		for j, d := range v.Discrepancies {
+			discrepancies[j] = &schema.IndexDiscrepancy{
+				Key:    d.Key,
+				TxID:   d.TxID,
+				Reason: d.Reason,
+			}
+		}
+
+		res.Indexes[i] = &schema.IndexVerification{
+			SourcePrefix:     v.SourcePrefix,
+			TargetPrefix:     v.TargetPrefix,
+			IndexedTxID:      v.IndexedTxID,
+			ExpectedEntries:  v.ExpectedEntries,
+			IndexedEntries:   v.IndexedEntries,
+			DiscrepancyCount: v.DiscrepancyCount,
+			Discrepancies:    discrepancies,
+		}
+	}
+
+	return res, nil
+}
+
+// CompactValues physically removes the values which can no longer be read from the value logs
+func (d *db) CompactValues(ctx context.Context, req *schema.CompactValuesRequest) (*schema.CompactValuesResponse, error) {
+	if req == nil {
+		return nil, store.ErrIllegalArguments
+	}
+
+	upToTxID := req.UpToTxID
+	if upToTxID == 0 {
+		upToTxID = d.st.LastCommittedTxID()
+	}
+
+	n, err := d.st.CompactValues(ctx, upToTxID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.CompactValuesResponse{RedactedValues: uint64(n)}, nil
+}
+
 // Set ...
 func (d *db) Set(ctx context.Context, req *schema.SetRequest) (*schema.TxHeader, error) {
 	d.mutex.RLock()
@@ -495,8 +589,17 @@ func (d *db) newTx(ctx context.Context, opts *store.TxOptions) (*store.OngoingTx
 	return d.txWithMetadata(ctx, tx)
 }
 
+// requestMetadata returns the metadata of the request to be recorded in the tx,
+// txs written using the first header version can not hold metadata
+func (d *db) requestMetadata(ctx context.Context) schema.Metadata {
+	if d.options.storeOpts.WriteTxHeaderVersion == 0 {
+		return nil
+	}
+	return schema.MetadataFromContext(ctx)
+}
+
 func (d *db) txWithMetadata(ctx context.Context, tx *store.OngoingTx) (*store.OngoingTx, error) {
-	meta := schema.MetadataFromContext(ctx)
+	meta := d.requestMetadata(ctx)
 	if len(meta) > 0 {
 		txmd := store.NewTxMetadata()
 
@@ -750,6 +853,7 @@ func (d *db) CurrentState() (*schema.ImmutableState, error) {
 		TxHash:             lastTxAlh[:],
 		PrecommittedTxId:   lastPreTxID,
 		PrecommittedTxHash: lastPreTxAlh[:],
+		HashAlgorithm:      schema.HashAlgorithmToProto(d.st.HashAlgorithm()),
 	}, nil
 }
 
@@ -895,6 +999,92 @@ func (d *db) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest
 	}, nil
 }
 
+// VerifiableGetKeyProof returns a proof of membership or non-membership of the key as of the specified transaction.
+// When the key is a member, the latest entry set for it is returned, including deleted ones.
+func (d *db) VerifiableGetKeyProof(ctx context.Context, req *schema.VerifiableGetKeyProofRequest) (*schema.VerifiableKeyProof, error) {
+	if req == nil || len(req.Key) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < req.ProveSinceTx || lastTxID < req.AtTx {
+		return nil, ErrIllegalState
+	}
+
+	atTx := req.AtTx
+	if atTx == 0 {
+		atTx = lastTxID
+	}
+
+	key := EncodeKey(req.Key)
+
+	proof, hdr, err := d.st.AuthenticatedIndexProof(key, atTx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *schema.Entry
+
+	if proof.Leaf != nil && proof.Leaf.KeyDigest == hdr.HashAlgorithm.Sum(key) {
+		md, val, err := d.readMetadataAndValue(key, proof.Leaf.TxID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if md != nil && md.Deleted() {
+			entry = &schema.Entry{
+				Tx:       proof.Leaf.TxID,
+				Key:      req.Key,
+				Metadata: schema.KVMetadataToProto(md),
+			}
+		} else {
+			// references are not resolved as the proof is about the referencing entry
+			entry, err = d.resolveValue(ctx, key, val, 0, proof.Leaf.TxID, md, nil, 0, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var rootTxHdr *store.TxHeader
+
+	if req.ProveSinceTx == 0 {
+		rootTxHdr = hdr
+	} else {
+		rootTxHdr, err = d.st.ReadTxHeader(req.ProveSinceTx, false, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sourceTxHdr, targetTxHdr *store.TxHeader
+
+	if req.ProveSinceTx <= atTx {
+		sourceTxHdr = rootTxHdr
+		targetTxHdr = hdr
+	} else {
+		sourceTxHdr = hdr
+		targetTxHdr = rootTxHdr
+	}
+
+	dualProof, err := d.st.DualProof(sourceTxHdr, targetTxHdr)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the header of the transaction is needed to verify the proof
+	verifiableTx := &schema.VerifiableTx{
+		Tx:        &schema.Tx{Header: schema.TxHeaderToProto(hdr)},
+		DualProof: schema.DualProofToProto(dualProof),
+	}
+
+	return &schema.VerifiableKeyProof{
+		Entry:        entry,
+		Proof:        schema.AuthenticatedIndexProofToProto(proof),
+		VerifiableTx: verifiableTx,
+	}, nil
+}
+
 func (d *db) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error) {
 	if req == nil {
 		return nil, ErrIllegalArguments
@@ -955,6 +1145,111 @@ func (d *db) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema
 	return schema.TxHeaderToProto(hdr), nil
 }
 
+// DeleteRange logically deletes every key from start (inclusive) up to end (exclusive) with a single entry,
+// if end is empty the range has no upper bound
+func (d *db) DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error) {
+	if req == nil || len(req.Start) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	if len(req.End) > 0 && bytes.Compare(req.Start, req.End) >= 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	opts := store.DefaultTxOptions()
+
+	if req.SinceTx > 0 {
+		if req.SinceTx > d.st.LastPrecommittedTxID() {
+			return nil, store.ErrTxNotFound
+		}
+
+		opts.WithSnapshotMustIncludeTxID(func(_ uint64) uint64 {
+			return req.SinceTx
+		})
+	}
+
+	tx, err := d.newTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Cancel()
+
+	// the range is restricted to the key-value keyspace
+	err = tx.DeleteRange(EncodeKey(req.Start), EncodeRangeEnd(req.End))
+	if err != nil {
+		return nil, err
+	}
+
+	var hdr *store.TxHeader
+	if req.NoWait {
+		hdr, err = tx.AsyncCommit(ctx)
+	} else {
+		hdr, err = tx.Commit(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.TxHeaderToProto(hdr), nil
+}
+
+// VerifiableDeleteRange ...
+func (d *db) VerifiableDeleteRange(ctx context.Context, req *schema.VerifiableDeleteRangeRequest) (*schema.VerifiableTx, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < req.ProveSinceTx {
+		return nil, ErrIllegalState
+	}
+
+	// Preallocate tx buffers
+	lastTx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(lastTx)
+
+	txhdr, err := d.DeleteRange(ctx, req.DeleteRangeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.st.ReadTx(uint64(txhdr.Id), false, lastTx)
+	if err != nil {
+		return nil, err
+	}
+
+	var prevTxHdr *store.TxHeader
+
+	if req.ProveSinceTx == 0 {
+		prevTxHdr = lastTx.Header()
+	} else {
+		prevTxHdr, err = d.st.ReadTxHeader(req.ProveSinceTx, false, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dualProof, err := d.st.DualProof(prevTxHdr, lastTx.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.VerifiableTx{
+		Tx:        schema.TxToProto(lastTx),
+		DualProof: schema.DualProofToProto(dualProof),
+	}, nil
+}
+
 // GetAll ...
 func (d *db) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	snap, err := d.snapshotSince(ctx, []byte{SetKeyPrefix}, req.SinceTx)
@@ -1783,3 +2078,9 @@ func (d *db) FindTruncationPoint(ctx context.Context, until time.Time) (*schema.
 func (d *db) TruncateUptoTx(_ context.Context, txID uint64) error {
 	return d.st.TruncateUptoTx(txID)
 }
+
+// MigrateValuesUptoTx moves into the cold storage tier the value log chunks holding only values of
+// transactions up to txID, it returns the number of migrated chunks
+func (d *db) MigrateValuesUptoTx(_ context.Context, txID uint64) (int, error) {
+	return d.st.MigrateValuesUptoTx(txID)
+}
@@ -32,6 +32,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -107,6 +108,10 @@ func (u *mockUser) SQLPrivileges() []sql.SQLPrivilege {
 	return sql.DefaultSQLPrivilegesForPermission(sql.PermissionAdmin)
 }
 
+func (u *mockUser) TablePrivileges() []sql.TablePrivilege {
+	return nil
+}
+
 func (h *dummyMultidbHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
 	return &mockUser{}, nil
 }
@@ -131,6 +136,22 @@ func (h *dummyMultidbHandler) RevokeSQLPrivileges(ctx context.Context, database,
 	return sql.ErrNoSupported
 }
 
+func (h *dummyMultidbHandler) GrantTablePrivileges(ctx context.Context, table *sql.Table, username string, privileges []sql.SQLPrivilege, columns []string) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) RevokeTablePrivileges(ctx context.Context, table *sql.Table, username string, privileges []sql.SQLPrivilege, columns []string) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) GrantSchemaPrivileges(ctx context.Context, schema, username string, privileges []sql.SQLPrivilege) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) RevokeSchemaPrivileges(ctx context.Context, schema, username string, privileges []sql.SQLPrivilege) error {
+	return sql.ErrNoSupported
+}
+
 func (h *dummyMultidbHandler) DropUser(ctx context.Context, username string) error {
 	return sql.ErrNoSupported
 }
@@ -1269,6 +1290,136 @@ func TestPreconditionedSet(t *testing.T) {
 		"did not fail when too many preconditions were given")
 }
 
+func TestContentPreconditionedSet(t *testing.T) {
+	db := makeDb(t)
+
+	tx1, err := db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{
+			{
+				Key:   []byte("order/1/status"),
+				Value: []byte("pending"),
+			},
+			{
+				Key:      []byte("order/1/lock"),
+				Value:    []byte("owner"),
+				Metadata: &schema.KVMetadata{Expiration: &schema.Expiration{ExpiresAt: time.Now().Add(time.Hour).Unix()}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	pendingHash := EncodedValueDigest(hashing.SHA256, []byte("pending"))
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{
+			Key:   []byte("order/1/status"),
+			Value: []byte("shipped"),
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionKeyValueHashEquals([]byte("order/1/status"), []byte("pending")),
+		},
+	})
+	require.ErrorIs(t, err, store.ErrInvalidPrecondition,
+		"did not fail when an invalid value hash was given")
+
+	_, err = db.VerifiableSet(context.Background(), &schema.VerifiableSetRequest{
+		SetRequest: &schema.SetRequest{
+			KVs: []*schema.KeyValue{{
+				Key:   []byte("order/1/status"),
+				Value: []byte("shipped"),
+			}},
+			Preconditions: []*schema.Precondition{
+				schema.PreconditionKeyValueHashEquals([]byte("order/1/status"), pendingHash[:]),
+				schema.PreconditionKeyExpiresAfter([]byte("order/1/lock"), time.Now().Add(time.Minute)),
+				schema.PreconditionNoKeyWithPrefixModifiedAfterTX([]byte("order/1/"), tx1.Id),
+			},
+		},
+	})
+	require.NoError(t, err,
+		"did not add valid entry with content preconditions")
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{
+			Key:   []byte("order/1/status"),
+			Value: []byte("cancelled"),
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionKeyValueHashEquals([]byte("order/1/status"), pendingHash[:]),
+		},
+	})
+	require.ErrorIs(t, err, store.ErrPreconditionFailed,
+		"did not detect failed KeyValueHashEquals precondition after the value was changed")
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{{
+			Operation: &schema.Op_Kv{
+				Kv: &schema.KeyValue{
+					Key:   []byte("order/1/status"),
+					Value: []byte("cancelled"),
+				},
+			},
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionNoKeyWithPrefixModifiedAfterTX([]byte("order/1/"), tx1.Id),
+		},
+	})
+	require.ErrorIs(t, err, store.ErrPreconditionFailed,
+		"did not detect failed NoKeyWithPrefixModifiedAfterTX precondition after a key with the prefix was changed")
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{
+			Key:   []byte("order/1/status"),
+			Value: []byte("cancelled"),
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionKeyExpiresAfter([]byte("order/1/lock"), time.Now().Add(2*time.Hour)),
+		},
+	})
+	require.ErrorIs(t, err, store.ErrPreconditionFailed,
+		"did not detect failed KeyExpiresAfter precondition")
+}
+
+func TestContentPreconditionedSetWithHashAlgorithm(t *testing.T) {
+	options := DefaultOptions().WithDBRootPath(t.TempDir())
+	options.storeOpts.WithHashAlgorithm(hashing.BLAKE3)
+
+	db := makeDbWith(t, "db", options)
+
+	_, err := db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{
+			Key:   []byte("order/1/status"),
+			Value: []byte("pending"),
+		}},
+	})
+	require.NoError(t, err)
+
+	sha256Hash := EncodedValueDigest(hashing.SHA256, []byte("pending"))
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{
+			Key:   []byte("order/1/status"),
+			Value: []byte("shipped"),
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionKeyValueHashEquals([]byte("order/1/status"), sha256Hash[:]),
+		},
+	})
+	require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+	pendingHash := EncodedValueDigest(hashing.BLAKE3, []byte("pending"))
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{
+			Key:   []byte("order/1/status"),
+			Value: []byte("shipped"),
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionKeyValueHashEquals([]byte("order/1/status"), pendingHash[:]),
+		},
+	})
+	require.NoError(t, err)
+}
+
 func TestPreconditionedSetParallel(t *testing.T) {
 	db := makeDb(t)
 
@@ -2270,3 +2421,80 @@ func Test_database_truncate(t *testing.T) {
 	}
 
 }
+
+func TestRebuildAndVerifyIndex(t *testing.T) {
+	db := makeDb(t)
+
+	for _, kv := range kvs {
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{kv}})
+		require.NoError(t, err)
+	}
+
+	err := db.RebuildIndex(context.Background())
+	require.NoError(t, err)
+
+	for _, kv := range kvs {
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: kv.Key})
+		require.NoError(t, err)
+		require.Equal(t, kv.Value, entry.Value)
+	}
+
+	_, err = db.VerifyIndex(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	res, err := db.VerifyIndex(context.Background(), &schema.VerifyIndexRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Indexes)
+
+	for _, idx := range res.Indexes {
+		require.Zero(t, idx.DiscrepancyCount)
+		require.Empty(t, idx.Discrepancies)
+		require.Equal(t, idx.ExpectedEntries, idx.IndexedEntries)
+	}
+}
+
+func TestCompactValues(t *testing.T) {
+	options := DefaultOptions().WithDBRootPath(t.TempDir())
+	options.storeOpts.WithFileSize(256)
+
+	db := makeDbWith(t, "db", options)
+
+	_, err := db.CompactValues(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "CREATE TABLE mytable(id INTEGER, title VARCHAR, PRIMARY KEY id)"})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+			Sql: fmt.Sprintf("INSERT INTO mytable(id, title) VALUES (%d, '%s')", i, strings.Repeat("t", 100)),
+		})
+		require.NoError(t, err)
+	}
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "DELETE FROM mytable WHERE id < 5"})
+	require.NoError(t, err)
+
+	// the active chunk gets sealed
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+		Sql: fmt.Sprintf("INSERT INTO mytable(id, title) VALUES (10, '%s')", strings.Repeat("t", 300)),
+	})
+	require.NoError(t, err)
+
+	err = db.st.WaitForIndexingUpto(context.Background(), db.st.LastCommittedTxID())
+	require.NoError(t, err)
+
+	// values of deleted rows are removed once the deletion is indexed
+	res, err := db.CompactValues(context.Background(), &schema.CompactValuesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.RedactedValues)
+
+	reader, err := db.SQLQuery(context.Background(), nil, &schema.SQLQueryRequest{Sql: "SELECT COUNT(*) FROM mytable"})
+	require.NoError(t, err)
+
+	defer reader.Close()
+
+	row, err := reader.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(6), row.ValuesByPosition[0].RawValue())
+}
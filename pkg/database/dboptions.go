@@ -26,6 +26,7 @@ const (
 	DefaultDbRootPath          = "./data"
 	DefaultReadTxPoolSize      = 128
 	DefaultTruncationFrequency = 24 * time.Hour
+	DefaultTieringFrequency    = time.Hour
 )
 
 // Options database instance options
@@ -41,6 +42,13 @@ type Options struct {
 	readTxPoolSize int
 	maxResultSize  int
 
+	// StatementTimeout is the maximum amount of time a SQL statement may run, zero means no timeout.
+	StatementTimeout time.Duration
+
+	// QueryMemoryLimit is the maximum number of bytes a SQL statement may use for sorting,
+	// grouping and removing duplicates, zero means no limit.
+	QueryMemoryLimit int64
+
 	// TruncationFrequency determines how frequently to truncate data from the database.
 	TruncationFrequency time.Duration
 
@@ -116,6 +124,16 @@ func (o *Options) WithRetentionPeriod(c time.Duration) *Options {
 	return o
 }
 
+func (o *Options) WithStatementTimeout(timeout time.Duration) *Options {
+	o.StatementTimeout = timeout
+	return o
+}
+
+func (o *Options) WithQueryMemoryLimit(limit int64) *Options {
+	o.QueryMemoryLimit = limit
+	return o
+}
+
 func (o *Options) WithMaxResultSize(maxResultSize int) *Options {
 	o.maxResultSize = maxResultSize
 	return o
@@ -39,12 +39,16 @@ func TestDefaultOptions(t *testing.T) {
 		WithStoreOptions(storeOpts).
 		WithReadTxPoolSize(789).
 		WithSyncReplication(true).
-		WithTruncationFrequency(1 * time.Hour)
+		WithTruncationFrequency(1 * time.Hour).
+		WithStatementTimeout(5 * time.Second).
+		WithQueryMemoryLimit(1 << 20)
 
 	require.Equal(t, op.GetDBRootPath(), rootpath)
 	require.Equal(t, op.GetTxPoolSize(), 789)
 	require.True(t, op.syncReplication)
 	require.Equal(t, op.TruncationFrequency, 1*time.Hour)
+	require.Equal(t, 5*time.Second, op.StatementTimeout)
+	require.Equal(t, int64(1<<20), op.QueryMemoryLimit)
 
 	require.Equal(t, storeOpts, op.storeOpts)
 }
@@ -174,6 +174,16 @@ func (db *lazyDB) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRe
 	return d.VerifiableGet(ctx, req)
 }
 
+func (db *lazyDB) VerifiableGetKeyProof(ctx context.Context, req *schema.VerifiableGetKeyProofRequest) (*schema.VerifiableKeyProof, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.VerifiableGetKeyProof(ctx, req)
+}
+
 func (db *lazyDB) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -194,6 +204,26 @@ func (db *lazyDB) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*s
 	return d.Delete(ctx, req)
 }
 
+func (db *lazyDB) DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.DeleteRange(ctx, req)
+}
+
+func (db *lazyDB) VerifiableDeleteRange(ctx context.Context, req *schema.VerifiableDeleteRangeRequest) (*schema.VerifiableTx, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.VerifiableDeleteRange(ctx, req)
+}
+
 func (db *lazyDB) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -324,6 +354,26 @@ func (db *lazyDB) SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []sq
 	return d.SQLExecPrepared(ctx, tx, stmts, params)
 }
 
+func (db *lazyDB) SQLPrepare(sql string, params map[string]interface{}) ([]sql.SQLStmt, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.SQLPrepare(sql, params)
+}
+
+func (db *lazyDB) SQLDescribe(ctx context.Context, tx *sql.SQLTx, sql string) ([]sql.SQLStmt, map[string]sql.SQLValueType, []sql.ColDescriptor, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.SQLDescribe(ctx, tx, sql)
+}
+
 func (db *lazyDB) InferParameters(ctx context.Context, tx *sql.SQLTx, sql string) (map[string]sql.SQLValueType, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -374,6 +424,16 @@ func (db *lazyDB) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.
 	return d.SQLQueryPrepared(ctx, tx, stmt, params)
 }
 
+func (db *lazyDB) DeclareSQLCursor(ctx context.Context, opts *sql.TxOptions, name string, stmt sql.DataSource, params map[string]interface{}) (*sql.Cursor, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.DeclareSQLCursor(ctx, opts, name, stmt, params)
+}
+
 func (db *lazyDB) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetRequest) (*schema.VerifiableSQLEntry, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -404,6 +464,16 @@ func (db *lazyDB) DescribeTable(ctx context.Context, tx *sql.SQLTx, table string
 	return d.DescribeTable(ctx, tx, table)
 }
 
+func (db *lazyDB) GetTableID(ctx context.Context, table string) (uint32, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return 0, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.GetTableID(ctx, table)
+}
+
 func (db *lazyDB) WaitForTx(ctx context.Context, txID uint64, allowPrecommitted bool) error {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -525,6 +595,46 @@ func (db *lazyDB) CompactIndex() error {
 	return d.CompactIndex()
 }
 
+func (db *lazyDB) RebuildIndex(ctx context.Context) error {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.RebuildIndex(ctx)
+}
+
+func (db *lazyDB) VerifyIndex(ctx context.Context, req *schema.VerifyIndexRequest) (*schema.VerifyIndexResponse, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.VerifyIndex(ctx, req)
+}
+
+func (db *lazyDB) CompactValues(ctx context.Context, req *schema.CompactValuesRequest) (*schema.CompactValuesResponse, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.CompactValues(ctx, req)
+}
+
+func (db *lazyDB) MigrateValuesUptoTx(ctx context.Context, txID uint64) (int, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return 0, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.MigrateValuesUptoTx(ctx, txID)
+}
+
 func (db *lazyDB) IsClosed() bool {
 	return db.m.IsClosed(db.idx)
 }
@@ -20,6 +20,7 @@ import (
 	"encoding/binary"
 	"math"
 
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -51,6 +52,28 @@ func EncodeKey(key []byte) []byte {
 	return WrapWithPrefix(key, SetKeyPrefix)
 }
 
+// EncodeRangeEnd encodes the exclusive end of a range of keys,
+// an empty end is encoded as the end of the key-value keyspace
+func EncodeRangeEnd(end []byte) []byte {
+	if len(end) == 0 {
+		return []byte{SetKeyPrefix + 1}
+	}
+
+	return EncodeKey(end)
+}
+
+// EncodeRangeTombstone returns the entry written to logically delete a range of keys
+func EncodeRangeTombstone(start, end []byte) *store.EntrySpec {
+	md := store.NewKVMetadata()
+	md.AsDeletedRange(true)
+
+	return &store.EntrySpec{
+		Key:      EncodeKey(start),
+		Metadata: md,
+		Value:    EncodeRangeEnd(end),
+	}
+}
+
 func EncodeEntrySpec(
 	key []byte,
 	md *store.KVMetadata,
@@ -63,6 +86,12 @@ func EncodeEntrySpec(
 	}
 }
 
+// EncodedValueDigest returns the digest of a plain value as stored in the transaction entry,
+// as expected by KeyValueHashEquals preconditions. It must be computed with the hash algorithm of the database.
+func EncodedValueDigest(alg hashing.Algorithm, value []byte) [hashing.Size]byte {
+	return alg.Sum(WrapWithPrefix(value, PlainValuePrefix))
+}
+
 func EncodeReference(
 	key []byte,
 	md *store.KVMetadata,
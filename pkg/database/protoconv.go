@@ -17,6 +17,9 @@ limitations under the License.
 package database
 
 import (
+	"crypto/sha256"
+	"time"
+
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 )
@@ -56,6 +59,40 @@ func PreconditionFromProto(c *schema.Precondition) (store.Precondition, error) {
 			Key:  EncodeKey(key),
 			TxID: c.KeyNotModifiedAfterTX.GetTxID(),
 		}, nil
+
+	case *schema.Precondition_KeyValueHashEquals:
+		key := c.KeyValueHashEquals.GetKey()
+		if len(key) == 0 {
+			return nil, store.ErrInvalidPreconditionNullKey
+		}
+
+		valueHash := c.KeyValueHashEquals.GetValueHash()
+		if len(valueHash) != sha256.Size {
+			return nil, store.ErrInvalidPreconditionInvalidValueHash
+		}
+
+		cs := &store.PreconditionKeyValueHashEquals{
+			Key: EncodeKey(key),
+		}
+		copy(cs.ValueHash[:], valueHash)
+
+		return cs, nil
+
+	case *schema.Precondition_NoKeyWithPrefixModifiedAfterTX:
+		return &store.PreconditionNoKeyWithPrefixModifiedAfterTx{
+			Prefix: EncodeKey(c.NoKeyWithPrefixModifiedAfterTX.GetPrefix()),
+			TxID:   c.NoKeyWithPrefixModifiedAfterTX.GetTxID(),
+		}, nil
+
+	case *schema.Precondition_KeyExpiresAfter:
+		key := c.KeyExpiresAfter.GetKey()
+		if len(key) == 0 {
+			return nil, store.ErrInvalidPreconditionNullKey
+		}
+		return &store.PreconditionKeyExpiresAfter{
+			Key:       EncodeKey(key),
+			ExpiresAt: time.Unix(c.KeyExpiresAfter.GetExpiresAt(), 0),
+		}, nil
 	}
 
 	return nil, store.ErrInvalidPreconditionNull
@@ -18,7 +18,9 @@ package database
 
 import (
 	"testing"
+	"time"
 
+	"github.com/codenotary/immudb/embedded/hashing"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/stretchr/testify/require"
@@ -78,4 +80,38 @@ func TestPreconditionFromProto(t *testing.T) {
 		require.IsType(t, &store.PreconditionKeyNotModifiedAfterTx{}, c)
 	})
 
+	t.Run("KeyValueHashEquals", func(t *testing.T) {
+		valueHash := EncodedValueDigest(hashing.SHA256, []byte{2})
+
+		_, err := PreconditionFromProto(schema.PreconditionKeyValueHashEquals(nil, valueHash[:]))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionNullKey)
+
+		_, err = PreconditionFromProto(schema.PreconditionKeyValueHashEquals([]byte{1}, nil))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionInvalidValueHash)
+
+		c, err := PreconditionFromProto(schema.PreconditionKeyValueHashEquals([]byte{1}, valueHash[:]))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionKeyValueHashEquals{}, c)
+		require.Equal(t, valueHash, c.(*store.PreconditionKeyValueHashEquals).ValueHash)
+	})
+
+	t.Run("NoKeyWithPrefixModifiedAfterTX", func(t *testing.T) {
+		c, err := PreconditionFromProto(schema.PreconditionNoKeyWithPrefixModifiedAfterTX(nil, 1))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionNoKeyWithPrefixModifiedAfterTx{}, c)
+		require.Equal(t, []byte{SetKeyPrefix}, c.(*store.PreconditionNoKeyWithPrefixModifiedAfterTx).Prefix)
+	})
+
+	t.Run("KeyExpiresAfter", func(t *testing.T) {
+		_, err := PreconditionFromProto(schema.PreconditionKeyExpiresAfter(nil, time.Now()))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionNullKey)
+
+		c, err := PreconditionFromProto(schema.PreconditionKeyExpiresAfter([]byte{1}, time.Now()))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionKeyExpiresAfter{}, c)
+	})
+
 }
@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -220,6 +219,24 @@ func (d *db) ListTables(ctx context.Context, tx *sql.SQLTx) (*schema.SQLQueryRes
 	return res, nil
 }
 
+func (d *db) GetTableID(ctx context.Context, tableName string) (uint32, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	catalog, err := d.sqlEngine.Catalog(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	table, err := catalog.GetTableByName(tableName)
+	if err != nil {
+		return 0, err
+	}
+
+	// privileges are granted under the id the table was created with, which is kept when it's truncated
+	return table.PrivilegeID(), nil
+}
+
 func (d *db) DescribeTable(ctx context.Context, tx *sql.SQLTx, tableName string) (*schema.SQLQueryResult, error) {
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
@@ -304,7 +321,7 @@ func (d *db) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (tx *sql.SQLTx,
 	}()
 
 	go func() {
-		md := schema.MetadataFromContext(ctx)
+		md := d.requestMetadata(ctx)
 		if len(md) > 0 {
 			data, err := md.Marshal()
 			if err != nil {
@@ -338,22 +355,39 @@ func (d *db) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (tx *sql.SQLTx,
 	}
 }
 
+// SQLPrepare parses the given SQL text, reusing previously parsed statements when possible
+func (d *db) SQLPrepare(sql string, params map[string]interface{}) ([]sql.SQLStmt, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.sqlEngine.PrepareSQL(sql, params)
+}
+
+// SQLDescribe parses the given SQL text and returns the types of its parameters
+// and, if it consists of a single query, the columns of its results
+func (d *db) SQLDescribe(ctx context.Context, tx *sql.SQLTx, sql string) ([]sql.SQLStmt, map[string]sql.SQLValueType, []sql.ColDescriptor, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.sqlEngine.DescribeSQL(ctx, tx, sql)
+}
+
 func (d *db) SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error) {
 	if req == nil {
 		return nil, nil, ErrIllegalArguments
 	}
 
-	stmts, err := sql.ParseSQL(strings.NewReader(req.Sql))
-	if err != nil {
-		return nil, nil, err
-	}
-
 	params := make(map[string]interface{})
 
 	for _, p := range req.Params {
 		params[p.Name] = schema.RawValue(p.Value)
 	}
 
+	stmts, err := d.SQLPrepare(req.Sql, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return d.SQLExecPrepared(ctx, tx, stmts, params)
 }
 
@@ -377,7 +411,9 @@ func (d *db) SQLQuery(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRe
 		return nil, ErrIllegalArguments
 	}
 
-	stmts, err := sql.ParseSQL(strings.NewReader(req.Sql))
+	params := schema.NamedParamsFromProto(req.Params)
+
+	stmts, err := d.SQLPrepare(req.Sql, params)
 	if err != nil {
 		return nil, err
 	}
@@ -386,7 +422,7 @@ func (d *db) SQLQuery(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRe
 	if !ok {
 		return nil, sql.ErrExpectingDQLStmt
 	}
-	reader, err := d.SQLQueryPrepared(ctx, tx, stmt, schema.NamedParamsFromProto(req.Params))
+	reader, err := d.SQLQueryPrepared(ctx, tx, stmt, params)
 	if !req.AcceptStream {
 		reader = &limitRowReader{RowReader: reader, maxRows: d.maxResultSize}
 	}
@@ -413,6 +449,19 @@ func (d *db) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataS
 	return d.sqlEngine.QueryPreparedStmt(ctx, tx, stmt, params)
 }
 
+// DeclareSQLCursor resolves the query within a dedicated read-only transaction,
+// from which its rows can be fetched in batches until the cursor is closed
+func (d *db) DeclareSQLCursor(ctx context.Context, opts *sql.TxOptions, name string, stmt sql.DataSource, params map[string]interface{}) (*sql.Cursor, error) {
+	if stmt == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.sqlEngine.DeclareCursor(ctx, opts, name, stmt, params)
+}
+
 func (d *db) InferParameters(ctx context.Context, tx *sql.SQLTx, sql string) (map[string]sql.SQLValueType, error) {
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
@@ -114,6 +114,16 @@ func TestSQLExecAndQuery(t *testing.T) {
 	require.Len(t, inferredParams, 1)
 	require.Equal(t, sql.BooleanType, inferredParams["active"])
 
+	preparedStmts, err := db.SQLPrepare(q, nil)
+	require.NoError(t, err)
+	require.Len(t, preparedStmts, 1)
+
+	describedStmts, inferredParams, cols, err := db.SQLDescribe(context.Background(), nil, q)
+	require.NoError(t, err)
+	require.Same(t, preparedStmts[0], describedStmts[0])
+	require.Equal(t, sql.BooleanType, inferredParams["active"])
+	require.Len(t, cols, 5)
+
 	_, err = db.VerifiableSQLGet(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrIllegalArguments)
 
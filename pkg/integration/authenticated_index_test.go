@@ -0,0 +1,109 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticatedIndex(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	t.Run("proofs are not available when the authenticated index is disabled", func(t *testing.T) {
+		_, err := client.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+		require.NoError(t, err)
+
+		_, err = client.VerifiedKeyMembership(ctx, []byte("key1"), 0)
+		require.ErrorContains(t, err, store.ErrAuthenticatedIndexDisabled.Error())
+	})
+
+	_, err := client.CreateDatabaseV2(ctx, "db1", &schema.DatabaseNullableSettings{
+		AuthenticatedIndex: &schema.NullableBool{Value: true},
+	})
+	require.NoError(t, err)
+
+	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: "db1"})
+	require.NoError(t, err)
+
+	res, err := client.GetDatabaseSettingsV2(ctx)
+	require.NoError(t, err)
+	require.True(t, res.Settings.AuthenticatedIndex.Value)
+
+	hdr1, err := client.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	hdr2, err := client.VerifiedSet(ctx, []byte("key1"), []byte("value2"))
+	require.NoError(t, err)
+
+	hdr3, err := client.VerifiedSetReference(ctx, []byte("ref1"), []byte("key1"))
+	require.NoError(t, err)
+
+	hdr4, err := client.Delete(ctx, &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key1")}})
+	require.NoError(t, err)
+
+	t.Run("membership proofs", func(t *testing.T) {
+		entry, err := client.VerifiedKeyMembership(ctx, []byte("key1"), hdr1.Id)
+		require.NoError(t, err)
+		require.Equal(t, hdr1.Id, entry.Tx)
+		require.Equal(t, []byte("value1"), entry.Value)
+
+		entry, err = client.VerifiedKeyMembership(ctx, []byte("key1"), hdr3.Id)
+		require.NoError(t, err)
+		require.Equal(t, hdr2.Id, entry.Tx)
+		require.Equal(t, []byte("value2"), entry.Value)
+
+		entry, err = client.VerifiedKeyMembership(ctx, []byte("ref1"), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte("key1"), entry.Key)
+		require.Equal(t, hdr3.Id, entry.ReferencedBy.Tx)
+
+		// deleted entries are still members of the authenticated index
+		entry, err = client.VerifiedKeyMembership(ctx, []byte("key1"), 0)
+		require.NoError(t, err)
+		require.Equal(t, hdr4.Id, entry.Tx)
+		require.True(t, entry.Metadata.Deleted)
+
+		_, err = client.VerifiedKeyMembership(ctx, []byte("key2"), 0)
+		require.ErrorIs(t, err, store.ErrKeyNotFound)
+	})
+
+	t.Run("non-membership proofs", func(t *testing.T) {
+		err := client.VerifiedKeyNonMembership(ctx, []byte("key2"), 0)
+		require.NoError(t, err)
+
+		err = client.VerifiedKeyNonMembership(ctx, []byte("ref1"), hdr2.Id)
+		require.NoError(t, err)
+
+		err = client.VerifiedKeyNonMembership(ctx, []byte("ref1"), hdr3.Id)
+		require.ErrorIs(t, err, ic.ErrKeyIsMember)
+
+		err = client.VerifiedKeyNonMembership(ctx, nil, 0)
+		require.ErrorIs(t, err, ic.ErrIllegalArguments)
+	})
+
+	t.Run("the authenticated index can not be disabled", func(t *testing.T) {
+		_, err = client.UpdateDatabaseV2(ctx, "db1", &schema.DatabaseNullableSettings{
+			AuthenticatedIndex: &schema.NullableBool{Value: false},
+		})
+		require.Error(t, err)
+	})
+}
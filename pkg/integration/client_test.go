@@ -625,6 +625,15 @@ func TestImmuClientDisconnect(t *testing.T) {
 	_, err = client.FlushIndex(ctx, 100, true)
 	require.ErrorIs(t, err, ic.ErrNotConnected)
 
+	err = client.RebuildIndex(ctx)
+	require.ErrorIs(t, err, ic.ErrNotConnected)
+
+	_, err = client.VerifyIndex(ctx, 0)
+	require.ErrorIs(t, err, ic.ErrNotConnected)
+
+	_, err = client.CompactValues(ctx, 0)
+	require.ErrorIs(t, err, ic.ErrNotConnected)
+
 	_, err = client.Login(context.Background(), []byte("user"), []byte("passwd"))
 	require.True(t, errors.Is(err.(immuErrors.ImmuError), ic.ErrNotConnected))
 
@@ -968,6 +977,20 @@ func TestImmuClient_GetAll(t *testing.T) {
 	err = client.CompactIndex(ctx, &emptypb.Empty{})
 	require.NoError(t, err)
 
+	err = client.RebuildIndex(ctx)
+	require.NoError(t, err)
+
+	verifyRes, err := client.VerifyIndex(ctx, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, verifyRes.Indexes)
+
+	for _, idx := range verifyRes.Indexes {
+		require.Zero(t, idx.DiscrepancyCount)
+	}
+
+	_, err = client.CompactValues(ctx, 0)
+	require.NoError(t, err)
+
 	entries, err = client.GetAll(ctx, [][]byte{[]byte(`aaa`), []byte(`bbb`)})
 	require.NoError(t, err)
 	require.Len(t, entries.Entries, 2)
@@ -102,6 +102,8 @@ func TestCreateDatabaseV2(t *testing.T) {
 			RetentionPeriod:     &schema.NullableMilliseconds{Value: 24 * time.Hour.Milliseconds()},
 			TruncationFrequency: &schema.NullableMilliseconds{Value: 1 * time.Hour.Milliseconds()},
 		},
+		StatementTimeout: &schema.NullableMilliseconds{Value: 30_000},
+		QueryMemoryLimit: &schema.NullableUint64{Value: 1 << 26},
 	}
 	_, err := client.CreateDatabaseV2(ctx, "db1", dbNullableSettings)
 	require.NoError(t, err)
@@ -152,6 +154,9 @@ func TestCreateDatabaseV2(t *testing.T) {
 	require.Equal(t, dbNullableSettings.TruncationSettings.RetentionPeriod.Value, res.Settings.TruncationSettings.RetentionPeriod.Value)
 	require.Equal(t, dbNullableSettings.TruncationSettings.TruncationFrequency.Value, res.Settings.TruncationSettings.TruncationFrequency.Value)
 
+	require.Equal(t, dbNullableSettings.StatementTimeout.Value, res.Settings.StatementTimeout.Value)
+	require.Equal(t, dbNullableSettings.QueryMemoryLimit.Value, res.Settings.QueryMemoryLimit.Value)
+
 	_, err = client.UpdateDatabaseV2(ctx, "db1", &schema.DatabaseNullableSettings{})
 	require.NoError(t, err)
 }
@@ -165,3 +170,42 @@ func TestCreateDatabaseWithUnderscoreCharacter(t *testing.T) {
 	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: "db_with_"})
 	require.NoError(t, err)
 }
+
+func TestCreateDatabaseWithHashAlgorithm(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.CreateDatabaseV2(ctx, "db1", &schema.DatabaseNullableSettings{
+		HashAlgorithm: &schema.NullableString{Value: "blake3"},
+	})
+	require.NoError(t, err)
+
+	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: "db1"})
+	require.NoError(t, err)
+
+	res, err := client.GetDatabaseSettingsV2(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "blake3", res.Settings.HashAlgorithm.Value)
+
+	_, err = client.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	hdr, err := client.VerifiedSet(ctx, []byte("key2"), []byte("value2"))
+	require.NoError(t, err)
+	require.Equal(t, "blake3", hdr.HashAlgorithm)
+
+	entry, err := client.VerifiedGet(ctx, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), entry.Value)
+
+	_, err = client.VerifiedTxByID(ctx, hdr.Id)
+	require.NoError(t, err)
+
+	state, err := client.CurrentState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "blake3", state.HashAlgorithm)
+
+	_, err = client.UpdateDatabaseV2(ctx, "db1", &schema.DatabaseNullableSettings{
+		HashAlgorithm: &schema.NullableString{Value: "sha256"},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteRange(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.SQLExec(ctx, "CREATE TABLE table1(id INTEGER, PRIMARY KEY id); INSERT INTO table1(id) VALUES (1)", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err := client.Set(ctx, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	t.Run("invalid ranges should fail", func(t *testing.T) {
+		_, err := client.DeleteRange(ctx, &schema.DeleteRangeRequest{})
+		require.ErrorContains(t, err, "illegal arguments")
+
+		_, err = client.DeleteRange(ctx, &schema.DeleteRangeRequest{Start: []byte("key5"), End: []byte("key2")})
+		require.ErrorContains(t, err, "illegal arguments")
+	})
+
+	hdr, err := client.DeleteRange(ctx, &schema.DeleteRangeRequest{Start: []byte("key2"), End: []byte("key4")})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, hdr.Nentries)
+
+	_, err = client.VerifiedDeleteRange(ctx, []byte("key6"), []byte("key8"))
+	require.NoError(t, err)
+
+	scan := func() []string {
+		entries, err := client.Scan(ctx, &schema.ScanRequest{Prefix: []byte("key")})
+		require.NoError(t, err)
+
+		var keys []string
+		for _, e := range entries.Entries {
+			keys = append(keys, string(e.Key))
+		}
+
+		return keys
+	}
+
+	require.Equal(t, []string{"key0", "key1", "key4", "key5", "key8", "key9"}, scan())
+
+	_, err = client.Get(ctx, []byte("key3"))
+	require.ErrorContains(t, err, "key not found")
+
+	_, err = client.VerifiedGet(ctx, []byte("key7"))
+	require.ErrorContains(t, err, "key not found")
+
+	// keys set after the range was deleted are not affected
+	_, err = client.Set(ctx, []byte("key3"), []byte("value3"))
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, []byte("key3"))
+	require.NoError(t, err)
+
+	_, err = client.VerifiedDeleteRange(ctx, []byte("key5"), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"key0", "key1", "key3", "key4"}, scan())
+
+	// the range is restricted to the key-value keyspace
+	res, err := client.SQLQuery(ctx, "SELECT id FROM table1", nil, true)
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+}
@@ -577,3 +577,46 @@ func TestQueryTxMetadata(t *testing.T) {
 		md,
 	)
 }
+
+func TestQueryTxUser(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir())
+
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	client, err := bs.NewAuthenticatedClient(ic.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	_, err = client.SQLExec(
+		context.Background(),
+		`CREATE TABLE mytable(id INTEGER, PRIMARY KEY (id));
+		INSERT INTO mytable(id) VALUES (1);`,
+		nil,
+	)
+	require.NoError(t, err)
+
+	it, err := client.SQLQueryReader(
+		context.Background(),
+		"SELECT _tx_id, _tx_user, _tx_metadata FROM mytable WHERE _tx_user = 'immudb'",
+		nil,
+	)
+	require.NoError(t, err)
+
+	require.True(t, it.Next())
+
+	row, err := it.Read()
+	require.NoError(t, err)
+	require.Equal(t, "immudb", row[1])
+
+	// the address of the client is only recorded when request metadata logging is enabled
+	var md map[string]interface{}
+	err = json.Unmarshal([]byte(row[2].(string)), &md)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"usr": "immudb"}, md)
+
+	require.False(t, it.Next())
+}
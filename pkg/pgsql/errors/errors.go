@@ -17,9 +17,11 @@ limitations under the License.
 package errors
 
 import (
+	"context"
 	"errors"
 	"strings"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 )
@@ -40,6 +42,7 @@ var ErrParametersValueSizeTooLarge = errors.New("provided parameters exceeded th
 var ErrNegativeParameterValueLen = errors.New("negative parameter length detected")
 var ErrMalformedMessage = errors.New("malformed message detected")
 var ErrMessageTooLarge = errors.New("payload message hit allowed memory boundaries")
+var ErrInvalidSetting = errors.New("invalid setting")
 
 func MapPgError(err error) (er bm.ErrorResp) {
 	switch {
@@ -93,6 +96,27 @@ func MapPgError(err error) (er bm.ErrorResp) {
 			bm.Code(pgmeta.PgServerErrProtocolViolation),
 			bm.Message(err.Error()),
 		)
+	case errors.Is(err, sql.ErrStatementTimeout):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.QueryCanceled),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, context.Canceled):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.QueryCanceled),
+			bm.Message("canceling statement due to user request"),
+		)
+	case errors.Is(err, sql.ErrQueryMemoryLimitExceeded):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.OutOfMemory),
+			bm.Message(err.Error()),
+			bm.Hint("increase the query memory limit of the database or reduce the number of rows to be sorted, grouped or deduplicated"),
+		)
+	case errors.Is(err, ErrInvalidSetting):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.InvalidParameterValue),
+			bm.Message(err.Error()),
+		)
 	default:
 		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
 			bm.Message(err.Error()),
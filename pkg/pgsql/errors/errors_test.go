@@ -17,8 +17,10 @@ limitations under the License.
 package errors
 
 import (
+	"context"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,4 +41,16 @@ func TestMapPgError(t *testing.T) {
 	err = ErrMalformedMessage
 	be = MapPgError(err)
 	require.NotNil(t, be)
+	err = sql.ErrStatementTimeout
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = context.Canceled
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = sql.ErrQueryMemoryLimitExceeded
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrInvalidSetting
+	be = MapPgError(err)
+	require.NotNil(t, be)
 }
@@ -0,0 +1,558 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pgschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/sql"
+)
+
+const informationSchema = "information_schema"
+
+// InformationSchemaResolvers returns the resolvers emulating the views of the information schema,
+// describing the catalog of the given database.
+// They must be referenced through their schema, e.g. information_schema.tables
+func InformationSchemaResolvers(database string) []sql.TableResolver {
+	return []sql.TableResolver{
+		&schemataResolver{database: database},
+		&tablesResolver{database: database},
+		&columnsResolver{database: database},
+		&tableConstraintsResolver{database: database},
+		&keyColumnUsageResolver{database: database},
+	}
+}
+
+// userTables returns the tables listed by the information schema.
+// As in postgres, materialized views are not included.
+func userTables(catalog *sql.Catalog) []*sql.Table {
+	var tables []*sql.Table
+
+	for _, t := range catalog.GetTables() {
+		if t.MaterializedView() == nil {
+			tables = append(tables, t)
+		}
+	}
+	return tables
+}
+
+var schemataCols = []sql.ColDescriptor{
+	{
+		Column: "catalog_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "schema_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "schema_owner",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "default_character_set_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "default_character_set_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "default_character_set_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "sql_path",
+		Type:   sql.VarcharType,
+	},
+}
+
+type schemataResolver struct {
+	database string
+}
+
+func (r *schemataResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	schemas := []string{"pg_catalog", informationSchema}
+	for _, s := range tx.Catalog().GetSchemas() {
+		schemas = append(schemas, s.Name())
+	}
+
+	rows := make([][]sql.ValueExp, len(schemas))
+	for i, s := range schemas {
+		rows[i] = []sql.ValueExp{
+			sql.NewVarchar(r.database),   // catalog_name
+			sql.NewVarchar(s),            // schema_name
+			sql.NewNull(sql.VarcharType), // schema_owner
+			sql.NewNull(sql.VarcharType), // default_character_set_catalog
+			sql.NewNull(sql.VarcharType), // default_character_set_schema
+			sql.NewNull(sql.VarcharType), // default_character_set_name
+			sql.NewNull(sql.VarcharType), // sql_path
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		schemataCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *schemataResolver) Table() string {
+	return informationSchema + ".schemata"
+}
+
+var tablesCols = []sql.ColDescriptor{
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_type",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_insertable_into",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_typed",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "commit_action",
+		Type:   sql.VarcharType,
+	},
+}
+
+type tablesResolver struct {
+	database string
+}
+
+func (r *tablesResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	tables := userTables(tx.Catalog())
+
+	rows := make([][]sql.ValueExp, len(tables))
+	for i, t := range tables {
+		rows[i] = []sql.ValueExp{
+			sql.NewVarchar(r.database),          // table_catalog
+			sql.NewVarchar(t.Schema()),          // table_schema
+			sql.NewVarchar(t.UnqualifiedName()), // table_name
+			sql.NewVarchar("BASE TABLE"),        // table_type
+			sql.NewVarchar("YES"),               // is_insertable_into
+			sql.NewVarchar("NO"),                // is_typed
+			sql.NewNull(sql.VarcharType),        // commit_action
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		tablesCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *tablesResolver) Table() string {
+	return informationSchema + ".tables"
+}
+
+var columnsCols = []sql.ColDescriptor{
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "column_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "ordinal_position",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "column_default",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_nullable",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "data_type",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "character_maximum_length",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "numeric_precision",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "udt_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_identity",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "identity_generation",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_updatable",
+		Type:   sql.VarcharType,
+	},
+}
+
+type columnsResolver struct {
+	database string
+}
+
+func (r *columnsResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range userTables(tx.Catalog()) {
+		for i, col := range t.Cols() {
+			dataType, udtName := columnDataType(col)
+
+			var maxLen sql.ValueExp = sql.NewNull(sql.IntegerType)
+			if col.Type() == sql.VarcharType && col.MaxLen() > 0 {
+				maxLen = sql.NewInteger(int64(col.MaxLen()))
+			}
+
+			var precision sql.ValueExp = sql.NewNull(sql.IntegerType)
+			switch col.Type() {
+			case sql.IntegerType:
+				precision = sql.NewInteger(64)
+			case sql.Float64Type:
+				precision = sql.NewInteger(53)
+			}
+
+			// primary key columns can not be null even if not declared as such
+			nullable := col.IsNullable() && !t.PrimaryIndex().IncludesCol(col.ID())
+
+			var identityGeneration sql.ValueExp = sql.NewNull(sql.VarcharType)
+			if col.IsAutoIncremental() {
+				identityGeneration = sql.NewVarchar("BY DEFAULT")
+			}
+
+			rows = append(rows, []sql.ValueExp{
+				sql.NewVarchar(r.database),                       // table_catalog
+				sql.NewVarchar(t.Schema()),                       // table_schema
+				sql.NewVarchar(t.UnqualifiedName()),              // table_name
+				sql.NewVarchar(col.Name()),                       // column_name
+				sql.NewInteger(int64(i + 1)),                     // ordinal_position
+				sql.NewNull(sql.VarcharType),                     // column_default
+				sql.NewVarchar(yesOrNo(nullable)),                // is_nullable
+				sql.NewVarchar(dataType),                         // data_type
+				maxLen,                                           // character_maximum_length
+				precision,                                        // numeric_precision
+				sql.NewVarchar(udtName),                          // udt_name
+				sql.NewVarchar(yesOrNo(col.IsAutoIncremental())), // is_identity
+				identityGeneration,                               // identity_generation
+				sql.NewVarchar("YES"),                            // is_updatable
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		columnsCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *columnsResolver) Table() string {
+	return informationSchema + ".columns"
+}
+
+// columnDataType returns the names of the postgres type used to represent the values of the column
+func columnDataType(col *sql.Column) (dataType string, udtName string) {
+	switch col.Type() {
+	case sql.IntegerType:
+		return "bigint", "int8"
+	case sql.BooleanType:
+		return "boolean", "bool"
+	case sql.VarcharType:
+		if col.MaxLen() > 0 {
+			return "character varying", "varchar"
+		}
+		return "text", "text"
+	case sql.UUIDType:
+		return "uuid", "uuid"
+	case sql.BLOBType:
+		return "bytea", "bytea"
+	case sql.TimestampType:
+		return "timestamp without time zone", "timestamp"
+	case sql.Float64Type:
+		return "double precision", "float8"
+	case sql.JSONType:
+		return "json", "json"
+	}
+	return strings.ToLower(col.Type()), strings.ToLower(col.Type())
+}
+
+func yesOrNo(b bool) string {
+	if b {
+		return "YES"
+	}
+	return "NO"
+}
+
+// tableConstraint is a constraint of a table as described by the information schema
+type tableConstraint struct {
+	name           string
+	constraintType string
+	cols           []*sql.Column
+}
+
+// tableConstraintsOf returns the constraints of the table, named following postgres conventions:
+// the primary key, the unique indexes and the check constraints
+func tableConstraintsOf(t *sql.Table) []tableConstraint {
+	constraints := []tableConstraint{
+		{
+			name:           t.UnqualifiedName() + "_pkey",
+			constraintType: "PRIMARY KEY",
+			cols:           t.PrimaryIndex().Cols(),
+		},
+	}
+
+	for _, index := range t.GetIndexes() {
+		if index.IsPrimary() || !index.IsUnique() || indexesJSONPath(index) {
+			continue
+		}
+
+		colNames := make([]string, len(index.Cols()))
+		for i, col := range index.Cols() {
+			colNames[i] = col.Name()
+		}
+
+		constraints = append(constraints, tableConstraint{
+			name:           fmt.Sprintf("%s_%s_key", t.UnqualifiedName(), strings.Join(colNames, "_")),
+			constraintType: "UNIQUE",
+			cols:           index.Cols(),
+		})
+	}
+
+	for _, check := range t.CheckConstraints() {
+		constraints = append(constraints, tableConstraint{
+			name:           check.Name(),
+			constraintType: "CHECK",
+		})
+	}
+
+	return constraints
+}
+
+// indexesJSONPath returns true if the index includes JSON paths. As expression
+// indexes in postgres, such indexes are not reported as constraints
+func indexesJSONPath(index *sql.Index) bool {
+	for _, col := range index.Cols() {
+		if source, _ := col.JSONPath(); source != nil {
+			return true
+		}
+	}
+	return false
+}
+
+var tableConstraintsCols = []sql.ColDescriptor{
+	{
+		Column: "constraint_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_type",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_deferrable",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "initially_deferred",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "enforced",
+		Type:   sql.VarcharType,
+	},
+}
+
+type tableConstraintsResolver struct {
+	database string
+}
+
+func (r *tableConstraintsResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range userTables(tx.Catalog()) {
+		for _, c := range tableConstraintsOf(t) {
+			rows = append(rows, []sql.ValueExp{
+				sql.NewVarchar(r.database),          // constraint_catalog
+				sql.NewVarchar(t.Schema()),          // constraint_schema
+				sql.NewVarchar(c.name),              // constraint_name
+				sql.NewVarchar(r.database),          // table_catalog
+				sql.NewVarchar(t.Schema()),          // table_schema
+				sql.NewVarchar(t.UnqualifiedName()), // table_name
+				sql.NewVarchar(c.constraintType),    // constraint_type
+				sql.NewVarchar("NO"),                // is_deferrable
+				sql.NewVarchar("NO"),                // initially_deferred
+				sql.NewVarchar("YES"),               // enforced
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		tableConstraintsCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *tableConstraintsResolver) Table() string {
+	return informationSchema + ".table_constraints"
+}
+
+var keyColumnUsageCols = []sql.ColDescriptor{
+	{
+		Column: "constraint_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "column_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "ordinal_position",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "position_in_unique_constraint",
+		Type:   sql.IntegerType,
+	},
+}
+
+type keyColumnUsageResolver struct {
+	database string
+}
+
+func (r *keyColumnUsageResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range userTables(tx.Catalog()) {
+		for _, c := range tableConstraintsOf(t) {
+			for i, col := range c.cols {
+				rows = append(rows, []sql.ValueExp{
+					sql.NewVarchar(r.database),          // constraint_catalog
+					sql.NewVarchar(t.Schema()),          // constraint_schema
+					sql.NewVarchar(c.name),              // constraint_name
+					sql.NewVarchar(r.database),          // table_catalog
+					sql.NewVarchar(t.Schema()),          // table_schema
+					sql.NewVarchar(t.UnqualifiedName()), // table_name
+					sql.NewVarchar(col.Name()),          // column_name
+					sql.NewInteger(int64(i + 1)),        // ordinal_position
+					sql.NewNull(sql.IntegerType),        // position_in_unique_constraint
+				})
+			}
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		keyColumnUsageCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *keyColumnUsageResolver) Table() string {
+	return informationSchema + ".key_column_usage"
+}
@@ -18,6 +18,7 @@ package pgschema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -32,7 +33,8 @@ func setupEngine(t *testing.T, multiDBHandler sql.MultiDBHandler) *sql.Engine {
 	t.Cleanup(func() { st.Close() })
 
 	opts := sql.DefaultOptions().
-		WithTableResolvers(PgCatalogResolvers()...)
+		WithTableResolvers(PgCatalogResolvers()...).
+		WithTableResolvers(InformationSchemaResolvers("defaultdb")...)
 	if multiDBHandler != nil {
 		opts = opts.WithMultiDBHandler(multiDBHandler)
 	}
@@ -51,7 +53,11 @@ func TestQueryPgCatalogTables(t *testing.T) {
 
 	_, _, err := engine.Exec(context.Background(),
 		nil,
-		`CREATE TABLE table1 (id INTEGER, PRIMARY KEY id)`,
+		`
+		CREATE TABLE table1 (id INTEGER, PRIMARY KEY id);
+		CREATE SCHEMA sales;
+		CREATE TABLE sales.orders (id INTEGER, PRIMARY KEY id);
+		`,
 		nil)
 	require.NoError(t, err)
 
@@ -81,12 +87,24 @@ func TestQueryPgCatalogTables(t *testing.T) {
 	name, _ := row.ValuesBySelector[sql.EncodeSelector("", "c", "name")].RawValue().(string)
 	owner, _ := row.ValuesBySelector[sql.EncodeSelector("", "c", "owner")].RawValue().(string)
 	relType, _ := row.ValuesBySelector[sql.EncodeSelector("", "c", "type")].RawValue().(string)
-	schema := row.ValuesBySelector[sql.EncodeSelector("", "n", "schema")].RawValue()
+	schema, _ := row.ValuesBySelector[sql.EncodeSelector("", "n", "schema")].RawValue().(string)
 
 	require.Equal(t, "table1", name)
 	require.Equal(t, "immudb", owner)
 	require.Equal(t, "table", relType)
-	require.Nil(t, schema)
+	require.Equal(t, sql.DefaultSchema, schema)
+
+	row, err = res.Read(context.Background())
+	require.NoError(t, err)
+
+	name, _ = row.ValuesBySelector[sql.EncodeSelector("", "c", "name")].RawValue().(string)
+	schema, _ = row.ValuesBySelector[sql.EncodeSelector("", "n", "schema")].RawValue().(string)
+
+	require.Equal(t, "orders", name)
+	require.Equal(t, "sales", schema)
+
+	_, err = res.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
 }
 
 func TestQueryPgRolesTable(t *testing.T) {
@@ -132,6 +150,177 @@ func TestQueryPgRolesTable(t *testing.T) {
 	require.False(t, roleSuper)
 }
 
+func TestQueryPgStatsTable(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	_, _, err := engine.Exec(context.Background(),
+		nil,
+		`
+		CREATE TABLE table1 (id INTEGER, title VARCHAR, PRIMARY KEY id);
+		INSERT INTO table1 (id, title) VALUES (1, 'a'), (2, 'b"c'), (3, NULL), (4, NULL);
+		`,
+		nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "ANALYZE table1", nil)
+	require.NoError(t, err)
+
+	rows, err := engine.Query(
+		context.Background(),
+		nil,
+		"SELECT c.reltuples, s.attname, s.null_frac, s.n_distinct, s.histogram_bounds FROM pg_stats s INNER JOIN pg_class c ON c.relname = s.tablename WHERE s.tablename = 'table1'",
+		nil,
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	row, err := rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 4.0, row.ValuesByPosition[0].RawValue())
+	require.Equal(t, "id", row.ValuesByPosition[1].RawValue())
+	require.Equal(t, 0.0, row.ValuesByPosition[2].RawValue())
+	require.Equal(t, 4.0, row.ValuesByPosition[3].RawValue())
+	require.Equal(t, "{1,2,3,4,4}", row.ValuesByPosition[4].RawValue())
+
+	row, err = rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "title", row.ValuesByPosition[1].RawValue())
+	require.Equal(t, 0.5, row.ValuesByPosition[2].RawValue())
+	require.Equal(t, 2.0, row.ValuesByPosition[3].RawValue())
+	require.Equal(t, `{"a","b\"c","b\"c"}`, row.ValuesByPosition[4].RawValue())
+
+	_, err = rows.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
+}
+
+func TestQueryInformationSchema(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	_, _, err := engine.Exec(context.Background(),
+		nil,
+		`
+		CREATE TABLE table1 (
+			id INTEGER AUTO_INCREMENT,
+			title VARCHAR[64] NOT NULL,
+			amount FLOAT,
+			CONSTRAINT positive_amount CHECK amount > 0,
+			PRIMARY KEY id
+		);
+		CREATE UNIQUE INDEX ON table1 (title, amount);
+		CREATE SCHEMA sales;
+		CREATE TABLE sales.orders (id INTEGER, code VARCHAR[16], note VARCHAR, PRIMARY KEY (id, code));
+		`,
+		nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE MATERIALIZED VIEW titles AS SELECT id, title FROM table1", nil)
+	require.NoError(t, err)
+
+	queryAll := func(t *testing.T, query string) [][]interface{} {
+		rows, err := engine.Query(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var res [][]interface{}
+		for {
+			row, err := rows.Read(context.Background())
+			if errors.Is(err, sql.ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			values := make([]interface{}, len(row.ValuesByPosition))
+			for i, v := range row.ValuesByPosition {
+				values[i] = v.RawValue()
+			}
+			res = append(res, values)
+		}
+		return res
+	}
+
+	t.Run("schemata", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{{"defaultdb", "information_schema"}, {"defaultdb", "pg_catalog"}, {"defaultdb", "public"}, {"defaultdb", "sales"}},
+			queryAll(t, "SELECT catalog_name, schema_name FROM information_schema.schemata ORDER BY schema_name"),
+		)
+	})
+
+	t.Run("tables", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{{"defaultdb", "public", "table1", "BASE TABLE"}, {"defaultdb", "sales", "orders", "BASE TABLE"}},
+			queryAll(t, "SELECT table_catalog, table_schema, table_name, table_type FROM information_schema.tables ORDER BY table_schema, table_name"),
+		)
+
+		require.Equal(t,
+			[][]interface{}{{int64(1)}},
+			queryAll(t, "SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLES t WHERE t.table_schema = 'public' AND t.table_name = 'table1'"),
+		)
+
+		rows, err := engine.Query(
+			context.Background(),
+			nil,
+			"SELECT table_name FROM information_schema.tables WHERE table_schema = @schema",
+			map[string]interface{}{"schema": "sales"},
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		row, err := rows.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "orders", row.ValuesByPosition[0].RawValue())
+
+		_, err = rows.Read(context.Background())
+		require.ErrorIs(t, err, sql.ErrNoMoreRows)
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"id", int64(1), "NO", "bigint", nil, int64(64), "int8", "YES"},
+				{"title", int64(2), "NO", "character varying", int64(64), nil, "varchar", "NO"},
+				{"amount", int64(3), "YES", "double precision", nil, int64(53), "float8", "NO"},
+			},
+			queryAll(t, `
+				SELECT column_name, ordinal_position, is_nullable, data_type, character_maximum_length, numeric_precision, udt_name, is_identity
+				FROM information_schema.columns
+				WHERE table_schema = 'public' AND table_name = 'table1'
+				ORDER BY ordinal_position`),
+		)
+
+		require.Equal(t,
+			[][]interface{}{{"id", "bigint"}, {"code", "character varying"}, {"note", "text"}},
+			queryAll(t, "SELECT column_name, data_type FROM information_schema.columns WHERE table_name = 'orders'"),
+		)
+	})
+
+	t.Run("table constraints", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"table1_pkey", "PRIMARY KEY"},
+				{"table1_title_amount_key", "UNIQUE"},
+				{"positive_amount", "CHECK"},
+			},
+			queryAll(t, "SELECT constraint_name, constraint_type FROM information_schema.table_constraints WHERE table_name = 'table1'"),
+		)
+	})
+
+	t.Run("key column usage", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"orders_pkey", "id", int64(1)},
+				{"orders_pkey", "code", int64(2)},
+			},
+			queryAll(t, `
+				SELECT k.constraint_name, k.column_name, k.ordinal_position
+				FROM information_schema.table_constraints c
+				INNER JOIN information_schema.key_column_usage k
+					ON k.constraint_name = c.constraint_name AND k.table_schema = c.table_schema
+				WHERE c.constraint_type = 'PRIMARY KEY' AND c.table_schema = 'sales'
+				ORDER BY k.ordinal_position`),
+		)
+	})
+}
+
 type mockMultiDBHandler struct {
 	sql.MultiDBHandler
 
@@ -155,6 +344,10 @@ func (u *user) SQLPrivileges() []sql.SQLPrivilege {
 	return []sql.SQLPrivilege{sql.SQLPrivilegeCreate, sql.SQLPrivilegeSelect}
 }
 
+func (u *user) TablePrivileges() []sql.TablePrivilege {
+	return nil
+}
+
 func (h *mockMultiDBHandler) ListUsers(ctx context.Context) ([]sql.User, error) {
 	return h.users, nil
 }
@@ -18,10 +18,31 @@ package pgschema
 
 import (
 	"context"
+	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
 )
 
+const (
+	pgCatalogNamespaceOID = 11
+	publicNamespaceOID    = 2200
+
+	// firstUserNamespaceOID is the oid assigned to the first user-defined schema
+	firstUserNamespaceOID = 16384
+)
+
+func namespaceOID(catalog *sql.Catalog, schema string) int64 {
+	if schema == sql.DefaultSchema {
+		return publicNamespaceOID
+	}
+
+	s, err := catalog.GetSchemaByName(schema)
+	if err != nil {
+		return -1
+	}
+	return firstUserNamespaceOID + int64(s.ID())
+}
+
 var pgClassCols = []sql.ColDescriptor{
 	{
 		Column: "oid",
@@ -186,40 +207,50 @@ func (r *pgClassResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias stri
 
 	rows := make([][]sql.ValueExp, len(tables))
 	for i, t := range tables {
+		var reltuples sql.ValueExp = sql.NewNull(sql.Float64Type)
+		if stats := t.Stats(); stats != nil {
+			reltuples = sql.NewFloat64(float64(stats.RowCount()))
+		}
+
+		relkind := "r"
+		if t.MaterializedView() != nil {
+			relkind = "m"
+		}
+
 		rows[i] = []sql.ValueExp{
-			sql.NewInteger(int64(t.ID())),        // oid
-			sql.NewVarchar(t.Name()),             // relname
-			sql.NewInteger(-1),                   // relnamespace
-			sql.NewVarchar(""),                   // reltype
-			sql.NewNull(sql.IntegerType),         // reloftype
-			sql.NewInteger(0),                    // relowner
-			sql.NewNull(sql.IntegerType),         // relam
-			sql.NewNull(sql.IntegerType),         // relfilenode
-			sql.NewNull(sql.IntegerType),         // reltablespace
-			sql.NewNull(sql.IntegerType),         // relpages
-			sql.NewNull(sql.Float64Type),         // reltuples
-			sql.NewNull(sql.IntegerType),         // relallvisible
-			sql.NewNull(sql.IntegerType),         // reltoastrelid
-			sql.NewBool(len(t.GetIndexes()) > 1), // relhasindex
-			sql.NewBool(false),                   // relisshared
-			sql.NewNull(sql.VarcharType),         // relpersistence
-			sql.NewVarchar("r"),                  // relkind
-			sql.NewNull(sql.IntegerType),         // relnats
-			sql.NewNull(sql.IntegerType),         // relchecks
-			sql.NewBool(false),                   // relhasrules
-			sql.NewBool(false),                   // relhastriggers
-			sql.NewBool(false),                   // relhassubclass
-			sql.NewBool(false),                   // relrowsecurity
-			sql.NewBool(false),                   // relforcerowsecurity
-			sql.NewBool(false),                   // relispopulated
-			sql.NewVarchar(""),                   // relreplident
-			sql.NewBool(false),                   // relispartition
-			sql.NewInteger(0),                    // relrewrite
-			sql.NewNull(sql.IntegerType),         // relfrozenxid
-			sql.NewNull(sql.IntegerType),         // relminmxid
-			sql.NewNull(sql.AnyType),             // relacl
-			sql.NewNull(sql.AnyType),             // reloptions
-			sql.NewNull(sql.AnyType),             // relpartbound
+			sql.NewInteger(int64(t.ID())),                     // oid
+			sql.NewVarchar(t.UnqualifiedName()),               // relname
+			sql.NewInteger(namespaceOID(catalog, t.Schema())), // relnamespace
+			sql.NewVarchar(""),                                // reltype
+			sql.NewNull(sql.IntegerType),                      // reloftype
+			sql.NewInteger(0),                                 // relowner
+			sql.NewNull(sql.IntegerType),                      // relam
+			sql.NewNull(sql.IntegerType),                      // relfilenode
+			sql.NewNull(sql.IntegerType),                      // reltablespace
+			sql.NewNull(sql.IntegerType),                      // relpages
+			reltuples,                                         // reltuples
+			sql.NewNull(sql.IntegerType),                      // relallvisible
+			sql.NewNull(sql.IntegerType),                      // reltoastrelid
+			sql.NewBool(len(t.GetIndexes()) > 1),              // relhasindex
+			sql.NewBool(false),                                // relisshared
+			sql.NewNull(sql.VarcharType),                      // relpersistence
+			sql.NewVarchar(relkind),                           // relkind
+			sql.NewNull(sql.IntegerType),                      // relnats
+			sql.NewNull(sql.IntegerType),                      // relchecks
+			sql.NewBool(false),                                // relhasrules
+			sql.NewBool(false),                                // relhastriggers
+			sql.NewBool(false),                                // relhassubclass
+			sql.NewBool(false),                                // relrowsecurity
+			sql.NewBool(false),                                // relforcerowsecurity
+			sql.NewBool(false),                                // relispopulated
+			sql.NewVarchar(""),                                // relreplident
+			sql.NewBool(false),                                // relispartition
+			sql.NewInteger(0),                                 // relrewrite
+			sql.NewNull(sql.IntegerType),                      // relfrozenxid
+			sql.NewNull(sql.IntegerType),                      // relminmxid
+			sql.NewNull(sql.AnyType),                          // relacl
+			sql.NewNull(sql.AnyType),                          // reloptions
+			sql.NewNull(sql.AnyType),                          // relpartbound
 		}
 	}
 
@@ -259,13 +290,34 @@ var pgNamespaceCols = []sql.ColDescriptor{
 type pgNamespaceResolver struct{}
 
 func (r *pgNamespaceResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	catalog := tx.Catalog()
+	schemas := catalog.GetSchemas()
+
+	rows := make([][]sql.ValueExp, 0, len(schemas)+1)
+
+	rows = append(rows, []sql.ValueExp{
+		sql.NewInteger(pgCatalogNamespaceOID), // oid
+		sql.NewVarchar("pg_catalog"),          // nspname
+		sql.NewInteger(0),                     // nspowner
+		sql.NewNull(sql.AnyType),              // nspacl
+	})
+
+	for _, s := range schemas {
+		rows = append(rows, []sql.ValueExp{
+			sql.NewInteger(namespaceOID(catalog, s.Name())), // oid
+			sql.NewVarchar(s.Name()),                        // nspname
+			sql.NewInteger(0),                               // nspowner
+			sql.NewNull(sql.AnyType),                        // nspacl
+		})
+	}
+
 	return sql.NewValuesRowReader(
 		tx,
 		nil,
 		pgNamespaceCols,
 		true,
 		alias,
-		nil,
+		rows,
 	)
 }
 
@@ -371,10 +423,115 @@ func (r *pgRolesResolver) Table() string {
 	return "pg_roles"
 }
 
+var pgStatsCols = []sql.ColDescriptor{
+	{
+		Column: "schemaname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "tablename",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "attname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "null_frac",
+		Type:   sql.Float64Type,
+	},
+	{
+		Column: "n_distinct",
+		Type:   sql.Float64Type,
+	},
+	{
+		Column: "histogram_bounds",
+		Type:   sql.VarcharType,
+	},
+}
+
+// pgStatsResolver exposes the statistics collected by ANALYZE,
+// with one row for each column of the analyzed tables
+type pgStatsResolver struct{}
+
+func (r *pgStatsResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		stats := t.Stats()
+		if stats == nil {
+			continue
+		}
+
+		for _, col := range t.Cols() {
+			cs, ok := stats.ColumnStats(col.ID())
+			if !ok {
+				continue
+			}
+
+			nullFrac := 0.0
+			if stats.RowCount() > 0 {
+				nullFrac = float64(cs.NullCount()) / float64(stats.RowCount())
+			}
+
+			var histogramBounds sql.ValueExp = sql.NewNull(sql.VarcharType)
+			if bounds := cs.HistogramBounds(); len(bounds) > 0 {
+				histogramBounds = sql.NewVarchar(formatArray(bounds))
+			}
+
+			rows = append(rows, []sql.ValueExp{
+				sql.NewVarchar(t.Schema()),                  // schemaname
+				sql.NewVarchar(t.UnqualifiedName()),         // tablename
+				sql.NewVarchar(col.Name()),                  // attname
+				sql.NewFloat64(nullFrac),                    // null_frac
+				sql.NewFloat64(float64(cs.DistinctCount())), // n_distinct
+				histogramBounds,                             // histogram_bounds
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgStatsCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgStatsResolver) Table() string {
+	return "pg_stats"
+}
+
+// formatArray formats values as a postgres array literal
+func formatArray(vals []sql.TypedValue) string {
+	var sb strings.Builder
+
+	sb.WriteByte('{')
+	for i, v := range vals {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+
+		if s, isString := v.RawValue().(string); isString {
+			sb.WriteByte('"')
+			sb.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s))
+			sb.WriteByte('"')
+			continue
+		}
+		sb.WriteString(v.String())
+	}
+	sb.WriteByte('}')
+
+	return sb.String()
+}
+
 var tableResolvers = []sql.TableResolver{
 	&pgClassResolver{},
 	&pgNamespaceResolver{},
 	&pgRolesResolver{},
+	&pgStatsResolver{},
 }
 
 func PgCatalogResolvers() []sql.TableResolver {
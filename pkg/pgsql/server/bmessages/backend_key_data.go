@@ -0,0 +1,39 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+func BackendKeyData(processID, secretKey uint32) []byte {
+	// Identifies the message as cancellation key data.
+	messageType := []byte(`K`)
+	messageLength := make([]byte, 4)
+	binary.BigEndian.PutUint32(messageLength, uint32(12))
+
+	// The process ID of this backend.
+	pid := make([]byte, 4)
+	binary.BigEndian.PutUint32(pid, processID)
+
+	// The secret key of this backend.
+	secret := make([]byte, 4)
+	binary.BigEndian.PutUint32(secret, secretKey)
+
+	return bytes.Join([][]byte{messageType, messageLength, pid, secret}, nil)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+)
+
+// errCancelRequest is returned once a CancelRequest is served, as the
+// connection must be closed without sending any response
+var errCancelRequest = errors.New("cancel request served")
+
+// backendKey identifies a session in CancelRequest messages
+type backendKey struct {
+	processID uint32
+	secretKey uint32
+}
+
+// sessionRegistry keeps track of the authenticated sessions so that
+// queries can be canceled from a different connection
+type sessionRegistry struct {
+	mutex         sync.Mutex
+	lastProcessID uint32
+	sessions      map[backendKey]*session
+}
+
+func newSessionRegistry() *sessionRegistry {
+	return &sessionRegistry{
+		sessions: make(map[backendKey]*session),
+	}
+}
+
+func (r *sessionRegistry) register(s *session) (backendKey, error) {
+	var secret [4]byte
+
+	_, err := rand.Read(secret[:])
+	if err != nil {
+		return backendKey{}, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.lastProcessID++
+
+	key := backendKey{
+		processID: r.lastProcessID,
+		secretKey: binary.BigEndian.Uint32(secret[:]),
+	}
+
+	r.sessions[key] = s
+
+	return key, nil
+}
+
+func (r *sessionRegistry) unregister(key backendKey) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.sessions, key)
+}
+
+// cancel cancels the query being executed by the session identified by key, if any.
+// As in PostgreSQL, requests not matching any session are silently ignored.
+func (r *sessionRegistry) cancel(key backendKey) {
+	r.mutex.Lock()
+	s, ok := r.sessions[key]
+	r.mutex.Unlock()
+
+	if ok {
+		s.cancelQuery()
+	}
+}
+
+// handleCancelRequest reads the body of a CancelRequest message
+func (s *session) handleCancelRequest() error {
+	payload := make([]byte, 8)
+	if _, err := io.ReadFull(s.mr, payload); err != nil {
+		return err
+	}
+
+	if s.sessions != nil {
+		s.sessions.cancel(backendKey{
+			processID: binary.BigEndian.Uint32(payload[0:4]),
+			secretKey: binary.BigEndian.Uint32(payload[4:8]),
+		})
+	}
+
+	return errCancelRequest
+}
+
+func (s *session) setQueryCancel(cancel func()) {
+	s.cancelMutex.Lock()
+	defer s.cancelMutex.Unlock()
+
+	s.queryCancel = cancel
+}
+
+func (s *session) cancelQuery() {
+	s.cancelMutex.Lock()
+	defer s.cancelMutex.Unlock()
+
+	if s.queryCancel != nil {
+		s.queryCancel()
+	}
+}
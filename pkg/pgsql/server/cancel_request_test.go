@@ -0,0 +1,119 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRegistry(t *testing.T) {
+	registry := newSessionRegistry()
+
+	s1 := &session{sessions: registry}
+	s2 := &session{sessions: registry}
+
+	key1, err := registry.register(s1)
+	require.NoError(t, err)
+
+	key2, err := registry.register(s2)
+	require.NoError(t, err)
+	require.NotEqual(t, key1.processID, key2.processID)
+
+	canceled := 0
+	s1.setQueryCancel(func() { canceled++ })
+
+	// the secret key must match
+	registry.cancel(backendKey{processID: key1.processID, secretKey: key1.secretKey + 1})
+	require.Zero(t, canceled)
+
+	registry.cancel(key2)
+	require.Zero(t, canceled)
+
+	registry.cancel(key1)
+	require.Equal(t, 1, canceled)
+
+	registry.unregister(key1)
+
+	registry.cancel(key1)
+	require.Equal(t, 1, canceled)
+}
+
+func TestHandleFragmentedCancelRequest(t *testing.T) {
+	registry := newSessionRegistry()
+
+	target := &session{sessions: registry}
+
+	key, err := registry.register(target)
+	require.NoError(t, err)
+
+	canceled := 0
+	target.setQueryCancel(func() { canceled++ })
+
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	var payload [8]byte
+	binary.BigEndian.PutUint32(payload[0:4], key.processID)
+	binary.BigEndian.PutUint32(payload[4:8], key.secretKey)
+
+	go func() {
+		// the key is received in separate reads
+		c2.Write(payload[:3])
+		c2.Write(payload[3:])
+	}()
+
+	s := &session{sessions: registry, mr: NewMessageReader(c1)}
+
+	err = s.handleCancelRequest()
+	require.ErrorIs(t, err, errCancelRequest)
+	require.Equal(t, 1, canceled)
+}
+
+func TestParseStatementTimeout(t *testing.T) {
+	for _, c := range []struct {
+		value    string
+		expected time.Duration
+		isErr    bool
+	}{
+		{value: "0", expected: 0},
+		{value: "DEFAULT", expected: 0},
+		{value: "1500", expected: 1500 * time.Millisecond},
+		{value: "'2500'", expected: 2500 * time.Millisecond},
+		{value: "'10s'", expected: 10 * time.Second},
+		{value: "'2 min'", expected: 2 * time.Minute},
+		{value: "'1h'", expected: time.Hour},
+		{value: "-1", isErr: true},
+		{value: "'1 week'", isErr: true},
+		{value: "abc", isErr: true},
+	} {
+		t.Run(c.value, func(t *testing.T) {
+			timeout, err := parseStatementTimeout(c.value)
+			if c.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, timeout)
+		})
+	}
+}
@@ -41,7 +41,9 @@ import (
 func (s *session) InitializeSession() (err error) {
 	defer func() {
 		if err != nil {
-			s.HandleError(err)
+			if !errors.Is(err, errCancelRequest) {
+				s.HandleError(err)
+			}
 			s.mr.CloseConnection()
 		}
 	}()
@@ -86,6 +88,10 @@ func (s *session) InitializeSession() (err error) {
 		s.protocolVersion = parseProtocolVersion(pvb)
 	}
 
+	if s.protocolVersion == pgmeta.PgsqlCancelRequestProtocolVersion {
+		return s.handleCancelRequest()
+	}
+
 	if !isValidProtocolVersion(s.protocolVersion) {
 		return fmt.Errorf("%w: %s", pgmeta.ErrInvalidPgsqlProtocolVersion, s.protocolVersion)
 	}
@@ -231,6 +237,18 @@ func (s *session) HandleStartup(ctx context.Context) (err error) {
 		return err
 	}
 
+	// key data used by clients to issue CancelRequest messages
+	if s.sessions != nil {
+		s.backendKey, err = s.sessions.register(s)
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.writeMessage(bm.BackendKeyData(s.backendKey.processID, s.backendKey.secretKey)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -245,8 +263,14 @@ func isValidProtocolVersion(version string) bool {
 }
 
 func (s *session) Close() error {
+	if s.sessions != nil {
+		s.sessions.unregister(s.backendKey)
+	}
+
 	s.mr.CloseConnection()
 
+	s.closeCursors()
+
 	if s.client != nil {
 		return s.client.CloseSession(s.ctx)
 	}
@@ -27,9 +27,10 @@ const (
 	PgTypeMapOid    = 0
 	PgTypeMapLength = 1
 
-	PgsqlProtocolVersion           = "3.0"
-	PgsqlSSLRequestProtocolVersion = "1234.5679"
-	PgsqlServerVersion             = "9.6"
+	PgsqlProtocolVersion              = "3.0"
+	PgsqlSSLRequestProtocolVersion    = "1234.5679"
+	PgsqlCancelRequestProtocolVersion = "1234.5678"
+	PgsqlServerVersion                = "9.6"
 )
 
 var PgsqlServerVersionMessage = fmt.Sprintf("pgsql server %s or greater version implemented by immudb", PgsqlServerVersion)
@@ -65,6 +66,9 @@ const PgServerErrProtocolViolation = "08P01"
 const PgServerErrConnectionFailure = "08006"
 const ProgramLimitExceeded = "54000"
 const DataException = "22000"
+const InvalidParameterValue = "22023"
+const OutOfMemory = "53200"
+const QueryCanceled = "57014"
 
 var MTypes = map[byte]string{
 	'Q': "query",
@@ -82,6 +86,8 @@ var MTypes = map[byte]string{
 	't': "parameterDesctiption",
 	'B': "bind",
 	'H': "flush",
+	'K': "backendKeyData",
+	's': "portalSuspended",
 }
 
 var MaxMsgSize = 32 << 20 // 32MB
@@ -33,6 +33,7 @@ import (
 	"github.com/codenotary/immudb/pkg/pgsql/errors"
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 	"github.com/codenotary/immudb/pkg/server"
+	"github.com/jackc/pgproto3/v2"
 	"github.com/jackc/pgx/v4"
 	pq "github.com/lib/pq"
 
@@ -769,6 +770,365 @@ func TestPgsqlServerSetStatement(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPgsqlServerSearchPath(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(td).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	// search_path is a session setting
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec("CREATE SCHEMA sales")
+	require.NoError(t, err)
+
+	_, err = db.Exec("CREATE TABLE sales.orders (id INTEGER AUTO_INCREMENT, customer VARCHAR, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO sales.orders (customer) VALUES ('John')")
+	require.NoError(t, err)
+
+	_, err = db.Exec("SELECT id FROM orders")
+	require.Error(t, err)
+
+	_, err = db.Exec(`SET search_path TO "$user", sales, public`)
+	require.NoError(t, err)
+
+	var customer string
+	err = db.QueryRow("SELECT customer FROM orders").Scan(&customer)
+	require.NoError(t, err)
+	require.Equal(t, "John", customer)
+
+	var n int
+	err = db.QueryRow("SELECT COUNT(*) FROM pg_namespace WHERE nspname = 'sales'").Scan(&n)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestPgsqlServerCursors(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(td).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	connStr := fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort())
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+
+	_, err = db.Exec("CREATE TABLE items (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err = db.Exec(fmt.Sprintf("INSERT INTO items (name) VALUES ('item%d')", i))
+		require.NoError(t, err)
+	}
+
+	t.Run("cursors are fetched within transaction blocks", func(t *testing.T) {
+		conn, err := db.Conn(context.Background())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.ExecContext(context.Background(), "BEGIN TRANSACTION")
+		require.NoError(t, err)
+
+		_, err = conn.ExecContext(context.Background(), "DECLARE c1 CURSOR FOR SELECT id FROM items ORDER BY id")
+		require.NoError(t, err)
+
+		fetchIDs := func(fetch string) []int64 {
+			rows, err := conn.QueryContext(context.Background(), fetch)
+			require.NoError(t, err)
+			defer rows.Close()
+
+			var ids []int64
+			for rows.Next() {
+				var id int64
+				require.NoError(t, rows.Scan(&id))
+				ids = append(ids, id)
+			}
+			require.NoError(t, rows.Err())
+			return ids
+		}
+
+		require.Equal(t, []int64{1, 2, 3}, fetchIDs("FETCH 3 FROM c1"))
+		require.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10}, fetchIDs("FETCH ALL FROM c1"))
+		require.Empty(t, fetchIDs("FETCH NEXT FROM c1"))
+
+		_, err = conn.ExecContext(context.Background(), "CLOSE c1")
+		require.NoError(t, err)
+
+		_, err = conn.ExecContext(context.Background(), "COMMIT")
+		require.NoError(t, err)
+	})
+
+	t.Run("portals are executed with a row limit", func(t *testing.T) {
+		conn, err := pgx.Connect(context.Background(), connStr)
+		require.NoError(t, err)
+		defer conn.Close(context.Background())
+
+		pgConn := conn.PgConn()
+
+		// receive returns the types of the messages received until the server is ready for a new query
+		receive := func() []string {
+			var msgs []string
+			for {
+				msg, err := pgConn.ReceiveMessage(context.Background())
+				require.NoError(t, err)
+
+				if _, ok := msg.(*pgproto3.ReadyForQuery); ok {
+					return msgs
+				}
+				msgs = append(msgs, fmt.Sprintf("%T", msg))
+			}
+		}
+
+		execute := func(maxRows uint32) []string {
+			var buf []byte
+			buf = (&pgproto3.Execute{Portal: "p1", MaxRows: maxRows}).Encode(buf)
+			buf = (&pgproto3.Sync{}).Encode(buf)
+
+			require.NoError(t, pgConn.SendBytes(context.Background(), buf))
+			return receive()
+		}
+
+		var buf []byte
+		buf = (&pgproto3.Parse{Query: "SELECT id, name FROM items ORDER BY id"}).Encode(buf)
+		buf = (&pgproto3.Bind{DestinationPortal: "p1"}).Encode(buf)
+		buf = (&pgproto3.Sync{}).Encode(buf)
+
+		require.NoError(t, pgConn.SendBytes(context.Background(), buf))
+		require.Equal(t, []string{"*pgproto3.ParseComplete", "*pgproto3.BindComplete"}, receive())
+
+		dataRows := func(n int) []string {
+			msgs := make([]string, n)
+			for i := range msgs {
+				msgs[i] = "*pgproto3.DataRow"
+			}
+			return msgs
+		}
+
+		require.Equal(t, append(dataRows(4), "*pgproto3.PortalSuspended"), execute(4))
+
+		// rows committed meanwhile are not returned by the portal
+		_, err = db.Exec("INSERT INTO items (name) VALUES ('item10')")
+		require.NoError(t, err)
+
+		require.Equal(t, append(dataRows(4), "*pgproto3.PortalSuspended"), execute(4))
+		require.Equal(t, append(dataRows(2), "*pgproto3.CommandComplete"), execute(4))
+
+		// the portal is released once all its rows were returned
+		require.Equal(t, []string{"*pgproto3.ErrorResponse"}, execute(4))
+	})
+}
+
+func TestPgsqlServerInformationSchema(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(td).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	_, err = db.Exec("CREATE TABLE schema_migrations (version INTEGER, dirty BOOLEAN NOT NULL, PRIMARY KEY version)")
+	require.NoError(t, err)
+
+	// query issued by migration tools to check whether the migrations table exists
+	var count int64
+	err = db.QueryRow(
+		"SELECT COUNT(*) FROM information_schema.tables WHERE table_catalog = $1 AND table_schema = $2 AND table_name = $3",
+		"defaultdb", "public", "schema_migrations",
+	).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	rows, err := db.Query("SELECT column_name, data_type, is_nullable FROM information_schema.columns WHERE table_name = 'schema_migrations' ORDER BY ordinal_position")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var cols [][]string
+	for rows.Next() {
+		var name, dataType, isNullable string
+		require.NoError(t, rows.Scan(&name, &dataType, &isNullable))
+		cols = append(cols, []string{name, dataType, isNullable})
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, [][]string{{"version", "bigint", "NO"}, {"dirty", "boolean", "NO"}}, cols)
+}
+
+func TestPgsqlServerStatementTimeout(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(td).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	// statement_timeout is a session setting
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec("CREATE TABLE items (id INTEGER AUTO_INCREMENT, title VARCHAR, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO items (title) VALUES ('item1'), ('item2')")
+	require.NoError(t, err)
+
+	_, err = db.Exec("SET statement_timeout = 'invalid'")
+	require.Error(t, err)
+
+	_, err = db.Exec("SET statement_timeout = '1ns'")
+	require.NoError(t, err)
+
+	var n int
+	err = db.QueryRow("SELECT COUNT(*) FROM items").Scan(&n)
+	require.Error(t, err)
+
+	pqErr, ok := err.(*pq.Error)
+	require.True(t, ok)
+	require.Equal(t, pq.ErrorCode(pgmeta.QueryCanceled), pqErr.Code)
+	require.Contains(t, pqErr.Message, isql.ErrStatementTimeout.Error())
+
+	_, err = db.Exec("SET statement_timeout TO 60000")
+	require.NoError(t, err)
+
+	err = db.QueryRow("SELECT COUNT(*) FROM items").Scan(&n)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	_, err = db.Exec("SET statement_timeout TO DEFAULT")
+	require.NoError(t, err)
+
+	err = db.QueryRow("SELECT COUNT(*) FROM items").Scan(&n)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+}
+
+func TestPgsqlServerCancelRequest(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(td).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	conn, err := pgx.Connect(context.Background(), fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+	defer conn.Close(context.Background())
+
+	// backend key data is sent on startup
+	require.NotZero(t, conn.PgConn().PID())
+
+	// there is no running query to be canceled
+	err = conn.PgConn().CancelRequest(context.Background())
+	require.NoError(t, err)
+
+	var n int64
+	err = conn.QueryRow(context.Background(), "SELECT COUNT(*) FROM pg_namespace").Scan(&n)
+	require.NoError(t, err)
+}
+
 func TestPgsqlServer_SimpleQueryNilValues(t *testing.T) {
 	td := t.TempDir()
 
@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -105,8 +106,9 @@ func (s *session) QueryMachine() error {
 			var stmt sql.SQLStmt
 
 			if !s.isInBlackList(v.Statements) {
-				stmts, err := sql.ParseSQL(strings.NewReader(v.Statements))
-				if err != nil {
+				var stmts []sql.SQLStmt
+
+				if stmts, paramCols, resCols, err = s.describe(v.Statements); err != nil {
 					waitForSync = extQueryMode
 					s.HandleError(err)
 					continue
@@ -121,11 +123,7 @@ func (s *session) QueryMachine() error {
 					s.HandleError(pserr.ErrMaxStmtNumberExceeded)
 					continue
 				}
-				if paramCols, resCols, err = s.inferParamAndResultCols(stmts[0]); err != nil {
-					waitForSync = extQueryMode
-					s.HandleError(err)
-					continue
-				}
+				stmt = stmts[0]
 			}
 
 			_, err = s.writeMessage(bm.ParseComplete())
@@ -193,13 +191,16 @@ func (s *session) QueryMachine() error {
 			waitForSync = false
 			s.writeMessage(bm.ReadyForQuery())
 		case fm.BindMsg:
-			_, ok := s.portals[v.DestPortalName]
+			prevPortal, ok := s.portals[v.DestPortalName]
 			// unnamed portal overrides previous
 			if ok && v.DestPortalName != "" {
 				waitForSync = extQueryMode
 				s.HandleError(fmt.Errorf("portal '%s' already present", v.DestPortalName))
 				continue
 			}
+			if ok {
+				prevPortal.close()
+			}
 
 			st, ok := s.statements[v.PreparedStatementName]
 			if !ok {
@@ -237,7 +238,23 @@ func (s *session) QueryMachine() error {
 				continue
 			}
 
+			// the rows of queries are returned in batches when a row limit is specified
+			if _, isQuery := portal.Statement.PreparedStmt.(*sql.SelectStmt); isQuery && v.MaxRows > 0 {
+				suspended, err := s.executePortal(portal, int(v.MaxRows))
+				if err != nil {
+					waitForSync = extQueryMode
+					s.HandleError(err)
+				}
+
+				if err != nil || !suspended {
+					portal.close()
+					delete(s.portals, v.PortalName)
+				}
+				continue
+			}
+
 			delete(s.portals, v.PortalName)
+			portal.close()
 
 			err := s.fetchAndWriteResults(portal.Statement.SQLStatement,
 				portal.Parameters,
@@ -258,11 +275,6 @@ func (s *session) QueryMachine() error {
 }
 
 func (s *session) fetchAndWriteResults(statements string, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, extQueryMode bool) error {
-	if s.isInBlackList(statements) {
-		_, err := s.writeMessage(bm.CommandComplete([]byte("ok")))
-		return err
-	}
-
 	if i := s.isEmulableInternally(statements); i != nil {
 		if err := s.tryToHandleInternally(i); err != nil && err != pserr.ErrMessageCannotBeHandledInternally {
 			return err
@@ -272,27 +284,36 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 		return err
 	}
 
-	stmts, err := sql.ParseSQL(
-		strings.NewReader(
-			removePGCatalogReferences(statements),
-		),
-	)
+	if s.isInBlackList(statements) {
+		_, err := s.writeMessage(bm.CommandComplete([]byte("ok")))
+		return err
+	}
+
+	stmts, err := s.db.SQLPrepare(removePGCatalogReferences(statements), nil)
 	if err != nil {
 		return err
 	}
 
+	// queries can be canceled through CancelRequest messages
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.setQueryCancel(cancel)
+	defer func() {
+		s.setQueryCancel(nil)
+		cancel()
+	}()
+
 	for _, stmt := range stmts {
 		switch st := stmt.(type) {
 		case *sql.UseDatabaseStmt:
 			{
 				return pserr.ErrUseDBStatementNotSupported
 			}
-		case *sql.SelectStmt:
-			if err = s.query(st, parameters, resultColumnFormatCodes, extQueryMode); err != nil {
+		case *sql.SelectStmt, *sql.FetchCursorStmt:
+			if err = s.query(ctx, st.(sql.DataSource), parameters, resultColumnFormatCodes, extQueryMode); err != nil {
 				return err
 			}
 		default:
-			if err = s.exec(st, parameters, resultColumnFormatCodes, extQueryMode); err != nil {
+			if err = s.exec(ctx, st, parameters, resultColumnFormatCodes, extQueryMode); err != nil {
 				return err
 			}
 		}
@@ -306,23 +327,71 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 	return nil
 }
 
+// executePortal writes at most maxRows rows of the query of the portal. Rows are read through a cursor
+// kept by the portal, so the next Execute message resumes from the following row.
+// It returns true if the portal was suspended before all its rows were written.
+func (s *session) executePortal(portal *portal, maxRows int) (bool, error) {
+	if portal.cursor == nil {
+		cursor, err := s.db.DeclareSQLCursor(
+			s.ctx,
+			s.txOptions(),
+			portal.Name,
+			portal.Statement.PreparedStmt.(sql.DataSource),
+			schema.NamedParamsFromProto(portal.Parameters),
+		)
+		if err != nil {
+			return false, err
+		}
+		portal.cursor = cursor
+	}
+
+	rows, err := portal.cursor.Fetch(s.ctx, maxRows)
+	if err != nil {
+		return false, err
+	}
+
+	cols := portal.cursor.Columns()
+
+	for i := 0; i < len(rows); i += maxRowsPerMessage {
+		j := i + maxRowsPerMessage
+		if j > len(rows) {
+			j = len(rows)
+		}
+
+		if _, err := s.writeMessage(bm.DataRow(rows[i:j], len(cols), portal.ResultColumnFormatCodes)); err != nil {
+			return false, err
+		}
+	}
+
+	if len(rows) == maxRows {
+		_, err = s.writeMessage(bm.PortalSuspended())
+		return err == nil, err
+	}
+
+	_, err = s.writeMessage(bm.CommandComplete([]byte("ok")))
+	return false, err
+}
+
 func removePGCatalogReferences(sql string) string {
 	return strings.ReplaceAll(sql, "pg_catalog.", "")
 }
 
-func (s *session) query(st *sql.SelectStmt, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
-	tx, err := s.sqlTx()
+func (s *session) query(ctx context.Context, st sql.DataSource, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
+	tx, err := s.stmtTx(st)
 	if err != nil {
 		return err
 	}
+	if tx != s.tx {
+		defer tx.Cancel()
+	}
 
-	reader, err := s.db.SQLQueryPrepared(s.ctx, tx, st, schema.NamedParamsFromProto(parameters))
+	reader, err := s.db.SQLQueryPrepared(ctx, tx, st, schema.NamedParamsFromProto(parameters))
 	if err != nil {
 		return err
 	}
 	defer reader.Close()
 
-	cols, err := reader.Columns(s.ctx)
+	cols, err := reader.Columns(ctx)
 	if err != nil {
 		return err
 	}
@@ -333,25 +402,30 @@ func (s *session) query(st *sql.SelectStmt, parameters []*schema.NamedParam, res
 		}
 	}
 
-	return sql.ReadRowsBatch(s.ctx, reader, maxRowsPerMessage, func(rowBatch []*sql.Row) error {
+	return sql.ReadRowsBatch(ctx, reader, maxRowsPerMessage, func(rowBatch []*sql.Row) error {
 		_, err := s.writeMessage(bm.DataRow(rowBatch, len(cols), resultColumnFormatCodes))
 		return err
 	})
 }
 
-func (s *session) exec(st sql.SQLStmt, namedParams []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
+func (s *session) exec(ctx context.Context, st sql.SQLStmt, namedParams []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
 	params := make(map[string]interface{}, len(namedParams))
 
 	for _, p := range namedParams {
 		params[p.Name] = schema.RawValue(p.Value)
 	}
 
-	tx, err := s.sqlTx()
+	tx, err := s.stmtTx(st)
 	if err != nil {
 		return err
 	}
 
-	ntx, _, err := s.db.SQLExecPrepared(s.ctx, tx, []sql.SQLStmt{st}, params)
+	// transactions keep the context they were created with
+	if _, ok := st.(*sql.BeginTransactionStmt); ok {
+		ctx = s.ctx
+	}
+
+	ntx, _, err := s.db.SQLExecPrepared(ctx, tx, []sql.SQLStmt{st}, params)
 	s.tx = ntx
 
 	return err
@@ -362,6 +436,16 @@ type portal struct {
 	Statement               *statement
 	Parameters              []*schema.NamedParam
 	ResultColumnFormatCodes []int16
+
+	// cursor reads the rows of the portal when they are returned in batches
+	cursor *sql.Cursor
+}
+
+func (p *portal) close() error {
+	if p.cursor == nil {
+		return nil
+	}
+	return p.cursor.Close()
 }
 
 type statement struct {
@@ -372,31 +456,24 @@ type statement struct {
 	Results      []sql.ColDescriptor
 }
 
-func (s *session) inferParamAndResultCols(stmt sql.SQLStmt) ([]sql.ColDescriptor, []sql.ColDescriptor, error) {
-	var resCols []sql.ColDescriptor
-
-	sel, ok := stmt.(*sql.SelectStmt)
-	if ok {
-		rr, err := s.db.SQLQueryPrepared(s.ctx, s.tx, sel, nil)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		resCols, err = rr.Columns(s.ctx)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		rr.Close()
+// describe parses the given statements and infers the types of their parameters and results.
+// Parsed statements and their descriptions are shared with other sessions through the statement cache.
+func (s *session) describe(statements string) ([]sql.SQLStmt, []sql.ColDescriptor, []sql.ColDescriptor, error) {
+	tx, err := s.sqlTx()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if tx != s.tx {
+		defer tx.Cancel()
 	}
 
-	r, err := s.db.InferParametersPrepared(s.ctx, s.tx, stmt)
+	stmts, r, resCols, err := s.db.SQLDescribe(s.ctx, tx, removePGCatalogReferences(statements))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if len(r) > math.MaxInt16 {
-		return nil, nil, pserr.ErrMaxParamsNumberExceeded
+		return nil, nil, nil, pserr.ErrMaxParamsNumberExceeded
 	}
 
 	var paramsNameList []string
@@ -409,5 +486,5 @@ func (s *session) inferParamAndResultCols(stmt sql.SQLStmt) ([]sql.ColDescriptor
 	for _, n := range paramsNameList {
 		paramCols = append(paramCols, sql.ColDescriptor{Column: n, Type: r[n]})
 	}
-	return paramCols, resCols, nil
+	return stmts, paramCols, resCols, nil
 }
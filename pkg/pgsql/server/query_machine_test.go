@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/logger"
@@ -415,6 +416,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			}
 
 			s := session{
+				ctx:        context.Background(),
 				log:        logger.NewSimpleLogger("test", os.Stdout),
 				mr:         mr,
 				statements: make(map[string]*statement),
@@ -441,6 +443,26 @@ type mockDB struct {
 	database.DB
 }
 
+func (db *mockDB) SQLPrepare(sqlStr string, params map[string]interface{}) ([]sql.SQLStmt, error) {
+	return sql.ParseSQL(strings.NewReader(sqlStr))
+}
+
+func (db *mockDB) SQLDescribe(ctx context.Context, tx *sql.SQLTx, sqlStr string) ([]sql.SQLStmt, map[string]sql.SQLValueType, []sql.ColDescriptor, error) {
+	stmts, err := sql.ParseSQL(strings.NewReader(sqlStr))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if _, ok := stmts[0].(sql.DataSource); ok {
+		return nil, nil, nil, fmt.Errorf("dummy error")
+	}
+	return stmts, nil, nil, nil
+}
+
 func (db *mockDB) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error) {
 	return nil, fmt.Errorf("dummy error")
 }
+
+func (db *mockDB) DeclareSQLCursor(ctx context.Context, opts *sql.TxOptions, name string, stmt sql.DataSource, params map[string]interface{}) (*sql.Cursor, error) {
+	return nil, fmt.Errorf("dummy error")
+}
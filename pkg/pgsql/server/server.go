@@ -41,6 +41,7 @@ type pgsrv struct {
 	port               int
 	immudbPort         int
 	dbList             database.DatabaseList
+	sessions           *sessionRegistry
 	listener           net.Listener
 }
 
@@ -61,6 +62,7 @@ func New(setters ...Option) *pgsrv {
 		host:           "0.0.0.0",
 		immudbPort:     3322,
 		port:           5432,
+		sessions:       newSessionRegistry(),
 	}
 
 	for _, setter := range setters {
@@ -105,7 +107,7 @@ func (s *pgsrv) Serve() (err error) {
 }
 
 func (s *pgsrv) newSession(conn net.Conn) Session {
-	return newSession(conn, s.host, s.immudbPort, s.logger, s.tlsConfig, s.logRequestMetadata, s.dbList)
+	return newSession(conn, s.host, s.immudbPort, s.logger, s.tlsConfig, s.logRequestMetadata, s.dbList, s.sessions)
 }
 
 func (s *pgsrv) Stop() (err error) {
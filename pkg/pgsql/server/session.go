@@ -19,7 +19,10 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"net"
 
@@ -40,7 +43,10 @@ type session struct {
 	log                logger.Logger
 	logRequestMetadata bool
 
-	dbList database.DatabaseList
+	dbList   database.DatabaseList
+	sessions *sessionRegistry
+
+	backendKey backendKey
 
 	client client.ImmuClient
 
@@ -57,6 +63,16 @@ type session struct {
 
 	statements map[string]*statement
 	portals    map[string]*portal
+	cursors    map[string]*sql.Cursor // cursors declared through DECLARE statements
+
+	// searchPath holds the schemas set through the search_path setting
+	searchPath []string
+
+	// statementTimeout is set through the statement_timeout setting
+	statementTimeout time.Duration
+
+	cancelMutex sync.Mutex
+	queryCancel func()
 }
 
 type Session interface {
@@ -75,6 +91,7 @@ func newSession(
 	tlsConfig *tls.Config,
 	logRequestMetadata bool,
 	dbList database.DatabaseList,
+	sessions *sessionRegistry,
 ) *session {
 	addr := c.RemoteAddr().String()
 	i := strings.Index(addr, ":")
@@ -89,10 +106,12 @@ func newSession(
 		log:                log,
 		logRequestMetadata: logRequestMetadata,
 		dbList:             dbList,
+		sessions:           sessions,
 		ipAddr:             addr,
 		mr:                 NewMessageReader(c),
 		statements:         make(map[string]*statement),
 		portals:            make(map[string]*portal),
+		cursors:            make(map[string]*sql.Cursor),
 	}
 }
 
@@ -161,16 +180,97 @@ func (s *session) writeMessage(msg []byte) (int, error) {
 }
 
 func (s *session) sqlTx() (*sql.SQLTx, error) {
-	if s.tx != nil || !s.logRequestMetadata {
+	if s.tx != nil || (s.user == "" && len(s.searchPath) == 0 && s.statementTimeout == 0) {
 		return s.tx, nil
 	}
+	return s.newSQLTx()
+}
+
+// stmtTx returns the transaction the statement should be executed in. Statements using cursors,
+// and the ones starting transaction blocks where cursors may be declared, require the options of the session.
+func (s *session) stmtTx(stmt sql.SQLStmt) (*sql.SQLTx, error) {
+	tx, err := s.sqlTx()
+	if tx != nil || err != nil {
+		return tx, err
+	}
+
+	switch stmt.(type) {
+	case *sql.BeginTransactionStmt, *sql.DeclareCursorStmt, *sql.FetchCursorStmt, *sql.CloseCursorStmt:
+		return s.newSQLTx()
+	}
+	return nil, nil
+}
+
+func (s *session) newSQLTx() (*sql.SQLTx, error) {
+	ctx := s.ctx
+
+	if s.user != "" {
+		md := schema.Metadata{
+			schema.UserRequestMetadataKey: s.user,
+		}
+
+		if s.logRequestMetadata && s.ipAddr != "" {
+			md[schema.IpRequestMetadataKey] = s.ipAddr
+		}
+
+		// create transaction explicitly to inject request metadata
+		ctx = schema.ContextWithMetadata(s.ctx, md)
+	}
+
+	return s.db.NewSQLTx(ctx, s.txOptions())
+}
+
+func (s *session) txOptions() *sql.TxOptions {
+	opts := sql.DefaultTxOptions().WithCursors(s)
+
+	if len(s.searchPath) > 0 {
+		opts = opts.WithSearchPath(s.searchPath)
+	}
+
+	if s.statementTimeout > 0 {
+		opts = opts.WithStatementTimeout(s.statementTimeout)
+	}
+	return opts
+}
+
+func (s *session) AddCursor(cursor *sql.Cursor) error {
+	if _, exists := s.cursors[cursor.Name()]; exists {
+		return fmt.Errorf("%w (%s)", sql.ErrCursorAlreadyExists, cursor.Name())
+	}
+
+	s.cursors[cursor.Name()] = cursor
+	return nil
+}
+
+func (s *session) GetCursor(name string) (*sql.Cursor, error) {
+	cursor, exists := s.cursors[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", sql.ErrCursorDoesNotExist, name)
+	}
+	return cursor, nil
+}
 
-	md := schema.Metadata{
-		schema.UserRequestMetadataKey: s.user,
-		schema.IpRequestMetadataKey:   s.ipAddr,
+func (s *session) CloseCursor(name string) error {
+	cursor, exists := s.cursors[name]
+	if !exists {
+		return fmt.Errorf("%w (%s)", sql.ErrCursorDoesNotExist, name)
 	}
 
-	// create transaction explicitly to inject request metadata
-	ctx := schema.ContextWithMetadata(s.ctx, md)
-	return s.db.NewSQLTx(ctx, sql.DefaultTxOptions())
+	delete(s.cursors, name)
+
+	return cursor.Close()
+}
+
+// closeCursors releases the cursors declared within the session and the ones kept by portals
+func (s *session) closeCursors() {
+	for name, cursor := range s.cursors {
+		if err := cursor.Close(); err != nil {
+			s.log.Errorf("unable to close cursor '%s': %v", name, err)
+		}
+		delete(s.cursors, name)
+	}
+
+	for _, portal := range s.portals {
+		portal.close()
+	}
 }
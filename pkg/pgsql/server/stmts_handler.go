@@ -17,15 +17,21 @@ limitations under the License.
 package server
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
 )
 
 var (
-	set           = regexp.MustCompile(`(?i)set\s+.+`)
-	selectVersion = regexp.MustCompile(`(?i)select\s+version\(\s*\)`)
-	dealloc       = regexp.MustCompile(`(?i)deallocate\s+\"([^\"]+)\"`)
+	set            = regexp.MustCompile(`(?i)set\s+.+`)
+	selectVersion  = regexp.MustCompile(`(?i)select\s+version\(\s*\)`)
+	dealloc        = regexp.MustCompile(`(?i)deallocate\s+\"([^\"]+)\"`)
+	setSearchPath  = regexp.MustCompile(`(?i)^\s*set\s+(?:session\s+)?search_path\s*(?:=|to)\s*(.+?)\s*;?\s*$`)
+	setStmtTimeout = regexp.MustCompile(`(?i)^\s*set\s+(?:session\s+)?statement_timeout\s*(?:=|to)\s*(.+?)\s*;?\s*$`)
 )
 
 func (s *session) isInBlackList(statement string) bool {
@@ -44,6 +50,16 @@ func (s *session) isEmulableInternally(statement string) interface{} {
 		return &version{}
 	}
 
+	if setSearchPath.MatchString(statement) {
+		matches := setSearchPath.FindStringSubmatch(statement)
+		return &searchPath{schemas: parseSearchPath(matches[1])}
+	}
+
+	if setStmtTimeout.MatchString(statement) {
+		matches := setStmtTimeout.FindStringSubmatch(statement)
+		return &statementTimeout{value: matches[1]}
+	}
+
 	if dealloc.MatchString(statement) {
 		matches := dealloc.FindStringSubmatch(statement)
 		if len(matches) == 2 {
@@ -62,6 +78,16 @@ func (s *session) tryToHandleInternally(command interface{}) error {
 	case *deallocate:
 		delete(s.statements, cmd.plan)
 		return nil
+	case *searchPath:
+		s.searchPath = cmd.schemas
+		return nil
+	case *statementTimeout:
+		timeout, err := parseStatementTimeout(cmd.value)
+		if err != nil {
+			return err
+		}
+		s.statementTimeout = timeout
+		return nil
 	default:
 		return pserr.ErrMessageCannotBeHandledInternally
 	}
@@ -73,3 +99,72 @@ type version struct{}
 type deallocate struct {
 	plan string
 }
+
+type searchPath struct {
+	schemas []string
+}
+
+type statementTimeout struct {
+	value string
+}
+
+// parseSearchPath parses the value of a search_path setting.
+// Non existent schemas are kept, as they are just skipped when resolving table names.
+func parseSearchPath(value string) []string {
+	if strings.EqualFold(value, "default") {
+		return nil
+	}
+
+	var schemas []string
+
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+
+		if len(s) > 1 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+			s = s[1 : len(s)-1]
+		} else {
+			s = strings.ToLower(s)
+		}
+
+		// there is no schema per user
+		if s == "" || s == "$user" {
+			continue
+		}
+		schemas = append(schemas, s)
+	}
+	return schemas
+}
+
+// parseStatementTimeout parses the value of a statement_timeout setting.
+// As in PostgreSQL, values without units are taken as milliseconds and zero disables the timeout.
+func parseStatementTimeout(value string) (time.Duration, error) {
+	if len(value) > 1 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = strings.TrimSpace(value[1 : len(value)-1])
+	}
+
+	if strings.EqualFold(value, "default") {
+		return 0, nil
+	}
+
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		if ms < 0 {
+			return 0, fmt.Errorf("%w: invalid value for parameter \"statement_timeout\": \"%s\"", pserr.ErrInvalidSetting, value)
+		}
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+
+	// PostgreSQL units not understood by time.ParseDuration
+	unit := strings.TrimLeft(strings.ToLower(value), "0123456789. ")
+	number := strings.TrimSpace(value[:len(value)-len(unit)])
+
+	if unit == "min" {
+		unit = "m"
+	}
+
+	timeout, err := time.ParseDuration(number + unit)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("%w: invalid value for parameter \"statement_timeout\": \"%s\"", pserr.ErrInvalidSetting, value)
+	}
+	return timeout, nil
+}
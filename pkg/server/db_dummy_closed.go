@@ -102,6 +102,10 @@ func (db *closedDB) VerifiableGet(ctx context.Context, req *schema.VerifiableGet
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableGetKeyProof(ctx context.Context, req *schema.VerifiableGetKeyProofRequest) (*schema.VerifiableKeyProof, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -110,6 +114,14 @@ func (db *closedDB) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) VerifiableDeleteRange(ctx context.Context, req *schema.VerifiableDeleteRangeRequest) (*schema.VerifiableTx, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -162,6 +174,14 @@ func (db *closedDB) SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []
 	return nil, nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) SQLPrepare(sql string, params map[string]interface{}) ([]sql.SQLStmt, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) SQLDescribe(ctx context.Context, tx *sql.SQLTx, sql string) ([]sql.SQLStmt, map[string]sql.SQLValueType, []sql.ColDescriptor, error) {
+	return nil, nil, nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) InferParameters(ctx context.Context, tx *sql.SQLTx, sql string) (map[string]sql.SQLValueType, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -182,6 +202,10 @@ func (db *closedDB) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sq
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) DeclareSQLCursor(ctx context.Context, opts *sql.TxOptions, name string, stmt sql.DataSource, params map[string]interface{}) (*sql.Cursor, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetRequest) (*schema.VerifiableSQLEntry, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -194,6 +218,10 @@ func (db *closedDB) DescribeTable(ctx context.Context, tx *sql.SQLTx, table stri
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) GetTableID(ctx context.Context, table string) (uint32, error) {
+	return 0, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) WaitForTx(ctx context.Context, txID uint64, allowPrecommitted bool) error {
 	return store.ErrAlreadyClosed
 }
@@ -238,6 +266,22 @@ func (db *closedDB) CompactIndex() error {
 	return store.ErrAlreadyClosed
 }
 
+func (db *closedDB) RebuildIndex(ctx context.Context) error {
+	return store.ErrAlreadyClosed
+}
+
+func (db *closedDB) VerifyIndex(ctx context.Context, req *schema.VerifyIndexRequest) (*schema.VerifyIndexResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) CompactValues(ctx context.Context, req *schema.CompactValuesRequest) (*schema.CompactValuesResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) MigrateValuesUptoTx(ctx context.Context, txID uint64) (int, error) {
+	return 0, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) IsClosed() bool {
 	return true
 }
@@ -169,6 +169,18 @@ func TestDummyClosedDatabase(t *testing.T) {
 
 	err = cdb.CompactIndex()
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	err = cdb.RebuildIndex(context.Background())
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.VerifyIndex(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.CompactValues(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.MigrateValuesUptoTx(context.Background(), 1)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 	require.True(t, cdb.IsClosed())
 
 	err = cdb.Truncate(0)
@@ -102,9 +102,10 @@ func (s *ImmuServer) VerifiableSet(ctx context.Context, req *schema.VerifiableSe
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -145,9 +146,10 @@ func (s *ImmuServer) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -161,6 +163,40 @@ func (s *ImmuServer) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 	return vEntry, nil
 }
 
+// VerifiableGetKeyProof ...
+func (s *ImmuServer) VerifiableGetKeyProof(ctx context.Context, req *schema.VerifiableGetKeyProofRequest) (*schema.VerifiableKeyProof, error) {
+	db, err := s.getDBFromCtx(ctx, "VerifiableGetKeyProof")
+	if err != nil {
+		return nil, err
+	}
+
+	vProof, err := db.VerifiableGetKeyProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil {
+		hdr := schema.TxHeaderFromProto(vProof.VerifiableTx.DualProof.TargetTxHeader)
+		alh := hdr.Alh()
+
+		newState := &schema.ImmutableState{
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
+		}
+
+		err = s.StateSigner.Sign(newState)
+		if err != nil {
+			return nil, err
+		}
+
+		vProof.VerifiableTx.Signature = newState.Signature
+	}
+
+	return vProof, nil
+}
+
 // Scan ...
 func (s *ImmuServer) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error) {
 	db, err := s.getDBFromCtx(ctx, "Scan")
@@ -218,9 +254,10 @@ func (s *ImmuServer) VerifiableTxById(ctx context.Context, req *schema.Verifiabl
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -289,9 +326,10 @@ func (s *ImmuServer) VerifiableSetReference(ctx context.Context, req *schema.Ver
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -350,9 +388,10 @@ func (s *ImmuServer) VerifiableZAdd(ctx context.Context, req *schema.VerifiableZ
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -389,6 +428,34 @@ func (s *ImmuServer) CompactIndex(ctx context.Context, _ *empty.Empty) (*empty.E
 	return &empty.Empty{}, err
 }
 
+func (s *ImmuServer) RebuildIndex(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	db, err := s.getDBFromCtx(ctx, "RebuildIndex")
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.RebuildIndex(ctx)
+	return &empty.Empty{}, err
+}
+
+func (s *ImmuServer) VerifyIndex(ctx context.Context, req *schema.VerifyIndexRequest) (*schema.VerifyIndexResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "VerifyIndex")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.VerifyIndex(ctx, req)
+}
+
+func (s *ImmuServer) CompactValues(ctx context.Context, req *schema.CompactValuesRequest) (*schema.CompactValuesResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "CompactValues")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.CompactValues(ctx, req)
+}
+
 // GetAll ...
 func (s *ImmuServer) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	if req == nil {
@@ -416,6 +483,56 @@ func (s *ImmuServer) Delete(ctx context.Context, req *schema.DeleteKeysRequest)
 	return db.Delete(ctx, req)
 }
 
+func (s *ImmuServer) DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error) {
+	if req == nil {
+		return nil, store.ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "DeleteRange")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.DeleteRange(ctx, req)
+}
+
+func (s *ImmuServer) VerifiableDeleteRange(ctx context.Context, req *schema.VerifiableDeleteRangeRequest) (*schema.VerifiableTx, error) {
+	if req == nil {
+		return nil, store.ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "VerifiableDeleteRange")
+	if err != nil {
+		return nil, err
+	}
+
+	vtx, err := db.VerifiableDeleteRange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil {
+		hdr := schema.TxHeaderFromProto(vtx.DualProof.TargetTxHeader)
+		alh := hdr.Alh()
+
+		newState := &schema.ImmutableState{
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
+		}
+
+		err = s.StateSigner.Sign(newState)
+		if err != nil {
+			return nil, err
+		}
+
+		vtx.Signature = newState.Signature
+	}
+
+	return vtx, nil
+}
+
 func (s *ImmuServer) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
@@ -21,9 +21,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/ahtree"
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/tieredapp"
+	"github.com/codenotary/immudb/embedded/hashing"
+	"github.com/codenotary/immudb/embedded/remotestorage/local"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/pkg/api/schema"
@@ -61,9 +68,19 @@ type dbOptions struct {
 	MaxKeyLen      int  `json:"maxKeyLen"`      // permanent
 	MaxValueLen    int  `json:"maxValueLen"`    // permanent
 	MaxTxEntries   int  `json:"maxTxEntries"`   // permanent
+	Encrypted      bool `json:"encrypted"`      // permanent
+
+	HashAlgorithm string `json:"hashAlgorithm"` // permanent, empty means the default algorithm
+
+	AuthenticatedIndex bool `json:"authenticatedIndex"` // permanent
 
 	ExcludeCommitTime bool `json:"excludeCommitTime"`
 
+	// compression settings only apply to newly created value log files
+	CompressionFormat     string `json:"compressionFormat"`
+	CompressionLevel      int    `json:"compressionLevel"` // 0 means the default level
+	CompressionDictionary []byte `json:"compressionDictionary"`
+
 	MaxActiveTransactions int `json:"maxActiveTransactions"`
 	MVCCReadSetLimit      int `json:"mvccReadSetLimit"`
 
@@ -94,6 +111,16 @@ type dbOptions struct {
 
 	RetentionPeriod     Milliseconds `json:"retentionPeriod"`
 	TruncationFrequency Milliseconds `json:"truncationFrequency"` // ms
+
+	// value tiering options, values of transactions committed before the hot retention period
+	// are periodically moved into the cold storage directory
+	ColdStorageDir     string       `json:"coldStorageDir"` // permanent, empty means value tiering is disabled
+	HotRetentionPeriod Milliseconds `json:"hotRetentionPeriod"`
+	MigrationFrequency Milliseconds `json:"migrationFrequency"` // ms
+
+	// sql options
+	StatementTimeout Milliseconds `json:"statementTimeout"` // ms
+	QueryMemoryLimit int64        `json:"queryMemoryLimit"`
 }
 
 type featureState int
@@ -131,6 +158,17 @@ type ahtOptions struct {
 	WriteBufferSize int `json:"writeBufferSize"`
 }
 
+var compressionFormats = map[string]int{
+	"":      appendable.NoCompression,
+	"none":  appendable.NoCompression,
+	"flate": appendable.FlateCompression,
+	"gzip":  appendable.GZipCompression,
+	"lzw":   appendable.LZWCompression,
+	"zlib":  appendable.ZLibCompression,
+	"zstd":  appendable.ZStdCompression,
+	"lz4":   appendable.LZ4Compression,
+}
+
 const (
 	DefaultMaxValueLen   = 1 << 25 //32Mb
 	DefaultStoreFileSize = 1 << 29 //512Mb
@@ -149,6 +187,7 @@ func (s *ImmuServer) defaultDBOptions(dbName, userName string) *dbOptions {
 		MaxKeyLen:      store.DefaultMaxKeyLen,
 		MaxValueLen:    DefaultMaxValueLen,
 		MaxTxEntries:   store.DefaultMaxTxEntries,
+		Encrypted:      s.keyProvider != nil,
 
 		ExcludeCommitTime: false,
 
@@ -174,6 +213,7 @@ func (s *ImmuServer) defaultDBOptions(dbName, userName string) *dbOptions {
 		CreatedAt:           time.Now(),
 		CreatedBy:           userName,
 		TruncationFrequency: Milliseconds(database.DefaultTruncationFrequency.Milliseconds()),
+		MigrationFrequency:  Milliseconds(database.DefaultTieringFrequency.Milliseconds()),
 	}
 
 	if dbName == s.Options.systemAdminDBName || dbName == s.Options.defaultDBName {
@@ -198,6 +238,14 @@ func (s *ImmuServer) defaultDBOptions(dbName, userName string) *dbOptions {
 		}
 	}
 
+	if dbName == s.Options.defaultDBName && s.Options.ValueTieringOptions != nil {
+		tieringOpts := s.Options.ValueTieringOptions
+
+		dbOpts.ColdStorageDir = tieringOpts.ColdStorageDir
+		dbOpts.HotRetentionPeriod = Milliseconds(tieringOpts.HotRetentionPeriod.Milliseconds())
+		dbOpts.MigrationFrequency = Milliseconds(tieringOpts.MigrationFrequency.Milliseconds())
+	}
+
 	return dbOpts
 }
 
@@ -229,15 +277,24 @@ func (s *ImmuServer) defaultAHTOptions() *ahtOptions {
 }
 
 func (s *ImmuServer) databaseOptionsFrom(opts *dbOptions) *database.Options {
+	stOpts := opts.storeOptions()
+
+	if opts.Encrypted {
+		// a missing key provider is detected when opening encrypted files
+		stOpts.WithKeyProvider(s.keyProvider)
+	}
+
 	return database.DefaultOptions().
 		WithDBRootPath(s.Options.Dir).
-		WithStoreOptions(s.storeOptionsForDB(opts.Database, s.remoteStorage, opts.storeOptions())).
+		WithStoreOptions(s.storeOptionsForDB(opts.Database, s.remoteStorage, stOpts)).
 		AsReplica(opts.Replica).
 		WithSyncReplication(opts.SyncReplication).
 		WithSyncAcks(opts.SyncAcks).
 		WithReadTxPoolSize(opts.ReadTxPoolSize).
 		WithRetentionPeriod(time.Millisecond * time.Duration(opts.RetentionPeriod)).
 		WithTruncationFrequency(time.Millisecond * time.Duration(opts.TruncationFrequency)).
+		WithStatementTimeout(time.Millisecond * time.Duration(opts.StatementTimeout)).
+		WithQueryMemoryLimit(opts.QueryMemoryLimit).
 		WithMaxResultSize(s.Options.MaxResultSize)
 }
 
@@ -291,7 +348,19 @@ func (opts *dbOptions) storeOptions() *store.Options {
 		WithTxLogMaxOpenedFiles(opts.TxLogMaxOpenedFiles).
 		WithCommitLogMaxOpenedFiles(opts.CommitLogMaxOpenedFiles).
 		WithIndexOptions(indexOpts).
-		WithAHTOptions(ahtOpts)
+		WithAHTOptions(ahtOpts).
+		WithCompressionFormat(compressionFormats[opts.CompressionFormat]).
+		WithCompressionDictionary(opts.CompressionDictionary).
+		WithHashAlgorithm(opts.hashAlgorithm()).
+		WithAuthenticatedIndex(opts.AuthenticatedIndex)
+
+	if opts.CompressionLevel > 0 {
+		stOpts.WithCompresionLevel(opts.CompressionLevel)
+	}
+
+	if opts.ColdStorageDir != "" {
+		stOpts.WithVLogAppFactory(opts.tieredVLogAppFactory())
+	}
 
 	if opts.ExcludeCommitTime {
 		stOpts.WithTimeFunc(func() time.Time { return time.Unix(0, 0) })
@@ -302,6 +371,37 @@ func (opts *dbOptions) storeOptions() *store.Options {
 	return stOpts
 }
 
+// tieredVLogAppFactory opens value logs whose older chunks can be moved into the cold storage
+// directory, chunks of each database are kept under a folder named after it
+func (opts *dbOptions) tieredVLogAppFactory() store.AppFactoryFunc {
+	return func(rootPath, subPath string, appOpts *multiapp.Options) (appendable.Appendable, error) {
+		coldStorage, err := local.Open(opts.ColdStorageDir, store.DefaultFileMode)
+		if err != nil {
+			return nil, err
+		}
+
+		tieredOpts := tieredapp.DefaultOptions()
+		tieredOpts.Options = *appOpts
+
+		return tieredapp.Open(
+			filepath.Join(rootPath, subPath),
+			path.Join(opts.Database, subPath)+"/",
+			coldStorage,
+			tieredOpts,
+		)
+	}
+}
+
+// hashAlgorithm returns the hash algorithm set for the database,
+// invalid names are rejected during validation
+func (opts *dbOptions) hashAlgorithm() hashing.Algorithm {
+	hashAlgorithm, err := hashing.ParseAlgorithm(opts.HashAlgorithm)
+	if err != nil {
+		return hashing.DefaultAlgorithm
+	}
+	return hashAlgorithm
+}
+
 func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettings {
 	return &schema.DatabaseNullableSettings{
 		ReplicationSettings: &schema.ReplicationNullableSettings{
@@ -328,6 +428,9 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 		MaxTxEntries:   &schema.NullableUint32{Value: uint32(opts.MaxTxEntries)},
 		EmbeddedValues: &schema.NullableBool{Value: opts.EmbeddedValues},
 		PreallocFiles:  &schema.NullableBool{Value: opts.PreallocFiles},
+		HashAlgorithm:  &schema.NullableString{Value: opts.hashAlgorithm().String()},
+
+		AuthenticatedIndex: &schema.NullableBool{Value: opts.AuthenticatedIndex},
 
 		ExcludeCommitTime: &schema.NullableBool{Value: opts.ExcludeCommitTime},
 
@@ -378,6 +481,19 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 			RetentionPeriod:     &schema.NullableMilliseconds{Value: int64(opts.RetentionPeriod)},
 			TruncationFrequency: &schema.NullableMilliseconds{Value: int64(opts.TruncationFrequency)},
 		},
+
+		ValueTieringSettings: &schema.ValueTieringNullableSettings{
+			ColdStorageDir:     &schema.NullableString{Value: opts.ColdStorageDir},
+			HotRetentionPeriod: &schema.NullableMilliseconds{Value: int64(opts.HotRetentionPeriod)},
+			MigrationFrequency: &schema.NullableMilliseconds{Value: int64(opts.MigrationFrequency)},
+		},
+
+		StatementTimeout: &schema.NullableMilliseconds{Value: int64(opts.StatementTimeout)},
+		QueryMemoryLimit: &schema.NullableUint64{Value: uint64(opts.QueryMemoryLimit)},
+
+		CompressionFormat:     &schema.NullableString{Value: opts.CompressionFormat},
+		CompressionLevel:      &schema.NullableUint32{Value: uint32(opts.CompressionLevel)},
+		CompressionDictionary: &schema.NullableBytes{Value: opts.CompressionDictionary},
 	}
 }
 
@@ -453,10 +569,25 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 				"prealloc files", opts.Database)
 		}
 
+		if settings.HashAlgorithm != nil {
+			return fmt.Errorf("%w: %s can not be changed after database creation ('%s')", ErrIllegalArguments,
+				"hash algorithm", opts.Database)
+		}
+
+		if settings.AuthenticatedIndex != nil {
+			return fmt.Errorf("%w: %s can not be changed after database creation ('%s')", ErrIllegalArguments,
+				"authenticated index", opts.Database)
+		}
+
 		if settings.IndexSettings != nil && settings.IndexSettings.MaxNodeSize != nil {
 			return fmt.Errorf("%w: %s can not be changed after database creation ('%s')", ErrIllegalArguments, "max node size", opts.Database)
 		}
 
+		if settings.ValueTieringSettings != nil && settings.ValueTieringSettings.ColdStorageDir != nil {
+			return fmt.Errorf("%w: %s can not be changed after database creation ('%s')", ErrIllegalArguments,
+				"cold storage directory", opts.Database)
+		}
+
 		opts.UpdatedAt = time.Now()
 	}
 
@@ -564,6 +695,14 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		opts.PreallocFiles = settings.PreallocFiles.Value
 	}
 
+	if settings.HashAlgorithm != nil {
+		opts.HashAlgorithm = settings.HashAlgorithm.Value
+	}
+
+	if settings.AuthenticatedIndex != nil {
+		opts.AuthenticatedIndex = settings.AuthenticatedIndex.Value
+	}
+
 	if settings.ExcludeCommitTime != nil {
 		opts.ExcludeCommitTime = settings.ExcludeCommitTime.Value
 	}
@@ -624,6 +763,50 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		}
 	}
 
+	// value tiering options
+	if settings.ValueTieringSettings != nil {
+		if settings.ValueTieringSettings.ColdStorageDir != nil {
+			opts.ColdStorageDir = settings.ValueTieringSettings.ColdStorageDir.Value
+		}
+
+		if settings.ValueTieringSettings.HotRetentionPeriod != nil {
+			opts.HotRetentionPeriod = Milliseconds(settings.ValueTieringSettings.HotRetentionPeriod.Value)
+		}
+
+		if settings.ValueTieringSettings.MigrationFrequency != nil {
+			opts.MigrationFrequency = Milliseconds(settings.ValueTieringSettings.MigrationFrequency.Value)
+		}
+	}
+
+	if opts.ColdStorageDir != "" && s.remoteStorage != nil {
+		return fmt.Errorf(
+			"%w: value tiering is not supported with remote storage (database '%s')",
+			ErrIllegalArguments, opts.Database,
+		)
+	}
+
+	// sql options
+	if settings.StatementTimeout != nil {
+		opts.StatementTimeout = Milliseconds(settings.StatementTimeout.Value)
+	}
+
+	if settings.QueryMemoryLimit != nil {
+		opts.QueryMemoryLimit = int64(settings.QueryMemoryLimit.Value)
+	}
+
+	// compression options
+	if settings.CompressionFormat != nil {
+		opts.CompressionFormat = settings.CompressionFormat.Value
+	}
+
+	if settings.CompressionLevel != nil {
+		opts.CompressionLevel = int(settings.CompressionLevel.Value)
+	}
+
+	if settings.CompressionDictionary != nil {
+		opts.CompressionDictionary = settings.CompressionDictionary.Value
+	}
+
 	// index options
 	if settings.IndexSettings != nil {
 		if opts.IndexOptions == nil {
@@ -819,6 +1002,95 @@ func (opts *dbOptions) Validate() error {
 			ErrIllegalArguments, opts.Database, store.MinimumTruncationFrequency.Hours())
 	}
 
+	if opts.HotRetentionPeriod < 0 {
+		return fmt.Errorf(
+			"%w: invalid hot retention period (%d) for database '%s'",
+			ErrIllegalArguments, opts.HotRetentionPeriod, opts.Database,
+		)
+	}
+
+	if opts.HotRetentionPeriod > 0 && opts.ColdStorageDir == "" {
+		return fmt.Errorf(
+			"%w: a hot retention period requires a cold storage directory (database '%s')",
+			ErrIllegalArguments, opts.Database,
+		)
+	}
+
+	if opts.isValueTieringEnabled() && opts.MigrationFrequency <= 0 {
+		return fmt.Errorf(
+			"%w: invalid migration frequency (%d) for database '%s'",
+			ErrIllegalArguments, opts.MigrationFrequency, opts.Database,
+		)
+	}
+
+	if opts.StatementTimeout < 0 {
+		return fmt.Errorf(
+			"%w: invalid statement timeout (%d) for database '%s'",
+			ErrIllegalArguments, opts.StatementTimeout, opts.Database,
+		)
+	}
+
+	if opts.QueryMemoryLimit < 0 {
+		return fmt.Errorf(
+			"%w: invalid query memory limit (%d) for database '%s'",
+			ErrIllegalArguments, opts.QueryMemoryLimit, opts.Database,
+		)
+	}
+
+	_, err := hashing.ParseAlgorithm(opts.HashAlgorithm)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: invalid hash algorithm '%s' for database '%s'",
+			ErrIllegalArguments, opts.HashAlgorithm, opts.Database,
+		)
+	}
+
+	compressionFormat, ok := compressionFormats[opts.CompressionFormat]
+	if !ok {
+		return fmt.Errorf(
+			"%w: invalid compression format '%s' for database '%s'",
+			ErrIllegalArguments, opts.CompressionFormat, opts.Database,
+		)
+	}
+
+	if opts.CompressionLevel < 0 || opts.CompressionLevel > appendable.BestCompression {
+		return fmt.Errorf(
+			"%w: invalid compression level (%d) for database '%s'",
+			ErrIllegalArguments, opts.CompressionLevel, opts.Database,
+		)
+	}
+
+	if len(opts.CompressionDictionary) > 0 && compressionFormat != appendable.ZStdCompression {
+		return fmt.Errorf(
+			"%w: compression dictionaries are only supported with zstd compression (database '%s')",
+			ErrIllegalArguments, opts.Database,
+		)
+	}
+
+	if opts.Encrypted && compressionFormat != appendable.NoCompression {
+		return fmt.Errorf(
+			"%w: compression is not supported on encrypted database '%s'",
+			ErrIllegalArguments, opts.Database,
+		)
+	}
+
+	if opts.ColdStorageDir != "" {
+		// cold chunks are read back as they were written, unencrypted and uncompressed
+		if !filepath.IsAbs(opts.ColdStorageDir) {
+			return fmt.Errorf(
+				"%w: cold storage directory '%s' must be an absolute path (database '%s')",
+				ErrIllegalArguments, opts.ColdStorageDir, opts.Database,
+			)
+		}
+
+		if opts.EmbeddedValues || opts.PreallocFiles || opts.Encrypted || compressionFormat != appendable.NoCompression {
+			return fmt.Errorf(
+				"%w: value tiering is not supported with embedded values, preallocated files, encryption or compression (database '%s')",
+				ErrIllegalArguments, opts.Database,
+			)
+		}
+	}
+
 	return opts.storeOptions().Validate()
 }
 
@@ -833,6 +1105,10 @@ func (opts *dbOptions) isDataRetentionEnabled() bool {
 	return opts.RetentionPeriod > 0
 }
 
+func (opts *dbOptions) isValueTieringEnabled() bool {
+	return opts.ColdStorageDir != "" && opts.HotRetentionPeriod > 0
+}
+
 func (s *ImmuServer) saveDBOptions(options *dbOptions) error {
 	serializedOptions, err := json.Marshal(options)
 	if err != nil {
@@ -913,6 +1189,9 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.MaxTxEntries: %v", database, opts.MaxTxEntries)
 	s.Logger.Infof("%s.EmbeddedValues: %v", database, opts.EmbeddedValues)
 	s.Logger.Infof("%s.PreallocFiles: %v", database, opts.PreallocFiles)
+	s.Logger.Infof("%s.Encrypted: %v", database, opts.Encrypted)
+	s.Logger.Infof("%s.HashAlgorithm: %v", database, opts.HashAlgorithm)
+	s.Logger.Infof("%s.AuthenticatedIndex: %v", database, opts.AuthenticatedIndex)
 	s.Logger.Infof("%s.ExcludeCommitTime: %v", database, opts.ExcludeCommitTime)
 	s.Logger.Infof("%s.MaxActiveTransactions: %v", database, opts.MaxActiveTransactions)
 	s.Logger.Infof("%s.MVCCReadSetLimit: %v", database, opts.MVCCReadSetLimit)
@@ -928,6 +1207,14 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.ReadTxPoolSize: %v", database, opts.ReadTxPoolSize)
 	s.Logger.Infof("%s.TruncationFrequency: %v", database, opts.TruncationFrequency)
 	s.Logger.Infof("%s.RetentionPeriod: %v", database, opts.RetentionPeriod)
+	s.Logger.Infof("%s.ColdStorageDir: %v", database, opts.ColdStorageDir)
+	s.Logger.Infof("%s.HotRetentionPeriod: %v", database, opts.HotRetentionPeriod)
+	s.Logger.Infof("%s.MigrationFrequency: %v", database, opts.MigrationFrequency)
+	s.Logger.Infof("%s.StatementTimeout: %v", database, opts.StatementTimeout)
+	s.Logger.Infof("%s.QueryMemoryLimit: %v", database, opts.QueryMemoryLimit)
+	s.Logger.Infof("%s.CompressionFormat: %v", database, opts.CompressionFormat)
+	s.Logger.Infof("%s.CompressionLevel: %v", database, opts.CompressionLevel)
+	s.Logger.Infof("%s.CompressionDictionarySize: %v", database, len(opts.CompressionDictionary))
 	s.Logger.Infof("%s.IndexOptions.FlushThreshold: %v", database, opts.IndexOptions.FlushThreshold)
 	s.Logger.Infof("%s.IndexOptions.SyncThreshold: %v", database, opts.IndexOptions.SyncThreshold)
 	s.Logger.Infof("%s.IndexOptions.FlushBufferSize: %v", database, opts.IndexOptions.FlushBufferSize)
@@ -17,8 +17,13 @@ limitations under the License.
 package server
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/hashing"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/replication"
 	"github.com/stretchr/testify/require"
 )
@@ -116,3 +121,178 @@ func TestPrimaryOptions(t *testing.T) {
 	opts.RetentionPeriod = -1
 	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
 }
+
+func TestCompressionOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1", "user")
+
+	// compression settings can be changed after database creation
+	err := s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		CompressionFormat:     &schema.NullableString{Value: "zstd"},
+		CompressionLevel:      &schema.NullableUint32{Value: appendable.BestCompression},
+		CompressionDictionary: &schema.NullableBytes{Value: []byte(`{"name":""}`)},
+	}, true)
+	require.NoError(t, err)
+	require.NoError(t, opts.Validate())
+
+	stOpts := opts.storeOptions()
+	require.Equal(t, appendable.ZStdCompression, stOpts.CompressionFormat)
+	require.Equal(t, appendable.BestCompression, stOpts.CompressionLevel)
+	require.Equal(t, []byte(`{"name":""}`), stOpts.CompressionDictionary)
+
+	settings := opts.databaseNullableSettings()
+	require.Equal(t, "zstd", settings.CompressionFormat.Value)
+	require.Equal(t, uint32(appendable.BestCompression), settings.CompressionLevel.Value)
+
+	opts.CompressionFormat = "lz4"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.CompressionDictionary = nil
+	require.NoError(t, opts.Validate())
+
+	opts.CompressionLevel = 0
+	require.Equal(t, appendable.DefaultCompressionLevel, opts.storeOptions().CompressionLevel)
+
+	opts.CompressionLevel = appendable.BestCompression + 1
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.CompressionLevel = 0
+	opts.CompressionFormat = "unknown"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.CompressionFormat = "gzip"
+	opts.Encrypted = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+}
+
+func TestHashAlgorithmOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1", "user")
+	require.Empty(t, opts.HashAlgorithm)
+	require.Equal(t, hashing.SHA256, opts.storeOptions().HashAlgorithm)
+
+	// the hash algorithm can not be changed after database creation
+	err := s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		HashAlgorithm: &schema.NullableString{Value: "blake3"},
+	}, true)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		HashAlgorithm: &schema.NullableString{Value: "blake3"},
+	}, false)
+	require.NoError(t, err)
+	require.NoError(t, opts.Validate())
+	require.Equal(t, hashing.BLAKE3, opts.storeOptions().HashAlgorithm)
+	require.Equal(t, "blake3", opts.databaseNullableSettings().HashAlgorithm.Value)
+
+	opts.HashAlgorithm = "md5"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+}
+
+func TestAuthenticatedIndexOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1", "user")
+	require.False(t, opts.AuthenticatedIndex)
+	require.False(t, opts.storeOptions().AuthenticatedIndex)
+
+	// the authenticated index can not be enabled after database creation
+	err := s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		AuthenticatedIndex: &schema.NullableBool{Value: true},
+	}, true)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		AuthenticatedIndex: &schema.NullableBool{Value: true},
+	}, false)
+	require.NoError(t, err)
+	require.True(t, opts.storeOptions().AuthenticatedIndex)
+	require.True(t, opts.databaseNullableSettings().AuthenticatedIndex.Value)
+}
+
+func TestValueTieringOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	s, closer := testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	opts := s.defaultDBOptions("db1", "user")
+	require.Empty(t, opts.ColdStorageDir)
+	require.False(t, opts.isValueTieringEnabled())
+
+	// a hot retention period requires a cold storage directory
+	err := s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		ValueTieringSettings: &schema.ValueTieringNullableSettings{
+			HotRetentionPeriod: &schema.NullableMilliseconds{Value: 1000},
+		},
+	}, false)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	coldDir := filepath.Join(dir, "cold")
+
+	opts = s.defaultDBOptions("db1", "user")
+
+	err = s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		ValueTieringSettings: &schema.ValueTieringNullableSettings{
+			ColdStorageDir:     &schema.NullableString{Value: coldDir},
+			HotRetentionPeriod: &schema.NullableMilliseconds{Value: 1000},
+		},
+	}, false)
+	require.NoError(t, err)
+	require.True(t, opts.isValueTieringEnabled())
+
+	settings := opts.databaseNullableSettings()
+	require.Equal(t, coldDir, settings.ValueTieringSettings.ColdStorageDir.Value)
+	require.Equal(t, int64(1000), settings.ValueTieringSettings.HotRetentionPeriod.Value)
+	require.Equal(t, database.DefaultTieringFrequency.Milliseconds(), settings.ValueTieringSettings.MigrationFrequency.Value)
+
+	// the tier policy can be changed after database creation but not the cold storage directory
+	err = s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		ValueTieringSettings: &schema.ValueTieringNullableSettings{
+			HotRetentionPeriod: &schema.NullableMilliseconds{Value: 0},
+		},
+	}, true)
+	require.NoError(t, err)
+	require.False(t, opts.isValueTieringEnabled())
+
+	err = s.overwriteWith(opts, &schema.DatabaseNullableSettings{
+		ValueTieringSettings: &schema.ValueTieringNullableSettings{
+			ColdStorageDir: &schema.NullableString{Value: ""},
+		},
+	}, true)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.HotRetentionPeriod = 1000
+	opts.MigrationFrequency = 0
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.MigrationFrequency = 1000
+	opts.ColdStorageDir = "cold"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.ColdStorageDir = coldDir
+	opts.CompressionFormat = "zstd"
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.CompressionFormat = "none"
+	opts.EmbeddedValues = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.EmbeddedValues = false
+	opts.Encrypted = true
+	require.ErrorIs(t, opts.Validate(), ErrIllegalArguments)
+
+	opts.Encrypted = false
+	require.NoError(t, opts.Validate())
+}
@@ -332,9 +332,10 @@ func (s *ImmuServer) ProofDocument(ctx context.Context, req *protomodel.ProofDoc
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"errors"
+
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
+)
+
+var ErrEncryptionKeySourceConflict = errors.New("encryption keyfile and key plugin can not be both specified")
+
+func (s *ImmuServer) createKeyProvider() (encryptedapp.KeyProvider, error) {
+	encOpts := s.Options.EncryptionOptions
+
+	if encOpts == nil {
+		return nil, nil
+	}
+
+	if encOpts.KeyFile != "" && encOpts.KeyPlugin != "" {
+		return nil, ErrEncryptionKeySourceConflict
+	}
+
+	if encOpts.KeyFile != "" {
+		return encryptedapp.OpenKeyFile(encOpts.KeyFile)
+	}
+
+	if encOpts.KeyPlugin != "" {
+		kp := encryptedapp.NewPluginKeyProvider(encOpts.KeyPlugin)
+
+		// fail early if the plugin is not able to provide the current key
+		_, _, err := kp.CurrentKey()
+		if err != nil {
+			return nil, err
+		}
+
+		return kp, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(dir, "keys")
+	err := os.WriteFile(keyFile, []byte("k1 000102030405060708090a0b0c0d0e0f\n"), 0600)
+	require.NoError(t, err)
+
+	t.Run("no encryption", func(t *testing.T) {
+		s, closer := testServer(DefaultOptions().WithEncryptionOptions(nil))
+		defer closer()
+
+		kp, err := s.createKeyProvider()
+		require.NoError(t, err)
+		require.Nil(t, kp)
+	})
+
+	t.Run("conflicting key sources", func(t *testing.T) {
+		encOpts := DefaultEncryptionOptions().
+			WithKeyFile(keyFile).
+			WithKeyPlugin("plugin")
+
+		s, closer := testServer(DefaultOptions().WithEncryptionOptions(encOpts))
+		defer closer()
+
+		_, err := s.createKeyProvider()
+		require.ErrorIs(t, err, ErrEncryptionKeySourceConflict)
+	})
+
+	t.Run("failing plugin", func(t *testing.T) {
+		encOpts := DefaultEncryptionOptions().WithKeyPlugin(filepath.Join(dir, "missing-plugin"))
+
+		s, closer := testServer(DefaultOptions().WithEncryptionOptions(encOpts))
+		defer closer()
+
+		_, err := s.createKeyProvider()
+		require.ErrorIs(t, err, encryptedapp.ErrKeyPluginFailure)
+	})
+
+	t.Run("keyfile", func(t *testing.T) {
+		encOpts := DefaultEncryptionOptions().WithKeyFile(keyFile)
+
+		s, closer := testServer(DefaultOptions().WithEncryptionOptions(encOpts))
+		defer closer()
+
+		kp, err := s.createKeyProvider()
+		require.NoError(t, err)
+
+		keyID, _, err := kp.CurrentKey()
+		require.NoError(t, err)
+		require.Equal(t, "k1", keyID)
+	})
+}
+
+func TestServerWithEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	err := os.WriteFile(keyFile, []byte("k1 000102030405060708090a0b0c0d0e0f\n"), 0600)
+	require.NoError(t, err)
+
+	opts := DefaultOptions().
+		WithDir(dir).
+		WithMetricsServer(false).
+		WithPgsqlServer(false).
+		WithEncryptionOptions(DefaultEncryptionOptions().WithKeyFile(keyFile))
+
+	s, closer := testServer(opts)
+
+	err = s.Initialize()
+	require.NoError(t, err)
+	require.NotNil(t, s.keyProvider)
+
+	dbOpts := s.defaultDBOptions(DefaultDBName, "")
+	require.True(t, dbOpts.Encrypted)
+	require.Equal(t, s.keyProvider, s.databaseOptionsFrom(dbOpts).GetStoreOptions().KeyProvider)
+
+	closer()
+
+	t.Run("restarting without encryption keys should fail", func(t *testing.T) {
+		opts := DefaultOptions().
+			WithDir(dir).
+			WithMetricsServer(false).
+			WithPgsqlServer(false)
+
+		s, closer := testServer(opts)
+		defer closer()
+
+		err := s.Initialize()
+		require.ErrorIs(t, err, encryptedapp.ErrKeyProviderRequired)
+	})
+
+	t.Run("restarting with encryption keys should succeed", func(t *testing.T) {
+		s, closer := testServer(opts)
+		defer closer()
+
+		err := s.Initialize()
+		require.NoError(t, err)
+	})
+}
@@ -57,6 +57,8 @@ var (
 	ErrTruncatorNotNeeded          = errors.New("truncator is not needed")
 	ErrTruncatorNotInProgress      = errors.New("truncation is not in progress")
 	ErrTruncatorDoesNotExist       = errors.New("truncator does not exist")
+	ErrMigratorNotNeeded           = errors.New("value tiering migrator is not needed")
+	ErrMigratorNotInProgress       = errors.New("value tiering migration is not in progress")
 )
 
 func mapServerError(err error) error {
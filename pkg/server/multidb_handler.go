@@ -64,7 +64,23 @@ func (h *multidbHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
 	isSysAdmin := user.Username == auth.SysAdminUsername
 
 	privileges := make([]sql.SQLPrivilege, 0, len(user.SQLPrivileges))
+	var tablePrivileges []sql.TablePrivilege
+
 	for _, p := range user.SQLPrivileges {
+		if p.Schema != "" || p.Table != "" {
+			if p.Database == db.GetName() {
+				tablePrivileges = append(tablePrivileges, sql.TablePrivilege{
+					Schema:          p.Schema,
+					Table:           p.Table,
+					TableID:         p.TableID,
+					Privilege:       sql.SQLPrivilege(p.Privilege),
+					Columns:         p.Columns,
+					ExcludedColumns: p.ExcludedColumns,
+				})
+			}
+			continue
+		}
+
 		if isSysAdmin || p.Database == db.GetName() {
 			privileges = append(privileges, sql.SQLPrivilege(p.Privilege))
 		}
@@ -72,9 +88,10 @@ func (h *multidbHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
 
 	permCode := user.WhichPermission(db.GetName())
 	return &User{
-		username:      user.Username,
-		perm:          sql.PermissionFromCode(permCode),
-		sqlPrivileges: privileges,
+		username:        user.Username,
+		perm:            sql.PermissionFromCode(permCode),
+		sqlPrivileges:   privileges,
+		tablePrivileges: tablePrivileges,
 	}, nil
 }
 
@@ -119,14 +136,35 @@ func (h *multidbHandler) ListUsers(ctx context.Context) ([]sql.User, error) {
 		}
 
 		privileges := make([]sql.SQLPrivilege, 0, len(user.SqlPrivileges))
+		var tablePrivileges []sql.TablePrivilege
+
 		for _, p := range user.SqlPrivileges {
+			if p.Schema != "" || p.Table != "" {
+				if p.Database == db.GetName() {
+					tablePrivileges = append(tablePrivileges, sql.TablePrivilege{
+						Schema:          p.Schema,
+						Table:           p.Table,
+						TableID:         p.TableID,
+						Privilege:       sql.SQLPrivilege(p.Privilege),
+						Columns:         p.Columns,
+						ExcludedColumns: p.ExcludedColumns,
+					})
+				}
+				continue
+			}
+
 			if isSysAdmin || p.Database == db.GetName() {
 				privileges = append(privileges, sql.SQLPrivilege(p.Privilege))
 			}
 		}
 
 		if perm != nil {
-			users = append(users, &User{username: string(user.User), perm: sql.PermissionFromCode(perm.Permission), sqlPrivileges: privileges})
+			users = append(users, &User{
+				username:        string(user.User),
+				perm:            sql.PermissionFromCode(perm.Permission),
+				sqlPrivileges:   privileges,
+				tablePrivileges: tablePrivileges,
+			})
 		}
 	}
 
@@ -143,9 +181,10 @@ func findPermission(permissions []*schema.Permission, database string) *schema.P
 }
 
 type User struct {
-	username      string
-	perm          sql.Permission
-	sqlPrivileges []sql.SQLPrivilege
+	username        string
+	perm            sql.Permission
+	sqlPrivileges   []sql.SQLPrivilege
+	tablePrivileges []sql.TablePrivilege
 }
 
 func (usr *User) Username() string {
@@ -160,6 +199,10 @@ func (usr *User) SQLPrivileges() []sql.SQLPrivilege {
 	return usr.sqlPrivileges
 }
 
+func (usr *User) TablePrivileges() []sql.TablePrivilege {
+	return usr.tablePrivileges
+}
+
 func permCode(permission sql.Permission) uint32 {
 	switch permission {
 	case sql.PermissionReadOnly:
@@ -254,6 +297,66 @@ func (h *multidbHandler) changeSQLPrivileges(ctx context.Context, database, user
 	return err
 }
 
+func (h *multidbHandler) GrantTablePrivileges(ctx context.Context, table *sql.Table, username string, privileges []sql.SQLPrivilege, columns []string) error {
+	return h.changeTablePrivileges(ctx, table, username, privileges, columns, schema.PermissionAction_GRANT)
+}
+
+func (h *multidbHandler) RevokeTablePrivileges(ctx context.Context, table *sql.Table, username string, privileges []sql.SQLPrivilege, columns []string) error {
+	return h.changeTablePrivileges(ctx, table, username, privileges, columns, schema.PermissionAction_REVOKE)
+}
+
+func (h *multidbHandler) changeTablePrivileges(ctx context.Context, table *sql.Table, username string, privileges []sql.SQLPrivilege, columns []string, action schema.PermissionAction) error {
+	db, err := h.s.getDBFromCtx(ctx, "ChangeSQLPrivileges")
+	if err != nil {
+		return err
+	}
+
+	ps := make([]string, len(privileges))
+	for i, p := range privileges {
+		ps[i] = string(p)
+	}
+
+	_, err = h.s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+		Action:     action,
+		Username:   username,
+		Database:   db.GetName(),
+		Table:      table.Name(),
+		TableID:    table.PrivilegeID(),
+		Columns:    columns,
+		Privileges: ps,
+	})
+	return err
+}
+
+func (h *multidbHandler) GrantSchemaPrivileges(ctx context.Context, schemaName, username string, privileges []sql.SQLPrivilege) error {
+	return h.changeSchemaPrivileges(ctx, schemaName, username, privileges, schema.PermissionAction_GRANT)
+}
+
+func (h *multidbHandler) RevokeSchemaPrivileges(ctx context.Context, schemaName, username string, privileges []sql.SQLPrivilege) error {
+	return h.changeSchemaPrivileges(ctx, schemaName, username, privileges, schema.PermissionAction_REVOKE)
+}
+
+func (h *multidbHandler) changeSchemaPrivileges(ctx context.Context, schemaName, username string, privileges []sql.SQLPrivilege, action schema.PermissionAction) error {
+	db, err := h.s.getDBFromCtx(ctx, "ChangeSQLPrivileges")
+	if err != nil {
+		return err
+	}
+
+	ps := make([]string, len(privileges))
+	for i, p := range privileges {
+		ps[i] = string(p)
+	}
+
+	_, err = h.s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+		Action:     action,
+		Username:   username,
+		Database:   db.GetName(),
+		Schema:     schemaName,
+		Privileges: ps,
+	})
+	return err
+}
+
 func (h *multidbHandler) DropUser(ctx context.Context, username string) error {
 	_, err := h.s.SetActiveUser(ctx, &schema.SetActiveUserRequest{
 		Username: username,
@@ -72,6 +72,8 @@ type Options struct {
 	SigningKey                  string
 	synced                      bool
 	RemoteStorageOptions        *RemoteStorageOptions
+	EncryptionOptions           *EncryptionOptions
+	ValueTieringOptions         *ValueTieringOptions
 	StreamChunkSize             int
 	TokenExpiryTimeMin          int
 	PgsqlServer                 bool
@@ -100,6 +102,21 @@ type RemoteStorageOptions struct {
 	S3InstanceMetadataURL string
 }
 
+// EncryptionOptions sets where the keys used to encrypt data at rest are taken from.
+// Only databases created while encryption is enabled are encrypted.
+type EncryptionOptions struct {
+	KeyFile   string
+	KeyPlugin string
+}
+
+// ValueTieringOptions sets where values of defaultdb are moved once they are older
+// than the hot retention period. The cold storage directory can not be changed once set.
+type ValueTieringOptions struct {
+	ColdStorageDir     string
+	HotRetentionPeriod time.Duration
+	MigrationFrequency time.Duration
+}
+
 type ReplicationOptions struct {
 	IsReplica                    bool
 	SyncReplication              bool
@@ -145,6 +162,8 @@ func DefaultOptions() *Options {
 		maintenance:                 false,
 		synced:                      true,
 		RemoteStorageOptions:        DefaultRemoteStorageOptions(),
+		EncryptionOptions:           DefaultEncryptionOptions(),
+		ValueTieringOptions:         DefaultValueTieringOptions(),
 		StreamChunkSize:             stream.DefaultChunkSize,
 		TokenExpiryTimeMin:          1440,
 		PgsqlServer:                 false,
@@ -167,6 +186,16 @@ func DefaultRemoteStorageOptions() *RemoteStorageOptions {
 	}
 }
 
+func DefaultEncryptionOptions() *EncryptionOptions {
+	return &EncryptionOptions{}
+}
+
+func DefaultValueTieringOptions() *ValueTieringOptions {
+	return &ValueTieringOptions{
+		MigrationFrequency: database.DefaultTieringFrequency,
+	}
+}
+
 func DefaultReplicationOptions() *ReplicationOptions {
 	return &ReplicationOptions{
 		IsReplica:                    false,
@@ -381,6 +410,18 @@ func (o *Options) String() string {
 		opts = append(opts, rightPad("   external id", o.RemoteStorageOptions.S3ExternalIdentifier))
 		opts = append(opts, rightPad("   metadata url", o.RemoteStorageOptions.S3InstanceMetadataURL))
 	}
+	if o.EncryptionOptions != nil && o.EncryptionOptions.KeyFile != "" {
+		opts = append(opts, rightPad("Encryption keyfile", o.EncryptionOptions.KeyFile))
+	}
+	if o.EncryptionOptions != nil && o.EncryptionOptions.KeyPlugin != "" {
+		opts = append(opts, rightPad("Encryption key plugin", o.EncryptionOptions.KeyPlugin))
+	}
+	if o.ValueTieringOptions != nil && o.ValueTieringOptions.ColdStorageDir != "" {
+		opts = append(opts, "Value tiering")
+		opts = append(opts, rightPad("   cold storage dir", o.ValueTieringOptions.ColdStorageDir))
+		opts = append(opts, rightPad("   hot retention", o.ValueTieringOptions.HotRetentionPeriod))
+		opts = append(opts, rightPad("   frequency", o.ValueTieringOptions.MigrationFrequency))
+	}
 	if o.AdminPassword == auth.SysAdminPassword {
 		opts = append(opts, "----------------------------------------")
 		opts = append(opts, "Superadmin default credentials")
@@ -507,6 +548,16 @@ func (o *Options) WithRemoteStorageOptions(remoteStorageOptions *RemoteStorageOp
 	return o
 }
 
+func (o *Options) WithValueTieringOptions(valueTieringOptions *ValueTieringOptions) *Options {
+	o.ValueTieringOptions = valueTieringOptions
+	return o
+}
+
+func (o *Options) WithEncryptionOptions(encryptionOptions *EncryptionOptions) *Options {
+	o.EncryptionOptions = encryptionOptions
+	return o
+}
+
 func (o *Options) WithReplicationOptions(replicationOptions *ReplicationOptions) *Options {
 	o.ReplicationOptions = replicationOptions
 	return o
@@ -542,6 +593,35 @@ func (o *Options) WithMaxActiveDatabases(n int) *Options {
 	return o
 }
 
+// EncryptionOptions
+
+func (opts *EncryptionOptions) WithKeyFile(keyFile string) *EncryptionOptions {
+	opts.KeyFile = keyFile
+	return opts
+}
+
+func (opts *EncryptionOptions) WithKeyPlugin(keyPlugin string) *EncryptionOptions {
+	opts.KeyPlugin = keyPlugin
+	return opts
+}
+
+// ValueTieringOptions
+
+func (opts *ValueTieringOptions) WithColdStorageDir(coldStorageDir string) *ValueTieringOptions {
+	opts.ColdStorageDir = coldStorageDir
+	return opts
+}
+
+func (opts *ValueTieringOptions) WithHotRetentionPeriod(hotRetentionPeriod time.Duration) *ValueTieringOptions {
+	opts.HotRetentionPeriod = hotRetentionPeriod
+	return opts
+}
+
+func (opts *ValueTieringOptions) WithMigrationFrequency(migrationFrequency time.Duration) *ValueTieringOptions {
+	opts.MigrationFrequency = migrationFrequency
+	return opts
+}
+
 // RemoteStorageOptions
 
 func (opts *RemoteStorageOptions) WithS3Storage(S3Storage bool) *RemoteStorageOptions {
@@ -11,17 +11,11 @@ import (
 )
 
 func (s *ImmuServer) InjectRequestMetadataUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if !s.Options.LogRequestMetadata {
-		return handler(ctx, req)
-	}
 	return handler(s.withRequestMetadata(ctx), req)
 }
 
 func (s *ImmuServer) InjectRequestMetadataStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	ctx := ss.Context()
-	if !s.Options.LogRequestMetadata {
-		return handler(srv, ss)
-	}
 	return handler(srv, &serverStreamWithContext{ServerStream: ss, ctx: s.withRequestMetadata(ctx)})
 }
 
@@ -34,11 +28,10 @@ func (s *serverStreamWithContext) Context() context.Context {
 	return s.ctx
 }
 
+// withRequestMetadata records the name of the authenticated user in the metadata of the txs
+// committed by the request, so that it can be queried using the _tx_user column.
+// The address of the client is also recorded when request metadata logging is enabled.
 func (s *ImmuServer) withRequestMetadata(ctx context.Context) context.Context {
-	if !s.Options.LogRequestMetadata {
-		return ctx
-	}
-
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return ctx
@@ -48,9 +41,11 @@ func (s *ImmuServer) withRequestMetadata(ctx context.Context) context.Context {
 		schema.UserRequestMetadataKey: user.Username,
 	}
 
-	ip := ipAddrFromContext(ctx)
-	if len(ip) > 0 {
-		md[schema.IpRequestMetadataKey] = ip
+	if s.Options.LogRequestMetadata {
+		ip := ipAddrFromContext(ctx)
+		if len(ip) > 0 {
+			md[schema.IpRequestMetadataKey] = ip
+		}
 	}
 	return schema.ContextWithMetadata(ctx, md)
 }
@@ -134,6 +134,11 @@ func (s *ImmuServer) Initialize() error {
 		return logErr(s.Logger, "unable to initialize remote storage: %v", err)
 	}
 
+	s.keyProvider, err = s.createKeyProvider()
+	if err != nil {
+		return logErr(s.Logger, "unable to initialize encryption key provider: %v", err)
+	}
+
 	// NOTE: MaxActiveDatabases might have changed since the server instance was created
 	s.dbList.Resize(s.Options.MaxActiveDatabases)
 
@@ -572,6 +577,18 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string, remoteStorage remotesto
 		return fmt.Errorf("%w: while loading '%s' database settings", err, s.Options.GetDefaultDBName())
 	}
 
+	if dbOpts.ColdStorageDir != "" {
+		// value tiering settings of defaultdb are taken from server flags
+		if remoteStorage != nil {
+			return fmt.Errorf("%w: value tiering is not supported with remote storage", ErrIllegalArguments)
+		}
+
+		err = dbOpts.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	defaultDbRootDir := s.OS.Join(dataDir, s.Options.GetDefaultDBName())
 
 	_, err = s.OS.Stat(defaultDbRootDir)
@@ -585,6 +602,13 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string, remoteStorage remotesto
 			}
 		}
 
+		if dbOpts.isValueTieringEnabled() {
+			err = s.startMigratorFor(db, dbOpts)
+			if err != nil {
+				s.Logger.Errorf("error starting value tiering for database '%s'. Reason: %v", db.GetName(), err)
+			}
+		}
+
 		return nil
 	}
 
@@ -604,6 +628,13 @@ func (s *ImmuServer) loadDefaultDatabase(dataDir string, remoteStorage remotesto
 		}
 	}
 
+	if dbOpts.isValueTieringEnabled() {
+		err = s.startMigratorFor(db, dbOpts)
+		if err != nil {
+			s.Logger.Errorf("error starting value tiering for database '%s'. Reason: %v", db.GetName(), err)
+		}
+	}
+
 	return nil
 }
 
@@ -663,6 +694,13 @@ func (s *ImmuServer) loadUserDatabases(dataDir string, remoteStorage remotestora
 				s.Logger.Errorf("error starting truncation for database '%s'. Reason: %v", db.GetName(), err)
 			}
 		}
+
+		if dbOpts.isValueTieringEnabled() {
+			err = s.startMigratorFor(db, dbOpts)
+			if err != nil {
+				s.Logger.Errorf("error starting value tiering for database '%s'. Reason: %v", db.GetName(), err)
+			}
+		}
 	}
 
 	return nil
@@ -767,6 +805,8 @@ func (s *ImmuServer) Stop() error {
 
 	s.stopTruncation()
 
+	s.stopValueTiering()
+
 	return s.CloseDatabases()
 }
 
@@ -1047,6 +1087,11 @@ func (s *ImmuServer) CreateDatabaseV2(ctx context.Context, req *schema.CreateDat
 		return nil, fmt.Errorf("%w: while starting truncation", err)
 	}
 
+	err = s.startMigratorFor(db, dbOpts)
+	if err != nil && err != ErrMigratorNotNeeded {
+		return nil, fmt.Errorf("%w: while starting value tiering", err)
+	}
+
 	return &schema.CreateDatabaseResponse{
 		Name:     req.Name,
 		Settings: dbOpts.databaseNullableSettings(),
@@ -1121,6 +1166,11 @@ func (s *ImmuServer) LoadDatabase(ctx context.Context, req *schema.LoadDatabaseR
 		return nil, fmt.Errorf("%w: while starting truncation", err)
 	}
 
+	err = s.startMigratorFor(db, dbOpts)
+	if err != nil && err != ErrMigratorNotNeeded {
+		return nil, fmt.Errorf("%w: while starting value tiering", err)
+	}
+
 	return &schema.LoadDatabaseResponse{
 		Database: req.Database,
 	}, nil
@@ -1191,6 +1241,13 @@ func (s *ImmuServer) UnloadDatabase(ctx context.Context, req *schema.UnloadDatab
 		}
 	}
 
+	if dbOpts.isValueTieringEnabled() {
+		err = s.stopMigratorFor(req.Database)
+		if err != nil && err != ErrMigratorNotInProgress {
+			return nil, fmt.Errorf("%w: while stopping value tiering", err)
+		}
+	}
+
 	err = db.Close()
 	if err != nil {
 		return nil, err
@@ -1349,6 +1406,14 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 		return nil, err
 	}
 
+	// the migrator keeps running when the new settings are rejected
+	if req.Settings.ValueTieringSettings != nil && !db.IsClosed() {
+		err = s.stopMigratorFor(req.Database)
+		if err != nil && err != ErrMigratorNotInProgress {
+			return nil, fmt.Errorf("%w: while stopping value tiering", err)
+		}
+	}
+
 	dbOpts.UpdatedBy = user.Username
 
 	err = s.saveDBOptions(dbOpts)
@@ -1376,6 +1441,13 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 		}
 	}
 
+	if req.Settings.ValueTieringSettings != nil && !db.IsClosed() {
+		err = s.startMigratorFor(db, dbOpts)
+		if err != nil && err != ErrMigratorNotNeeded {
+			return nil, fmt.Errorf("%w: while starting value tiering", err)
+		}
+	}
+
 	return &schema.UpdateDatabaseResponse{
 		Database: req.Database,
 		Settings: dbOpts.databaseNullableSettings(),
@@ -884,6 +884,19 @@ func testServerSetGetBatch(ctx context.Context, s *ImmuServer, t *testing.T) {
 	_, err = s.CompactIndex(ctx, &emptypb.Empty{})
 	require.ErrorIs(t, err, tbtree.ErrCompactionThresholdNotReached)
 
+	_, err = s.RebuildIndex(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	verifyRes, err := s.VerifyIndex(ctx, &schema.VerifyIndexRequest{MaxDiscrepancies: 1})
+	require.NoError(t, err)
+
+	for _, idx := range verifyRes.Indexes {
+		require.Zero(t, idx.DiscrepancyCount)
+	}
+
+	_, err = s.CompactValues(ctx, &schema.CompactValuesRequest{})
+	require.NoError(t, err)
+
 	_, err = s.GetAll(ctx, nil)
 	require.ErrorIs(t, err, store.ErrIllegalArguments)
 
@@ -182,6 +182,10 @@ func (s *ServerMock) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 	return s.Srv.VerifiableGet(ctx, req)
 }
 
+func (s *ServerMock) VerifiableGetKeyProof(ctx context.Context, req *schema.VerifiableGetKeyProofRequest) (*schema.VerifiableKeyProof, error) {
+	return s.Srv.VerifiableGetKeyProof(ctx, req)
+}
+
 func (s *ServerMock) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	return s.Srv.GetAll(ctx, req)
 }
@@ -190,6 +194,14 @@ func (s *ServerMock) Delete(ctx context.Context, req *schema.DeleteKeysRequest)
 	return s.Srv.Delete(ctx, req)
 }
 
+func (s *ServerMock) DeleteRange(ctx context.Context, req *schema.DeleteRangeRequest) (*schema.TxHeader, error) {
+	return s.Srv.DeleteRange(ctx, req)
+}
+
+func (s *ServerMock) VerifiableDeleteRange(ctx context.Context, req *schema.VerifiableDeleteRangeRequest) (*schema.VerifiableTx, error) {
+	return s.Srv.VerifiableDeleteRange(ctx, req)
+}
+
 func (s *ServerMock) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	if s.PostExecAllFn == nil {
 		return s.Srv.ExecAll(ctx, req)
@@ -343,6 +355,18 @@ func (s *ServerMock) CompactIndex(ctx context.Context, req *empty.Empty) (*empty
 	return s.Srv.CompactIndex(ctx, req)
 }
 
+func (s *ServerMock) RebuildIndex(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return s.Srv.RebuildIndex(ctx, req)
+}
+
+func (s *ServerMock) VerifyIndex(ctx context.Context, req *schema.VerifyIndexRequest) (*schema.VerifyIndexResponse, error) {
+	return s.Srv.VerifyIndex(ctx, req)
+}
+
+func (s *ServerMock) CompactValues(ctx context.Context, req *schema.CompactValuesRequest) (*schema.CompactValuesResponse, error) {
+	return s.Srv.CompactValues(ctx, req)
+}
+
 func (s *ServerMock) ChangePermission(ctx context.Context, req *schema.ChangePermissionRequest) (*empty.Empty, error) {
 	return s.Srv.ChangePermission(ctx, req)
 }
@@ -39,3 +39,4 @@ var ErrMaxSessionsReached = fmt.Errorf("%w: max sessions number reached", ErrCan
 var ErrCantCreateSessionID = fmt.Errorf("%w: generation of session id failed", ErrCantCreateSession)
 var ErrWriteOnlyTXNotAllowed = errors.New("write only transaction not allowed")
 var ErrReadOnlyTXNotAllowed = errors.New("read only transaction not allowed")
+var ErrMaxCursorsReached = errors.New("max cursors number reached")
@@ -149,6 +149,10 @@ func (sm *manager) deleteSession(sessionID string) error {
 		merr.Append(err)
 	}
 
+	if err := sess.CloseCursors(); err != nil {
+		merr.Append(err)
+	}
+
 	if err := sess.RollbackTransactions(); err != nil {
 		merr.Append(err)
 	}
@@ -18,6 +18,7 @@ package sessions
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -38,6 +39,9 @@ import (
 // DefaultMaxDocumentReadersCacheSize is the default maximum number of document readers to keep in cache
 const DefaultMaxDocumentReadersCacheSize = 1
 
+// DefaultMaxCursors is the maximum number of SQL cursors which can be open at once within a session
+const DefaultMaxCursors = 16
+
 var (
 	ErrPaginatedDocumentReaderNotFound = errors.New("document reader not found")
 )
@@ -58,6 +62,7 @@ type Session struct {
 	lastActivityTime time.Time
 	transactions     map[string]transactions.Transaction
 	documentReaders  *cache.Cache // track searchID to document.DocumentReader
+	cursors          map[string]*sql.Cursor
 	log              logger.Logger
 }
 
@@ -74,6 +79,7 @@ func NewSession(sessionID string, user *auth.User, db database.DB, log logger.Lo
 		transactions:     make(map[string]transactions.Transaction),
 		log:              log,
 		documentReaders:  lruCache,
+		cursors:          make(map[string]*sql.Cursor),
 	}
 }
 
@@ -81,6 +87,11 @@ func (s *Session) NewTransaction(ctx context.Context, opts *sql.TxOptions) (tran
 	s.mux.Lock()
 	defer s.mux.Unlock()
 
+	if opts != nil {
+		// cursors declared within the transaction are kept open by the session
+		opts.WithCursors(s)
+	}
+
 	tx, err := transactions.NewTransaction(ctx, opts, s.database, s.id)
 	if err != nil {
 		return nil, err
@@ -130,6 +141,72 @@ func (s *Session) CloseDocumentReaders() error {
 	return merr.Reduce()
 }
 
+// AddCursor keeps the cursor open until it is closed or the session ends
+func (s *Session) AddCursor(cursor *sql.Cursor) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, exists := s.cursors[cursor.Name()]; exists {
+		return fmt.Errorf("%w (%s)", sql.ErrCursorAlreadyExists, cursor.Name())
+	}
+
+	if len(s.cursors) >= DefaultMaxCursors {
+		return ErrMaxCursorsReached
+	}
+
+	s.cursors[cursor.Name()] = cursor
+	return nil
+}
+
+func (s *Session) GetCursor(name string) (*sql.Cursor, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	cursor, exists := s.cursors[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", sql.ErrCursorDoesNotExist, name)
+	}
+	return cursor, nil
+}
+
+func (s *Session) CloseCursor(name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	cursor, exists := s.cursors[name]
+	if !exists {
+		return fmt.Errorf("%w (%s)", sql.ErrCursorDoesNotExist, name)
+	}
+
+	delete(s.cursors, name)
+
+	return cursor.Close()
+}
+
+func (s *Session) GetCursorsCount() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	return len(s.cursors)
+}
+
+func (s *Session) CloseCursors() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	merr := multierr.NewMultiErr()
+
+	for name, cursor := range s.cursors {
+		if err := cursor.Close(); err != nil {
+			s.log.Errorf("Error while closing cursor %s: %v", name, err)
+			merr.Append(err)
+		}
+		delete(s.cursors, name)
+	}
+
+	return merr.Reduce()
+}
+
 func (s *Session) RollbackTransactions() error {
 	s.mux.Lock()
 	defer s.mux.Unlock()
@@ -40,9 +40,10 @@ func (s *ImmuServer) VerifiableSQLGet(ctx context.Context, req *schema.Verifiabl
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -66,7 +67,7 @@ func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*
 		return nil, err
 	}
 
-	tx, err := db.NewSQLTx(ctx, sql.DefaultTxOptions())
+	tx, err := db.NewSQLTx(ctx, s.sqlTxOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +110,21 @@ func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*
 	return res, err
 }
 
+// sqlTxOptions returns the options of the transactions used to serve SQL calls.
+// Calls made within a session can use the cursors declared within it.
+func (s *ImmuServer) sqlTxOptions(ctx context.Context) *sql.TxOptions {
+	opts := sql.DefaultTxOptions()
+
+	if s.SessManager == nil {
+		return opts
+	}
+
+	if sess, err := s.SessManager.GetSessionFromContext(ctx); err == nil {
+		opts.WithCursors(sess)
+	}
+	return opts
+}
+
 func (s *ImmuServer) UnarySQLQuery(ctx context.Context, req *schema.SQLQueryRequest) (*schema.SQLQueryResult, error) {
 	var sqlRes *schema.SQLQueryResult
 	err := s.sqlQuery(ctx, req, func(res *schema.SQLQueryResult) error {
@@ -124,7 +140,7 @@ func (s *ImmuServer) sqlQuery(ctx context.Context, req *schema.SQLQueryRequest,
 		return err
 	}
 
-	tx, err := db.NewSQLTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	tx, err := db.NewSQLTx(ctx, s.sqlTxOptions(ctx).WithReadOnly(true))
 	if err != nil {
 		return err
 	}
@@ -193,6 +193,88 @@ func TestSQLExecCreateDatabase(t *testing.T) {
 	require.ErrorContains(t, err, sql.ErrDatabaseAlreadyExists.Error())
 }
 
+func TestSQLCursors(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithMetricsServer(false)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	s.Initialize()
+
+	ctx := context.Background()
+
+	lr, err := s.Login(ctx, &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	_, err = s.SQLExec(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token)),
+		&schema.SQLExecRequest{Sql: "DECLARE c CURSOR FOR SELECT * FROM table1"},
+	)
+	require.ErrorIs(t, err, sql.ErrCursorsNotAvailable)
+
+	resp, err := s.OpenSession(ctx, &schema.OpenSessionRequest{
+		Username:     []byte(auth.SysAdminUsername),
+		Password:     []byte(auth.SysAdminPassword),
+		DatabaseName: DefaultDBName,
+	})
+	require.NoError(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"sessionid": resp.GetSessionID()}))
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "CREATE TABLE table1 (id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id)"})
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "INSERT INTO table1 (name) VALUES ('name')"})
+		require.NoError(t, err)
+	}
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "DECLARE c CURSOR FOR SELECT id FROM table1"})
+	require.NoError(t, err)
+
+	sess, err := s.SessManager.GetSession(resp.GetSessionID())
+	require.NoError(t, err)
+	require.Equal(t, 1, sess.GetCursorsCount())
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "DELETE FROM table1 WHERE id > 0"})
+	require.NoError(t, err)
+
+	fetch := func(sql string) []int64 {
+		res, err := s.UnarySQLQuery(ctx, &schema.SQLQueryRequest{Sql: sql})
+		require.NoError(t, err)
+
+		ids := make([]int64, len(res.Rows))
+		for i, row := range res.Rows {
+			ids[i] = row.Values[0].GetN()
+		}
+		return ids
+	}
+
+	require.Equal(t, []int64{1, 2}, fetch("FETCH 2 FROM c"))
+	require.Equal(t, []int64{3, 4, 5}, fetch("FETCH ALL FROM c"))
+	require.Empty(t, fetch("FETCH NEXT FROM c"))
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "CLOSE c"})
+	require.NoError(t, err)
+	require.Zero(t, sess.GetCursorsCount())
+
+	_, err = s.UnarySQLQuery(ctx, &schema.SQLQueryRequest{Sql: "FETCH 2 FROM c"})
+	require.ErrorContains(t, err, sql.ErrCursorDoesNotExist.Error())
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "DECLARE c CURSOR FOR SELECT id FROM table1"})
+	require.NoError(t, err)
+
+	_, err = s.CloseSession(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Zero(t, sess.GetCursorsCount())
+}
+
 type ImmuService_SQLQueryServerMock struct {
 	grpc.ServerStream
 	sendFunc func(*schema.SQLQueryResult) error
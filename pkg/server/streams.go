@@ -135,9 +135,10 @@ func (s *ImmuServer) StreamVerifiableGet(req *schema.VerifiableGetRequest, str s
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -268,9 +269,10 @@ func (s *ImmuServer) StreamVerifiableSet(str schema.ImmuService_StreamVerifiable
 		alh := hdr.Alh()
 
 		newState := &schema.ImmutableState{
-			Db:     db.GetName(),
-			TxId:   hdr.ID,
-			TxHash: alh[:],
+			Db:            db.GetName(),
+			TxId:          hdr.ID,
+			TxHash:        alh[:],
+			HashAlgorithm: schema.HashAlgorithmToProto(hdr.HashAlgorithm),
 		}
 
 		err = s.StateSigner.Sign(newState)
@@ -24,8 +24,10 @@ import (
 	"sync"
 
 	"github.com/codenotary/immudb/pkg/server/sessions"
+	"github.com/codenotary/immudb/pkg/tiering"
 	"github.com/codenotary/immudb/pkg/truncator"
 
+	"github.com/codenotary/immudb/embedded/appendable/encryptedapp"
 	"github.com/codenotary/immudb/embedded/remotestorage"
 	pgsqlsrv "github.com/codenotary/immudb/pkg/pgsql/server"
 	"github.com/codenotary/immudb/pkg/replication"
@@ -66,6 +68,9 @@ type ImmuServer struct {
 	truncators     map[string]*truncator.Truncator
 	truncatorMutex sync.Mutex
 
+	migrators     map[string]*tiering.Migrator
+	migratorMutex sync.Mutex
+
 	Logger      logger.Logger
 	Options     *Options
 	Listener    net.Listener
@@ -86,6 +91,7 @@ type ImmuServer struct {
 	PgsqlSrv             pgsqlsrv.PGSQLServer
 
 	remoteStorage remotestorage.Storage
+	keyProvider   encryptedapp.KeyProvider
 	SessManager   sessions.Manager
 }
 
@@ -95,6 +101,7 @@ func DefaultServer() *ImmuServer {
 		OS:                   immuos.NewStandardOS(),
 		replicators:          make(map[string]*replication.TxReplicator),
 		truncators:           make(map[string]*truncator.Truncator),
+		migrators:            make(map[string]*tiering.Migrator),
 		Logger:               logger.NewSimpleLogger("immudb ", os.Stderr),
 		Options:              DefaultOptions(),
 		quit:                 make(chan struct{}),
@@ -270,7 +270,15 @@ func toSchemaUser(u *auth.User) (*schema.User, error) {
 
 	privileges := make([]*schema.SQLPrivilege, len(u.SQLPrivileges))
 	for i, p := range u.SQLPrivileges {
-		privileges[i] = &schema.SQLPrivilege{Database: p.Database, Privilege: p.Privilege}
+		privileges[i] = &schema.SQLPrivilege{
+			Database:        p.Database,
+			Privilege:       p.Privilege,
+			Schema:          p.Schema,
+			Table:           p.Table,
+			TableID:         p.TableID,
+			Columns:         p.Columns,
+			ExcludedColumns: p.ExcludedColumns,
+		}
 	}
 
 	return &schema.User{
@@ -649,14 +657,16 @@ func (s *ImmuServer) ChangeSQLPrivileges(ctx context.Context, r *schema.ChangeSQ
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
+	db, err := s.dbList.GetByName(r.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	// sanitize input
 	{
 		if len(r.Username) == 0 {
 			return nil, status.Errorf(codes.InvalidArgument, "username can not be empty")
 		}
-		if _, err := s.dbList.GetByName(r.Database); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, err.Error())
-		}
 		if (r.Action != schema.PermissionAction_GRANT) &&
 			(r.Action != schema.PermissionAction_REVOKE) {
 			return nil, status.Errorf(codes.InvalidArgument, "action not recognized")
@@ -668,9 +678,26 @@ func (s *ImmuServer) ChangeSQLPrivileges(ctx context.Context, r *schema.ChangeSQ
 		if !isValidPrivilege(p) {
 			return nil, status.Errorf(codes.InvalidArgument, "SQL privilege not recognized")
 		}
+		if r.Table != "" && !sql.IsTablePrivilege(sql.SQLPrivilege(p)) {
+			return nil, status.Errorf(codes.InvalidArgument, "SQL privilege %s can not be granted on a table", p)
+		}
+		if r.Schema != "" && !sql.IsTablePrivilege(sql.SQLPrivilege(p)) {
+			return nil, status.Errorf(codes.InvalidArgument, "SQL privilege %s can not be granted on a schema", p)
+		}
+		if len(r.Columns) > 0 && sql.SQLPrivilege(p) != sql.SQLPrivilegeSelect {
+			return nil, status.Errorf(codes.InvalidArgument, "only the SELECT privilege can be restricted to columns")
+		}
 		privileges[i] = string(p)
 	}
 
+	if r.Table == "" && len(r.Columns) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "columns can only be specified together with a table")
+	}
+
+	if r.Table != "" && r.Schema != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "privileges can be granted either on a table or on a schema")
+	}
+
 	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
 	if err != nil {
 		return nil, err
@@ -708,9 +735,26 @@ func (s *ImmuServer) ChangeSQLPrivileges(ctx context.Context, r *schema.ChangeSQ
 		}
 	}
 
-	if r.Action == schema.PermissionAction_REVOKE {
+	if r.Table != "" && r.TableID == 0 {
+		// privileges are bound to the table id so they are not inherited by a new table with the same name
+		r.TableID, err = db.GetTableID(ctx, r.Table)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	switch {
+	case r.Schema != "" && r.Action == schema.PermissionAction_REVOKE:
+		targetUser.RevokeSchemaSQLPrivileges(r.Database, r.Schema, privileges)
+	case r.Schema != "":
+		targetUser.GrantSchemaSQLPrivileges(r.Database, r.Schema, privileges)
+	case r.Table != "" && r.Action == schema.PermissionAction_REVOKE:
+		targetUser.RevokeTableSQLPrivileges(r.Database, r.TableID, privileges, r.Columns)
+	case r.Table != "":
+		targetUser.GrantTableSQLPrivileges(r.Database, r.TableID, r.Table, privileges, r.Columns)
+	case r.Action == schema.PermissionAction_REVOKE:
 		targetUser.RevokeSQLPrivileges(r.Database, privileges)
-	} else {
+	default:
 		targetUser.GrantSQLPrivileges(r.Database, privileges)
 	}
 
@@ -738,7 +782,8 @@ func isValidPrivilege(p string) bool {
 		sql.SQLPrivilegeUpdate,
 		sql.SQLPrivilegeDelete,
 		sql.SQLPrivilegeDrop,
-		sql.SQLPrivilegeAlter:
+		sql.SQLPrivilegeAlter,
+		sql.SQLPrivilegeExecute:
 		return true
 	}
 	return false
@@ -317,7 +317,7 @@ func TestServerCreateUser(t *testing.T) {
 
 	u := users.Users[1]
 	require.Equal(t, string(u.User), string(testUsername))
-	require.Equal(t, u.SqlPrivileges, []*schema.SQLPrivilege{{Database: testDatabase, Privilege: string(sql.SQLPrivilegeSelect)}, {Database: testDatabase, Privilege: string(sql.SQLPrivilegeUpdate)}})
+	require.Equal(t, u.SqlPrivileges, []*schema.SQLPrivilege{{Database: testDatabase, Privilege: string(sql.SQLPrivilegeSelect)}, {Database: testDatabase, Privilege: string(sql.SQLPrivilegeExecute)}, {Database: testDatabase, Privilege: string(sql.SQLPrivilegeUpdate)}})
 
 	userCtx, err := loginAsUser(s, string(testUsername), string(testPassword))
 	require.NoError(t, err)
@@ -370,10 +370,204 @@ func TestServerCreateUser(t *testing.T) {
 	require.ErrorIs(t, err, sql.ErrAccessDenied)
 }
 
+func TestServerTableSQLPrivileges(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	s.Initialize()
+
+	ctx, err := loginAsUser(s, auth.SysAdminUsername, auth.SysAdminPassword)
+	require.NoError(t, err)
+
+	_, err = s.CreateDatabaseWith(ctx, &schema.DatabaseSettings{
+		DatabaseName: testDatabase,
+	})
+	require.NoError(t, err)
+
+	reply, err := s.UseDatabase(ctx, &schema.Database{DatabaseName: testDatabase})
+	require.NoError(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", reply.Token))
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE customers(id INTEGER AUTO_INCREMENT, name VARCHAR, ssn VARCHAR, PRIMARY KEY id);
+		INSERT INTO customers(name, ssn) VALUES ('John', '123-45-6789');
+	`})
+	require.NoError(t, err)
+
+	_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       testUsername,
+		Password:   testPassword,
+		Database:   testDatabase,
+		Permission: auth.PermissionR,
+	})
+	require.NoError(t, err)
+
+	_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+		Action:     schema.PermissionAction_GRANT,
+		Username:   string(testUsername),
+		Database:   testDatabase,
+		Table:      "customers",
+		Privileges: []string{string(sql.SQLPrivilegeCreate)},
+	})
+	require.ErrorContains(t, err, "can not be granted on a table")
+
+	_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+		Action:     schema.PermissionAction_GRANT,
+		Username:   string(testUsername),
+		Database:   testDatabase,
+		Table:      "customers",
+		Columns:    []string{"name"},
+		Privileges: []string{string(sql.SQLPrivilegeUpdate)},
+	})
+	require.ErrorContains(t, err, "only the SELECT privilege can be restricted to columns")
+
+	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: fmt.Sprintf(`
+		REVOKE ALL PRIVILEGES ON DATABASE %s TO USER %s;
+		GRANT SELECT (id, name) ON TABLE customers TO USER %s;
+	`, testDatabase, testUsername, testUsername)})
+	require.NoError(t, err)
+
+	users, err := s.ListUsers(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, users.Users, 2)
+
+	u := users.Users[1]
+	require.Equal(t, string(u.User), string(testUsername))
+	require.Equal(t, []*schema.SQLPrivilege{{
+		Database:  testDatabase,
+		Privilege: string(sql.SQLPrivilegeSelect),
+		Table:     "customers",
+		TableID:   1,
+		Columns:   []string{"id", "name"},
+	}}, u.SqlPrivileges)
+
+	userCtx, err := loginAsUser(s, string(testUsername), string(testPassword))
+	require.NoError(t, err)
+
+	reply, err = s.UseDatabase(userCtx, &schema.Database{DatabaseName: testDatabase})
+	require.NoError(t, err)
+
+	userCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", reply.Token))
+
+	res, err := s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id, name FROM customers"})
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+
+	_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM customers"})
+	require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+	_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT ssn FROM customers"})
+	require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+	t.Run("privileges can be granted on a schema", func(t *testing.T) {
+		_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: `
+			CREATE SCHEMA sales;
+			CREATE TABLE sales.orders(id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+			INSERT INTO sales.orders(amount) VALUES (10);
+		`})
+		require.NoError(t, err)
+
+		_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   string(testUsername),
+			Database:   testDatabase,
+			Schema:     "sales",
+			Table:      "orders",
+			Privileges: []string{string(sql.SQLPrivilegeSelect)},
+		})
+		require.ErrorContains(t, err, "either on a table or on a schema")
+
+		_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   string(testUsername),
+			Database:   testDatabase,
+			Schema:     "sales",
+			Privileges: []string{string(sql.SQLPrivilegeCreate)},
+		})
+		require.ErrorContains(t, err, "can not be granted on a schema")
+
+		_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM sales.orders"})
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+		_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   string(testUsername),
+			Database:   testDatabase,
+			Schema:     "sales",
+			Privileges: []string{string(sql.SQLPrivilegeSelect)},
+		})
+		require.NoError(t, err)
+
+		// changing privileges invalidates the sessions of the user
+		userCtx, err := loginAsUser(s, string(testUsername), string(testPassword))
+		require.NoError(t, err)
+
+		reply, err := s.UseDatabase(userCtx, &schema.Database{DatabaseName: testDatabase})
+		require.NoError(t, err)
+
+		userCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", reply.Token))
+
+		res, err := s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id, amount FROM sales.orders"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+	})
+
+	t.Run("privileges are not inherited by a new table with the same name", func(t *testing.T) {
+		_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   string(testUsername),
+			Database:   testDatabase,
+			Table:      "customers",
+			Privileges: []string{string(sql.SQLPrivilegeSelect)},
+		})
+		require.NoError(t, err)
+
+		_, err = s.ChangeSQLPrivileges(ctx, &schema.ChangeSQLPrivilegesRequest{
+			Action:     schema.PermissionAction_REVOKE,
+			Username:   string(testUsername),
+			Database:   testDatabase,
+			Table:      "customers",
+			Columns:    []string{"ssn"},
+			Privileges: []string{string(sql.SQLPrivilegeSelect)},
+		})
+		require.NoError(t, err)
+
+		userCtx, err := loginAsUser(s, string(testUsername), string(testPassword))
+		require.NoError(t, err)
+
+		reply, err := s.UseDatabase(userCtx, &schema.Database{DatabaseName: testDatabase})
+		require.NoError(t, err)
+
+		userCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", reply.Token))
+
+		_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id, name FROM customers"})
+		require.NoError(t, err)
+
+		_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT ssn FROM customers"})
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+		_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: `
+			DROP TABLE customers;
+			CREATE TABLE customers(id INTEGER AUTO_INCREMENT, name VARCHAR, PRIMARY KEY id);
+		`})
+		require.NoError(t, err)
+
+		_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id, name FROM customers"})
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+	})
+}
+
 func TestUnmarshalUserWithNoPrivileges(t *testing.T) {
 	u, err := unmarshalSchemaUser([]byte(`{"hasPrivileges": false, "permissions": [{"permission": 1, "database": "immudb"}]}`))
 	require.NoError(t, err)
-	require.Equal(t, u.SqlPrivileges, []*schema.SQLPrivilege{{Database: "immudb", Privilege: string(sql.SQLPrivilegeSelect)}})
+	require.Equal(t, u.SqlPrivileges, []*schema.SQLPrivilege{{Database: "immudb", Privilege: string(sql.SQLPrivilegeSelect)}, {Database: "immudb", Privilege: string(sql.SQLPrivilegeExecute)}})
 }
 
 func loginAsUser(s *ImmuServer, username, password string) (context.Context, error) {
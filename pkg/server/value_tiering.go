@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"time"
+
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/tiering"
+)
+
+func (s *ImmuServer) isMigratorRunningFor(db string) bool {
+	_, ok := s.migrators[db]
+	return ok
+}
+
+func (s *ImmuServer) startMigratorFor(db database.DB, dbOpts *dbOptions) error {
+	if !dbOpts.isValueTieringEnabled() {
+		s.Logger.Infof("Value tiering for database '%s' is not required.", db.GetName())
+		return ErrMigratorNotNeeded
+	}
+
+	s.migratorMutex.Lock()
+	defer s.migratorMutex.Unlock()
+
+	if s.isMigratorRunningFor(db.GetName()) {
+		return tiering.ErrMigratorAlreadyRunning
+	}
+
+	hrp := time.Millisecond * time.Duration(dbOpts.HotRetentionPeriod)
+	mf := time.Millisecond * time.Duration(dbOpts.MigrationFrequency)
+
+	m := tiering.NewMigrator(db, hrp, mf, s.Logger)
+	err := m.Start()
+	if err != nil {
+		return err
+	}
+
+	s.migrators[db.GetName()] = m
+
+	return nil
+}
+
+func (s *ImmuServer) stopMigratorFor(db string) error {
+	s.migratorMutex.Lock()
+	defer s.migratorMutex.Unlock()
+
+	m, ok := s.migrators[db]
+	if !ok {
+		return ErrMigratorNotInProgress
+	}
+
+	err := m.Stop()
+	if err == tiering.ErrMigratorAlreadyStopped {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	delete(s.migrators, db)
+
+	return nil
+}
+
+func (s *ImmuServer) stopValueTiering() {
+	s.migratorMutex.Lock()
+	defer s.migratorMutex.Unlock()
+
+	for db, m := range s.migrators {
+		err := m.Stop()
+		if err != nil {
+			s.Logger.Warningf("Error stopping value tiering migrator for '%s'. Reason: %v", db, err)
+		} else {
+			delete(s.migrators, db)
+		}
+	}
+}
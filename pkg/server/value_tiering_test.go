@@ -0,0 +1,182 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/tiering"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerValueTiering(t *testing.T) {
+	dir := t.TempDir()
+	coldDir := filepath.Join(t.TempDir(), "cold")
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithAuth(true).
+		WithPort(0)
+
+	s, closer := testServer(serverOptions)
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	r := &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	}
+
+	ctx := context.Background()
+	lr, err := s.Login(ctx, r)
+	require.NoError(t, err)
+
+	md := metadata.Pairs("authorization", lr.Token)
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+
+	newdb := &schema.CreateDatabaseRequest{
+		Name: "db",
+		Settings: &schema.DatabaseNullableSettings{
+			FileSize: &schema.NullableUint32{Value: 64},
+			ValueTieringSettings: &schema.ValueTieringNullableSettings{
+				ColdStorageDir:     &schema.NullableString{Value: coldDir},
+				HotRetentionPeriod: &schema.NullableMilliseconds{Value: 1},
+				MigrationFrequency: &schema.NullableMilliseconds{Value: (24 * time.Hour).Milliseconds()},
+			},
+		},
+	}
+
+	_, err = s.CreateDatabaseV2(ctx, newdb)
+	require.NoError(t, err)
+
+	_, err = s.UpdateDatabaseV2(ctx, &schema.UpdateDatabaseRequest{
+		Database: "db",
+		Settings: &schema.DatabaseNullableSettings{
+			ValueTieringSettings: &schema.ValueTieringNullableSettings{
+				ColdStorageDir: &schema.NullableString{Value: t.TempDir()},
+			},
+		},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	db, err := s.dbList.GetByName("db")
+	require.NoError(t, err)
+
+	dbOpts, err := s.loadDBOptions("db", false)
+	require.NoError(t, err)
+
+	err = s.startMigratorFor(db, dbOpts)
+	require.ErrorIs(t, err, tiering.ErrMigratorAlreadyRunning)
+
+	for i := 0; i < 10; i++ {
+		_, err = db.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{
+				Key:   []byte(fmt.Sprintf("key%d", i)),
+				Value: []byte(fmt.Sprintf("value%d_0123456789", i)),
+			}},
+		})
+		require.NoError(t, err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	n, err := s.migrators["db"].Migrate(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, n, 0)
+
+	coldFiles, err := os.ReadDir(filepath.Join(coldDir, "db", "val_0"))
+	require.NoError(t, err)
+	require.NotEmpty(t, coldFiles)
+
+	for i := 0; i < 10; i++ {
+		e, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte(fmt.Sprintf("key%d", i))})
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d_0123456789", i)), e.Value)
+	}
+
+	// disabling the tier policy stops the migrator
+	_, err = s.UpdateDatabaseV2(ctx, &schema.UpdateDatabaseRequest{
+		Database: "db",
+		Settings: &schema.DatabaseNullableSettings{
+			ValueTieringSettings: &schema.ValueTieringNullableSettings{
+				HotRetentionPeriod: &schema.NullableMilliseconds{Value: 0},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = s.stopMigratorFor("db")
+	require.ErrorIs(t, err, ErrMigratorNotInProgress)
+
+	err = s.startMigratorFor(db, dbOpts)
+	require.NoError(t, err)
+
+	s.stopValueTiering()
+
+	err = s.stopMigratorFor("db")
+	require.ErrorIs(t, err, ErrMigratorNotInProgress)
+}
+
+func TestServerDefaultDBValueTiering(t *testing.T) {
+	serverOptions := DefaultOptions().
+		WithDir(t.TempDir()).
+		WithMetricsServer(false).
+		WithPort(0).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithValueTieringOptions(DefaultValueTieringOptions().
+			WithColdStorageDir(filepath.Join(t.TempDir(), "cold")).
+			WithHotRetentionPeriod(time.Hour))
+
+	s, closer := testServer(serverOptions)
+	defer closer()
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	_, ok := s.migrators[DefaultDBName]
+	require.True(t, ok)
+
+	s.stopValueTiering()
+
+	t.Run("a relative cold storage dir should be rejected", func(t *testing.T) {
+		serverOptions := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithMetricsServer(false).
+			WithPort(0).
+			WithAdminPassword(auth.SysAdminPassword).
+			WithValueTieringOptions(DefaultValueTieringOptions().
+				WithColdStorageDir("cold"))
+
+		s, closer := testServer(serverOptions)
+		defer closer()
+
+		err := s.Initialize()
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
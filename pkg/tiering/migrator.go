@@ -0,0 +1,149 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tiering
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+var (
+	ErrMigratorAlreadyRunning = errors.New("value tiering migrator already running")
+	ErrMigratorAlreadyStopped = errors.New("value tiering migrator already stopped")
+)
+
+// Migrator periodically moves into the cold storage tier the values
+// of transactions committed before the hot retention period
+type Migrator struct {
+	mu sync.Mutex
+
+	hasStarted     bool
+	migrationMutex sync.Mutex
+
+	db database.DB
+
+	hotRetentionPeriod time.Duration
+	migrationFrequency time.Duration
+
+	logger logger.Logger
+
+	donech chan struct{}
+	stopch chan struct{}
+}
+
+func NewMigrator(
+	db database.DB,
+	hotRetentionPeriod time.Duration,
+	migrationFrequency time.Duration,
+	logger logger.Logger) *Migrator {
+
+	return &Migrator{
+		db:                 db,
+		logger:             logger,
+		donech:             make(chan struct{}),
+		stopch:             make(chan struct{}),
+		hotRetentionPeriod: hotRetentionPeriod,
+		migrationFrequency: migrationFrequency,
+	}
+}
+
+// Start triggers the migration periodically
+func (m *Migrator) Start() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.hasStarted {
+		return ErrMigratorAlreadyRunning
+	}
+
+	m.hasStarted = true
+
+	m.logger.Infof("starting value tiering migrator for database '%s' with hot retention period '%vs' and migration frequency '%vs'", m.db.GetName(), m.hotRetentionPeriod.Seconds(), m.migrationFrequency.Seconds())
+
+	go func() {
+		ticker := time.NewTicker(m.migrationFrequency)
+
+		for {
+			select {
+			case <-m.stopch:
+				ticker.Stop()
+				m.donech <- struct{}{}
+				return
+			case <-ticker.C:
+				_, err := m.Migrate(context.Background())
+				if err != nil {
+					m.logger.Errorf("failed to migrate values of database '%s' {err = %v}", m.db.GetName(), err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (m *Migrator) Stop() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.hasStarted {
+		return ErrMigratorAlreadyStopped
+	}
+
+	m.logger.Infof("Stopping value tiering migrator of database '%s'...", m.db.GetName())
+
+	m.stopch <- struct{}{}
+	<-m.donech
+
+	m.hasStarted = false
+
+	m.logger.Infof("Value tiering migrator for database '%s' successfully stopped", m.db.GetName())
+
+	return nil
+}
+
+// Migrate moves into the cold storage tier the value log chunks holding only values of transactions
+// committed before the hot retention period. Values remain readable.
+// The number of migrated chunks is returned.
+func (m *Migrator) Migrate(ctx context.Context) (int, error) {
+	m.migrationMutex.Lock()
+	defer m.migrationMutex.Unlock()
+
+	migrationTime := time.Now().Add(-m.hotRetentionPeriod)
+
+	hdr, err := m.db.FindTruncationPoint(ctx, migrationTime)
+	if errors.Is(err, database.ErrRetentionPeriodNotReached) {
+		m.logger.Infof("hot retention period not reached for migrating values of database '%s' at {ts = %v}", m.db.GetName(), migrationTime)
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := m.db.MigrateValuesUptoTx(ctx, hdr.Id)
+	if err != nil {
+		return n, err
+	}
+
+	m.logger.Infof("%d value log chunks of database '%s' migrated up to tx %d {ts = %v}", n, m.db.GetName(), hdr.Id, migrationTime)
+
+	return n, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tiering
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/tieredapp"
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/remotestorage/memory"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTieredDb(t *testing.T) (database.DB, *memory.Storage) {
+	coldStorage := memory.Open()
+
+	options := database.DefaultOptions().WithDBRootPath(t.TempDir())
+
+	so := options.GetStoreOptions().
+		WithEmbeddedValues(false).
+		WithMaxIOConcurrency(1).
+		WithFileSize(16).
+		WithVLogCacheSize(0).
+		WithSynced(false).
+		WithVLogAppFactory(func(rootPath, subPath string, opts *multiapp.Options) (appendable.Appendable, error) {
+			tieredOpts := tieredapp.DefaultOptions()
+			tieredOpts.Options = *opts
+
+			return tieredapp.Open(filepath.Join(rootPath, subPath), subPath+"/", coldStorage, tieredOpts)
+		})
+	options.WithStoreOptions(so)
+
+	db, err := database.NewDB("db", nil, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := db.Close()
+		if !t.Failed() {
+			require.NoError(t, err)
+		}
+	})
+
+	return db, coldStorage
+}
+
+func TestMigrator(t *testing.T) {
+	db, _ := makeTieredDb(t)
+
+	m := NewMigrator(db, 0, 10*time.Millisecond, logger.NewSimpleLogger("immudb ", os.Stderr))
+
+	err := m.Stop()
+	require.ErrorIs(t, err, ErrMigratorAlreadyStopped)
+
+	err = m.Start()
+	require.NoError(t, err)
+
+	err = m.Start()
+	require.ErrorIs(t, err, ErrMigratorAlreadyRunning)
+
+	time.Sleep(15 * time.Millisecond)
+
+	err = m.Stop()
+	require.NoError(t, err)
+}
+
+func TestMigrator_Migrate(t *testing.T) {
+	db, coldStorage := makeTieredDb(t)
+
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		_, err := db.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{
+			Key:   []byte(fmt.Sprintf("key_%d", i)),
+			Value: []byte(fmt.Sprintf("val_%d_0123456789", i)),
+		}}})
+		require.NoError(t, err)
+	}
+
+	// values are kept in the hot tier during the hot retention period
+	n, err := NewMigrator(db, time.Hour, time.Hour, logger.NewMemoryLogger()).Migrate(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	n, err = NewMigrator(db, 0, time.Hour, logger.NewMemoryLogger()).Migrate(ctx)
+	require.NoError(t, err)
+	require.Positive(t, n)
+
+	entries, _, err := coldStorage.ListEntries(ctx, "val_0/")
+	require.NoError(t, err)
+	require.Len(t, entries, n)
+
+	for i := 0; i < 10; i++ {
+		item, err := db.Get(ctx, &schema.KeyRequest{Key: []byte(fmt.Sprintf("key_%d", i))})
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("val_%d_0123456789", i)), item.Value)
+	}
+}
@@ -57,11 +57,22 @@ func VerifyDocument(ctx context.Context,
 		return nil, err
 	}
 
+	txHdr := schema.TxHeaderFromProto(proof.VerifiableTx.Tx.Header)
+
+	hashAlgorithm := txHdr.HashAlgorithm
+	if !hashAlgorithm.IsValid() {
+		return nil, fmt.Errorf("%w: unsupported hash algorithm", store.ErrInvalidProof)
+	}
+
+	if knownState != nil && knownState.TxId > 0 && schema.HashAlgorithmFromProto(knownState.HashAlgorithm) != hashAlgorithm {
+		return nil, fmt.Errorf("%w: hash algorithm does not match knownState", store.ErrInvalidProof)
+	}
+
 	var keyFound int
 
 	for _, txEntry := range proof.VerifiableTx.Tx.Entries {
 		if bytes.Equal(txEntry.Key, encDocKey) {
-			hVal := sha256.Sum256(proof.EncodedDocument)
+			hVal := hashAlgorithm.Sum(proof.EncodedDocument)
 
 			if !bytes.Equal(hVal[:], txEntry.HValue) {
 				return nil, store.ErrInvalidProof
@@ -108,12 +119,12 @@ func VerifyDocument(ctx context.Context,
 
 	entries := proof.VerifiableTx.Tx.Entries
 
-	htree, err := htree.New(len(entries))
+	htree, err := htree.NewWith(len(entries), hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(int(proof.VerifiableTx.Tx.Header.Version))
+	entrySpecDigest, err := store.EntrySpecDigestWith(int(proof.VerifiableTx.Tx.Header.Version), hashAlgorithm)
 	if err != nil {
 		return nil, err
 	}
@@ -135,8 +146,6 @@ func VerifyDocument(ctx context.Context,
 		return nil, err
 	}
 
-	txHdr := schema.TxHeaderFromProto(proof.VerifiableTx.Tx.Header)
-
 	if htree.Root() != txHdr.Eh {
 		return nil, store.ErrInvalidProof
 	}
@@ -150,8 +159,15 @@ func VerifyDocument(ctx context.Context,
 		return nil, fmt.Errorf("%w: source tx is newer than target tx", store.ErrInvalidProof)
 	}
 
-	sourceAlh := schema.TxHeaderFromProto(proof.VerifiableTx.DualProof.SourceTxHeader).Alh()
-	targetAlh := schema.TxHeaderFromProto(proof.VerifiableTx.DualProof.TargetTxHeader).Alh()
+	sourceHdr := schema.TxHeaderFromProto(proof.VerifiableTx.DualProof.SourceTxHeader)
+	targetHdr := schema.TxHeaderFromProto(proof.VerifiableTx.DualProof.TargetTxHeader)
+
+	if sourceHdr.HashAlgorithm != hashAlgorithm || targetHdr.HashAlgorithm != hashAlgorithm {
+		return nil, fmt.Errorf("%w: hash algorithm does not match the one of the transaction", store.ErrInvalidProof)
+	}
+
+	sourceAlh := sourceHdr.Alh()
+	targetAlh := targetHdr.Alh()
 
 	if txHdr.ID != sourceID && txHdr.ID != targetID {
 		return nil, fmt.Errorf("%w: tx must match source or target tx headers", store.ErrInvalidProof)
@@ -195,10 +211,11 @@ func VerifyDocument(ctx context.Context,
 	}
 
 	state := &schema.ImmutableState{
-		Db:        proof.Database,
-		TxId:      targetID,
-		TxHash:    targetAlh[:],
-		Signature: proof.VerifiableTx.Signature,
+		Db:            proof.Database,
+		TxId:          targetID,
+		TxHash:        targetAlh[:],
+		Signature:     proof.VerifiableTx.Signature,
+		HashAlgorithm: schema.HashAlgorithmToProto(hashAlgorithm),
 	}
 
 	if serverSigningPubKey != nil {